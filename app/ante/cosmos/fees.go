@@ -13,8 +13,10 @@ import (
 )
 
 // DeductFeeDecorator deducts fees from the first signer of the tx.
+// The gas covered by the gas quota of the fee payer is not charged, and the
+// fees given in fee tokens are valued and paid in the EVM denom.
 // If the first signer does not have the funds to pay for the fees,
-// and does not have enough unclaimed staking rewards, then return
+// and does not have enough unclaimed staking rewards or fee tokens, then return
 // with InsufficientFunds error.
 // The next AnteHandler is called if fees are successfully deducted.
 //
@@ -26,6 +28,8 @@ type DeductFeeDecorator struct {
 	distributionKeeper anteutils.DistributionKeeper
 	feegrantKeeper     authante.FeegrantKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	gasQuotaKeeper     anteutils.GasQuotaKeeper
+	feeAbsKeeper       anteutils.FeeAbsKeeper
 	txFeeChecker       anteutils.TxFeeChecker
 }
 
@@ -36,6 +40,8 @@ func NewDeductFeeDecorator(
 	dk anteutils.DistributionKeeper,
 	fk authante.FeegrantKeeper,
 	sk anteutils.StakingKeeper,
+	gqk anteutils.GasQuotaKeeper,
+	fak anteutils.FeeAbsKeeper,
 	tfc anteutils.TxFeeChecker,
 ) DeductFeeDecorator {
 	if tfc == nil {
@@ -48,12 +54,14 @@ func NewDeductFeeDecorator(
 		distributionKeeper: dk,
 		feegrantKeeper:     fk,
 		stakingKeeper:      sk,
+		gasQuotaKeeper:     gqk,
+		feeAbsKeeper:       fak,
 		txFeeChecker:       tfc,
 	}
 }
 
 // AnteHandle ensures that the transaction contains valid fee requirements and tries to deduct those
-// from the account balance or unclaimed staking rewards, which the transaction sender might have.
+// from the account balance, unclaimed staking rewards or fee tokens, which the transaction sender might have.
 func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	feeTx, ok := tx.(sdk.FeeTx)
 	if !ok {
@@ -78,25 +86,26 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 		}
 	}
 
-	// zero fee check for validators and delegators
+	// the fees given in fee tokens are paid in EVM denom, swapping the fee
+	// tokens of the fee payer if its balance is not sufficient
+	fee, err = dfd.feeAbsKeeper.ConvertFeesToEVMDenom(ctx, fee)
+	if err != nil {
+		return ctx, err
+	}
+
+	// the gas allowance of the fee payer covers the fees first
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
-	_, check := dfd.stakingKeeper.GetValidator(ctx, feePayer.Bytes())
-	delegator := dfd.stakingKeeper.GetDelegatorDelegations(ctx, feePayer.Bytes(), 10)
+	fee, coveredGas := dfd.gasQuotaKeeper.SubsidizeFees(ctx, feePayer, fee, feeTx.GetGas())
 
-	// Check if the fee payer is a validator or delegator and set the fee to 0 if true.
-	if check || len(delegator) != 0 {
-		fee = sdk.NewCoins()
-
-	}
-
-	// The feePayer is neither a validator nor a delegator, process the fee as usual.
 	if err = dfd.deductFee(ctx, tx, fee, feePayer, feeGranter); err != nil {
-
 		return ctx, err
 	}
 
-	newCtx := ctx.WithPriority(priority)
+	// the deducted fees are used by the post handler to distribute the fee
+	// revenue and the covered gas to credit back the unused allowance
+	newCtx := anteutils.WithDeductedFees(ctx.WithPriority(priority), fee)
+	newCtx = anteutils.WithCoveredGas(newCtx, coveredGas)
 
 	return next(newCtx, tx, simulate)
 }
@@ -139,7 +148,7 @@ func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, fees sdk.
 
 	// deduct the fees
 	if err := deductFeesFromBalanceOrUnclaimedStakingRewards(ctx, dfd, deductFeesFromAcc, fees); err != nil {
-		return fmt.Errorf("%q has insufficient funds and failed to claim sufficient staking rewards to pay for fees: %w", deductFeesFrom.String(), err)
+		return fmt.Errorf("%q has insufficient funds and failed to claim sufficient staking rewards or swap sufficient fee tokens to pay for fees: %w", deductFeesFrom.String(), err)
 	}
 
 	events := sdk.Events{
@@ -155,14 +164,22 @@ func (dfd DeductFeeDecorator) deductFee(ctx sdk.Context, sdkTx sdk.Tx, fees sdk.
 }
 
 // deductFeesFromBalanceOrUnclaimedStakingRewards tries to deduct the fees from the account balance.
-// If the account balance is not enough, it tries to claim enough staking rewards to cover the fees.
+// If the account balance is not enough, it tries to claim enough staking rewards or else to swap
+// enough fee tokens to cover the fees.
 func deductFeesFromBalanceOrUnclaimedStakingRewards(
 	ctx sdk.Context, dfd DeductFeeDecorator, deductFeesFromAcc authtypes.AccountI, fees sdk.Coins,
 ) error {
 	if err := anteutils.ClaimStakingRewardsIfNecessary(
 		ctx, dfd.bankKeeper, dfd.distributionKeeper, dfd.stakingKeeper, deductFeesFromAcc.GetAddress(), fees,
 	); err != nil {
-		return err
+		// the fee tokens cover the fees when the staking rewards don't
+		swapped, _, swapErr := dfd.feeAbsKeeper.SwapFeeTokensIfNecessary(ctx, deductFeesFromAcc.GetAddress(), fees)
+		if swapErr != nil {
+			return swapErr
+		}
+		if swapped.IsZero() {
+			return err
+		}
 	}
 
 	return authante.DeductFees(dfd.bankKeeper, ctx, deductFeesFromAcc, fees)
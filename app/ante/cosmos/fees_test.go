@@ -5,10 +5,14 @@ import (
 
 	"cosmossdk.io/math"
 	cosmosante "github.com/anryton/anryton/v2/app/ante/cosmos"
+	anteutils "github.com/anryton/anryton/v2/app/ante/utils"
 	"github.com/anryton/anryton/v2/testutil"
 	testutiltx "github.com/anryton/anryton/v2/testutil/tx"
 	"github.com/anryton/anryton/v2/utils"
+	feeabstypes "github.com/anryton/anryton/v2/x/feeabs/types"
+	sdktestutil "github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/feegrant"
 )
 
@@ -269,7 +273,7 @@ func (suite *AnteTestSuite) TestDeductFeeDecorator() {
 
 				// remove the feegrant keeper from the decorator
 				dfd = cosmosante.NewDeductFeeDecorator(
-					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, nil, suite.app.StakingKeeper, nil,
+					suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, nil, suite.app.StakingKeeper, suite.app.GasQuotaKeeper, suite.app.FeeAbsKeeper, nil,
 				)
 			},
 		},
@@ -310,3 +314,63 @@ func (suite *AnteTestSuite) TestDeductFeeDecorator() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestDeductFeeDecoratorFeeTokens() {
+	addr, priv := testutiltx.NewAccAddressAndKey()
+	feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	// 1uatom is worth 2 EVM denom, so the 500uatom of fees are worth 1000 EVM denom
+	feeToken := feeabstypes.NewGovernanceFeeToken("uatom", sdk.NewDec(2))
+	fees := sdk.NewCoins(sdk.NewInt64Coin(feeToken.Denom, 500))
+	evmFees := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1000))
+
+	testCases := []struct {
+		name        string
+		reserve     int64
+		expPass     bool
+		errContains string
+	}{
+		{"fail - empty reserve", 0, false, "insufficient"},
+		{"pass - fee tokens swapped into the EVM denom", 10_000, true, ""},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			dfd := cosmosante.NewDeductFeeDecorator(
+				suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.FeeGrantKeeper, suite.app.StakingKeeper, suite.app.GasQuotaKeeper, suite.app.FeeAbsKeeper, nil,
+			)
+
+			err := suite.app.FeeAbsKeeper.SetParams(suite.ctx, feeabstypes.NewParams(true, []feeabstypes.FeeToken{feeToken}))
+			suite.Require().NoError(err)
+			err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(feeToken.Denom, 600)))
+			suite.Require().NoError(err)
+			if tc.reserve > 0 {
+				reserve := sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, tc.reserve))
+				suite.Require().NoError(testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, feeabstypes.ModuleName, reserve))
+			}
+			collected := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector)
+
+			tx, err := testutiltx.PrepareCosmosTx(suite.ctx, suite.app, testutiltx.CosmosTxArgs{
+				TxCfg: suite.clientCtx.TxConfig,
+				Priv:  priv,
+				Gas:   1000,
+				Fees:  fees,
+				Msgs:  []sdk.Msg{sdktestutil.NewTestMsg(addr)},
+			})
+			suite.Require().NoError(err)
+
+			ctx, err := dfd.AnteHandle(suite.ctx.WithIsCheckTx(false), tx, false, testutil.NextFn)
+			if !tc.expPass {
+				suite.Require().ErrorContains(err, tc.errContains)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the fee collector receives the fees in the EVM denom
+			suite.Require().Equal(collected.Add(evmFees...), suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector))
+			suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(feeToken.Denom, 100)), suite.app.BankKeeper.GetAllBalances(suite.ctx, addr))
+			suite.Require().Equal(evmFees, anteutils.DeductedFees(ctx))
+		})
+	}
+}
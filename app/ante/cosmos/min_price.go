@@ -5,6 +5,7 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 	evmante "github.com/anryton/anryton/v2/app/ante/evm"
+	anteutils "github.com/anryton/anryton/v2/app/ante/utils"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 )
@@ -12,17 +13,19 @@ import (
 // MinGasPriceDecorator will check if the transaction's fee is at least as large
 // as the MinGasPrices param. If fee is too low, decorator returns error and tx
 // is rejected. This applies for both CheckTx and DeliverTx
+// The fee tokens provided as fee are valued in the EVM denom at their current rates.
 // If fee is high enough, then call next AnteHandler
 // CONTRACT: Tx must implement FeeTx to use MinGasPriceDecorator
 type MinGasPriceDecorator struct {
-	feesKeeper evmante.FeeMarketKeeper
-	evmKeeper  evmante.EVMKeeper
+	feesKeeper   evmante.FeeMarketKeeper
+	evmKeeper    evmante.EVMKeeper
+	feeAbsKeeper anteutils.FeeAbsKeeper
 }
 
 // NewMinGasPriceDecorator creates a new MinGasPriceDecorator instance used only for
 // Cosmos transactions.
-func NewMinGasPriceDecorator(fk evmante.FeeMarketKeeper, ek evmante.EVMKeeper) MinGasPriceDecorator {
-	return MinGasPriceDecorator{feesKeeper: fk, evmKeeper: ek}
+func NewMinGasPriceDecorator(fk evmante.FeeMarketKeeper, ek evmante.EVMKeeper, fak anteutils.FeeAbsKeeper) MinGasPriceDecorator {
+	return MinGasPriceDecorator{feesKeeper: fk, evmKeeper: ek, feeAbsKeeper: fak}
 }
 
 func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
@@ -46,7 +49,10 @@ func (mpd MinGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 		},
 	}
 
-	feeCoins := feeTx.GetFee()
+	feeCoins, err := mpd.feeAbsKeeper.ConvertFeesToEVMDenom(ctx, feeTx.GetFee())
+	if err != nil {
+		return ctx, err
+	}
 	gas := feeTx.GetGas()
 
 	requiredFees := make(sdk.Coins, 0)
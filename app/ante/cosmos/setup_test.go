@@ -118,10 +118,12 @@ func (suite *AnteTestSuite) SetupTest() {
 		StakingKeeper:          suite.app.StakingKeeper,
 		IBCKeeper:              suite.app.IBCKeeper,
 		FeeMarketKeeper:        suite.app.FeeMarketKeeper,
+		GasQuotaKeeper:         suite.app.GasQuotaKeeper,
+		FeeAbsKeeper:           suite.app.FeeAbsKeeper,
 		SignModeHandler:        encodingConfig.TxConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		ExtensionOptionChecker: types.HasDynamicFeeExtensionOption,
-		TxFeeChecker:           evmante.NewDynamicFeeChecker(suite.app.EvmKeeper),
+		TxFeeChecker:           evmante.NewDynamicFeeChecker(suite.app.EvmKeeper, suite.app.FeeAbsKeeper),
 	})
 
 	suite.anteHandler = anteHandler
@@ -0,0 +1,75 @@
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+// EthConditionalDecorator rejects conditional ethereum transactions whose
+// inclusion conditions (block number and timestamp ranges, known account
+// storage) are not met by the current block. It runs before the fees are
+// deducted so that stale transactions don't pay for gas, and on ReCheckTx so
+// that they are evicted from the mempool.
+type EthConditionalDecorator struct {
+	evmKeeper EVMKeeper
+}
+
+// NewEthConditionalDecorator creates a new EthConditionalDecorator
+func NewEthConditionalDecorator(ek EVMKeeper) EthConditionalDecorator {
+	return EthConditionalDecorator{
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle checks the conditions of the ExtensionOptionsEthereumTxConditional
+// extension option, if present. Transactions without it are passed through. The
+// conditions must be signed by the sender of the ethereum tx, so that they
+// cannot be altered by the node relaying it.
+func (ecd EthConditionalDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	opt := getExtensionOption(tx, conditionalTypeURL)
+	if opt == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	conditional, ok := opt.GetCachedValue().(*evmtypes.ExtensionOptionsEthereumTxConditional)
+	if !ok {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrUnknownExtensionOptions,
+			"invalid extension option type %T, expected %T", opt.GetCachedValue(), (*evmtypes.ExtensionOptionsEthereumTxConditional)(nil),
+		)
+	}
+
+	if err := conditional.Validate(); err != nil {
+		return ctx, err
+	}
+
+	// the sender is recovered here as the conditions are checked before the
+	// signature verification, to reject the stale txs early
+	signer := ethtypes.LatestSignerForChainID(ecd.evmKeeper.ChainID())
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		ethTx := msgEthTx.AsTransaction()
+		sender, err := signer.Sender(ethTx)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(errortypes.ErrorInvalidSigner, "couldn't retrieve sender address from the ethereum transaction: %s", err.Error())
+		}
+
+		if err := conditional.VerifySignature(ethTx.Hash(), sender); err != nil {
+			return ctx, errorsmod.Wrap(err, "invalid conditions signature")
+		}
+	}
+
+	if err := conditional.CheckConditions(ctx, ecd.evmKeeper); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,205 @@
+package evm_test
+
+import (
+	"math/big"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmante "github.com/anryton/anryton/v2/app/ante/evm"
+	"github.com/anryton/anryton/v2/testutil"
+	testutiltx "github.com/anryton/anryton/v2/testutil/tx"
+	"github.com/anryton/anryton/v2/utils"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestEthConditionalDecorator() {
+	addr, priv := testutiltx.NewAddrKey()
+	_, otherPriv := testutiltx.NewAddrKey()
+	contract := testutiltx.GenerateAddress()
+	slot := common.BytesToHash([]byte{1})
+	value := common.BytesToHash([]byte{2})
+
+	testCases := []struct {
+		name        string
+		conditional *evmtypes.ExtensionOptionsEthereumTxConditional
+		// malleate alters the conditions once signed by the sender
+		malleate func(conditional *evmtypes.ExtensionOptionsEthereumTxConditional, sigHash []byte)
+		expErr   error
+	}{
+		{
+			"pass - no conditions",
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"pass - empty conditions",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{},
+			nil,
+			nil,
+		},
+		{
+			"pass - block number and timestamp in range",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{
+				BlockNumberMin: 1,
+				BlockNumberMax: uint64(suite.ctx.BlockHeight()),
+				TimestampMin:   uint64(suite.ctx.BlockTime().Unix()),
+				TimestampMax:   uint64(suite.ctx.BlockTime().Unix()) + 10,
+			},
+			nil,
+			nil,
+		},
+		{
+			"fail - invalid conditions",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{
+				BlockNumberMin: 10,
+				BlockNumberMax: 1,
+			},
+			nil,
+			evmtypes.ErrConditionalTxRejected,
+		},
+		{
+			"fail - block number lower than min",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{
+				BlockNumberMin: uint64(suite.ctx.BlockHeight()) + 1,
+			},
+			nil,
+			evmtypes.ErrConditionalTxRejected,
+		},
+		{
+			"fail - block number greater than max",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{
+				BlockNumberMax: uint64(suite.ctx.BlockHeight()) - 1,
+			},
+			nil,
+			evmtypes.ErrConditionalTxRejected,
+		},
+		{
+			"fail - timestamp greater than max",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{
+				TimestampMax: uint64(suite.ctx.BlockTime().Unix()) - 1,
+			},
+			nil,
+			evmtypes.ErrConditionalTxRejected,
+		},
+		{
+			"pass - known account storage matches",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{
+				KnownAccounts: []evmtypes.KnownAccount{
+					{Address: contract.Hex(), Storage: evmtypes.Storage{evmtypes.NewState(slot, value)}},
+				},
+			},
+			nil,
+			nil,
+		},
+		{
+			"fail - known account storage mismatch",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{
+				KnownAccounts: []evmtypes.KnownAccount{
+					{Address: contract.Hex(), Storage: evmtypes.Storage{evmtypes.NewState(slot, common.BytesToHash([]byte{3}))}},
+				},
+			},
+			nil,
+			evmtypes.ErrConditionalTxRejected,
+		},
+		{
+			"fail - unsigned conditions",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{},
+			func(conditional *evmtypes.ExtensionOptionsEthereumTxConditional, _ []byte) {
+				conditional.Signature = nil
+			},
+			errortypes.ErrNoSignatures,
+		},
+		{
+			"fail - conditions altered after signing",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{
+				BlockNumberMax: uint64(suite.ctx.BlockHeight()),
+			},
+			func(conditional *evmtypes.ExtensionOptionsEthereumTxConditional, _ []byte) {
+				conditional.BlockNumberMax++
+			},
+			errortypes.ErrUnauthorized,
+		},
+		{
+			"fail - conditions signed by another account",
+			&evmtypes.ExtensionOptionsEthereumTxConditional{},
+			func(conditional *evmtypes.ExtensionOptionsEthereumTxConditional, sigHash []byte) {
+				var err error
+				conditional.Signature, err = otherPriv.Sign(sigHash)
+				suite.Require().NoError(err)
+			},
+			errortypes.ErrUnauthorized,
+		},
+	}
+
+	// newSignedTx builds a tx signed by the sender, along with its conditions
+	newSignedTx := func() (*evmtypes.MsgEthereumTx, keyring.Signer) {
+		msg := suite.BuildTestEthTx(addr, contract, big.NewInt(10), nil, big.NewInt(1), nil, nil, nil)
+		signer := testutiltx.NewSigner(priv)
+		suite.Require().NoError(msg.Sign(ethtypes.LatestSignerForChainID(suite.app.EvmKeeper.ChainID()), signer))
+		return msg, signer
+	}
+
+	signConditional := func(msg *evmtypes.MsgEthereumTx, signer keyring.Signer, conditional *evmtypes.ExtensionOptionsEthereumTxConditional) []byte {
+		sigHash, err := conditional.SigHash(msg.AsTransaction().Hash())
+		suite.Require().NoError(err)
+		conditional.Signature, _, err = signer.SignByAddress(sdk.AccAddress(addr.Bytes()), sigHash)
+		suite.Require().NoError(err)
+		return sigHash
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.app.EvmKeeper.SetState(suite.ctx, contract, slot, value.Bytes())
+
+			msg, signer := newSignedTx()
+			evmDenom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+			var (
+				tx  sdk.Tx
+				err error
+			)
+			if tc.conditional != nil {
+				sigHash := signConditional(msg, signer, tc.conditional)
+				if tc.malleate != nil {
+					tc.malleate(tc.conditional, sigHash)
+				}
+
+				tx, err = msg.BuildConditionalTx(suite.clientCtx.TxConfig.NewTxBuilder(), evmDenom, tc.conditional)
+			} else {
+				tx, err = msg.BuildTx(suite.clientCtx.TxConfig.NewTxBuilder(), evmDenom)
+			}
+			suite.Require().NoError(err)
+
+			dec := evmante.NewEthConditionalDecorator(suite.app.EvmKeeper)
+			_, err = dec.AnteHandle(suite.ctx, tx, false, testutil.NextFn)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+	suite.Run("the conditions are checked on sponsored conditional txs", func() {
+		suite.SetupTest()
+
+		msg, signer := newSignedTx()
+		conditional := &evmtypes.ExtensionOptionsEthereumTxConditional{
+			BlockNumberMin: uint64(suite.ctx.BlockHeight()) + 1,
+		}
+		signConditional(msg, signer, conditional)
+		sponsored := &evmtypes.ExtensionOptionsEthereumTxSponsored{FeeGranter: sdk.AccAddress(contract.Bytes()).String()}
+
+		tx, err := msg.BuildSponsoredTx(suite.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom, sponsored, conditional)
+		suite.Require().NoError(err)
+
+		dec := evmante.NewEthConditionalDecorator(suite.app.EvmKeeper)
+		_, err = dec.AnteHandle(suite.ctx, tx, false, testutil.NextFn)
+		suite.Require().ErrorIs(err, evmtypes.ErrConditionalTxRejected)
+	})
+}
@@ -22,19 +22,27 @@ import (
 
 // EthAccountVerificationDecorator validates an account balance checks
 type EthAccountVerificationDecorator struct {
-	ak        evmtypes.AccountKeeper
-	evmKeeper EVMKeeper
+	ak           evmtypes.AccountKeeper
+	evmKeeper    EVMKeeper
+	feeAbsKeeper anteutils.FeeAbsKeeper
 }
 
 // NewEthAccountVerificationDecorator creates a new EthAccountVerificationDecorator
-func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek EVMKeeper) EthAccountVerificationDecorator {
+func NewEthAccountVerificationDecorator(
+	ak evmtypes.AccountKeeper,
+	ek EVMKeeper,
+	fak anteutils.FeeAbsKeeper,
+) EthAccountVerificationDecorator {
 	return EthAccountVerificationDecorator{
-		ak:        ak,
-		evmKeeper: ek,
+		ak:           ak,
+		evmKeeper:    ek,
+		feeAbsKeeper: fak,
 	}
 }
 
-// AnteHandle validates checks that the sender balance is greater than the total transaction cost.
+// AnteHandle validates checks that the sender balance is greater than the total transaction cost,
+// or than the transferred value if the fees are paid by a fee granter. The fee tokens of the sender
+// that can be swapped to pay the fees are included in the balance.
 // The account will be set to store if it doesn't exist, i.e. cannot be found on store.
 // This AnteHandler decorator will fail if:
 // - any of the msgs is not a MsgEthereumTx
@@ -80,8 +88,29 @@ func (avd EthAccountVerificationDecorator) AnteHandle(
 				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
 		}
 
-		if err := keeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance), txData); err != nil {
-			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+		feeGranter, err := getFeeGranter(tx, msgEthTx)
+		if err != nil {
+			return ctx, err
+		}
+
+		// the fees of sponsored txs are checked against the fee allowance
+		if feeGranter != nil {
+			if acct.Balance.Cmp(txData.GetValue()) < 0 {
+				return ctx, errorsmod.Wrapf(
+					errortypes.ErrInsufficientFunds,
+					"failed to check sender balance: sender balance < tx value (%s < %s)", acct.Balance, txData.GetValue(),
+				)
+			}
+			continue
+		}
+
+		balance := sdkmath.NewIntFromBigInt(acct.Balance)
+		if err := keeper.CheckSenderBalance(balance, txData); err != nil {
+			// the fees can be paid with the fee tokens of the sender
+			balance = balance.Add(avd.feeAbsKeeper.GetSwappableAmount(ctx, from))
+			if err := keeper.CheckSenderBalance(balance, txData); err != nil {
+				return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+			}
 		}
 	}
 	return next(ctx, tx, simulate)
@@ -94,6 +123,9 @@ type EthGasConsumeDecorator struct {
 	distributionKeeper anteutils.DistributionKeeper
 	evmKeeper          EVMKeeper
 	stakingKeeper      anteutils.StakingKeeper
+	gasQuotaKeeper     anteutils.GasQuotaKeeper
+	feeGrantKeeper     FeeGrantKeeper
+	feeAbsKeeper       anteutils.FeeAbsKeeper
 	maxGasWanted       uint64
 }
 
@@ -103,6 +135,9 @@ func NewEthGasConsumeDecorator(
 	distributionKeeper anteutils.DistributionKeeper,
 	evmKeeper EVMKeeper,
 	stakingKeeper anteutils.StakingKeeper,
+	gasQuotaKeeper anteutils.GasQuotaKeeper,
+	feeGrantKeeper FeeGrantKeeper,
+	feeAbsKeeper anteutils.FeeAbsKeeper,
 	maxGasWanted uint64,
 ) EthGasConsumeDecorator {
 	return EthGasConsumeDecorator{
@@ -110,14 +145,19 @@ func NewEthGasConsumeDecorator(
 		distributionKeeper,
 		evmKeeper,
 		stakingKeeper,
+		gasQuotaKeeper,
+		feeGrantKeeper,
+		feeAbsKeeper,
 		maxGasWanted,
 	}
 }
 
 // AnteHandle validates that the Ethereum tx message has enough to cover intrinsic gas
 // (during CheckTx only) and that the sender has enough balance to pay for the gas cost.
-// If the balance is not sufficient, it will be attempted to withdraw enough staking rewards
-// for the payment.
+// The gas covered by the gas quota of the sender is not charged. The fees of sponsored txs are
+// paid by the fee granter named and signed by the sender. If the balance is not sufficient, it
+// will be attempted to withdraw enough staking rewards or else to swap enough fee tokens for the
+// payment.
 //
 // Intrinsic gas for a transaction is the amount of gas that the transaction uses before the
 // transaction is executed. The gas is a constant value plus any cost incurred by additional bytes
@@ -146,15 +186,6 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		return next(newCtx, tx, simulate)
 	}
 
-	//Zero Fee check for validator and delgators
-	feeTx, ok := tx.(sdk.FeeTx)
-	if !ok {
-		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
-	}
-	feePayer := feeTx.FeePayer()
-	_, check := egcd.stakingKeeper.GetValidator(ctx, feePayer.Bytes())
-	delegator := egcd.stakingKeeper.GetDelegatorDelegations(ctx, feePayer.Bytes(), 10)
-
 	evmParams := egcd.evmKeeper.GetParams(ctx)
 	evmDenom := evmParams.GetEvmDenom()
 	chainCfg := evmParams.GetChainConfig()
@@ -164,6 +195,9 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	homestead := ethCfg.IsHomestead(blockHeight)
 	istanbul := ethCfg.IsIstanbul(blockHeight)
 	var events sdk.Events
+	subsidizedGas := make(map[common.Hash]uint64)
+	feeTokenSwaps := make(map[common.Hash]evmtypes.FeeTokenSwap)
+	feePayers := make(map[common.Address]sdk.AccAddress)
 
 	// Use the lowest priority of all the messages as the final one.
 	minPriority := int64(math.MaxInt64)
@@ -193,24 +227,56 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		}
 
 		fees, err := keeper.VerifyFee(txData, evmDenom, baseFee, homestead, istanbul, ctx.IsCheckTx())
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
+		}
 
-		// Check if the fee payer is a validator or delegator and set the fee to 0 if true.
-		if check || len(delegator) != 0 {
-			fees = sdk.NewCoins()
+		// the gas allowance of the sender covers the fees first
+		fees, covered := egcd.gasQuotaKeeper.SubsidizeFees(ctx, from, fees, txData.GetGas())
+		if covered > 0 {
+			subsidizedGas[msgEthTx.AsTransaction().Hash()] = covered
 		}
 
-		if err != nil {
-			return ctx, errorsmod.Wrapf(err, "failed to verify the fees")
+		feePayer := from
+		if !fees.IsZero() {
+			feeGranter, err := useGrantedFees(ctx, egcd.feeGrantKeeper, tx, msgEthTx, fees)
+			if err != nil {
+				return ctx, err
+			}
+			if feeGranter != nil {
+				feePayer = feeGranter
+			}
+		}
+
+		// the leftover gas refunds are indexed by sender, so all the txs of a sender must share the fee payer
+		sender := common.BytesToAddress(from)
+		if payer, found := feePayers[sender]; found && !payer.Equals(feePayer) {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrInvalidRequest,
+				"ethereum txs of sender %s have different fee payers (%s, %s)", sender, payer, feePayer,
+			)
 		}
+		feePayers[sender] = feePayer
 
-		if err = egcd.deductFee(ctx, fees, from); err != nil {
+		// the base fee share of the fees paid at the effective gas price may be escrowed
+		gasPrice := txData.GetGasPrice()
+		if baseFee != nil {
+			gasPrice = txData.EffectiveGasPrice(baseFee)
+		}
+
+		swap, err := egcd.deductFee(ctx, fees, feePayer, gasPrice)
+		if err != nil {
 			return ctx, err
 		}
+		if !swap.AmountIn.IsZero() {
+			feeTokenSwaps[msgEthTx.AsTransaction().Hash()] = swap
+		}
 
 		events = append(events,
 			sdk.NewEvent(
 				sdk.EventTypeTx,
 				sdk.NewAttribute(sdk.AttributeKeyFee, fees.String()),
+				sdk.NewAttribute(sdk.AttributeKeyFeePayer, feePayer.String()),
 			),
 		)
 
@@ -249,30 +315,62 @@ func (egcd EthGasConsumeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		WithGasMeter(types.NewInfiniteGasMeterWithLimit(gasWanted)).
 		WithPriority(minPriority)
 
+	// the subsidized gas is not refunded by the EVM state transition
+	if len(subsidizedGas) > 0 {
+		newCtx = evmtypes.WithSubsidizedGas(newCtx, subsidizedGas)
+	}
+
+	// the leftover gas refunds of the fees paid with fee tokens are swapped back
+	if len(feeTokenSwaps) > 0 {
+		newCtx = evmtypes.WithFeeTokenSwaps(newCtx, feeTokenSwaps)
+	}
+
+	// the leftover gas of sponsored txs is refunded to the fee granter
+	feeGranters := make(map[common.Address]sdk.AccAddress)
+	for sender, feePayer := range feePayers {
+		if !feePayer.Equals(sdk.AccAddress(sender.Bytes())) {
+			feeGranters[sender] = feePayer
+		}
+	}
+	if len(feeGranters) > 0 {
+		newCtx = evmtypes.WithFeeGranters(newCtx, feeGranters)
+	}
+
 	// we know that we have enough gas on the pool to cover the intrinsic gas
 	return next(newCtx, tx, simulate)
 }
 
 // deductFee checks if the fee payer has enough funds to pay for the fees and deducts them.
-// If the spendable balance is not enough, it tries to claim enough staking rewards to cover the fees.
-func (egcd EthGasConsumeDecorator) deductFee(ctx sdk.Context, fees sdk.Coins, feePayer sdk.AccAddress) error {
+// If the spendable balance is not enough, it tries to claim enough staking rewards or else to
+// swap enough fee tokens to cover the fees. It returns the swap of fee tokens, if any.
+func (egcd EthGasConsumeDecorator) deductFee(ctx sdk.Context, fees sdk.Coins, feePayer sdk.AccAddress, gasPrice *big.Int) (evmtypes.FeeTokenSwap, error) {
+	swap := evmtypes.FeeTokenSwap{AmountIn: sdk.Coins{}, AmountOut: sdkmath.ZeroInt()}
 	if fees.IsZero() {
-		return nil
+		return swap, nil
 	}
 
 	// If the account balance is not sufficient, try to withdraw enough staking rewards
 	if err := anteutils.ClaimStakingRewardsIfNecessary(ctx, egcd.bankKeeper, egcd.distributionKeeper, egcd.stakingKeeper, feePayer, fees); err != nil {
-		return err
+		// If the staking rewards are not sufficient either, try to swap enough fee tokens
+		amountIn, amountOut, swapErr := egcd.feeAbsKeeper.SwapFeeTokensIfNecessary(ctx, feePayer, fees)
+		if swapErr != nil {
+			return swap, errorsmod.Wrap(swapErr, "failed to swap fee tokens")
+		}
+		if amountIn.IsZero() {
+			return swap, err
+		}
+		swap = evmtypes.FeeTokenSwap{AmountIn: amountIn, AmountOut: amountOut}
 	}
 
-	if err := egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(feePayer)); err != nil {
-		return errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
+	if err := egcd.evmKeeper.DeductTxCostsFromUserBalance(ctx, fees, common.BytesToAddress(feePayer), gasPrice); err != nil {
+		return swap, errorsmod.Wrapf(err, "failed to deduct transaction costs from user balance")
 	}
-	return nil
+	return swap, nil
 }
 
 // CanTransferDecorator checks if the sender is allowed to transfer funds according to the EVM block
-// context rules.
+// context rules, and if the access control of the EVM permits the sender and recipient of the
+// messages, so that the denied senders don't pay the fees of messages that can't be executed.
 type CanTransferDecorator struct {
 	evmKeeper EVMKeeper
 }
@@ -285,7 +383,8 @@ func NewCanTransferDecorator(evmKeeper EVMKeeper) CanTransferDecorator {
 }
 
 // AnteHandle creates an EVM from the message and calls the BlockContext CanTransfer function to
-// see if the address can execute the transaction.
+// see if the address can execute the transaction. The calls and contract creations made by the
+// contracts are only checked against the access control once executed.
 func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	params := ctd.evmKeeper.GetParams(ctx)
 	ethCfg := params.ChainConfig.EthereumConfig(ctd.evmKeeper.ChainID())
@@ -307,6 +406,10 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 			)
 		}
 
+		if err := params.AccessControl.CheckMessage(coreMsg.From(), coreMsg.To()); err != nil {
+			return ctx, err
+		}
+
 		if evmtypes.IsLondon(ethCfg, ctx.BlockHeight()) {
 			if baseFee == nil {
 				return ctx, errorsmod.Wrap(
@@ -351,20 +454,29 @@ func (ctd CanTransferDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate
 
 // EthIncrementSenderSequenceDecorator increments the sequence of the signers.
 type EthIncrementSenderSequenceDecorator struct {
-	ak evmtypes.AccountKeeper
+	ak      evmtypes.AccountKeeper
+	mempool Mempool
 }
 
 // NewEthIncrementSenderSequenceDecorator creates a new EthIncrementSenderSequenceDecorator.
-func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper) EthIncrementSenderSequenceDecorator {
+// The mempool is optional, without it CheckTx only accepts the txs using the
+// sequence of the sender.
+func NewEthIncrementSenderSequenceDecorator(ak evmtypes.AccountKeeper, mempool Mempool) EthIncrementSenderSequenceDecorator {
 	return EthIncrementSenderSequenceDecorator{
-		ak: ak,
+		ak:      ak,
+		mempool: mempool,
 	}
 }
 
 // AnteHandle handles incrementing the sequence of the signer (i.e. sender). If the transaction is a
 // contract creation, the nonce will be incremented during the transaction execution and not within
 // this AnteHandler decorator.
+//
+// On CheckTx, when the application mempool is set, the txs ahead of the sequence are accepted to be
+// queued by the mempool, and the txs below it are accepted to replace the mempool tx of the same nonce.
 func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	useMempool := ctx.IsCheckTx() && !simulate && issd.mempool != nil
+
 	for _, msg := range tx.GetMsgs() {
 		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
 		if !ok {
@@ -386,6 +498,21 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx s
 		}
 		nonce := acc.GetSequence()
 
+		if useMempool {
+			newNonce, err := issd.checkMempoolNonce(ctx, msgEthTx, txData.GetNonce(), nonce)
+			if err != nil {
+				return ctx, err
+			}
+			if newNonce == nonce {
+				continue
+			}
+			if err := acc.SetSequence(newNonce); err != nil {
+				return ctx, errorsmod.Wrapf(err, "failed to set sequence to %d", newNonce)
+			}
+			issd.ak.SetAccount(ctx, acc)
+			continue
+		}
+
 		// we merged the nonce verification to nonce increment, so when tx includes multiple messages
 		// with same sender, they'll be accepted.
 		if txData.GetNonce() != nonce {
@@ -404,3 +531,39 @@ func (issd EthIncrementSenderSequenceDecorator) AnteHandle(ctx sdk.Context, tx s
 
 	return next(ctx, tx, simulate)
 }
+
+// checkMempoolNonce checks the nonce of the tx against the sequence of the sender on
+// CheckTx and returns the new sequence. An executable tx increases the sequence past
+// the mempool txs following it, since they become executable too.
+func (issd EthIncrementSenderSequenceDecorator) checkMempoolNonce(
+	ctx sdk.Context,
+	msgEthTx *evmtypes.MsgEthereumTx,
+	txNonce, nonce uint64,
+) (uint64, error) {
+	sender := common.BytesToAddress(msgEthTx.GetFrom())
+
+	// on ReCheckTx, drop the txs evicted or replaced from the mempool
+	if ctx.IsReCheckTx() && !issd.mempool.HasTx(common.HexToHash(msgEthTx.Hash)) {
+		return nonce, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "tx %s is no longer in the mempool", msgEthTx.Hash)
+	}
+
+	switch {
+	case txNonce == nonce:
+		nonce++
+		for issd.mempool.HasNonce(sender, nonce) {
+			nonce++
+		}
+		return nonce, nil
+	case txNonce > nonce:
+		// queued until the nonce gap is filled
+		return nonce, nil
+	case issd.mempool.HasNonce(sender, txNonce):
+		// replaces the mempool tx of the same nonce
+		return nonce, nil
+	default:
+		return nonce, errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"invalid nonce; got %d, expected at least %d", txNonce, nonce,
+		)
+	}
+}
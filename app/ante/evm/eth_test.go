@@ -5,6 +5,7 @@ import (
 	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 
 	ethante "github.com/anryton/anryton/v2/app/ante/evm"
 	"github.com/anryton/anryton/v2/server/config"
@@ -14,13 +15,14 @@ import (
 	"github.com/anryton/anryton/v2/utils"
 	"github.com/anryton/anryton/v2/x/evm/statedb"
 	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	feeabstypes "github.com/anryton/anryton/v2/x/feeabs/types"
 
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 	dec := ethante.NewEthAccountVerificationDecorator(
-		suite.app.AccountKeeper, suite.app.EvmKeeper,
+		suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.FeeAbsKeeper,
 	)
 
 	addr := testutiltx.GenerateAddress()
@@ -116,7 +118,7 @@ func (suite *AnteTestSuite) TestNewEthAccountVerificationDecorator() {
 
 func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 	suite.SetupTest()
-	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, nil)
 
 	addr := testutiltx.GenerateAddress()
 
@@ -180,7 +182,7 @@ func (suite *AnteTestSuite) TestEthNonceVerificationDecorator() {
 
 func (suite *AnteTestSuite) TestEthGasConsumeDecorator() {
 	chainID := suite.app.EvmKeeper.ChainID()
-	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, config.DefaultMaxTxGasWanted)
+	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.GasQuotaKeeper, suite.app.FeeGrantKeeper, suite.app.FeeAbsKeeper, config.DefaultMaxTxGasWanted)
 
 	addr := testutiltx.GenerateAddress()
 
@@ -546,10 +548,42 @@ func (suite *AnteTestSuite) TestCanTransferDecorator() {
 			},
 			true,
 		},
+		{
+			"denied sender",
+			tx,
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				vmdb.AddBalance(addr, big.NewInt(1000000))
+
+				evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+				evmParams.AccessControl.Denylist = []string{addr.Hex()}
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+			},
+			false,
+		},
+		{
+			"sender not allowed to deploy contracts",
+			tx,
+			func() {
+				acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr.Bytes())
+				suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+				vmdb.AddBalance(addr, big.NewInt(1000000))
+
+				evmParams := suite.app.EvmKeeper.GetParams(suite.ctx)
+				evmParams.AccessControl.Create = evmtypes.AccessControlType{AccessType: evmtypes.AccessTypeAllowlist}
+				suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, evmParams))
+			},
+			false,
+		},
 	}
 
+	params := suite.app.EvmKeeper.GetParams(suite.ctx)
 	for _, tc := range testCases {
 		suite.Run(tc.name, func() {
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
 			vmdb = testutil.NewStateDB(suite.ctx, suite.app.EvmKeeper)
 			tc.malleate()
 			suite.Require().NoError(vmdb.Commit())
@@ -566,7 +600,7 @@ func (suite *AnteTestSuite) TestCanTransferDecorator() {
 }
 
 func (suite *AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
-	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper)
+	dec := ethante.NewEthIncrementSenderSequenceDecorator(suite.app.AccountKeeper, nil)
 	addr, privKey := testutiltx.NewAddrKey()
 
 	ethTxContractParamsNonce0 := &evmtypes.EvmTxArgs{
@@ -678,3 +712,91 @@ func (suite *AnteTestSuite) TestEthIncrementSenderSequenceDecorator() {
 		})
 	}
 }
+
+func (suite *AnteTestSuite) TestEthFeeTokens() {
+	verificationDec := ethante.NewEthAccountVerificationDecorator(
+		suite.app.AccountKeeper, suite.app.EvmKeeper, suite.app.FeeAbsKeeper,
+	)
+	gasDec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.GasQuotaKeeper, suite.app.FeeGrantKeeper, suite.app.FeeAbsKeeper, config.DefaultMaxTxGasWanted)
+
+	addr := testutiltx.GenerateAddress()
+	to := testutiltx.GenerateAddress()
+	reserve := sdk.AccAddress(feeabstypes.ModuleAddress.Bytes())
+
+	// the base fee is disabled, so the fees are the gas limit at a gas price of 1,
+	// worth half of the gas limit in uatom
+	feeToken := feeabstypes.NewGovernanceFeeToken("uatom", sdk.NewDec(2))
+	fees := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewIntFromUint64(TestGasLimit)))
+	feeTokens := sdk.NewCoins(sdk.NewCoin(feeToken.Denom, sdk.NewIntFromUint64(TestGasLimit/2)))
+
+	testCases := []struct {
+		name        string
+		feeTokens   sdk.Coins
+		reserve     sdk.Coins
+		expVerified bool
+		expPaid     bool
+	}{
+		{
+			"fail - insufficient fee tokens",
+			feeTokens.Sub(sdk.NewInt64Coin(feeToken.Denom, 1)),
+			fees,
+			false,
+			false,
+		},
+		{
+			"fail - empty reserve",
+			feeTokens,
+			sdk.NewCoins(),
+			true,
+			false,
+		},
+		{
+			"pass - fee tokens swapped to pay the fees",
+			feeTokens,
+			fees,
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			ctx, _ := suite.ctx.CacheContext()
+			ctx = ctx.WithIsCheckTx(true).WithGasMeter(sdk.NewInfiniteGasMeter())
+			suite.disableBaseFee(ctx)
+
+			err := suite.app.FeeAbsKeeper.SetParams(ctx, feeabstypes.NewParams(true, []feeabstypes.FeeToken{feeToken}))
+			suite.Require().NoError(err)
+			suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, addr.Bytes(), tc.feeTokens))
+			if !tc.reserve.IsZero() {
+				suite.Require().NoError(testutil.FundModuleAccount(ctx, suite.app.BankKeeper, feeabstypes.ModuleName, tc.reserve))
+			}
+
+			tx := suite.BuildTestEthTx(addr, to, nil, nil, big.NewInt(1), nil, nil, nil)
+
+			_, err = verificationDec.AnteHandle(ctx, tx, false, testutil.NextFn)
+			if !tc.expVerified {
+				suite.Require().ErrorIs(err, errortypes.ErrInsufficientFunds)
+				return
+			}
+			suite.Require().NoError(err)
+
+			newCtx, err := gasDec.AnteHandle(ctx, tx, false, testutil.NextFn)
+			if !tc.expPaid {
+				suite.Require().ErrorIs(err, feeabstypes.ErrInsufficientReserve)
+				return
+			}
+			suite.Require().NoError(err)
+
+			// the fee tokens are swapped against the reserve, which keeps them
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, addr.Bytes()).IsZero())
+			suite.Require().Equal(feeTokens, suite.app.BankKeeper.GetAllBalances(ctx, reserve))
+
+			// the swap is recorded to swap back the refund of the leftover gas
+			swap, found := evmtypes.GetFeeTokenSwap(newCtx, tx.AsTransaction().Hash())
+			suite.Require().True(found)
+			suite.Require().Equal(feeTokens, swap.AmountIn)
+			suite.Require().Equal(fees.AmountOf(utils.BaseDenom), swap.AmountOut)
+		})
+	}
+}
@@ -0,0 +1,47 @@
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+const (
+	conditionalTypeURL = "/ethermint.evm.v1.ExtensionOptionsEthereumTxConditional"
+	sponsoredTypeURL   = "/ethermint.evm.v1.ExtensionOptionsEthereumTxSponsored"
+)
+
+// getExtensionOption returns the extension option of the tx with the given
+// type URL, or nil if the tx doesn't have it.
+func getExtensionOption(tx sdk.Tx, typeURL string) *codectypes.Any {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+
+	for _, opt := range extTx.GetExtensionOptions() {
+		if opt.GetTypeUrl() == typeURL {
+			return opt
+		}
+	}
+	return nil
+}
+
+// validateExtensionOptions checks that an eth tx has a single extension option,
+// or both the conditional and sponsored options for a sponsored conditional tx.
+func validateExtensionOptions(opts []*codectypes.Any) error {
+	switch len(opts) {
+	case 1:
+		return nil
+	case 2:
+		if (opts[0].GetTypeUrl() == conditionalTypeURL && opts[1].GetTypeUrl() == sponsoredTypeURL) ||
+			(opts[0].GetTypeUrl() == sponsoredTypeURL && opts[1].GetTypeUrl() == conditionalTypeURL) {
+			return nil
+		}
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx only the conditional and sponsored ExtensionOptions can be combined")
+	default:
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "for eth tx length of ExtensionOptions should be 1, or 2 for sponsored conditional txs")
+	}
+}
@@ -16,57 +16,6 @@ import (
 	"github.com/anryton/anryton/v2/x/evm/types"
 )
 
-var isGenesistxn = true
-
-type GetValidator struct {
-	stakingKeeper    anteutils.StakingKeeper
-	dynamicfeeKeeper anteutils.DynamicFeeEVMKeeper
-}
-
-func NewDecorator(
-	sk anteutils.StakingKeeper,
-	dk anteutils.DynamicFeeEVMKeeper,
-) GetValidator {
-	return GetValidator{
-		stakingKeeper:    sk,
-		dynamicfeeKeeper: dk,
-	}
-}
-
-func (sk GetValidator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
-	// feeTx, ok := tx.(sdk.FeeTx)
-	// if !ok {
-	// 	return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
-	// }
-
-	var (
-		priority int64
-	)
-	// feePayer := feeTx.FeePayer()
-	// _, check := sk.stakingKeeper.GetValidator(ctx, feePayer.Bytes())
-	// delegator := sk.stakingKeeper.GetDelegatorDelegations(ctx, feePayer.Bytes(), 10)
-
-	// params := sk.dynamicfeeKeeper.GetParams(ctx)
-	// denom := params.EvmDenom
-	// // gas := feeTx.GetGas()
-	// feeCoins := feeTx.GetFee()
-	// fee := feeCoins.AmountOfNoDenomValidation(denom)
-	// // feeCap := fee.Quo(sdkmath.NewIntFromUint64(gas))
-
-	// if isGenesistxn == false {
-	// 	if check == false && (len(delegator) == 0) && fee.IsZero() {
-	// 		// If the fee payer is neither a delegator nor a validator and the fees are zero, return an error
-	// 		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "gas prices too low, got: required:. Please retry using a higher gas price or a higher fee")
-	// 	}
-	// }
-
-	// isGenesistxn = false
-
-	newCtx := ctx.WithPriority(priority)
-	return next(newCtx, tx, simulate)
-
-}
-
 // NewDynamicFeeChecker returns a `TxFeeChecker` that applies a dynamic fee to
 // Cosmos txs using the EIP-1559 fee market logic.
 // This can be called in both CheckTx and deliverTx modes.
@@ -75,7 +24,8 @@ func (sk GetValidator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, nex
 // - when `ExtensionOptionDynamicFeeTx` is omitted, `tipFeeCap` defaults to `MaxInt64`.
 // - when london hardfork is not enabled, it falls back to SDK default behavior (validator min-gas-prices).
 // - Tx priority is set to `effectiveGasPrice / DefaultPriorityReduction`.
-func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
+// - the fee tokens provided as fee are valued in `EvmDenom` at their current rates.
+func NewDynamicFeeChecker(k DynamicFeeEVMKeeper, fak anteutils.FeeAbsKeeper) anteutils.TxFeeChecker {
 	return func(ctx sdk.Context, feeTx sdk.FeeTx) (sdk.Coins, int64, error) {
 
 		if ctx.BlockHeight() == 0 {
@@ -112,7 +62,10 @@ func NewDynamicFeeChecker(k DynamicFeeEVMKeeper) anteutils.TxFeeChecker {
 		}
 
 		gas := feeTx.GetGas()
-		feeCoins := feeTx.GetFee()
+		feeCoins, err := fak.ConvertFeesToEVMDenom(ctx, feeTx.GetFee())
+		if err != nil {
+			return nil, 0, err
+		}
 		fee := feeCoins.AmountOfNoDenomValidation(denom)
 
 		feeCap := fee.Quo(sdkmath.NewIntFromUint64(gas))
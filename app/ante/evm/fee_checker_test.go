@@ -4,8 +4,10 @@ import (
 	"math/big"
 	"testing"
 
+	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	anteutils "github.com/anryton/anryton/v2/app/ante/utils"
 	"github.com/anryton/anryton/v2/encoding"
 	"github.com/anryton/anryton/v2/types"
 	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
@@ -40,6 +42,23 @@ func (m MockEVMKeeper) ChainID() *big.Int {
 	return big.NewInt(9000)
 }
 
+var _ anteutils.FeeAbsKeeper = MockFeeAbsKeeper{}
+
+// MockFeeAbsKeeper doesn't whitelist any fee token
+type MockFeeAbsKeeper struct{}
+
+func (m MockFeeAbsKeeper) ConvertFeesToEVMDenom(_ sdk.Context, fees sdk.Coins) (sdk.Coins, error) {
+	return fees, nil
+}
+
+func (m MockFeeAbsKeeper) GetSwappableAmount(_ sdk.Context, _ sdk.AccAddress) sdkmath.Int {
+	return sdkmath.ZeroInt()
+}
+
+func (m MockFeeAbsKeeper) SwapFeeTokensIfNecessary(_ sdk.Context, _ sdk.AccAddress, _ sdk.Coins) (sdk.Coins, sdkmath.Int, error) {
+	return sdk.Coins{}, sdkmath.ZeroInt(), nil
+}
+
 func TestSDKTxFeeChecker(t *testing.T) {
 	// testCases:
 	//   fallback
@@ -229,7 +248,7 @@ func TestSDKTxFeeChecker(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			fees, priority, err := NewDynamicFeeChecker(tc.keeper)(tc.ctx, tc.buildTx())
+			fees, priority, err := NewDynamicFeeChecker(tc.keeper, MockFeeAbsKeeper{})(tc.ctx, tc.buildTx())
 			if tc.expSuccess {
 				require.Equal(t, tc.expFees, fees.String())
 				require.Equal(t, tc.expPriority, priority)
@@ -0,0 +1,62 @@
+package evm
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+// getFeeGranter returns the fee granter named by the ExtensionOptionsEthereumTxSponsored
+// extension option of the tx, or nil if the tx doesn't have it. The fee granter
+// must be signed by the sender of the Ethereum tx, so that it cannot be replaced
+// by the node relaying it.
+func getFeeGranter(tx sdk.Tx, msgEthTx *evmtypes.MsgEthereumTx) (sdk.AccAddress, error) {
+	opt := getExtensionOption(tx, sponsoredTypeURL)
+	if opt == nil {
+		return nil, nil
+	}
+
+	sponsored, ok := opt.GetCachedValue().(*evmtypes.ExtensionOptionsEthereumTxSponsored)
+	if !ok {
+		return nil, errorsmod.Wrapf(
+			errortypes.ErrUnknownExtensionOptions,
+			"invalid extension option type %T, expected %T", opt.GetCachedValue(), (*evmtypes.ExtensionOptionsEthereumTxSponsored)(nil),
+		)
+	}
+
+	feeGranter, err := sdk.AccAddressFromBech32(sponsored.FeeGranter)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid fee granter address")
+	}
+
+	sender := common.BytesToAddress(msgEthTx.GetFrom())
+	if err := sponsored.VerifySignature(msgEthTx.AsTransaction().Hash(), sender); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid fee granter signature")
+	}
+	return feeGranter, nil
+}
+
+// useGrantedFees draws the fees of the Ethereum tx from the allowance of the fee
+// granter named by the tx. It returns the fee granter paying the fees, or nil if
+// the tx doesn't name one and the sender pays them.
+func useGrantedFees(
+	ctx sdk.Context,
+	feeGrantKeeper FeeGrantKeeper,
+	tx sdk.Tx,
+	msgEthTx *evmtypes.MsgEthereumTx,
+	fees sdk.Coins,
+) (sdk.AccAddress, error) {
+	feeGranter, err := getFeeGranter(tx, msgEthTx)
+	if err != nil || feeGranter == nil {
+		return nil, err
+	}
+
+	grantee := msgEthTx.GetFrom()
+	if err := feeGrantKeeper.UseGrantedFees(ctx, feeGranter, grantee, fees, []sdk.Msg{msgEthTx}); err != nil {
+		return nil, errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranter, grantee)
+	}
+	return feeGranter, nil
+}
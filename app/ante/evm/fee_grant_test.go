@@ -0,0 +1,250 @@
+package evm_test
+
+import (
+	"math/big"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	ethante "github.com/anryton/anryton/v2/app/ante/evm"
+	"github.com/anryton/anryton/v2/server/config"
+	"github.com/anryton/anryton/v2/testutil"
+	testutiltx "github.com/anryton/anryton/v2/testutil/tx"
+	"github.com/anryton/anryton/v2/utils"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+func (suite *AnteTestSuite) TestEthGasConsumeDecoratorFeeGrant() {
+	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.GasQuotaKeeper, suite.app.FeeGrantKeeper, suite.app.FeeAbsKeeper, config.DefaultMaxTxGasWanted)
+
+	sender, priv := testutiltx.NewAddrKey()
+	_, otherPriv := testutiltx.NewAddrKey()
+	granter := sdk.AccAddress(testutiltx.GenerateAddress().Bytes())
+	contract := testutiltx.GenerateAddress()
+	otherContract := testutiltx.GenerateAddress()
+
+	// the base fee is disabled, so the fees are the gas limit at a gas price of 1
+	gasPrice := big.NewInt(1)
+	fees := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewIntFromUint64(TestGasLimit)))
+	spendLimit := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e6)))
+	funds := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e16)))
+
+	newMsg := func(to common.Address) *evmtypes.MsgEthereumTx {
+		return suite.BuildTestEthTx(sender, to, nil, nil, gasPrice, nil, nil, nil)
+	}
+
+	// newSponsoredTx builds a tx naming the fee granter, signed with the given key
+	newSponsoredTx := func(to common.Address, key cryptotypes.PrivKey) sdk.Tx {
+		msg := newMsg(to)
+		tx, err := msg.BuildSponsoredTx(suite.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom, signSponsored(msg, key, granter), nil)
+		suite.Require().NoError(err)
+		// the sender is recovered from the signature by the previous decorators
+		msg.From = sender.Hex()
+		return tx
+	}
+
+	grantBasicAllowance := func(ctx sdk.Context) {
+		err := suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter, sender.Bytes(), &feegrant.BasicAllowance{SpendLimit: spendLimit})
+		suite.Require().NoError(err)
+	}
+
+	grantContractAllowance := func(ctx sdk.Context) {
+		allowance, err := evmtypes.NewAllowedContractAllowance(&feegrant.BasicAllowance{SpendLimit: spendLimit}, []string{contract.Hex()}, nil)
+		suite.Require().NoError(err)
+		suite.Require().NoError(suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter, sender.Bytes(), allowance))
+	}
+
+	requireSpendLimit := func(ctx sdk.Context, expSpendLimit sdk.Coins) {
+		allowance, err := suite.app.FeeGrantKeeper.GetAllowance(ctx, granter, sender.Bytes())
+		suite.Require().NoError(err)
+		if contractAllowance, ok := allowance.(*evmtypes.AllowedContractAllowance); ok {
+			allowance, err = contractAllowance.GetAllowance()
+			suite.Require().NoError(err)
+		}
+		suite.Require().Equal(expSpendLimit, allowance.(*feegrant.BasicAllowance).SpendLimit)
+	}
+
+	testCases := []struct {
+		name      string
+		malleate  func(ctx sdk.Context) sdk.Tx
+		expErr    error
+		postCheck func(ctx sdk.Context)
+	}{
+		{
+			"fail - fee granter without allowance",
+			func(ctx sdk.Context) sdk.Tx {
+				suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+				return newSponsoredTx(contract, priv)
+			},
+			errortypes.ErrNotFound,
+			func(sdk.Context) {},
+		},
+		{
+			"pass - fee granter named by the tx",
+			func(ctx sdk.Context) sdk.Tx {
+				suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+				grantBasicAllowance(ctx)
+				return newSponsoredTx(contract, priv)
+			},
+			nil,
+			func(ctx sdk.Context) {
+				suite.Require().Equal(funds.Sub(fees...), suite.app.BankKeeper.GetAllBalances(ctx, granter))
+				suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, sender.Bytes()).IsZero())
+				requireSpendLimit(ctx, spendLimit.Sub(fees...))
+				suite.Require().Equal(granter, evmtypes.FeeGranter(ctx, sender))
+			},
+		},
+		{
+			"fail - fee granter not signed by the sender",
+			func(ctx sdk.Context) sdk.Tx {
+				suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+				grantBasicAllowance(ctx)
+				return newSponsoredTx(contract, nil)
+			},
+			errortypes.ErrNoSignatures,
+			func(sdk.Context) {},
+		},
+		{
+			"fail - fee granter signed by another account",
+			func(ctx sdk.Context) sdk.Tx {
+				suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+				grantBasicAllowance(ctx)
+				return newSponsoredTx(contract, otherPriv)
+			},
+			errortypes.ErrUnauthorized,
+			func(sdk.Context) {},
+		},
+		{
+			"pass - contract allowance of the fee granter named by the tx",
+			func(ctx sdk.Context) sdk.Tx {
+				suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+				grantContractAllowance(ctx)
+				return newSponsoredTx(contract, priv)
+			},
+			nil,
+			func(ctx sdk.Context) {
+				suite.Require().Equal(funds.Sub(fees...), suite.app.BankKeeper.GetAllBalances(ctx, granter))
+				requireSpendLimit(ctx, spendLimit.Sub(fees...))
+				suite.Require().Equal(granter, evmtypes.FeeGranter(ctx, sender))
+			},
+		},
+		{
+			"fail - contract allowance for another contract",
+			func(ctx sdk.Context) sdk.Tx {
+				suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+				grantContractAllowance(ctx)
+				return newSponsoredTx(otherContract, priv)
+			},
+			feegrant.ErrMessageNotAllowed,
+			func(sdk.Context) {},
+		},
+		{
+			"fail - contract allowance of a fee granter not named by the tx",
+			func(ctx sdk.Context) sdk.Tx {
+				suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+				grantContractAllowance(ctx)
+				return newMsg(contract)
+			},
+			errortypes.ErrInsufficientFee,
+			func(sdk.Context) {},
+		},
+		{
+			"fail - fee granter signed for another tx of the sender",
+			func(ctx sdk.Context) sdk.Tx {
+				suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+				grantBasicAllowance(ctx)
+
+				msg, otherMsg := newMsg(contract), newMsg(otherContract)
+				tx, err := otherMsg.BuildSponsoredTx(suite.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom, signSponsored(msg, priv, granter), nil)
+				suite.Require().NoError(err)
+				otherMsg.From = sender.Hex()
+				return tx
+			},
+			errortypes.ErrUnauthorized,
+			func(sdk.Context) {},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			cacheCtx, _ := suite.ctx.CacheContext()
+			suite.disableBaseFee(cacheCtx)
+			tx := tc.malleate(cacheCtx)
+
+			ctx, err := dec.AnteHandle(cacheCtx.WithIsCheckTx(true).WithGasMeter(sdk.NewInfiniteGasMeter()), tx, false, testutil.NextFn)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			tc.postCheck(ctx)
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestRefundGasToFeeGranter() {
+	dec := ethante.NewEthGasConsumeDecorator(suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EvmKeeper, suite.app.StakingKeeper, suite.app.GasQuotaKeeper, suite.app.FeeGrantKeeper, suite.app.FeeAbsKeeper, config.DefaultMaxTxGasWanted)
+
+	sender, priv := testutiltx.NewAddrKey()
+	granter := sdk.AccAddress(testutiltx.GenerateAddress().Bytes())
+	contract := testutiltx.GenerateAddress()
+
+	gasPrice := big.NewInt(1)
+	leftoverGas := TestGasLimit / 2
+	spendLimit := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e6)))
+	funds := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(1e16)))
+	// the fees of the gas used, once the leftover gas is refunded
+	gasUsedFees := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewIntFromUint64(TestGasLimit-leftoverGas)))
+
+	ctx, _ := suite.ctx.CacheContext()
+	suite.disableBaseFee(ctx)
+	suite.Require().NoError(testutil.FundAccount(ctx, suite.app.BankKeeper, granter, funds))
+	err := suite.app.FeeGrantKeeper.GrantAllowance(ctx, granter, sender.Bytes(), &feegrant.BasicAllowance{SpendLimit: spendLimit})
+	suite.Require().NoError(err)
+
+	msg := suite.BuildTestEthTx(sender, contract, nil, nil, gasPrice, nil, nil, nil)
+	tx, err := msg.BuildSponsoredTx(suite.clientCtx.TxConfig.NewTxBuilder(), utils.BaseDenom, signSponsored(msg, priv, granter), nil)
+	suite.Require().NoError(err)
+	msg.From = sender.Hex()
+
+	ctx, err = dec.AnteHandle(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), tx, false, testutil.NextFn)
+	suite.Require().NoError(err)
+
+	ethMsg := ethtypes.NewMessage(
+		sender, &contract, 0, big.NewInt(0), TestGasLimit, gasPrice, gasPrice, gasPrice, nil, nil, false,
+	)
+	err = suite.app.EvmKeeper.RefundGas(ctx, ethMsg, leftoverGas, utils.BaseDenom)
+	suite.Require().NoError(err)
+
+	// the leftover gas is refunded to the granter and credited back to its allowance
+	suite.Require().Equal(funds.Sub(gasUsedFees...), suite.app.BankKeeper.GetAllBalances(ctx, granter))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(ctx, sender.Bytes()).IsZero())
+
+	allowance, err := suite.app.FeeGrantKeeper.GetAllowance(ctx, granter, sender.Bytes())
+	suite.Require().NoError(err)
+	suite.Require().Equal(spendLimit.Sub(gasUsedFees...), allowance.(*feegrant.BasicAllowance).SpendLimit)
+}
+
+// signSponsored names the fee granter of the tx, signed with the given key. The
+// fee granter is left unsigned if the key is nil.
+func signSponsored(msg *evmtypes.MsgEthereumTx, key cryptotypes.PrivKey, granter sdk.AccAddress) *evmtypes.ExtensionOptionsEthereumTxSponsored {
+	sponsored := &evmtypes.ExtensionOptionsEthereumTxSponsored{FeeGranter: granter.String()}
+	if key == nil {
+		return sponsored
+	}
+
+	sigHash, err := sponsored.SigHash(msg.AsTransaction().Hash())
+	if err != nil {
+		panic(err)
+	}
+	sponsored.Signature, err = key.Sign(sigHash)
+	if err != nil {
+		panic(err)
+	}
+	return sponsored
+}
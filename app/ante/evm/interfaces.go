@@ -21,7 +21,7 @@ type EVMKeeper interface { //nolint: revive
 	DynamicFeeEVMKeeper
 
 	NewEVM(ctx sdk.Context, msg core.Message, cfg *statedb.EVMConfig, tracer vm.EVMLogger, stateDB vm.StateDB) *vm.EVM
-	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error
+	DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address, gasPrice *big.Int) error
 	GetBalance(ctx sdk.Context, addr common.Address) *big.Int
 	ResetTransientGasUsed(ctx sdk.Context)
 	GetTxIndexTransient(ctx sdk.Context) uint64
@@ -41,6 +41,19 @@ type DynamicFeeEVMKeeper interface {
 	GetBaseFee(ctx sdk.Context, ethCfg *params.ChainConfig) *big.Int
 }
 
+// FeeGrantKeeper defines the expected feegrant keeper used to pay the fees of
+// sponsored Ethereum txs
+type FeeGrantKeeper interface {
+	UseGrantedFees(ctx sdk.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// Mempool defines the expected application mempool used to accept on CheckTx the
+// Ethereum txs that are queued or replace a tx of the same nonce
+type Mempool interface {
+	HasNonce(sender common.Address, nonce uint64) bool
+	HasTx(hash common.Hash) bool
+}
+
 type protoTxProvider interface {
 	GetProtoTx() *tx.Tx
 }
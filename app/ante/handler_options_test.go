@@ -166,7 +166,9 @@ func (suite *AnteTestSuite) TestValidateHandlerOptions() {
 				SignModeHandler:        encoding.MakeConfig(app.ModuleBasics).TxConfig.SignModeHandler(),
 				SigGasConsumer:         ante.SigVerificationGasConsumer,
 				MaxTxGasWanted:         40000000,
-				TxFeeChecker:           ethante.NewDynamicFeeChecker(suite.app.EvmKeeper),
+				TxFeeChecker:           ethante.NewDynamicFeeChecker(suite.app.EvmKeeper, suite.app.FeeAbsKeeper),
+				GasQuotaKeeper:         suite.app.GasQuotaKeeper,
+				FeeAbsKeeper:           suite.app.FeeAbsKeeper,
 			},
 			true,
 		},
@@ -54,27 +54,17 @@ func ClaimSufficientStakingRewards(
 	addr sdk.AccAddress,
 	amount sdk.Coin,
 ) error {
-	var (
-		err     error
-		reward  sdk.Coins
-		rewards sdk.Coins
-	)
-
 	// Allocate a cached context to avoid writing to state if there are not enough rewards
 	cacheCtx, writeFn := ctx.CacheContext()
 
 	// Iterate through delegations and get the rewards if any are unclaimed.
 	// The loop stops once a sufficient amount was withdrawn.
-	stakingKeeper.IterateDelegations(
+	rewards, err := withdrawDelegationRewards(
 		cacheCtx,
+		stakingKeeper,
+		distributionKeeper,
 		addr,
-		func(_ int64, delegation stakingtypes.DelegationI) (stop bool) {
-			reward, err = distributionKeeper.WithdrawDelegationRewards(cacheCtx, addr, delegation.GetValidatorAddr())
-			if err != nil {
-				return true
-			}
-			rewards = rewards.Add(reward...)
-
+		func(rewards sdk.Coins, _ uint32) bool {
 			return rewards.AmountOf(amount.Denom).GTE(amount.Amount)
 		},
 	)
@@ -91,3 +81,65 @@ func ClaimSufficientStakingRewards(
 	writeFn() // commit state changes
 	return nil
 }
+
+// WithdrawStakingRewards withdraws the unclaimed staking rewards of the given
+// address from at most maxRetrieve of its delegations and returns the total
+// amount withdrawn. It bounds the number of withdrawals so that the gas spent
+// on claiming from many validators at once stays predictable.
+func WithdrawStakingRewards(
+	ctx sdk.Context,
+	stakingKeeper StakingKeeper,
+	distributionKeeper DistributionKeeper,
+	addr sdk.AccAddress,
+	maxRetrieve uint32,
+) (sdk.Coins, error) {
+	rewards, err := withdrawDelegationRewards(
+		ctx,
+		stakingKeeper,
+		distributionKeeper,
+		addr,
+		func(_ sdk.Coins, withdrawn uint32) bool {
+			return withdrawn >= maxRetrieve
+		},
+	)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "error while withdrawing delegation rewards")
+	}
+
+	return rewards, nil
+}
+
+// withdrawDelegationRewards iterates through the delegations of the given address
+// and withdraws the rewards of each one until the stop function returns true.
+// It returns the total amount of rewards withdrawn.
+func withdrawDelegationRewards(
+	ctx sdk.Context,
+	stakingKeeper StakingKeeper,
+	distributionKeeper DistributionKeeper,
+	addr sdk.AccAddress,
+	stop func(rewards sdk.Coins, withdrawn uint32) bool,
+) (sdk.Coins, error) {
+	var (
+		err       error
+		reward    sdk.Coins
+		rewards   sdk.Coins
+		withdrawn uint32
+	)
+
+	stakingKeeper.IterateDelegations(
+		ctx,
+		addr,
+		func(_ int64, delegation stakingtypes.DelegationI) bool {
+			reward, err = distributionKeeper.WithdrawDelegationRewards(ctx, addr, delegation.GetValidatorAddr())
+			if err != nil {
+				return true
+			}
+			rewards = rewards.Add(reward...)
+			withdrawn++
+
+			return stop(rewards, withdrawn)
+		},
+	)
+
+	return rewards, err
+}
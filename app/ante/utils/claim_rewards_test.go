@@ -159,3 +159,53 @@ func (suite *AnteTestSuite) TestClaimStakingRewardsIfNecessary() {
 		})
 	}
 }
+
+// TestWithdrawStakingRewards tests the WithdrawStakingRewards function
+func (suite *AnteTestSuite) TestWithdrawStakingRewards() {
+	testcases := []struct {
+		// testcase name
+		name string
+		// maxRetrieve is the maximum number of delegations to withdraw the rewards from
+		maxRetrieve uint32
+		// expRewards is the amount of rewards expected to be withdrawn
+		expRewards sdk.Int
+	}{
+		{
+			name:        "pass - withdraw the rewards from all delegations",
+			maxRetrieve: 2,
+			expRewards:  sdk.NewInt(2e14),
+		},
+		{
+			name:        "pass - withdraw the rewards from more delegations than available",
+			maxRetrieve: 10,
+			expRewards:  sdk.NewInt(2e14),
+		},
+		{
+			name:        "pass - withdraw the rewards from only one delegation",
+			maxRetrieve: 1,
+			expRewards:  sdk.NewInt(1e14),
+		},
+	}
+
+	for _, tc := range testcases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			addr, _ := testutiltx.NewAccAddressAndKey()
+
+			var err error
+			suite.ctx, err = testutil.PrepareAccountsForDelegationRewards(
+				suite.T(), suite.ctx, suite.app, addr, sdk.ZeroInt(), sdk.NewInt(1e14), sdk.NewInt(1e14),
+			)
+			suite.Require().NoError(err, "failed to prepare accounts for delegation rewards")
+			suite.ctx, err = testutil.Commit(suite.ctx, suite.app, time.Second*0, nil)
+			suite.Require().NoError(err)
+
+			rewards, err := anteutils.WithdrawStakingRewards(suite.ctx, suite.app.StakingKeeper, suite.app.DistrKeeper, addr, tc.maxRetrieve)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expRewards, rewards.AmountOf(utils.BaseDenom))
+
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, addr, utils.BaseDenom)
+			suite.Require().Equal(tc.expRewards, balance.Amount, "expected the withdrawn rewards in the balance")
+		})
+	}
+}
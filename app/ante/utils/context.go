@@ -0,0 +1,42 @@
+package utils
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// private type creates an interface key for Context that cannot be accessed by any other package
+type contextKey int
+
+const (
+	// fees deducted from the fee payer of the tx
+	contextKeyDeductedFees contextKey = iota
+	// gas of the tx covered by the gas allowance of the fee payer
+	contextKeyCoveredGas
+)
+
+// WithDeductedFees stores the fees deducted from the fee payer of the tx in the
+// context
+func WithDeductedFees(ctx sdk.Context, fees sdk.Coins) sdk.Context {
+	return ctx.WithValue(contextKeyDeductedFees, fees)
+}
+
+// DeductedFees returns the fees deducted from the fee payer of the tx. The
+// result is empty if no fee was deducted.
+func DeductedFees(ctx sdk.Context) sdk.Coins {
+	fees, _ := ctx.Value(contextKeyDeductedFees).(sdk.Coins)
+	return fees
+}
+
+// WithCoveredGas stores the gas of the tx covered by the gas allowance of the
+// fee payer in the context
+func WithCoveredGas(ctx sdk.Context, gas uint64) sdk.Context {
+	return ctx.WithValue(contextKeyCoveredGas, gas)
+}
+
+// CoveredGas returns the gas of the tx covered by the gas allowance of the fee
+// payer. The unused part of this gas is credited back to the allowance after
+// the execution.
+func CoveredGas(ctx sdk.Context) uint64 {
+	gas, _ := ctx.Value(contextKeyCoveredGas).(uint64)
+	return gas
+}
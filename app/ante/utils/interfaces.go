@@ -3,6 +3,8 @@ package utils
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
+
 	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -29,6 +31,21 @@ type StakingKeeper interface {
 	GetValidator(ctx sdk.Context, valAddr sdk.ValAddress) (stakingtypes.Validator, bool)
 	GetDelegatorDelegations(ctx sdk.Context, delegator sdk.AccAddress, maxRetrieve uint16) []stakingtypes.Delegation
 }
+
+// GasQuotaKeeper defines the expected gasquota keeper used to cover the fees
+// of the stakers with their gas allowance.
+type GasQuotaKeeper interface {
+	SubsidizeFees(ctx sdk.Context, feePayer sdk.AccAddress, fees sdk.Coins, gas uint64) (sdk.Coins, uint64)
+}
+
+// FeeAbsKeeper defines the expected feeabs keeper used to pay the fees with
+// the whitelisted fee tokens, swapped into the EVM denom.
+type FeeAbsKeeper interface {
+	ConvertFeesToEVMDenom(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error)
+	GetSwappableAmount(ctx sdk.Context, account sdk.AccAddress) sdkmath.Int
+	SwapFeeTokensIfNecessary(ctx sdk.Context, payer sdk.AccAddress, fees sdk.Coins) (sdk.Coins, sdkmath.Int, error)
+}
+
 type DynamicFeeEVMKeeper interface {
 	ChainID() *big.Int
 	GetParams(ctx sdk.Context) evmtypes.Params
@@ -104,6 +104,8 @@ func (suite *AnteTestSuite) SetupTest() {
 		IBCKeeper:          suite.app.IBCKeeper,
 		StakingKeeper:      suite.app.StakingKeeper,
 		FeeMarketKeeper:    suite.app.FeeMarketKeeper,
+		GasQuotaKeeper:     suite.app.GasQuotaKeeper,
+		FeeAbsKeeper:       suite.app.FeeAbsKeeper,
 		SignModeHandler:    encodingConfig.TxConfig.SignModeHandler(),
 		SigGasConsumer:     ante.SigVerificationGasConsumer,
 	})
@@ -39,12 +39,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/store/streaming"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/types/mempool"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -109,6 +107,9 @@ import (
 	ibctesting "github.com/cosmos/ibc-go/v7/testing"
 
 	ica "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts"
+	icacontroller "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
 	icahost "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/keeper"
 	icahosttypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/host/types"
@@ -122,12 +123,22 @@ import (
 
 	"github.com/anryton/anryton/v2/app/ante"
 	ethante "github.com/anryton/anryton/v2/app/ante/evm"
+	evmmempool "github.com/anryton/anryton/v2/app/mempool"
+	"github.com/anryton/anryton/v2/app/post"
 	v1 "github.com/anryton/anryton/v2/app/upgrades/v1"
+	v2 "github.com/anryton/anryton/v2/app/upgrades/v2"
 	"github.com/anryton/anryton/v2/encoding"
 	"github.com/anryton/anryton/v2/ethereum/eip712"
 	"github.com/anryton/anryton/v2/precompiles/common"
+	icaprecompile "github.com/anryton/anryton/v2/precompiles/ica"
 	srvflags "github.com/anryton/anryton/v2/server/flags"
 	anrytontypes "github.com/anryton/anryton/v2/types"
+	"github.com/anryton/anryton/v2/x/cron"
+	cronkeeper "github.com/anryton/anryton/v2/x/cron/keeper"
+	crontypes "github.com/anryton/anryton/v2/x/cron/types"
+	"github.com/anryton/anryton/v2/x/cw20"
+	cw20keeper "github.com/anryton/anryton/v2/x/cw20/keeper"
+	cw20types "github.com/anryton/anryton/v2/x/cw20/types"
 	"github.com/anryton/anryton/v2/x/epochs"
 	epochskeeper "github.com/anryton/anryton/v2/x/epochs/keeper"
 	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
@@ -138,9 +149,30 @@ import (
 	"github.com/anryton/anryton/v2/x/evm"
 	evmkeeper "github.com/anryton/anryton/v2/x/evm/keeper"
 	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	"github.com/anryton/anryton/v2/x/feeabs"
+	feeabskeeper "github.com/anryton/anryton/v2/x/feeabs/keeper"
+	feeabstypes "github.com/anryton/anryton/v2/x/feeabs/types"
 	"github.com/anryton/anryton/v2/x/feemarket"
 	feemarketkeeper "github.com/anryton/anryton/v2/x/feemarket/keeper"
 	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
+	"github.com/anryton/anryton/v2/x/gasquota"
+	gasquotakeeper "github.com/anryton/anryton/v2/x/gasquota/keeper"
+	gasquotatypes "github.com/anryton/anryton/v2/x/gasquota/types"
+	ibcforward "github.com/anryton/anryton/v2/x/ibc/forward"
+	ibcforwardkeeper "github.com/anryton/anryton/v2/x/ibc/forward/keeper"
+	ibcforwardtypes "github.com/anryton/anryton/v2/x/ibc/forward/types"
+	ibchooks "github.com/anryton/anryton/v2/x/ibc/hooks"
+	ibchookskeeper "github.com/anryton/anryton/v2/x/ibc/hooks/keeper"
+	ibchookstypes "github.com/anryton/anryton/v2/x/ibc/hooks/types"
+	"github.com/anryton/anryton/v2/x/ratelimit"
+	ratelimitkeeper "github.com/anryton/anryton/v2/x/ratelimit/keeper"
+	ratelimittypes "github.com/anryton/anryton/v2/x/ratelimit/types"
+	"github.com/anryton/anryton/v2/x/revenue"
+	revenuekeeper "github.com/anryton/anryton/v2/x/revenue/keeper"
+	revenuetypes "github.com/anryton/anryton/v2/x/revenue/types"
+	"github.com/anryton/anryton/v2/x/tokenfactory"
+	tokenfactorykeeper "github.com/anryton/anryton/v2/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/anryton/anryton/v2/x/tokenfactory/types"
 
 	"github.com/anryton/anryton/v2/x/vesting"
 	vestingclient "github.com/anryton/anryton/v2/x/vesting/client"
@@ -251,6 +283,13 @@ var (
 		feemarket.AppModuleBasic{},
 		erc20.AppModuleBasic{},
 		epochs.AppModuleBasic{},
+		ratelimit.AppModuleBasic{},
+		cw20.AppModuleBasic{},
+		tokenfactory.AppModuleBasic{},
+		revenue.AppModuleBasic{},
+		cron.AppModuleBasic{},
+		gasquota.AppModuleBasic{},
+		feeabs.AppModuleBasic{},
 		consensus.AppModuleBasic{},
 	)
 
@@ -265,18 +304,27 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		icatypes.ModuleName:            nil,
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+		feemarkettypes.ModuleName:      {authtypes.Burner},                   // escrows the base fees burned at the end of the block
 		erc20types.ModuleName:          {authtypes.Minter, authtypes.Burner},
+		cw20types.ModuleName:           {authtypes.Minter, authtypes.Burner},
+		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
+		crontypes.ModuleName:           nil,
+		feeabstypes.ModuleName:         nil,
 		wasmtypes.ModuleName:           {authtypes.Burner},
 	}
 
 	// // module accounts that are allowed to receive tokens
-	allowedReceivingModAcc = map[string]bool{}
+	allowedReceivingModAcc = map[string]bool{
+		// the reserve of the fee abstraction module is funded by transfers
+		feeabstypes.ModuleName: true,
+	}
 )
 
 var (
-	_ servertypes.Application = (*Anryton)(nil)
-	_ ibctesting.TestingApp   = (*Anryton)(nil)
-	_ runtime.AppI            = (*Anryton)(nil)
+	_ servertypes.Application        = (*Anryton)(nil)
+	_ anrytontypes.EVMTxPoolProvider = (*Anryton)(nil)
+	_ ibctesting.TestingApp          = (*Anryton)(nil)
+	_ runtime.AppI                   = (*Anryton)(nil)
 )
 
 // Anryton implements an extended ABCI application. It is an application
@@ -313,6 +361,7 @@ type Anryton struct {
 	AuthzKeeper           authzkeeper.Keeper
 	IBCKeeper             *ibckeeper.Keeper // IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	ICAHostKeeper         icahostkeeper.Keeper
+	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	EvidenceKeeper        evidencekeeper.Keeper
 	TransferKeeper        transferkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
@@ -327,9 +376,18 @@ type Anryton struct {
 	FeeMarketKeeper feemarketkeeper.Keeper
 
 	// Anryton keepers
-	Erc20Keeper   erc20keeper.Keeper
-	EpochsKeeper  epochskeeper.Keeper
-	VestingKeeper vestingkeeper.Keeper
+	Erc20Keeper        erc20keeper.Keeper
+	EpochsKeeper       epochskeeper.Keeper
+	VestingKeeper      vestingkeeper.Keeper
+	IBCHooksKeeper     ibchookskeeper.Keeper
+	ForwardKeeper      ibcforwardkeeper.Keeper
+	RateLimitKeeper    ratelimitkeeper.Keeper
+	Cw20Keeper         cw20keeper.Keeper
+	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	RevenueKeeper      revenuekeeper.Keeper
+	CronKeeper         cronkeeper.Keeper
+	GasQuotaKeeper     gasquotakeeper.Keeper
+	FeeAbsKeeper       feeabskeeper.Keeper
 	//wasm keepers
 	IBCFeeKeeper ibcfeekeeper.Keeper
 	WasmKeeper   wasmkeeper.Keeper
@@ -343,6 +401,9 @@ type Anryton struct {
 	sm *module.SimulationManager
 
 	tpsCounter *tpsCounter
+
+	// the application mempool, nil when disabled
+	mempool *evmmempool.EVMMempool
 }
 
 // SimulationManager implements runtime.AppI
@@ -381,15 +442,6 @@ func NewAnryton(
 
 	eip712.SetEncodingConfig(encodingConfig)
 
-	// Setup Mempool and Proposal Handlers
-	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NoOpMempool{}
-		app.SetMempool(mempool)
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
-	})
-
 	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
 	bApp := baseapp.NewBaseApp(
 		Name,
@@ -410,14 +462,20 @@ func NewAnryton(
 		evidencetypes.StoreKey, capabilitytypes.StoreKey, consensusparamtypes.StoreKey,
 		feegrant.StoreKey, authzkeeper.StoreKey, crisistypes.StoreKey,
 		// ibc keys
-		ibcexported.StoreKey, ibctransfertypes.StoreKey,
+		ibcexported.StoreKey, ibctransfertypes.StoreKey, ibchookstypes.StoreKey, ibcforwardtypes.StoreKey,
 		// ica keys
-		icahosttypes.StoreKey,
+		icahosttypes.StoreKey, icacontrollertypes.StoreKey,
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
 		// anryton keys
 		erc20types.StoreKey,
-		epochstypes.StoreKey, vestingtypes.StoreKey,
+		epochstypes.StoreKey, vestingtypes.StoreKey, ratelimittypes.StoreKey,
+		cw20types.StoreKey,
+		tokenfactorytypes.StoreKey,
+		revenuetypes.StoreKey,
+		crontypes.StoreKey,
+		gasquotatypes.StoreKey,
+		feeabstypes.StoreKey,
 		//wasm keys
 		wasmtypes.StoreKey,
 	)
@@ -459,6 +517,7 @@ func NewAnryton(
 	scopedIBCKeeper := app.CapabilityKeeper.ScopeToModule(ibcexported.ModuleName)
 	scopedTransferKeeper := app.CapabilityKeeper.ScopeToModule(ibctransfertypes.ModuleName)
 	scopedICAHostKeeper := app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
+	scopedICAControllerKeeper := app.CapabilityKeeper.ScopeToModule(icacontrollertypes.SubModuleName)
 	scopedWasmKeeper := app.CapabilityKeeper.ScopeToModule(wasmtypes.ModuleName)
 
 	// Applications that wish to enforce statically created ScopedKeepers should call `Seal` after creating
@@ -500,12 +559,13 @@ func NewAnryton(
 		appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
 		keys[feemarkettypes.StoreKey],
 		tkeys[feemarkettypes.TransientKey],
+		app.BankKeeper, app.DistrKeeper,
 		app.GetSubspace(feemarkettypes.ModuleName),
 	)
 
 	evmKeeper := evmkeeper.NewKeeper(
 		appCodec, keys[evmtypes.StoreKey], tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, stakingKeeper, app.FeeMarketKeeper,
+		app.AccountKeeper, app.BankKeeper, stakingKeeper, app.FeeMarketKeeper, app.FeeGrantKeeper,
 		tracer, app.GetSubspace(evmtypes.ModuleName),
 	)
 
@@ -533,9 +593,6 @@ func NewAnryton(
 		stakingKeeper, app.MsgServiceRouter(), govConfig, authAddr,
 	)
 
-	// Set legacy router for backwards compatibility with gov v1beta1
-	govKeeper.SetLegacyRouter(govRouter)
-
 	// Anryton Keeper
 
 	// register the staking hooks
@@ -561,14 +618,50 @@ func NewAnryton(
 		app.AccountKeeper, app.BankKeeper, app.EvmKeeper, app.StakingKeeper,
 	)
 
+	// the token factory keeper registers the ERC20 representation of the
+	// created denoms
+	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
+		keys[tokenfactorytypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, app.Erc20Keeper,
+	)
+
+	// the rate limit keeper wraps the channel keeper to check the outflows of
+	// the sent ICS-20 packets
+	app.RateLimitKeeper = ratelimitkeeper.NewKeeper(
+		keys[ratelimittypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper, app.IBCKeeper.ChannelKeeper, app.IBCKeeper.ChannelKeeper,
+	)
+
 	app.TransferKeeper = transferkeeper.NewKeeper(
 		appCodec, keys[ibctransfertypes.StoreKey], app.GetSubspace(ibctransfertypes.ModuleName),
-		app.IBCKeeper.ChannelKeeper, // ICS4 Wrapper: claims IBC middleware
+		app.RateLimitKeeper, // ICS4 Wrapper: rate limit IBC middleware
 		app.IBCKeeper.ChannelKeeper, &app.IBCKeeper.PortKeeper,
 		app.AccountKeeper, app.BankKeeper, scopedTransferKeeper,
 		app.Erc20Keeper, // Add ERC20 Keeper for ERC20 transfers
 	)
 
+	// the IBC hooks execute the EVM and CosmWasm calls of the ICS-20 memos and
+	// the callbacks of the transfers sent by the ICS20 precompile
+	app.IBCHooksKeeper = ibchookskeeper.NewKeeper(
+		keys[ibchookstypes.StoreKey],
+		app.BankKeeper,
+		app.Erc20Keeper,
+		app.EvmKeeper,
+		wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper),
+	)
+
+	// the ICA controller keeper is used by the ICA precompile to register and
+	// control interchain accounts owned by EVM contracts
+	app.ICAControllerKeeper = icacontrollerkeeper.NewKeeper(
+		appCodec, keys[icacontrollertypes.StoreKey],
+		app.GetSubspace(icacontrollertypes.SubModuleName),
+		app.IBCKeeper.ChannelKeeper, // ICS4 Wrapper
+		app.IBCKeeper.ChannelKeeper,
+		&app.IBCKeeper.PortKeeper,
+		scopedICAControllerKeeper,
+		bApp.MsgServiceRouter(),
+	)
+
 	// We call this after setting the hooks to ensure that the hooks are set on the keeper
 	evmKeeper.WithPrecompiles(
 		evmkeeper.AvailablePrecompiles(
@@ -578,16 +671,16 @@ func NewAnryton(
 			app.AuthzKeeper,
 			app.TransferKeeper,
 			app.IBCKeeper.ChannelKeeper,
+			app.IBCHooksKeeper,
+			app.ICAControllerKeeper,
+			app.TokenFactoryKeeper,
+			app.BankKeeper,
+			evmKeeper,
 		),
 	)
 
+	// NOTE: the epoch hooks are set once the cron keeper is initialized
 	epochsKeeper := epochskeeper.NewKeeper(appCodec, keys[epochstypes.StoreKey])
-	app.EpochsKeeper = *epochsKeeper.SetHooks(
-		epochskeeper.NewMultiEpochHooks(
-		// insert epoch hooks receivers here
-		// app.InflationKeeper.Hooks(),
-		),
-	)
 
 	wasmDir := filepath.Join(homePath, "wasm")
 	wasmConfig, err := wasm.ReadWasmConfig(appOpts)
@@ -619,20 +712,71 @@ func NewAnryton(
 		wasmOpts...,
 	)
 
+	// the cw20 keeper converts the CW20 tokens to and from their bank and ERC20
+	// representations
+	app.Cw20Keeper = cw20keeper.NewKeeper(
+		keys[cw20types.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.Erc20Keeper,
+		wasmkeeper.NewDefaultPermissionKeeper(&app.WasmKeeper),
+		&app.WasmKeeper,
+	)
+
 	// The gov proposal types can be individually enabled
 	if len(enabledProposals) != 0 {
 		govRouter.AddRoute(wasmtypes.RouterKey, wasmkeeper.NewWasmProposalHandler(app.WasmKeeper, enabledProposals)) //nolint:staticcheck
 	}
 
+	// Set legacy router for backwards compatibility with gov v1beta1. The
+	// router is sealed once set, so all the routes must be added before.
+	govKeeper.SetLegacyRouter(govRouter)
+
 	app.GovKeeper = *govKeeper.SetHooks(
 		govtypes.NewMultiGovHooks(
 			app.VestingKeeper.Hooks(),
 		),
 	)
 
+	// the revenue keeper distributes the developer share of the fees spent
+	// calling the registered EVM and CosmWasm contracts
+	app.RevenueKeeper = revenuekeeper.NewKeeper(
+		keys[revenuetypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper, app.EvmKeeper, &app.WasmKeeper, authtypes.FeeCollectorName,
+	)
+
 	app.EvmKeeper = app.EvmKeeper.SetHooks(
 		evmkeeper.NewMultiEvmHooks(
 			app.Erc20Keeper.Hooks(),
+			app.RevenueKeeper.Hooks(),
+		),
+	)
+
+	// the cron keeper executes the scheduled EVM calls and CosmWasm sudo
+	// messages at the end of their epochs
+	app.CronKeeper = cronkeeper.NewKeeper(
+		keys[crontypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.AccountKeeper, app.BankKeeper, app.DistrKeeper, epochsKeeper, app.EvmKeeper, app.FeeMarketKeeper, &app.WasmKeeper,
+	)
+
+	// the gasquota keeper grants the stakers a gas allowance renewed on every
+	// epoch
+	app.GasQuotaKeeper = gasquotakeeper.NewKeeper(
+		keys[gasquotatypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.StakingKeeper, epochsKeeper,
+	)
+	app.EvmKeeper = app.EvmKeeper.SetGasQuotaKeeper(app.GasQuotaKeeper)
+
+	// the feeabs keeper swaps the whitelisted fee tokens into the EVM denom to
+	// pay the tx fees
+	app.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		keys[feeabstypes.StoreKey], appCodec, authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper, app.Erc20Keeper, app.EvmKeeper, app.FeeMarketKeeper,
+	)
+	app.EvmKeeper = app.EvmKeeper.SetFeeAbsKeeper(app.FeeAbsKeeper)
+
+	app.EpochsKeeper = *epochsKeeper.SetHooks(
+		epochskeeper.NewMultiEpochHooks(
+			app.CronKeeper.Hooks(),
+			app.GasQuotaKeeper.Hooks(),
 		),
 	)
 
@@ -658,27 +802,51 @@ func NewAnryton(
 	// create host IBC module
 	icaHostIBCModule := icahost.NewIBCModule(app.ICAHostKeeper)
 
+	// create controller IBC module, the accounts registered through the ICA
+	// precompile are authenticated by the precompile IBC module, which emits
+	// the events of their acknowledgements and timeouts
+	icaControllerIBCModule := icacontroller.NewIBCMiddleware(icaprecompile.NewIBCModule(), app.ICAControllerKeeper)
+
 	/*
 		Create Transfer Stack
 
 		transfer stack contains (from bottom to top):
+			- Packet Forward Middleware
+			- IBC Hooks Middleware
 			- ERC-20 Middleware
 		 	- Airdrop Claims Middleware
+			- Rate Limit Middleware
 			- IBC Transfer
 
 		SendPacket, since it is originating from the application to core IBC:
-		 	transferKeeper.SendPacket -> claim.SendPacket -> erc20.SendPacket -> channel.SendPacket
+		 	transferKeeper.SendPacket -> ratelimit.SendPacket -> channel.SendPacket
 
 		RecvPacket, message that originates from core IBC and goes down to app, the flow is the other way
-			channel.RecvPacket -> erc20.OnRecvPacket -> claim.OnRecvPacket -> transfer.OnRecvPacket
+			channel.RecvPacket -> forward.OnRecvPacket -> ibchooks.OnRecvPacket -> erc20.OnRecvPacket -> claim.OnRecvPacket -> ratelimit.OnRecvPacket -> transfer.OnRecvPacket
 	*/
 
+	// the packet forward middleware sends the received ICS-20 tokens to the
+	// next chain requested by the memo and acknowledges the packets asynchronously
+	app.ForwardKeeper = ibcforwardkeeper.NewKeeper(
+		keys[ibcforwardtypes.StoreKey],
+		appCodec,
+		app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		scopedTransferKeeper,
+		app.BankKeeper,
+		app.Erc20Keeper,
+	)
+
 	// create IBC module from top to bottom of stack
 	var transferStack porttypes.IBCModule
 
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
+	transferStack = ratelimit.NewIBCMiddleware(app.RateLimitKeeper, transferStack)
 	// transferStack = claims.NewIBCMiddleware(*app.ClaimsKeeper, transferStack)
 	transferStack = erc20.NewIBCMiddleware(app.Erc20Keeper, transferStack)
+	transferStack = ibchooks.NewIBCMiddleware(app.IBCHooksKeeper, transferStack)
+	transferStack = ibcforward.NewIBCMiddleware(app.ForwardKeeper, transferStack)
+
 	var wasmStack porttypes.IBCModule
 	wasmStack = wasm.NewIBCHandler(app.WasmKeeper, app.IBCKeeper.ChannelKeeper, app.IBCFeeKeeper)
 	wasmStack = ibcfee.NewIBCMiddleware(wasmStack, app.IBCFeeKeeper)
@@ -686,6 +854,7 @@ func NewAnryton(
 	ibcRouter := porttypes.NewRouter()
 	ibcRouter.
 		AddRoute(icahosttypes.SubModuleName, icaHostIBCModule).
+		AddRoute(icacontrollertypes.SubModuleName, icaControllerIBCModule).
 		AddRoute(wasmtypes.ModuleName, wasmStack).
 		AddRoute(ibctransfertypes.ModuleName, transferStack)
 
@@ -735,7 +904,7 @@ func NewAnryton(
 
 		// ibc modules
 		ibc.NewAppModule(app.IBCKeeper),
-		ica.NewAppModule(nil, &app.ICAHostKeeper),
+		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
 		transferModule,
 		// Ethermint app modules
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, app.GetSubspace(evmtypes.ModuleName)),
@@ -744,6 +913,13 @@ func NewAnryton(
 		erc20.NewAppModule(app.Erc20Keeper, app.AccountKeeper,
 			app.GetSubspace(erc20types.ModuleName)),
 		epochs.NewAppModule(appCodec, app.EpochsKeeper),
+		ratelimit.NewAppModule(app.RateLimitKeeper),
+		cw20.NewAppModule(app.Cw20Keeper, app.AccountKeeper),
+		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper),
+		revenue.NewAppModule(app.RevenueKeeper),
+		cron.NewAppModule(app.CronKeeper, app.AccountKeeper),
+		gasquota.NewAppModule(app.GasQuotaKeeper),
+		feeabs.NewAppModule(app.FeeAbsKeeper, app.AccountKeeper),
 		vesting.NewAppModule(app.VestingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
 		wasm.NewAppModule(appCodec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
 	)
@@ -780,6 +956,13 @@ func NewAnryton(
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		erc20types.ModuleName,
+		ratelimittypes.ModuleName,
+		cw20types.ModuleName,
+		tokenfactorytypes.ModuleName,
+		revenuetypes.ModuleName,
+		crontypes.ModuleName,
+		gasquotatypes.ModuleName,
+		feeabstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		wasmtypes.ModuleName,
 	)
@@ -812,6 +995,13 @@ func NewAnryton(
 		// Anryton modules
 		vestingtypes.ModuleName,
 		erc20types.ModuleName,
+		ratelimittypes.ModuleName,
+		cw20types.ModuleName,
+		tokenfactorytypes.ModuleName,
+		revenuetypes.ModuleName,
+		crontypes.ModuleName,
+		gasquotatypes.ModuleName,
+		feeabstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		wasmtypes.ModuleName,
 	)
@@ -851,6 +1041,13 @@ func NewAnryton(
 		vestingtypes.ModuleName,
 		erc20types.ModuleName,
 		epochstypes.ModuleName,
+		ratelimittypes.ModuleName,
+		cw20types.ModuleName,
+		tokenfactorytypes.ModuleName,
+		revenuetypes.ModuleName,
+		crontypes.ModuleName,
+		gasquotatypes.ModuleName,
+		feeabstypes.ModuleName,
 		// NOTE: crisis module must go at the end to check for invariants on each module
 		crisistypes.ModuleName,
 		consensusparamtypes.ModuleName,
@@ -892,6 +1089,19 @@ func NewAnryton(
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
 
+	// Setup the EVM mempool, unless disabled, and the proposal handlers selecting its txs
+	mempoolConfig, mempoolEnabled, err := evmmempool.ReadConfig(appOpts)
+	if err != nil {
+		panic(fmt.Errorf("invalid mempool config: %w", err))
+	}
+	if mempoolEnabled {
+		app.mempool = evmmempool.NewEVMMempool(mempoolConfig, app.AccountKeeper, app.FeeMarketKeeper)
+		app.SetMempool(app.mempool)
+		handler := baseapp.NewDefaultProposalHandler(app.mempool, app)
+		app.SetPrepareProposal(handler.PrepareProposalHandler())
+		app.SetProcessProposal(handler.ProcessProposalHandler())
+	}
+
 	maxGasWanted := cast.ToUint64(appOpts.Get(srvflags.EVMMaxTxGasWanted))
 
 	app.setAnteHandler(encodingConfig.TxConfig, maxGasWanted, wasmConfig, keys[wasmtypes.StoreKey])
@@ -928,6 +1138,15 @@ func NewAnryton(
 	return app
 }
 
+// EVMTxPool returns the application mempool serving the txpool JSON-RPC namespace,
+// or nil when it is disabled.
+func (app *Anryton) EVMTxPool() anrytontypes.EVMTxPool {
+	if app.mempool == nil {
+		return nil
+	}
+	return app.mempool
+}
+
 // Name returns the name of the App
 func (app *Anryton) Name() string { return app.BaseApp.Name() }
 
@@ -948,9 +1167,15 @@ func (app *Anryton) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64
 		SignModeHandler:        txConfig.SignModeHandler(),
 		SigGasConsumer:         ante.SigVerificationGasConsumer,
 		MaxTxGasWanted:         maxGasWanted,
-		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper),
+		TxFeeChecker:           ethante.NewDynamicFeeChecker(app.EvmKeeper, app.FeeAbsKeeper),
 		WasmConfig:             &wasmConfig,
 		TXCounterStoreKey:      txCounterStoreKey,
+		GasQuotaKeeper:         app.GasQuotaKeeper,
+		FeeAbsKeeper:           app.FeeAbsKeeper,
+	}
+
+	if app.mempool != nil {
+		options.Mempool = app.mempool
 	}
 
 	if err := options.Validate(); err != nil {
@@ -961,14 +1186,16 @@ func (app *Anryton) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64
 }
 
 func (app *Anryton) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
-	)
-	if err != nil {
+	options := post.HandlerOptions{
+		RevenueKeeper:  app.RevenueKeeper,
+		GasQuotaKeeper: app.GasQuotaKeeper,
+	}
+
+	if err := options.Validate(); err != nil {
 		panic(err)
 	}
 
-	app.SetPostHandler(postHandler)
+	app.SetPostHandler(post.NewPostHandler(options))
 }
 
 // BeginBlocker runs the Tendermint ABCI BeginBlock logic. It executes state changes at the beginning
@@ -1217,6 +1444,7 @@ func initParamsKeeper(
 	paramsKeeper.Subspace(ibctransfertypes.ModuleName)
 	paramsKeeper.Subspace(ibcexported.ModuleName)
 	paramsKeeper.Subspace(icahosttypes.SubModuleName)
+	paramsKeeper.Subspace(icacontrollertypes.SubModuleName)
 	paramsKeeper.Subspace(minttypes.ModuleName)
 	// ethermint subspaces
 	paramsKeeper.Subspace(evmtypes.ModuleName).WithKeyTable(evmtypes.ParamKeyTable()) //nolint:staticcheck
@@ -1236,6 +1464,17 @@ func (app *Anryton) setupUpgradeHandlers() {
 		),
 	)
 
+	// v2 upgrade handler
+	app.UpgradeKeeper.SetUpgradeHandler(
+		v2.UpgradeName,
+		v2.CreateUpgradeHandler(
+			app.mm, app.configurator,
+			app.AccountKeeper,
+			app.ICAControllerKeeper,
+			app.EvmKeeper,
+		),
+	)
+
 	// When a planned update height is reached, the old binary will panic
 	// writing on disk the height and name of the update that triggered it
 	// This will read that value, and execute the preparations for the upgrade.
@@ -1252,6 +1491,8 @@ func (app *Anryton) setupUpgradeHandlers() {
 
 	switch upgradeInfo.Name {
 	case v1.UpgradeName:
+	case v2.UpgradeName:
+		storeUpgrades = &v2.StoreUpgrades
 	}
 
 	if storeUpgrades != nil {
@@ -0,0 +1,96 @@
+package mempool
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/spf13/cast"
+
+	srvflags "github.com/anryton/anryton/v2/server/flags"
+)
+
+const (
+	// DefaultMaxTxs is the default maximum number of txs kept in the mempool
+	DefaultMaxTxs = 5000
+
+	// DefaultPriceBump is the default minimum price bump, in percent, required to
+	// replace a tx of the same sender and nonce
+	DefaultPriceBump uint64 = 10
+
+	// DefaultLifetime is the default maximum time a tx is kept in the mempool
+	DefaultLifetime = 3 * time.Hour
+
+	// maxQueuedPerSender is the maximum number of txs of a sender waiting for a
+	// nonce gap to be filled
+	maxQueuedPerSender = 64
+)
+
+// Config defines the configuration of the EVM mempool.
+type Config struct {
+	// MaxTxs is the maximum number of txs in the mempool. Zero means unbounded.
+	MaxTxs int
+	// PriceBump is the minimum price bump, in percent, required to replace a tx
+	// of the same sender and nonce.
+	PriceBump uint64
+	// Lifetime is the maximum time a tx is kept in the mempool. Zero disables the
+	// eviction by age.
+	Lifetime time.Duration
+}
+
+// DefaultConfig returns the default mempool configuration.
+func DefaultConfig() Config {
+	return Config{
+		MaxTxs:    DefaultMaxTxs,
+		PriceBump: DefaultPriceBump,
+		Lifetime:  DefaultLifetime,
+	}
+}
+
+// Validate returns an error if the configuration is invalid.
+func (c Config) Validate() error {
+	if c.MaxTxs < 0 {
+		return fmt.Errorf("max txs cannot be negative: %d", c.MaxTxs)
+	}
+	if c.Lifetime < 0 {
+		return fmt.Errorf("lifetime cannot be negative: %s", c.Lifetime)
+	}
+	return nil
+}
+
+// ReadConfig reads the mempool configuration from the app options, keeping
+// the default values of the missing options. It returns false when the EVM
+// mempool is disabled by a negative mempool.max-txs, as the SDK mempool is.
+func ReadConfig(appOpts servertypes.AppOptions) (Config, bool, error) {
+	config := DefaultConfig()
+
+	if v := appOpts.Get(server.FlagMempoolMaxTxs); v != nil {
+		maxTxs, err := cast.ToIntE(v)
+		if err != nil {
+			return config, false, fmt.Errorf("invalid %s: %w", server.FlagMempoolMaxTxs, err)
+		}
+		if maxTxs < 0 {
+			return config, false, nil
+		}
+		config.MaxTxs = maxTxs
+	}
+
+	if v := appOpts.Get(srvflags.EVMMempoolPriceBump); v != nil {
+		priceBump, err := cast.ToUint64E(v)
+		if err != nil {
+			return config, false, fmt.Errorf("invalid %s: %w", srvflags.EVMMempoolPriceBump, err)
+		}
+		config.PriceBump = priceBump
+	}
+
+	if v := appOpts.Get(srvflags.EVMMempoolLifetime); v != nil {
+		lifetime, err := cast.ToDurationE(v)
+		if err != nil {
+			return config, false, fmt.Errorf("invalid %s: %w", srvflags.EVMMempoolLifetime, err)
+		}
+		config.Lifetime = lifetime
+	}
+
+	return config, true, config.Validate()
+}
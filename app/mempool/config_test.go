@@ -0,0 +1,82 @@
+package mempool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	"github.com/stretchr/testify/require"
+
+	srvflags "github.com/anryton/anryton/v2/server/flags"
+)
+
+func TestReadConfig(t *testing.T) {
+	testCases := []struct {
+		name       string
+		appOpts    simtestutil.AppOptionsMap
+		expConfig  Config
+		expEnabled bool
+		expErr     bool
+	}{
+		{
+			"missing options use the defaults",
+			simtestutil.AppOptionsMap{},
+			DefaultConfig(),
+			true,
+			false,
+		},
+		{
+			"configured options",
+			simtestutil.AppOptionsMap{
+				server.FlagMempoolMaxTxs:     100,
+				srvflags.EVMMempoolPriceBump: uint64(20),
+				srvflags.EVMMempoolLifetime:  "1h",
+			},
+			Config{MaxTxs: 100, PriceBump: 20, Lifetime: time.Hour},
+			true,
+			false,
+		},
+		{
+			"zero max txs is unbounded",
+			simtestutil.AppOptionsMap{server.FlagMempoolMaxTxs: 0},
+			Config{MaxTxs: 0, PriceBump: DefaultPriceBump, Lifetime: DefaultLifetime},
+			true,
+			false,
+		},
+		{
+			"negative max txs disables the mempool",
+			simtestutil.AppOptionsMap{server.FlagMempoolMaxTxs: -1},
+			DefaultConfig(),
+			false,
+			false,
+		},
+		{
+			"invalid max txs",
+			simtestutil.AppOptionsMap{server.FlagMempoolMaxTxs: "many"},
+			DefaultConfig(),
+			false,
+			true,
+		},
+		{
+			"negative lifetime",
+			simtestutil.AppOptionsMap{srvflags.EVMMempoolLifetime: "-1h"},
+			Config{MaxTxs: DefaultMaxTxs, PriceBump: DefaultPriceBump, Lifetime: -time.Hour},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			config, enabled, err := ReadConfig(tc.appOpts)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.expEnabled, enabled)
+			require.Equal(t, tc.expConfig, config)
+		})
+	}
+}
@@ -0,0 +1,20 @@
+package mempool
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AccountKeeper defines the expected account keeper used to look up the
+// sequence of the senders
+type AccountKeeper interface {
+	GetSequence(ctx sdk.Context, addr sdk.AccAddress) (uint64, error)
+}
+
+// FeeMarketKeeper defines the expected fee market keeper used to compute the
+// effective tip of the Ethereum txs
+type FeeMarketKeeper interface {
+	GetBaseFeeEnabled(ctx sdk.Context) bool
+	GetBaseFee(ctx sdk.Context) *big.Int
+}
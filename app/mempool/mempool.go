@@ -0,0 +1,387 @@
+package mempool
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+var (
+	// ErrReplaceUnderpriced is returned when a tx replacing another one of the same
+	// sender and nonce doesn't bump its prices enough
+	ErrReplaceUnderpriced = errors.New("replacement transaction underpriced")
+	// ErrSenderQueueFull is returned when the sender has too many txs waiting for
+	// a nonce gap to be filled
+	ErrSenderQueueFull = errors.New("too many queued transactions for sender")
+)
+
+var _ sdkmempool.Mempool = (*EVMMempool)(nil)
+
+// EVMMempool is an application mempool aware of the nonces of the Ethereum txs.
+// It keeps the txs of each sender sorted by nonce: the txs executable one after
+// another from the nonce of the sender on chain are pending, the txs following
+// a nonce gap are queued until the gap is filled. A tx replaces the one of the
+// same sender and nonce if it bumps its prices by the configured percent. The
+// pending txs are selected by effective tip, and the txs are evicted when they
+// are older than the configured lifetime or the mempool is full.
+type EVMMempool struct {
+	mtx sync.RWMutex
+
+	config          Config
+	accountKeeper   AccountKeeper
+	feeMarketKeeper FeeMarketKeeper
+
+	senders map[string]*senderTxs
+	hashes  map[common.Hash]*txEntry
+	count   int
+	// baseFee is the base fee of the last selection, used to rank the txs on eviction
+	baseFee *big.Int
+
+	now func() time.Time
+}
+
+// NewEVMMempool creates a new EVM mempool.
+func NewEVMMempool(config Config, ak AccountKeeper, fmk FeeMarketKeeper) *EVMMempool {
+	return &EVMMempool{
+		config:          config,
+		accountKeeper:   ak,
+		feeMarketKeeper: fmk,
+		senders:         make(map[string]*senderTxs),
+		hashes:          make(map[common.Hash]*txEntry),
+		now:             time.Now,
+	}
+}
+
+// Insert adds the tx to the mempool. It is called by CheckTx once the ante
+// handler passed, so the sequence of the sender on the context is already
+// increased when the tx is executable.
+func (mp *EVMMempool) Insert(goCtx context.Context, tx sdk.Tx) error {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	entry, err := newTxEntry(tx)
+	if err != nil {
+		return err
+	}
+	entry.priority = ctx.Priority()
+	entry.time = mp.now()
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.evictExpired()
+
+	s, found := mp.senders[entry.sender]
+	if !found {
+		seq, err := mp.accountKeeper.GetSequence(ctx, sdk.AccAddress(entry.sender))
+		if err != nil {
+			return err
+		}
+		// the sender has no other tx, so the sequence is only increased past the
+		// nonce of the tx when the tx is executable
+		s = &senderTxs{nonce: seq}
+		if entry.nonce < seq {
+			s.nonce = entry.nonce
+		}
+	}
+
+	if entry.nonce < s.nonce {
+		return fmt.Errorf("nonce too low: got %d, expected at least %d", entry.nonce, s.nonce)
+	}
+
+	if old := s.get(entry.nonce); old != nil {
+		if old.nonce != entry.nonce || !entry.canReplace(old, mp.config.PriceBump) {
+			return fmt.Errorf("%w: the fee cap and tip cap must be bumped by %d%%", ErrReplaceUnderpriced, mp.config.PriceBump)
+		}
+		mp.removeEntry(s, old)
+	} else {
+		pending := entry.nonce == s.nextNonce()
+		if !pending && len(s.txs)-s.pending() >= maxQueuedPerSender {
+			return fmt.Errorf("%w: %d", ErrSenderQueueFull, maxQueuedPerSender)
+		}
+		if mp.config.MaxTxs > 0 && mp.count >= mp.config.MaxTxs {
+			if err := mp.evictFor(entry, pending); err != nil {
+				return err
+			}
+		}
+	}
+
+	mp.insertEntry(s, entry)
+	return nil
+}
+
+// Select returns an iterator over the pending txs of the mempool. The txs of a
+// sender are returned in nonce order and the txs of the senders are interleaved
+// by decreasing effective tip.
+func (mp *EVMMempool) Select(goCtx context.Context, _ [][]byte) sdkmempool.Iterator {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	mp.evictExpired()
+
+	mp.baseFee = nil
+	if mp.feeMarketKeeper.GetBaseFeeEnabled(ctx) {
+		mp.baseFee = mp.feeMarketKeeper.GetBaseFee(ctx)
+	}
+
+	senders := make(txCursors, 0, len(mp.senders))
+	for key, s := range mp.senders {
+		// the selection context holds the committed state, so the txs using a
+		// lower nonce than the sender sequence are already executed
+		if seq, err := mp.accountKeeper.GetSequence(ctx, sdk.AccAddress(key)); err == nil && seq > s.nonce {
+			s.nonce = seq
+			for _, e := range s.stale() {
+				mp.removeEntry(s, e)
+			}
+			if len(s.txs) == 0 {
+				continue
+			}
+		}
+
+		if n := s.pending(); n > 0 {
+			senders = append(senders, &txCursor{txs: s.txs[:n:n], baseFee: mp.baseFee})
+		}
+	}
+
+	heap.Init(&senders)
+	var txs []sdk.Tx
+	for senders.Len() > 0 {
+		cursor := senders[0]
+		txs = append(txs, cursor.txs[0].tx)
+		if cursor.txs = cursor.txs[1:]; len(cursor.txs) > 0 {
+			heap.Fix(&senders, 0)
+		} else {
+			heap.Pop(&senders)
+		}
+	}
+
+	if len(txs) == 0 {
+		return nil
+	}
+	return &iterator{txs: txs}
+}
+
+// CountTx returns the number of txs in the mempool.
+func (mp *EVMMempool) CountTx() int {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+	return mp.count
+}
+
+// Remove removes the tx using the sender and nonce of the given tx. It is called
+// once the tx is delivered, so the nonce of the sender is moved past it.
+func (mp *EVMMempool) Remove(tx sdk.Tx) error {
+	entry, err := newTxEntry(tx)
+	if err != nil {
+		return err
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	s, found := mp.senders[entry.sender]
+	if !found {
+		return sdkmempool.ErrTxNotFound
+	}
+
+	old := s.get(entry.nonce)
+	if old == nil {
+		return sdkmempool.ErrTxNotFound
+	}
+	mp.removeEntry(s, old)
+
+	if next := entry.nonce + entry.span; next > s.nonce {
+		s.nonce = next
+		for _, e := range s.stale() {
+			mp.removeEntry(s, e)
+		}
+	}
+	return nil
+}
+
+// HasNonce returns true if the mempool has a tx of the sender using the nonce.
+func (mp *EVMMempool) HasNonce(sender common.Address, nonce uint64) bool {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	s, found := mp.senders[string(sender.Bytes())]
+	return found && s.get(nonce) != nil
+}
+
+// HasTx returns true if the mempool has the Ethereum tx.
+func (mp *EVMMempool) HasTx(hash common.Hash) bool {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	_, found := mp.hashes[hash]
+	return found
+}
+
+// Content returns the Ethereum txs of the mempool grouped by sender, split into
+// the pending and the queued ones.
+func (mp *EVMMempool) Content() (map[common.Address]ethtypes.Transactions, map[common.Address]ethtypes.Transactions) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	pending := make(map[common.Address]ethtypes.Transactions)
+	queued := make(map[common.Address]ethtypes.Transactions)
+
+	for key, s := range mp.senders {
+		sender := common.BytesToAddress([]byte(key))
+		n := s.pending()
+		for i, e := range s.txs {
+			content := pending
+			if i >= n {
+				content = queued
+			}
+			for _, msg := range e.msgs {
+				content[sender] = append(content[sender], msg.AsTransaction())
+			}
+		}
+	}
+
+	return pending, queued
+}
+
+// insertEntry adds the tx to the sender txs and the indexes.
+func (mp *EVMMempool) insertEntry(s *senderTxs, entry *txEntry) {
+	s.insert(entry)
+	mp.senders[entry.sender] = s
+	for _, hash := range entry.hashes() {
+		mp.hashes[hash] = entry
+	}
+	mp.count++
+}
+
+// removeEntry removes the tx from the sender txs and the indexes.
+func (mp *EVMMempool) removeEntry(s *senderTxs, entry *txEntry) {
+	if !s.remove(entry) {
+		return
+	}
+	for _, hash := range entry.hashes() {
+		delete(mp.hashes, hash)
+	}
+	if len(s.txs) == 0 {
+		delete(mp.senders, entry.sender)
+	}
+	mp.count--
+}
+
+// evictExpired removes the txs older than the lifetime.
+func (mp *EVMMempool) evictExpired() {
+	if mp.config.Lifetime == 0 {
+		return
+	}
+
+	deadline := mp.now().Add(-mp.config.Lifetime)
+	for _, s := range mp.senders {
+		for _, e := range append([]*txEntry(nil), s.txs...) {
+			if e.time.Before(deadline) {
+				mp.removeEntry(s, e)
+			}
+		}
+	}
+}
+
+// evictFor makes room for the tx by removing the last tx of a sender, so that no
+// nonce gap is created. The queued txs are evicted before the pending ones, then
+// the txs with the lowest effective tip. It fails if the tx ranks below all the
+// txs that could be evicted.
+func (mp *EVMMempool) evictFor(entry *txEntry, pending bool) error {
+	var (
+		victim        *txEntry
+		victimSender  *senderTxs
+		victimPending bool
+	)
+
+	for key, s := range mp.senders {
+		last := s.txs[len(s.txs)-1]
+		if key == entry.sender && last.nonce < entry.nonce {
+			// the tx would follow the evicted one
+			continue
+		}
+
+		lastPending := s.pending() == len(s.txs)
+		if victim == nil || ranksBelow(last, lastPending, victim, victimPending, mp.baseFee) {
+			victim, victimSender, victimPending = last, s, lastPending
+		}
+	}
+
+	if victim == nil || !ranksBelow(victim, victimPending, entry, pending, mp.baseFee) {
+		return sdkmempool.ErrMempoolTxMaxCapacity
+	}
+
+	mp.removeEntry(victimSender, victim)
+	return nil
+}
+
+// ranksBelow returns true if the tx a is evicted before the tx b.
+func ranksBelow(a *txEntry, aPending bool, b *txEntry, bPending bool, baseFee *big.Int) bool {
+	if aPending != bPending {
+		return !aPending
+	}
+	return a.effectivePriority(baseFee) < b.effectivePriority(baseFee)
+}
+
+// txCursor points to the next pending tx of a sender on selection.
+type txCursor struct {
+	txs     []*txEntry
+	baseFee *big.Int
+}
+
+// txCursors is a max-heap of the senders by the effective tip of their next tx,
+// the oldest tx first on equal tips.
+type txCursors []*txCursor
+
+func (h txCursors) Len() int { return len(h) }
+
+func (h txCursors) Less(i, j int) bool {
+	a, b := h[i].txs[0], h[j].txs[0]
+	pa, pb := a.effectivePriority(h[i].baseFee), b.effectivePriority(h[j].baseFee)
+	if pa != pb {
+		return pa > pb
+	}
+	return a.time.Before(b.time)
+}
+
+func (h txCursors) Swap(i, j int) { h[i], h[j] = h[j], h[i] }
+
+func (h *txCursors) Push(x interface{}) { *h = append(*h, x.(*txCursor)) }
+
+func (h *txCursors) Pop() interface{} {
+	old := *h
+	n := len(old)
+	x := old[n-1]
+	*h = old[:n-1]
+	return x
+}
+
+var _ sdkmempool.Iterator = (*iterator)(nil)
+
+// iterator walks through the txs returned by Select.
+type iterator struct {
+	txs []sdk.Tx
+}
+
+// Next returns the iterator over the following txs, or nil when there is none.
+func (it *iterator) Next() sdkmempool.Iterator {
+	if len(it.txs) <= 1 {
+		return nil
+	}
+	return &iterator{txs: it.txs[1:]}
+}
+
+// Tx returns the current tx.
+func (it *iterator) Tx() sdk.Tx {
+	return it.txs[0]
+}
@@ -0,0 +1,290 @@
+package mempool
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkmempool "github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+var (
+	alice = common.BytesToAddress([]byte("alice"))
+	bob   = common.BytesToAddress([]byte("bob"))
+	carol = common.BytesToAddress([]byte("carol"))
+)
+
+type mockAccountKeeper struct {
+	sequences map[string]uint64
+}
+
+func (m mockAccountKeeper) GetSequence(_ sdk.Context, addr sdk.AccAddress) (uint64, error) {
+	return m.sequences[string(addr)], nil
+}
+
+func (m mockAccountKeeper) setSequence(addr common.Address, seq uint64) {
+	m.sequences[string(addr.Bytes())] = seq
+}
+
+type mockFeeMarketKeeper struct {
+	baseFee *big.Int
+}
+
+func (m mockFeeMarketKeeper) GetBaseFeeEnabled(sdk.Context) bool { return m.baseFee != nil }
+
+func (m mockFeeMarketKeeper) GetBaseFee(sdk.Context) *big.Int { return m.baseFee }
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg { return tx.msgs }
+
+func (tx mockTx) ValidateBasic() error { return nil }
+
+func gwei(n int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(n), big.NewInt(params.GWei))
+}
+
+// newEthTx returns a dynamic fee Ethereum tx of the sender.
+func newEthTx(sender common.Address, nonce uint64, feeCap, tipCap int64) sdk.Tx {
+	msg := evmtypes.NewTx(&evmtypes.EvmTxArgs{
+		ChainID:   big.NewInt(9000),
+		Nonce:     nonce,
+		GasLimit:  21000,
+		GasFeeCap: gwei(feeCap),
+		GasTipCap: gwei(tipCap),
+		To:        &common.Address{},
+		Accesses:  &ethtypes.AccessList{},
+	})
+	msg.From = sender.Hex()
+	return mockTx{msgs: []sdk.Msg{msg}}
+}
+
+func txHash(tx sdk.Tx) common.Hash {
+	return common.HexToHash(tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).Hash)
+}
+
+func setupMempool(config Config, baseFee *big.Int) (*EVMMempool, mockAccountKeeper) {
+	ak := mockAccountKeeper{sequences: make(map[string]uint64)}
+	mp := NewEVMMempool(config, ak, mockFeeMarketKeeper{baseFee: baseFee})
+
+	// every tx is inserted a second after the previous one
+	now := time.Now()
+	mp.now = func() time.Time {
+		now = now.Add(time.Second)
+		return now
+	}
+	return mp, ak
+}
+
+// insert inserts the tx with the sender sequence left by the ante handler.
+func insert(t *testing.T, mp *EVMMempool, ak mockAccountKeeper, tx sdk.Tx, seq uint64) error {
+	t.Helper()
+	msg := tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	ak.setSequence(common.HexToAddress(msg.From), seq)
+	return mp.Insert(sdk.Context{}, tx)
+}
+
+func selectTxs(mp *EVMMempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(sdk.Context{}, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestInsertQueuesNonceGap(t *testing.T) {
+	mp, ak := setupMempool(DefaultConfig(), nil)
+
+	tx0, tx1, tx2 := newEthTx(alice, 0, 10, 1), newEthTx(alice, 1, 10, 1), newEthTx(alice, 2, 10, 1)
+
+	require.NoError(t, insert(t, mp, ak, tx0, 1))
+	// the nonce gap leaves the sequence untouched
+	require.NoError(t, insert(t, mp, ak, tx2, 1))
+	require.Equal(t, 2, mp.CountTx())
+
+	pending, queued := mp.Content()
+	require.Len(t, pending[alice], 1)
+	require.Len(t, queued[alice], 1)
+	require.Equal(t, uint64(2), queued[alice][0].Nonce())
+
+	ak.setSequence(alice, 0)
+	require.Equal(t, []sdk.Tx{tx0}, selectTxs(mp))
+
+	// filling the gap promotes the queued tx
+	require.NoError(t, insert(t, mp, ak, tx1, 3))
+	pending, queued = mp.Content()
+	require.Len(t, pending[alice], 3)
+	require.Empty(t, queued)
+
+	ak.setSequence(alice, 0)
+	require.Equal(t, []sdk.Tx{tx0, tx1, tx2}, selectTxs(mp))
+
+	require.True(t, mp.HasNonce(alice, 2))
+	require.False(t, mp.HasNonce(alice, 3))
+	require.False(t, mp.HasNonce(bob, 0))
+}
+
+func TestInsertQueueLimit(t *testing.T) {
+	mp, ak := setupMempool(DefaultConfig(), nil)
+
+	for i := uint64(1); i <= maxQueuedPerSender; i++ {
+		require.NoError(t, insert(t, mp, ak, newEthTx(alice, i, 10, 1), 0))
+	}
+	require.ErrorIs(t, insert(t, mp, ak, newEthTx(alice, maxQueuedPerSender+1, 10, 1), 0), ErrSenderQueueFull)
+
+	// the executable txs are still accepted
+	require.NoError(t, insert(t, mp, ak, newEthTx(alice, 0, 10, 1), 1))
+}
+
+func TestReplaceByFee(t *testing.T) {
+	mp, ak := setupMempool(DefaultConfig(), nil)
+
+	original := newEthTx(alice, 0, 100, 10)
+	require.NoError(t, insert(t, mp, ak, original, 1))
+
+	testCases := []struct {
+		name   string
+		feeCap int64
+		tipCap int64
+	}{
+		{"same prices", 100, 10},
+		{"fee cap below the bump", 109, 11},
+		{"tip cap below the bump", 110, 10},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := insert(t, mp, ak, newEthTx(alice, 0, tc.feeCap, tc.tipCap), 1)
+			require.ErrorIs(t, err, ErrReplaceUnderpriced)
+		})
+	}
+
+	replacement := newEthTx(alice, 0, 110, 11)
+	require.NoError(t, insert(t, mp, ak, replacement, 1))
+	require.Equal(t, 1, mp.CountTx())
+	require.False(t, mp.HasTx(txHash(original)))
+	require.True(t, mp.HasTx(txHash(replacement)))
+
+	ak.setSequence(alice, 0)
+	require.Equal(t, []sdk.Tx{replacement}, selectTxs(mp))
+}
+
+func TestSelectOrdersByEffectiveTip(t *testing.T) {
+	mp, ak := setupMempool(DefaultConfig(), gwei(10))
+
+	// the effective tip of alice is capped to 2 gwei by the fee cap
+	aliceTx := newEthTx(alice, 0, 12, 5)
+	bobTx0, bobTx1 := newEthTx(bob, 0, 20, 3), newEthTx(bob, 1, 20, 1)
+	carolTx := newEthTx(carol, 0, 20, 2)
+
+	require.NoError(t, insert(t, mp, ak, aliceTx, 1))
+	require.NoError(t, insert(t, mp, ak, bobTx0, 1))
+	require.NoError(t, insert(t, mp, ak, bobTx1, 2))
+	require.NoError(t, insert(t, mp, ak, carolTx, 1))
+
+	ak.setSequence(alice, 0)
+	ak.setSequence(bob, 0)
+	ak.setSequence(carol, 0)
+
+	// the txs of bob keep their nonce order, the ties are broken by age
+	require.Equal(t, []sdk.Tx{bobTx0, aliceTx, carolTx, bobTx1}, selectTxs(mp))
+}
+
+func TestSelectDropsExecutedTxs(t *testing.T) {
+	mp, ak := setupMempool(DefaultConfig(), nil)
+
+	tx0, tx1 := newEthTx(alice, 0, 10, 1), newEthTx(alice, 1, 10, 1)
+	require.NoError(t, insert(t, mp, ak, tx0, 1))
+	require.NoError(t, insert(t, mp, ak, tx1, 2))
+
+	// the first tx was executed in a block proposed by another validator
+	ak.setSequence(alice, 1)
+	require.Equal(t, []sdk.Tx{tx1}, selectTxs(mp))
+	require.Equal(t, 1, mp.CountTx())
+	require.False(t, mp.HasTx(txHash(tx0)))
+}
+
+func TestRemove(t *testing.T) {
+	mp, ak := setupMempool(DefaultConfig(), nil)
+
+	tx0, tx1, tx3 := newEthTx(alice, 0, 10, 1), newEthTx(alice, 1, 10, 1), newEthTx(alice, 3, 10, 1)
+	require.NoError(t, insert(t, mp, ak, tx0, 1))
+	require.NoError(t, insert(t, mp, ak, tx1, 2))
+	require.NoError(t, insert(t, mp, ak, tx3, 2))
+
+	require.NoError(t, mp.Remove(tx0))
+	require.ErrorIs(t, mp.Remove(tx0), sdkmempool.ErrTxNotFound)
+	require.ErrorIs(t, mp.Remove(newEthTx(bob, 0, 10, 1)), sdkmempool.ErrTxNotFound)
+	require.Equal(t, 2, mp.CountTx())
+
+	// the delivered tx moves the nonce of the sender
+	pending, queued := mp.Content()
+	require.Len(t, pending[alice], 1)
+	require.Len(t, queued[alice], 1)
+
+	require.NoError(t, mp.Remove(tx1))
+	require.NoError(t, mp.Remove(tx3))
+	require.Zero(t, mp.CountTx())
+	require.Nil(t, mp.Select(sdk.Context{}, nil))
+}
+
+func TestEvictBySize(t *testing.T) {
+	mp, ak := setupMempool(Config{MaxTxs: 3, PriceBump: DefaultPriceBump}, nil)
+
+	aliceTx, bobTx, carolQueuedTx := newEthTx(alice, 0, 1, 1), newEthTx(bob, 0, 2, 2), newEthTx(carol, 1, 5, 5)
+	require.NoError(t, insert(t, mp, ak, aliceTx, 1))
+	require.NoError(t, insert(t, mp, ak, bobTx, 1))
+	require.NoError(t, insert(t, mp, ak, carolQueuedTx, 0))
+
+	// the queued txs are evicted first
+	carolTx := newEthTx(carol, 0, 3, 3)
+	require.NoError(t, insert(t, mp, ak, carolTx, 1))
+	require.False(t, mp.HasTx(txHash(carolQueuedTx)))
+
+	// then the pending txs with the lowest tip
+	aliceTx1 := newEthTx(alice, 1, 4, 4)
+	require.NoError(t, insert(t, mp, ak, aliceTx1, 2))
+	require.False(t, mp.HasTx(txHash(bobTx)))
+	require.Equal(t, 3, mp.CountTx())
+
+	// the txs ranking below all the others are rejected
+	require.ErrorIs(t, insert(t, mp, ak, newEthTx(bob, 0, 1, 1), 1), sdkmempool.ErrMempoolTxMaxCapacity)
+	require.ErrorIs(t, insert(t, mp, ak, newEthTx(bob, 5, 9, 9), 0), sdkmempool.ErrMempoolTxMaxCapacity)
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestEvictByAge(t *testing.T) {
+	mp, ak := setupMempool(Config{PriceBump: DefaultPriceBump, Lifetime: time.Hour}, nil)
+
+	now := time.Now()
+	mp.now = func() time.Time { return now }
+
+	oldTx := newEthTx(alice, 0, 10, 1)
+	require.NoError(t, insert(t, mp, ak, oldTx, 1))
+
+	now = now.Add(30 * time.Minute)
+	newTx := newEthTx(bob, 0, 10, 1)
+	require.NoError(t, insert(t, mp, ak, newTx, 1))
+
+	now = now.Add(31 * time.Minute)
+	ak.setSequence(alice, 0)
+	ak.setSequence(bob, 0)
+	require.Equal(t, []sdk.Tx{newTx}, selectTxs(mp))
+	require.Equal(t, 1, mp.CountTx())
+	require.False(t, mp.HasTx(txHash(oldTx)))
+}
+
+func TestBumpPrice(t *testing.T) {
+	require.Equal(t, big.NewInt(110), bumpPrice(big.NewInt(100), 10))
+	require.Equal(t, big.NewInt(2), bumpPrice(big.NewInt(1), 10))
+	require.Equal(t, big.NewInt(100), bumpPrice(big.NewInt(100), 0))
+}
@@ -0,0 +1,245 @@
+package mempool
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/common"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+// txEntry is a tx kept in the mempool along with the values used to order and
+// replace it.
+type txEntry struct {
+	tx sdk.Tx
+	// sender is the address of the first signer of the tx
+	sender string
+	// nonce is the first nonce of the sender used by the tx
+	nonce uint64
+	// span is the number of consecutive nonces of the sender used by the tx
+	span uint64
+	// msgs are the Ethereum msgs of the tx, empty for the Cosmos txs
+	msgs   []*evmtypes.MsgEthereumTx
+	txData []evmtypes.TxData
+	// gasFeeCap and gasTipCap are the prices compared on replacement
+	gasFeeCap *big.Int
+	gasTipCap *big.Int
+	// priority is the priority of the Cosmos txs set by the ante handler
+	priority int64
+	time     time.Time
+}
+
+// newTxEntry creates the mempool entry of the tx.
+func newTxEntry(tx sdk.Tx) (*txEntry, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return nil, errors.New("tx must have at least one message")
+	}
+
+	if _, ok := msgs[0].(*evmtypes.MsgEthereumTx); ok {
+		return newEthereumTxEntry(tx, msgs)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return nil, fmt.Errorf("tx of type %T does not implement %T", tx, (authsigning.SigVerifiableTx)(nil))
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	signers := sigTx.GetSigners()
+	if len(sigs) == 0 || len(signers) == 0 {
+		return nil, errors.New("tx must have at least one signer")
+	}
+
+	return &txEntry{
+		tx:     tx,
+		sender: string(signers[0]),
+		nonce:  sigs[0].Sequence,
+		span:   1,
+	}, nil
+}
+
+// newEthereumTxEntry creates the mempool entry of a tx made of Ethereum msgs.
+// The tx uses the consecutive nonces of the msgs signed by the sender of the
+// first msg.
+func newEthereumTxEntry(tx sdk.Tx, msgs []sdk.Msg) (*txEntry, error) {
+	entry := &txEntry{tx: tx}
+
+	for i, msg := range msgs {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return nil, fmt.Errorf("invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(ethMsg.Data)
+		if err != nil {
+			return nil, fmt.Errorf("failed to unpack tx data: %w", err)
+		}
+
+		if i == 0 {
+			entry.sender = string(ethMsg.GetFrom())
+			entry.nonce = txData.GetNonce()
+			entry.gasFeeCap = txData.GetGasFeeCap()
+			entry.gasTipCap = txData.GetGasTipCap()
+		}
+		if string(ethMsg.GetFrom()) == entry.sender && txData.GetNonce() == entry.nonce+entry.span {
+			entry.span++
+		}
+
+		entry.msgs = append(entry.msgs, ethMsg)
+		entry.txData = append(entry.txData, txData)
+	}
+
+	if len(entry.sender) == 0 {
+		return nil, errors.New("sender of the Ethereum tx is not set")
+	}
+	return entry, nil
+}
+
+// isEthereum returns true if the tx is made of Ethereum msgs.
+func (e *txEntry) isEthereum() bool {
+	return len(e.msgs) > 0
+}
+
+// hashes returns the hashes of the Ethereum msgs of the tx.
+func (e *txEntry) hashes() []common.Hash {
+	hashes := make([]common.Hash, len(e.msgs))
+	for i, msg := range e.msgs {
+		hashes[i] = common.HexToHash(msg.Hash)
+	}
+	return hashes
+}
+
+// effectivePriority returns the priority of the tx. The priority of the Ethereum
+// txs is the lowest effective tip of their msgs, reduced like the priority set by
+// the ante handler.
+func (e *txEntry) effectivePriority(baseFee *big.Int) int64 {
+	if !e.isEthereum() {
+		return e.priority
+	}
+	if baseFee == nil {
+		// the tip of the dynamic fee txs is capped by their fee cap
+		baseFee = common.Big0
+	}
+
+	priority := evmtypes.GetTxPriority(e.txData[0], baseFee)
+	for _, txData := range e.txData[1:] {
+		if p := evmtypes.GetTxPriority(txData, baseFee); p < priority {
+			priority = p
+		}
+	}
+	return priority
+}
+
+// prices returns the fee cap and tip cap compared on replacement.
+func (e *txEntry) prices() (*big.Int, *big.Int) {
+	if !e.isEthereum() {
+		priority := big.NewInt(e.priority)
+		return priority, priority
+	}
+	return e.gasFeeCap, e.gasTipCap
+}
+
+// canReplace returns true if both the fee cap and the tip cap of the tx are
+// higher than the ones of the old tx by at least the price bump percent.
+func (e *txEntry) canReplace(old *txEntry, priceBump uint64) bool {
+	feeCap, tipCap := e.prices()
+	oldFeeCap, oldTipCap := old.prices()
+
+	return feeCap.Cmp(bumpPrice(oldFeeCap, priceBump)) >= 0 &&
+		tipCap.Cmp(bumpPrice(oldTipCap, priceBump)) >= 0
+}
+
+// bumpPrice returns the price increased by the price bump percent, rounded up.
+func bumpPrice(price *big.Int, priceBump uint64) *big.Int {
+	bumped := new(big.Int).Mul(price, new(big.Int).SetUint64(100+priceBump))
+	bumped.Add(bumped, big.NewInt(99))
+	return bumped.Quo(bumped, big.NewInt(100))
+}
+
+// senderTxs are the txs of a sender sorted by nonce.
+type senderTxs struct {
+	// nonce is the next nonce of the sender on chain
+	nonce uint64
+	txs   []*txEntry
+}
+
+// search returns the index of the first tx whose nonce is not lower than the
+// given nonce.
+func (s *senderTxs) search(nonce uint64) int {
+	return sort.Search(len(s.txs), func(i int) bool { return s.txs[i].nonce >= nonce })
+}
+
+// get returns the tx using the nonce, or nil if there is none.
+func (s *senderTxs) get(nonce uint64) *txEntry {
+	i := s.search(nonce)
+	if i > 0 && s.txs[i-1].nonce+s.txs[i-1].span > nonce {
+		return s.txs[i-1]
+	}
+	if i < len(s.txs) && s.txs[i].nonce == nonce {
+		return s.txs[i]
+	}
+	return nil
+}
+
+// insert adds the tx at its nonce position.
+func (s *senderTxs) insert(entry *txEntry) {
+	i := s.search(entry.nonce)
+	s.txs = append(s.txs, nil)
+	copy(s.txs[i+1:], s.txs[i:])
+	s.txs[i] = entry
+}
+
+// remove removes the tx and returns true if it belonged to the sender.
+func (s *senderTxs) remove(entry *txEntry) bool {
+	for i, e := range s.txs {
+		if e == entry {
+			s.txs = append(s.txs[:i], s.txs[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// pending returns the number of leading txs executable one after another from
+// the nonce of the sender. The txs following them are queued.
+func (s *senderTxs) pending() int {
+	next := s.nonce
+	for i, e := range s.txs {
+		if e.nonce != next {
+			return i
+		}
+		next += e.span
+	}
+	return len(s.txs)
+}
+
+// nextNonce returns the nonce following the pending txs of the sender.
+func (s *senderTxs) nextNonce() uint64 {
+	next := s.nonce
+	for _, e := range s.txs[:s.pending()] {
+		next += e.span
+	}
+	return next
+}
+
+// stale returns the txs using a nonce lower than the nonce of the sender.
+func (s *senderTxs) stale() []*txEntry {
+	var stale []*txEntry
+	for _, e := range s.txs {
+		if e.nonce >= s.nonce {
+			break
+		}
+		stale = append(stale, e)
+	}
+	return stale
+}
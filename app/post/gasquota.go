@@ -0,0 +1,52 @@
+package post
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anteutils "github.com/anryton/anryton/v2/app/ante/utils"
+)
+
+// GasQuotaDecorator credits back to the gas allowance of the fee payer the gas
+// covered by the DeductFeeDecorator of the AnteHandler and left unused by a
+// successful Cosmos transaction. The unused gas of the Ethereum transactions
+// is credited back by the EVM keeper with the refund of the leftover gas.
+type GasQuotaDecorator struct {
+	gasQuotaKeeper GasQuotaKeeper
+}
+
+// NewGasQuotaDecorator creates a new GasQuotaDecorator
+func NewGasQuotaDecorator(gqk GasQuotaKeeper) GasQuotaDecorator {
+	return GasQuotaDecorator{
+		gasQuotaKeeper: gqk,
+	}
+}
+
+// PostHandle credits back the unused gas of the transaction, up to the gas
+// covered by the allowance.
+func (gqd GasQuotaDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	covered := anteutils.CoveredGas(ctx)
+	if !success || !ok || covered == 0 {
+		return next(ctx, tx, simulate, success)
+	}
+
+	if refund := unusedGas(covered, ctx.GasMeter().GasConsumed(), feeTx.GetGas()); refund > 0 {
+		gqd.gasQuotaKeeper.RefundGasQuota(ctx, feeTx.FeePayer(), refund)
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// unusedGas returns the gas left unused out of the gas limit of the
+// transaction, capped to the covered gas.
+func unusedGas(covered, gasUsed, gasLimit uint64) uint64 {
+	if gasUsed >= gasLimit {
+		return 0
+	}
+
+	unused := gasLimit - gasUsed
+	if unused > covered {
+		return covered
+	}
+	return unused
+}
@@ -0,0 +1,29 @@
+package post
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestUnusedGas(t *testing.T) {
+	testCases := []struct {
+		name      string
+		covered   uint64
+		gasUsed   uint64
+		gasLimit  uint64
+		expUnused uint64
+	}{
+		{"all the gas used", 200_000, 200_000, 200_000, 0},
+		{"more gas used than the limit", 200_000, 250_000, 200_000, 0},
+		{"all the gas covered", 200_000, 50_000, 200_000, 150_000},
+		{"capped to the covered gas", 100_000, 50_000, 200_000, 100_000},
+		{"paid gas unused", 100_000, 150_000, 200_000, 50_000},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expUnused, unusedGas(tc.covered, tc.gasUsed, tc.gasLimit))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package post
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// RevenueKeeper defines the exposed interface for using functionality of the
+// revenue keeper in the context of the PostHandler package.
+type RevenueKeeper interface {
+	DistributeWasmFees(ctx sdk.Context, fees sdk.Coins, contracts []sdk.AccAddress) error
+}
+
+// GasQuotaKeeper defines the exposed interface for using functionality of the
+// gasquota keeper in the context of the PostHandler package.
+type GasQuotaKeeper interface {
+	RefundGasQuota(ctx sdk.Context, addr sdk.AccAddress, gas uint64)
+}
@@ -0,0 +1,34 @@
+package post
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// HandlerOptions defines the list of module keepers required to run the Anryton
+// PostHandler decorators.
+type HandlerOptions struct {
+	RevenueKeeper  RevenueKeeper
+	GasQuotaKeeper GasQuotaKeeper
+}
+
+// Validate checks if the keepers are defined
+func (options HandlerOptions) Validate() error {
+	if options.RevenueKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "revenue keeper is required for PostHandler")
+	}
+	if options.GasQuotaKeeper == nil {
+		return errorsmod.Wrap(errortypes.ErrLogic, "gasquota keeper is required for PostHandler")
+	}
+	return nil
+}
+
+// NewPostHandler returns the PostHandler chain, which runs after the messages
+// of a transaction are executed successfully.
+func NewPostHandler(options HandlerOptions) sdk.PostHandler {
+	return sdk.ChainPostDecorators(
+		NewRevenueDecorator(options.RevenueKeeper),
+		NewGasQuotaDecorator(options.GasQuotaKeeper),
+	)
+}
@@ -0,0 +1,96 @@
+package post
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	anteutils "github.com/anryton/anryton/v2/app/ante/utils"
+	wasmtypes "github.com/anryton/anryton/v2/x/wasm/types"
+)
+
+// RevenueDecorator distributes the developer share of the fees of a successful
+// Cosmos transaction to the withdrawers of the registered CosmWasm contracts it
+// executes. The fees are deducted by the DeductFeeDecorator of the
+// AnteHandler.
+type RevenueDecorator struct {
+	revenueKeeper RevenueKeeper
+}
+
+// NewRevenueDecorator creates a new RevenueDecorator
+func NewRevenueDecorator(rk RevenueKeeper) RevenueDecorator {
+	return RevenueDecorator{
+		revenueKeeper: rk,
+	}
+}
+
+// PostHandle distributes the developer share of the fees of the gas used by
+// the transaction between the executed CosmWasm contracts, including the
+// contracts executed through authz. The fees of the unused gas are not
+// distributed.
+func (rd RevenueDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !success || !ok || feeTx.GetGas() == 0 {
+		return next(ctx, tx, simulate, success)
+	}
+
+	fees := usedFees(anteutils.DeductedFees(ctx), ctx.GasMeter().GasConsumed(), feeTx.GetGas())
+	if fees.IsZero() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	contracts, err := executedContracts(tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+
+	if err := rd.revenueKeeper.DistributeWasmFees(ctx, fees, contracts); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// usedFees returns the part of the fees paid for the gas used out of the gas
+// limit of the transaction.
+func usedFees(fees sdk.Coins, gasUsed, gasLimit uint64) sdk.Coins {
+	if gasUsed >= gasLimit {
+		return fees
+	}
+
+	ratio := sdk.NewDecFromInt(sdk.NewIntFromUint64(gasUsed)).QuoInt(sdk.NewIntFromUint64(gasLimit))
+	used := sdk.Coins{}
+	for _, fee := range fees {
+		amount := sdk.NewDecFromInt(fee.Amount).Mul(ratio).TruncateInt()
+		if amount.IsPositive() {
+			used = used.Add(sdk.NewCoin(fee.Denom, amount))
+		}
+	}
+	return used
+}
+
+// executedContracts returns the addresses of the CosmWasm contracts executed by
+// the messages.
+func executedContracts(msgs []sdk.Msg) ([]sdk.AccAddress, error) {
+	var contracts []sdk.AccAddress
+	for _, msg := range msgs {
+		switch msg := msg.(type) {
+		case *wasmtypes.MsgExecuteContract:
+			contract, err := sdk.AccAddressFromBech32(msg.Contract)
+			if err != nil {
+				return nil, err
+			}
+			contracts = append(contracts, contract)
+		case *authz.MsgExec:
+			execMsgs, err := msg.GetMessages()
+			if err != nil {
+				return nil, err
+			}
+			execContracts, err := executedContracts(execMsgs)
+			if err != nil {
+				return nil, err
+			}
+			contracts = append(contracts, execContracts...)
+		}
+	}
+	return contracts, nil
+}
@@ -0,0 +1,31 @@
+package post
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsedFees(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewInt64Coin("anryton", 1000), sdk.NewInt64Coin("uatom", 3))
+
+	testCases := []struct {
+		name     string
+		gasUsed  uint64
+		gasLimit uint64
+		expFees  sdk.Coins
+	}{
+		{"all the gas used", 200_000, 200_000, fees},
+		{"more gas used than the limit", 250_000, 200_000, fees},
+		{"half of the gas used", 100_000, 200_000, sdk.NewCoins(sdk.NewInt64Coin("anryton", 500), sdk.NewInt64Coin("uatom", 1))},
+		{"truncated to zero", 10_000, 200_000, sdk.NewCoins(sdk.NewInt64Coin("anryton", 50))},
+		{"no gas used", 0, 200_000, sdk.Coins{}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expFees, usedFees(fees, tc.gasUsed, tc.gasLimit))
+		})
+	}
+}
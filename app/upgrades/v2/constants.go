@@ -0,0 +1,58 @@
+package v2
+
+import (
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	icaprecompile "github.com/anryton/anryton/v2/precompiles/ica"
+	tokenfactoryprecompile "github.com/anryton/anryton/v2/precompiles/tokenfactory"
+	crontypes "github.com/anryton/anryton/v2/x/cron/types"
+	cw20types "github.com/anryton/anryton/v2/x/cw20/types"
+	feeabstypes "github.com/anryton/anryton/v2/x/feeabs/types"
+	gasquotatypes "github.com/anryton/anryton/v2/x/gasquota/types"
+	ibcforwardtypes "github.com/anryton/anryton/v2/x/ibc/forward/types"
+	ibchookstypes "github.com/anryton/anryton/v2/x/ibc/hooks/types"
+	ratelimittypes "github.com/anryton/anryton/v2/x/ratelimit/types"
+	revenuetypes "github.com/anryton/anryton/v2/x/revenue/types"
+	tokenfactorytypes "github.com/anryton/anryton/v2/x/tokenfactory/types"
+)
+
+const (
+	// UpgradeName is the shared upgrade plan name for mainnet and testnet
+	UpgradeName = "v2.0.0"
+)
+
+// StoreUpgrades defines the stores added by the upgrade
+var StoreUpgrades = storetypes.StoreUpgrades{
+	Added: []string{
+		ibchookstypes.StoreKey,
+		ibcforwardtypes.StoreKey,
+		ratelimittypes.StoreKey,
+		icacontrollertypes.StoreKey,
+		cw20types.StoreKey,
+		tokenfactorytypes.StoreKey,
+		revenuetypes.StoreKey,
+		crontypes.StoreKey,
+		gasquotatypes.StoreKey,
+		feeabstypes.StoreKey,
+	},
+}
+
+// ModuleAccounts defines the module accounts of the modules added by the
+// upgrade. The revenue module has no module account, the developer fees are
+// paid from the fee collector, and neither has the gasquota module.
+var ModuleAccounts = []string{
+	cw20types.ModuleName,
+	tokenfactorytypes.ModuleName,
+	crontypes.ModuleName,
+	feeabstypes.ModuleName,
+}
+
+// Precompiles defines the precompiled contracts of the modules added by the
+// upgrade, enabled on the EVM params: the ICA (0x804) and token factory (0x805)
+// precompiles.
+var Precompiles = []common.Address{
+	icaprecompile.Precompile{}.Address(),
+	tokenfactoryprecompile.Precompile{}.Address(),
+}
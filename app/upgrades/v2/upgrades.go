@@ -0,0 +1,53 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+
+	evmkeeper "github.com/anryton/anryton/v2/x/evm/keeper"
+)
+
+// CreateUpgradeHandler creates an SDK upgrade handler for v2
+func CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	accountKeeper authkeeper.AccountKeeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	evmKeeper *evmkeeper.Keeper,
+) upgradetypes.UpgradeHandler {
+	return func(ctx sdk.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		logger := ctx.Logger().With("upgrade", UpgradeName)
+
+		// The module accounts of the modules added by the upgrade are created
+		// before their InitGenesis, which expects them to be set.
+		for _, moduleName := range ModuleAccounts {
+			accountKeeper.GetModuleAccount(ctx, moduleName)
+		}
+
+		// The modules added by the upgrade are not in the version map, so
+		// RunMigrations runs their InitGenesis with the default genesis state.
+		logger.Debug("running module migrations ...")
+		vm, err := mm.RunMigrations(ctx, configurator, vm)
+		if err != nil {
+			return vm, err
+		}
+
+		// The ICA module is already in the version map, so the params of the
+		// controller submodule added by the upgrade are set explicitly.
+		logger.Debug("setting the ICA controller params ...")
+		icaControllerKeeper.SetParams(ctx, icacontrollertypes.DefaultParams())
+
+		// The precompiles of the modules added by the upgrade are enabled on the
+		// existing EVM params, which are kept by the migrations.
+		logger.Debug("enabling the precompiles ...")
+		if err := evmKeeper.EnablePrecompiles(ctx, Precompiles...); err != nil {
+			return vm, err
+		}
+
+		return vm, nil
+	}
+}
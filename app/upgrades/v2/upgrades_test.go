@@ -0,0 +1,31 @@
+package v2_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/anryton/anryton/v2/app/upgrades/v2"
+)
+
+func TestStoreUpgrades(t *testing.T) {
+	added := make(map[string]bool)
+	for _, key := range v2.StoreUpgrades.Added {
+		require.NotEmpty(t, key)
+		require.False(t, added[key], "store %s added twice", key)
+		added[key] = true
+	}
+	for _, moduleName := range v2.ModuleAccounts {
+		require.True(t, added[moduleName], "module account of %s added without its store", moduleName)
+	}
+	require.Empty(t, v2.StoreUpgrades.Renamed)
+	require.Empty(t, v2.StoreUpgrades.Deleted)
+}
+
+func TestPrecompiles(t *testing.T) {
+	require.Equal(t, []common.Address{
+		common.HexToAddress("0x0000000000000000000000000000000000000804"),
+		common.HexToAddress("0x0000000000000000000000000000000000000805"),
+	}, v2.Precompiles)
+}
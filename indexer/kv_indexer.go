@@ -102,6 +102,9 @@ func (kv *KVIndexer) IndexBlock(block *tmtypes.Block, txResults []*abci.Response
 				}
 				txResult.GasUsed = parsedTx.GasUsed
 				txResult.Failed = parsedTx.Failed
+				if txResult.Failed {
+					txResult.RevertReason = rpctypes.TxRevertReason(result, msgIndex)
+				}
 			}
 
 			cumulativeGasUsed += txResult.GasUsed
@@ -201,11 +204,19 @@ func isEthTx(tx sdk.Tx) bool {
 	if !ok {
 		return false
 	}
+	// the sponsored conditional txs carry a second extension option
 	opts := extTx.GetExtensionOptions()
-	if len(opts) != 1 || opts[0].GetTypeUrl() != "/ethermint.evm.v1.ExtensionOptionsEthereumTx" {
+	if len(opts) == 0 {
+		return false
+	}
+	switch opts[0].GetTypeUrl() {
+	case "/ethermint.evm.v1.ExtensionOptionsEthereumTx",
+		"/ethermint.evm.v1.ExtensionOptionsEthereumTxConditional",
+		"/ethermint.evm.v1.ExtensionOptionsEthereumTxSponsored":
+		return true
+	default:
 		return false
 	}
-	return true
 }
 
 // saveTxResult index the txResult into the kv db batch
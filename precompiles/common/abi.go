@@ -1,9 +1,11 @@
 package common
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"reflect"
+	"sort"
 
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
@@ -125,3 +127,107 @@ func PackNum(value reflect.Value) []byte {
 		panic("abi: fatal error")
 	}
 }
+
+// abiEntryJSON is a method or an event of the JSON encoded ABI.
+type abiEntryJSON struct {
+	Type            string            `json:"type"`
+	Name            string            `json:"name"`
+	Inputs          []abiArgumentJSON `json:"inputs"`
+	Outputs         []abiArgumentJSON `json:"outputs,omitempty"`
+	StateMutability string            `json:"stateMutability,omitempty"`
+	Anonymous       bool              `json:"anonymous,omitempty"`
+}
+
+// abiArgumentJSON is an argument of the JSON encoded ABI.
+type abiArgumentJSON struct {
+	Name       string            `json:"name"`
+	Type       string            `json:"type"`
+	Indexed    bool              `json:"indexed,omitempty"`
+	Components []abiArgumentJSON `json:"components,omitempty"`
+}
+
+// MarshalABI returns the JSON encoding of the methods and events of the ABI,
+// sorted by name.
+func MarshalABI(contractABI abi.ABI) ([]byte, error) {
+	entries := make([]abiEntryJSON, 0, len(contractABI.Methods)+len(contractABI.Events))
+
+	for _, method := range contractABI.Methods {
+		outputs := marshalArguments(method.Outputs)
+		if outputs == nil {
+			outputs = []abiArgumentJSON{}
+		}
+		entries = append(entries, abiEntryJSON{
+			Type:            "function",
+			Name:            method.RawName,
+			Inputs:          marshalArguments(method.Inputs),
+			Outputs:         outputs,
+			StateMutability: method.StateMutability,
+		})
+	}
+
+	for _, event := range contractABI.Events {
+		entries = append(entries, abiEntryJSON{
+			Type:      "event",
+			Name:      event.RawName,
+			Inputs:    marshalArguments(event.Inputs),
+			Anonymous: event.Anonymous,
+		})
+	}
+
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Type != entries[j].Type {
+			return entries[i].Type > entries[j].Type
+		}
+		return entries[i].Name < entries[j].Name
+	})
+
+	return json.Marshal(entries)
+}
+
+// marshalArguments converts the ABI arguments to their JSON representation.
+func marshalArguments(args abi.Arguments) []abiArgumentJSON {
+	if len(args) == 0 {
+		return []abiArgumentJSON{}
+	}
+
+	res := make([]abiArgumentJSON, len(args))
+	for i, arg := range args {
+		res[i] = marshalType(arg.Name, arg.Type)
+		res[i].Indexed = arg.Indexed
+	}
+	return res
+}
+
+// marshalType converts the ABI type to its JSON representation, listing the
+// components of the tuples.
+func marshalType(name string, t abi.Type) abiArgumentJSON {
+	arg := abiArgumentJSON{Name: name, Type: typeName(t)}
+
+	elem := &t
+	for elem.T == abi.SliceTy || elem.T == abi.ArrayTy {
+		elem = elem.Elem
+	}
+	if elem.T == abi.TupleTy {
+		arg.Components = make([]abiArgumentJSON, len(elem.TupleElems))
+		for i, component := range elem.TupleElems {
+			arg.Components[i] = marshalType(elem.TupleRawNames[i], *component)
+		}
+	}
+
+	return arg
+}
+
+// typeName returns the name of the ABI type, where the tuples are named
+// "tuple" as their components are listed separately.
+func typeName(t abi.Type) string {
+	switch t.T {
+	case abi.TupleTy:
+		return "tuple"
+	case abi.SliceTy:
+		return typeName(*t.Elem) + "[]"
+	case abi.ArrayTy:
+		return fmt.Sprintf("%s[%d]", typeName(*t.Elem), t.Size)
+	default:
+		return t.String()
+	}
+}
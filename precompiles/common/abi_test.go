@@ -0,0 +1,45 @@
+package common_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anryton/anryton/v2/precompiles/common"
+)
+
+const testABI = `[
+	{"type":"function","name":"delegate","stateMutability":"nonpayable","inputs":[{"name":"delegator","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"success","type":"bool"}]},
+	{"type":"function","name":"delegations","stateMutability":"view","inputs":[{"name":"delegators","type":"address[]"}],"outputs":[{"name":"balances","type":"tuple[2][]","components":[{"name":"denom","type":"string"},{"name":"amount","type":"uint256"}]}]},
+	{"type":"event","name":"Delegate","anonymous":false,"inputs":[{"name":"delegator","type":"address","indexed":true},{"name":"amount","type":"uint256","indexed":false}]}
+]`
+
+func TestMarshalABI(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	bz, err := common.MarshalABI(contractABI)
+	require.NoError(t, err)
+
+	decodedABI, err := abi.JSON(strings.NewReader(string(bz)))
+	require.NoError(t, err)
+
+	require.Len(t, decodedABI.Methods, len(contractABI.Methods))
+	for name, method := range contractABI.Methods {
+		decoded, ok := decodedABI.Methods[name]
+		require.True(t, ok, name)
+		require.Equal(t, method.String(), decoded.String())
+		require.Equal(t, method.ID, decoded.ID)
+		require.Equal(t, method.StateMutability, decoded.StateMutability)
+	}
+
+	require.Len(t, decodedABI.Events, len(contractABI.Events))
+	for name, event := range contractABI.Events {
+		decoded, ok := decodedABI.Events[name]
+		require.True(t, ok, name)
+		require.Equal(t, event.String(), decoded.String())
+		require.Equal(t, event.ID, decoded.ID)
+	}
+}
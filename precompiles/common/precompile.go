@@ -20,19 +20,40 @@ type Precompile struct {
 	ApprovalExpiration   time.Duration
 	KvGasConfig          storetypes.GasConfig
 	TransientKVGasConfig storetypes.GasConfig
+	// MethodGas is the base gas charged for each method name. The methods
+	// without entry are charged the flat cost of the KV gas config.
+	MethodGas map[string]uint64
+}
+
+// GetABI returns the ABI of the precompile.
+func (p Precompile) GetABI() abi.ABI {
+	return p.ABI
+}
+
+// GetMethodGas returns the base gas charged for each method name.
+func (p Precompile) GetMethodGas() map[string]uint64 {
+	return p.MethodGas
 }
 
 // RequiredGas calculates the base minimum required gas for a transaction or a query.
-// It uses the method ID to determine if the input is a transaction or a query and
-// uses the Cosmos SDK gas config flat cost and the flat per byte cost * len(argBz) to calculate the gas.
+// It uses the base gas of the method if any, or the Cosmos SDK gas config flat cost
+// depending on whether the input is a transaction or a query, and adds the flat
+// per byte cost * len(argBz) to calculate the gas.
 func (p Precompile) RequiredGas(input []byte, isTransaction bool) uint64 {
 	argsBz := input[4:]
 
+	baseGas, perByteGas := p.KvGasConfig.ReadCostFlat, p.KvGasConfig.ReadCostPerByte
 	if isTransaction {
-		return p.KvGasConfig.WriteCostFlat + (p.KvGasConfig.WriteCostPerByte * uint64(len(argsBz)))
+		baseGas, perByteGas = p.KvGasConfig.WriteCostFlat, p.KvGasConfig.WriteCostPerByte
+	}
+
+	if method, err := p.MethodById(input[:4]); err == nil {
+		if gas, ok := p.MethodGas[method.Name]; ok {
+			baseGas = gas
+		}
 	}
 
-	return p.KvGasConfig.ReadCostFlat + (p.KvGasConfig.ReadCostPerByte * uint64(len(argsBz)))
+	return baseGas + (perByteGas * uint64(len(argsBz)))
 }
 
 // RunSetup runs the initial setup required to run a transaction or a query.
@@ -10,6 +10,7 @@ address constant DISTRIBUTION_PRECOMPILE_ADDRESS = 0x000000000000000000000000000
 string constant MSG_SET_WITHDRAWER_ADDRESS = "/cosmos.distribution.v1beta1.MsgSetWithdrawAddress";
 string constant MSG_WITHDRAW_DELEGATOR_REWARD = "/cosmos.distribution.v1beta1.MsgWithdrawDelegatorReward";
 string constant MSG_WITHDRAW_VALIDATOR_COMMISSION = "/cosmos.distribution.v1beta1.MsgWithdrawValidatorCommission";
+string constant MSG_FUND_COMMUNITY_POOL = "/cosmos.distribution.v1beta1.MsgFundCommunityPool";
 
 /// @dev The DistributionI contract's instance.
 DistributionI constant DISTRIBUTION_CONTRACT = DistributionI(
@@ -64,6 +65,27 @@ interface DistributionI {
         string memory validatorAddress
     ) external returns (Coin[] calldata amount);
 
+    /// @dev Claims the rewards of a delegator from up to maxRetrieve of its delegations
+    /// in a single call. The number of delegations is bounded to keep the gas cost predictable.
+    /// @param delegatorAddress The address of the delegator
+    /// @param maxRetrieve The maximum number of delegations to claim the rewards from,
+    /// at most the maximum number of validators
+    /// @return success Whether the transaction was successful or not
+    function claimRewards(
+        address delegatorAddress,
+        uint32 maxRetrieve
+    ) external returns (bool success);
+
+    /// @dev Sends the given amount of the bond denom from the depositor to the community pool.
+    /// The depositor must be the caller, so that no contract can spend the funds of its callers.
+    /// @param depositor The address of the depositor
+    /// @param amount The amount to fund the community pool with
+    /// @return success Whether the transaction was successful or not
+    function fundCommunityPool(
+        address depositor,
+        uint256 amount
+    ) external returns (bool success);
+
     /// QUERIES
     /// @dev Queries validator commission and self-delegation rewards for validator.
     /// @param validatorAddress The address of the validator
@@ -150,6 +172,13 @@ interface DistributionI {
         address delegatorAddress
     ) external view returns (string memory withdrawAddress);
 
+    /// @dev Queries the coins held in the community pool.
+    /// @return coins The coins held in the community pool.
+    function communityPool()
+        external
+        view
+        returns (DecCoin[] calldata coins);
+
     /// @dev SetWithdrawerAddress defines an Event emitted when a new withdrawer address is being set
     /// @param caller the caller of the transaction
     /// @param withdrawerAddress the newly set withdrawer address
@@ -175,4 +204,20 @@ interface DistributionI {
         string indexed validatorAddress,
         uint256 commission
     );
+
+    /// @dev ClaimRewards defines an Event emitted when rewards are claimed from multiple delegations
+    /// @param delegatorAddress the address of the delegator
+    /// @param amount the total amount of rewards claimed
+    event ClaimRewards(
+        address indexed delegatorAddress,
+        uint256 amount
+    );
+
+    /// @dev FundCommunityPool defines an Event emitted when the community pool is funded
+    /// @param depositor the address funding the community pool
+    /// @param amount the amount sent to the community pool
+    event FundCommunityPool(
+        address indexed depositor,
+        uint256 amount
+    );
 }
@@ -9,6 +9,7 @@ import (
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -25,12 +26,14 @@ var f embed.FS
 type Precompile struct {
 	cmn.Precompile
 	distributionKeeper distributionkeeper.Keeper
+	stakingKeeper      stakingkeeper.Keeper
 }
 
 // NewPrecompile creates a new distribution Precompile instance as a
 // PrecompiledContract interface.
 func NewPrecompile(
 	distributionKeeper distributionkeeper.Keeper,
+	stakingKeeper stakingkeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 ) (*Precompile, error) {
 	abiBz, err := f.ReadFile("abi.json")
@@ -50,8 +53,10 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			MethodGas:            DefaultMethodGas,
 		},
 		distributionKeeper: distributionKeeper,
+		stakingKeeper:      stakingKeeper,
 	}, nil
 }
 
@@ -93,6 +98,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.WithdrawDelegatorRewards(ctx, evm.Origin, contract, stateDB, method, args)
 	case WithdrawValidatorCommissionMethod:
 		bz, err = p.WithdrawValidatorCommission(ctx, evm.Origin, contract, stateDB, method, args)
+	case ClaimRewardsMethod:
+		bz, err = p.ClaimRewards(ctx, evm.Origin, contract, stateDB, method, args)
+	case FundCommunityPoolMethod:
+		bz, err = p.FundCommunityPool(ctx, evm.Origin, contract, stateDB, method, args)
 	// Distribution queries
 	case ValidatorDistributionInfoMethod:
 		bz, err = p.ValidatorDistributionInfo(ctx, contract, method, args)
@@ -110,6 +119,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.DelegatorValidators(ctx, contract, method, args)
 	case DelegatorWithdrawAddressMethod:
 		bz, err = p.DelegatorWithdrawAddress(ctx, contract, method, args)
+	case CommunityPoolMethod:
+		bz, err = p.CommunityPool(ctx, contract, method, args)
 	}
 
 	if err != nil {
@@ -131,11 +142,15 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - SetWithdrawAddress
 //   - WithdrawDelegatorRewards
 //   - WithdrawValidatorCommission
+//   - ClaimRewards
+//   - FundCommunityPool
 func (Precompile) IsTransaction(methodID string) bool {
 	switch methodID {
 	case SetWithdrawAddressMethod,
 		WithdrawDelegatorRewardsMethod,
-		WithdrawValidatorCommissionMethod:
+		WithdrawValidatorCommissionMethod,
+		ClaimRewardsMethod,
+		FundCommunityPoolMethod:
 		return true
 	default:
 		return false
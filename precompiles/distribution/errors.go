@@ -9,4 +9,10 @@ const (
 	ErrWithdrawValCommissionAuth = "withdraw validator commission authorization for address %s does not exist"
 	// ErrDifferentValidator is raised when the origin address is not the same as the validator address.
 	ErrDifferentValidator = "origin address %s is not the same as validator address %s"
+	// ErrInvalidDepositor is raised when the depositor address is not valid.
+	ErrInvalidDepositor = "invalid depositor address: %s"
+	// ErrDifferentDepositor is raised when the caller address is not the same as the depositor address.
+	ErrDifferentDepositor = "caller address %s is not the same as depositor address %s"
+	// ErrInvalidMaxRetrieve is raised when the number of delegations to claim the rewards from is out of bounds.
+	ErrInvalidMaxRetrieve = "invalid max retrieve %d; expected a value between 1 and %d"
 )
@@ -2,6 +2,7 @@ package distribution
 
 import (
 	"bytes"
+	"math/big"
 	"reflect"
 
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
@@ -19,6 +20,10 @@ const (
 	EventTypeWithdrawDelegatorRewards = "WithdrawDelegatorRewards"
 	// EventTypeWithdrawValidatorCommission defines the event type for the distribution WithdrawValidatorCommissionMethod transaction.
 	EventTypeWithdrawValidatorCommission = "WithdrawValidatorCommission"
+	// EventTypeClaimRewards defines the event type for the distribution ClaimRewardsMethod transaction.
+	EventTypeClaimRewards = "ClaimRewards"
+	// EventTypeFundCommunityPool defines the event type for the distribution FundCommunityPoolMethod transaction.
+	EventTypeFundCommunityPool = "FundCommunityPool"
 )
 
 // EmitSetWithdrawAddressEvent creates a new event emitted on a SetWithdrawAddressMethod transaction.
@@ -115,3 +120,61 @@ func (p Precompile) EmitWithdrawValidatorCommissionEvent(ctx sdk.Context, stateD
 
 	return nil
 }
+
+// EmitClaimRewardsEvent creates a new event emitted on a ClaimRewards transaction.
+func (p Precompile) EmitClaimRewardsEvent(ctx sdk.Context, stateDB vm.StateDB, delegatorAddress common.Address, amount *big.Int) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeClaimRewards]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(delegatorAddress)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	var b bytes.Buffer
+	b.Write(cmn.PackNum(reflect.ValueOf(amount)))
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        b.Bytes(),
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
+
+// EmitFundCommunityPoolEvent creates a new event emitted on a FundCommunityPool transaction.
+func (p Precompile) EmitFundCommunityPoolEvent(ctx sdk.Context, stateDB vm.StateDB, depositor common.Address, amount *big.Int) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeFundCommunityPool]
+	topics := make([]common.Hash, 2)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	topics[1], err = cmn.MakeTopic(depositor)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data
+	var b bytes.Buffer
+	b.Write(cmn.PackNum(reflect.ValueOf(amount)))
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        b.Bytes(),
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+
+	return nil
+}
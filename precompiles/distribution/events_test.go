@@ -205,3 +205,58 @@ func (s *PrecompileTestSuite) TestWithdrawValidatorCommissionEvent() {
 		}
 	}
 }
+
+func (s *PrecompileTestSuite) TestClaimRewardsEvent() {
+	method := s.precompile.Methods[distribution.ClaimRewardsMethod]
+
+	s.SetupTest()
+	s.allocateRewardsToValidators(1e18)
+
+	contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 20000)
+	s.ctx = s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	_, err := s.precompile.ClaimRewards(s.ctx, s.address, contract, s.stateDB, &method, []interface{}{s.address, uint32(2)})
+	s.Require().NoError(err)
+
+	log := s.stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[distribution.EventTypeClaimRewards]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(s.ctx.BlockHeight()))
+
+	// Check the fully unpacked event matches the one emitted
+	var claimRewards distribution.EventClaimRewards
+	err = cmn.UnpackLog(s.precompile.ABI, &claimRewards, distribution.EventTypeClaimRewards, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.address, claimRewards.DelegatorAddress)
+	s.Require().Equal(big.NewInt(2000000000000000000), claimRewards.Amount)
+}
+
+func (s *PrecompileTestSuite) TestFundCommunityPoolEvent() {
+	method := s.precompile.Methods[distribution.FundCommunityPoolMethod]
+
+	s.SetupTest()
+
+	contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 20000)
+	s.ctx = s.ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	_, err := s.precompile.FundCommunityPool(s.ctx, s.address, contract, s.stateDB, &method, []interface{}{s.address, big.NewInt(1e18)})
+	s.Require().NoError(err)
+
+	log := s.stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[distribution.EventTypeFundCommunityPool]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(s.ctx.BlockHeight()))
+
+	// Check the fully unpacked event matches the one emitted
+	var fundCommunityPool distribution.EventFundCommunityPool
+	err = cmn.UnpackLog(s.precompile.ABI, &fundCommunityPool, distribution.EventTypeFundCommunityPool, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.address, fundCommunityPool.Depositor)
+	s.Require().Equal(big.NewInt(1000000000000000000), fundCommunityPool.Amount)
+}
@@ -0,0 +1,34 @@
+package distribution
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// DefaultMethodGas is the base gas charged for each method of the distribution
+// precompile, on top of the cost of its arguments and of its state accesses.
+// The methods iterating over the delegations or the slashes are charged more.
+var DefaultMethodGas = map[string]uint64{
+	// Distribution transactions
+	SetWithdrawAddressMethod:          2000,
+	WithdrawDelegatorRewardsMethod:    4000,
+	WithdrawValidatorCommissionMethod: 4000,
+	ClaimRewardsMethod:                6000,
+	FundCommunityPoolMethod:           2000,
+	// Distribution queries
+	ValidatorDistributionInfoMethod:   1500,
+	ValidatorOutstandingRewardsMethod: 1000,
+	ValidatorCommissionMethod:         1000,
+	ValidatorSlashesMethod:            3000,
+	DelegationRewardsMethod:           2000,
+	DelegationTotalRewardsMethod:      4000,
+	DelegatorValidatorsMethod:         2000,
+	DelegatorWithdrawAddressMethod:    1000,
+	CommunityPoolMethod:               1000,
+}
+
+// WithMethodGas returns a copy of the precompile charging the given base gas
+// for each method.
+func (p Precompile) WithMethodGas(methodGas map[string]uint64) vm.PrecompiledContract {
+	p.MethodGas = methodGas
+	return &p
+}
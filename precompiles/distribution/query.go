@@ -4,6 +4,7 @@ import (
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/core/vm"
 )
@@ -33,6 +34,9 @@ const (
 	// DelegatorWithdrawAddressMethod defines the ABI method name for the
 	// DelegatorWithdrawAddress query.
 	DelegatorWithdrawAddressMethod = "delegatorWithdrawAddress"
+	// CommunityPoolMethod defines the ABI method name for the
+	// CommunityPool query.
+	CommunityPoolMethod = "communityPool"
 )
 
 // ValidatorDistributionInfo returns the distribution info for a validator.
@@ -215,3 +219,20 @@ func (p Precompile) DelegatorWithdrawAddress(
 
 	return method.Outputs.Pack(res.WithdrawAddress)
 }
+
+// CommunityPool returns the coins held in the community pool.
+func (p Precompile) CommunityPool(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	_ []interface{},
+) ([]byte, error) {
+	querier := distributionkeeper.Querier{Keeper: p.distributionKeeper}
+
+	res, err := querier.CommunityPool(ctx, &distributiontypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(cmn.NewDecCoinsResponse(res.Pool))
+}
@@ -843,3 +843,47 @@ func (s *PrecompileTestSuite) TestDelegatorWithdrawAddress() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestCommunityPool() {
+	method := s.precompile.Methods[distribution.CommunityPoolMethod]
+
+	testCases := []distrTestCases{
+		{
+			"success - community pool funded",
+			func() []interface{} {
+				err := s.app.DistrKeeper.FundCommunityPool(s.ctx, sdk.NewCoins(sdk.NewCoin(s.bondDenom, sdk.NewInt(1e18))), s.address.Bytes())
+				s.Require().NoError(err)
+				return []interface{}{}
+			},
+			func(bz []byte) {
+				var coins []cmn.DecCoin
+				err := s.precompile.UnpackIntoInterface(&coins, distribution.CommunityPoolMethod, bz)
+				s.Require().NoError(err, "failed to unpack output", err)
+				s.Require().Len(coins, 1)
+				s.Require().Equal(s.bondDenom, coins[0].Denom)
+				s.Require().Equal(big.NewInt(1e18), coins[0].Amount)
+			},
+			100000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest() // reset
+			contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), tc.gas)
+
+			bz, err := s.precompile.CommunityPool(s.ctx, contract, &method, tc.malleate())
+
+			if tc.expErr {
+				s.Require().Error(err)
+				s.Require().Contains(err.Error(), tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				s.Require().NotEmpty(bz)
+				tc.postCheck(bz)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package distribution
 import (
 	"fmt"
 
+	anteutils "github.com/anryton/anryton/v2/app/ante/utils"
 	"github.com/anryton/anryton/v2/x/evm/statedb"
 
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
@@ -25,6 +26,12 @@ const (
 	// WithdrawValidatorCommissionMethod defines the ABI method name for the distribution
 	// WithdrawValidatorCommission transaction.
 	WithdrawValidatorCommissionMethod = "withdrawValidatorCommission"
+	// ClaimRewardsMethod defines the ABI method name for the distribution
+	// ClaimRewards transaction.
+	ClaimRewardsMethod = "claimRewards"
+	// FundCommunityPoolMethod defines the ABI method name for the distribution
+	// FundCommunityPool transaction.
+	FundCommunityPoolMethod = "fundCommunityPool"
 )
 
 // SetWithdrawAddress sets the withdrawal address for a delegator (or validator self-delegation).
@@ -133,3 +140,87 @@ func (p Precompile) WithdrawValidatorCommission(
 
 	return method.Outputs.Pack(cmn.NewCoinsResponse(res.Amount))
 }
+
+// ClaimRewards withdraws the rewards of a delegator from up to maxRetrieve of its delegations.
+func (p Precompile) ClaimRewards(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	delegatorHexAddr, maxRetrieve, err := checkClaimRewardsArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	maxValidators := p.stakingKeeper.GetParams(ctx).MaxValidators
+	if maxRetrieve == 0 || maxRetrieve > maxValidators {
+		return nil, fmt.Errorf(ErrInvalidMaxRetrieve, maxRetrieve, maxValidators)
+	}
+
+	// If the contract is the delegator, we don't need an origin check
+	// Otherwise check if the origin matches the delegator address
+	isContractDelegator := contract.CallerAddress == delegatorHexAddr
+	if !isContractDelegator && origin != delegatorHexAddr {
+		return nil, fmt.Errorf(cmn.ErrDifferentOrigin, origin.String(), delegatorHexAddr.String())
+	}
+
+	delegatorAddr := sdk.AccAddress(delegatorHexAddr.Bytes())
+	rewards, err := anteutils.WithdrawStakingRewards(ctx, p.stakingKeeper, p.distributionKeeper, delegatorAddr, maxRetrieve)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := rewards.AmountOf(p.stakingKeeper.BondDenom(ctx)).BigInt()
+	if err = p.EmitClaimRewardsEvent(ctx, stateDB, delegatorHexAddr, amount); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	// The rewards are mirrored if they were sent to the origin or the contract, which are loaded in the stateDB.
+	withdrawerHexAddr := common.BytesToAddress(p.distributionKeeper.GetDelegatorWithdrawAddr(ctx, delegatorAddr))
+	if withdrawerHexAddr == origin || withdrawerHexAddr == contract.CallerAddress {
+		stateDB.(*statedb.StateDB).AddBalance(withdrawerHexAddr, amount)
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// FundCommunityPool sends the given amount of the bond denom from the depositor to the community pool.
+// The depositor must be the caller, either an EOA calling the precompile or a contract funding the
+// pool with its own balance, so that the contracts can't spend the funds of the origin.
+func (p Precompile) FundCommunityPool(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, depositorHexAddr, err := NewMsgFundCommunityPool(args, p.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if contract.CallerAddress != depositorHexAddr {
+		return nil, fmt.Errorf(ErrDifferentDepositor, contract.CallerAddress.String(), depositorHexAddr.String())
+	}
+
+	msgSrv := distributionkeeper.NewMsgServerImpl(p.distributionKeeper)
+	if _, err = msgSrv.FundCommunityPool(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitFundCommunityPoolEvent(ctx, stateDB, depositorHexAddr, msg.Amount[0].Amount.BigInt()); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	stateDB.(*statedb.StateDB).SubBalance(contract.CallerAddress, msg.Amount[0].Amount.BigInt())
+
+	return method.Outputs.Pack(true)
+}
@@ -305,3 +305,290 @@ func (s *PrecompileTestSuite) TestWithdrawValidatorCommission() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestClaimRewards() {
+	method := s.precompile.Methods[distribution.ClaimRewardsMethod]
+	var caller common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid delegator address",
+			func() []interface{} {
+				return []interface{}{
+					"",
+					uint32(1),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidDelegator, ""),
+		},
+		{
+			"fail - zero max retrieve",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					uint32(0),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid max retrieve 0",
+		},
+		{
+			"fail - max retrieve above the max number of validators",
+			func() []interface{} {
+				maxValidators := s.app.StakingKeeper.GetParams(s.ctx).MaxValidators
+				return []interface{}{
+					s.address,
+					maxValidators + 1,
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"invalid max retrieve",
+		},
+		{
+			"fail - origin is not the delegator",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(),
+					uint32(1),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"does not match the delegator address",
+		},
+		{
+			"success - claim the rewards from all validators",
+			func() []interface{} {
+				s.allocateRewardsToValidators(1e18)
+				return []interface{}{
+					s.address,
+					uint32(2),
+				}
+			},
+			func() {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(big.NewInt(7000000000000000000), balance.Amount.BigInt())
+			},
+			20000,
+			false,
+			"",
+		},
+		{
+			"success - claim the rewards from only one validator",
+			func() []interface{} {
+				s.allocateRewardsToValidators(1e18)
+				return []interface{}{
+					s.address,
+					uint32(1),
+				}
+			},
+			func() {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(big.NewInt(6000000000000000000), balance.Amount.BigInt())
+			},
+			20000,
+			false,
+			"",
+		},
+		{
+			"success - contract claiming the rewards of the origin",
+			func() []interface{} {
+				caller = utiltx.GenerateAddress()
+				s.allocateRewardsToValidators(1e18)
+				// the origin is loaded in the stateDB before the precompile runs
+				s.Require().Equal(big.NewInt(5000000000000000000), s.stateDB.GetBalance(s.address))
+				return []interface{}{
+					s.address,
+					uint32(2),
+				}
+			},
+			func() {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(big.NewInt(7000000000000000000), balance.Amount.BigInt())
+				// the claimed rewards are mirrored to the origin balance of the stateDB
+				s.Require().Equal(balance.Amount.BigInt(), s.stateDB.GetBalance(s.address))
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			caller = s.address
+			args := tc.malleate()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, caller, s.precompile, tc.gas)
+
+			bz, err := s.precompile.ClaimRewards(s.ctx, s.address, contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				success, err := method.Outputs.Unpack(bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+				tc.postCheck()
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestFundCommunityPool() {
+	method := s.precompile.Methods[distribution.FundCommunityPoolMethod]
+	var caller common.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		postCheck   func()
+		gas         uint64
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 2, 0),
+		},
+		{
+			"fail - invalid depositor address",
+			func() []interface{} {
+				return []interface{}{
+					"",
+					big.NewInt(1e18),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(distribution.ErrInvalidDepositor, ""),
+		},
+		{
+			"fail - invalid amount",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					"invalid",
+				}
+			},
+			func() {},
+			200000,
+			true,
+			fmt.Sprintf(cmn.ErrInvalidAmount, "invalid"),
+		},
+		{
+			"fail - caller is not the depositor",
+			func() []interface{} {
+				return []interface{}{
+					utiltx.GenerateAddress(),
+					big.NewInt(1e18),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"is not the same as depositor address",
+		},
+		{
+			"fail - contract funding the community pool with the origin balance",
+			func() []interface{} {
+				caller = utiltx.GenerateAddress()
+				return []interface{}{
+					s.address,
+					big.NewInt(1e18),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"is not the same as depositor address",
+		},
+		{
+			"fail - insufficient funds",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					big.NewInt(6e18),
+				}
+			},
+			func() {},
+			200000,
+			true,
+			"insufficient funds",
+		},
+		{
+			"success - fund the community pool",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					big.NewInt(1e18),
+				}
+			},
+			func() {
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), utils.BaseDenom)
+				s.Require().Equal(big.NewInt(4000000000000000000), balance.Amount.BigInt())
+
+				pool := s.app.DistrKeeper.GetFeePoolCommunityCoins(s.ctx)
+				s.Require().Equal(sdk.NewDec(1e18), pool.AmountOf(utils.BaseDenom))
+			},
+			20000,
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			caller = s.address
+			args := tc.malleate()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, caller, s.precompile, tc.gas)
+
+			_, err := s.precompile.FundCommunityPool(s.ctx, s.address, contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+			} else {
+				s.Require().NoError(err)
+				tc.postCheck()
+			}
+		})
+	}
+}
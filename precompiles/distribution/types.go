@@ -31,6 +31,18 @@ type EventWithdrawValidatorRewards struct {
 	Commission       *big.Int
 }
 
+// EventClaimRewards defines the event data for the ClaimRewards transaction.
+type EventClaimRewards struct {
+	DelegatorAddress common.Address
+	Amount           *big.Int
+}
+
+// EventFundCommunityPool defines the event data for the FundCommunityPool transaction.
+type EventFundCommunityPool struct {
+	Depositor common.Address
+	Amount    *big.Int
+}
+
 // EventApproval defines the event data for the authorization Approve transaction.
 type EventApproval struct {
 	Owner       common.Address
@@ -122,6 +134,56 @@ func NewMsgWithdrawValidatorCommission(args []interface{}) (*distributiontypes.M
 	return msg, validatorHexAddr, nil
 }
 
+// NewMsgFundCommunityPool creates a new MsgFundCommunityPool message funding
+// the community pool with the given amount of the bond denom.
+func NewMsgFundCommunityPool(args []interface{}, denom string) (*distributiontypes.MsgFundCommunityPool, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	depositorAddress, ok := args[0].(common.Address)
+	if !ok || depositorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDepositor, args[0])
+	}
+
+	amount, ok := args[1].(*big.Int)
+	if !ok || amount == nil {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidAmount, args[1])
+	}
+
+	msg := &distributiontypes.MsgFundCommunityPool{
+		Depositor: sdk.AccAddress(depositorAddress.Bytes()).String(),
+		Amount:    sdk.Coins{{Denom: denom, Amount: sdk.NewIntFromBigInt(amount)}},
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, depositorAddress, nil
+}
+
+// checkClaimRewardsArgs checks the arguments of the ClaimRewards transaction
+// and returns the delegator address and the maximum number of delegations to
+// withdraw the rewards from.
+func checkClaimRewardsArgs(args []interface{}) (common.Address, uint32, error) {
+	if len(args) != 2 {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	delegatorAddress, ok := args[0].(common.Address)
+	if !ok || delegatorAddress == (common.Address{}) {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	maxRetrieve, ok := args[1].(uint32)
+	if !ok {
+		return common.Address{}, 0, fmt.Errorf(cmn.ErrInvalidType, "maxRetrieve", uint32(0), args[1])
+	}
+
+	return delegatorAddress, maxRetrieve, nil
+}
+
 // NewValidatorDistributionInfoRequest creates a new QueryValidatorDistributionInfoRequest  instance and does sanity
 // checks on the provided arguments.
 func NewValidatorDistributionInfoRequest(args []interface{}) (*distributiontypes.QueryValidatorDistributionInfoRequest, error) {
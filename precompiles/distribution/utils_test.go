@@ -181,7 +181,7 @@ func (s *PrecompileTestSuite) DoSetupTest() {
 
 	s.ethSigner = ethtypes.LatestSignerForChainID(s.app.EvmKeeper.ChainID())
 
-	precompile, err := distribution.NewPrecompile(s.app.DistrKeeper, s.app.AuthzKeeper)
+	precompile, err := distribution.NewPrecompile(s.app.DistrKeeper, s.app.StakingKeeper, s.app.AuthzKeeper)
 	s.Require().NoError(err)
 	s.precompile = precompile
 	queryHelperEvm := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
@@ -262,3 +262,18 @@ func (s *PrecompileTestSuite) setupValidatorSlashes(valAddr sdk.ValAddress, slas
 
 	return slashEvent
 }
+
+// allocateRewardsToValidators allocates the given amount of rewards to each of
+// the validators of the suite, which are all paid out to the suite's address.
+func (s *PrecompileTestSuite) allocateRewardsToValidators(amount int64) {
+	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(amount)))
+	// set distribution module account balance which pays out the rewards
+	distrAcc := s.app.DistrKeeper.GetDistributionAccount(s.ctx)
+	total := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(amount*int64(len(s.validators)))))
+	err := anrytonutil.FundModuleAccount(s.ctx, s.app.BankKeeper, distrAcc.GetName(), total)
+	s.Require().NoError(err)
+
+	for _, val := range s.validators {
+		s.app.DistrKeeper.AllocateTokensToValidator(s.ctx, val, sdk.NewDecCoinsFromCoins(coins...))
+	}
+}
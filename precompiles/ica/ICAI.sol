@@ -0,0 +1,81 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The ICAI contract's address.
+address constant ICA_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000804;
+
+/// @dev The ICA contract's instance.
+ICAI constant ICA_CONTRACT = ICAI(ICA_PRECOMPILE_ADDRESS);
+
+/// @dev ProtoMsg is a Cosmos SDK message executed by the interchain account on
+/// the host chain, encoded as a protobuf Any.
+struct ProtoMsg {
+    // typeUrl is the type URL of the message, e.g. /cosmos.staking.v1beta1.MsgDelegate.
+    string typeUrl;
+    // value is the protobuf encoded message.
+    bytes value;
+}
+
+/// @author Anryton Team
+/// @title Interchain Accounts Precompiled Contract
+/// @dev The interface through which solidity contracts will control interchain
+/// accounts (ICS27) on other chains. The caller of the precompile is the owner
+/// of the interchain account.
+/// @custom:address 0x0000000000000000000000000000000000000804
+interface ICAI {
+    /// @dev RegisterInterchainAccount defines an Event emitted when the registration
+    /// of an interchain account is initiated.
+    /// @param owner the address of the interchain account owner
+    /// @param connectionId the connection to the host chain
+    /// @param portId the controller port of the owner
+    /// @param channelId the channel being opened with the host chain
+    event RegisterInterchainAccount(
+        address indexed owner,
+        string connectionId,
+        string portId,
+        string channelId
+    );
+
+    /// @dev SendTx defines an Event emitted when a transaction is sent to the
+    /// interchain account.
+    /// @param owner the address of the interchain account owner
+    /// @param connectionId the connection to the host chain
+    /// @param sequence the sequence of the sent packet
+    event SendTx(
+        address indexed owner,
+        string connectionId,
+        uint64 sequence
+    );
+
+    /// @dev RegisterInterchainAccount starts the opening handshake of the channel
+    /// with the host chain, which creates the interchain account owned by the caller.
+    /// The account address is available once the handshake is completed.
+    /// @param connectionId the connection to the host chain
+    /// @return channelId the channel being opened with the host chain
+    function registerInterchainAccount(
+        string memory connectionId
+    ) external returns (string memory channelId);
+
+    /// @dev SendTx sends the messages to be executed by the interchain account of
+    /// the caller on the host chain.
+    /// @param connectionId the connection to the host chain
+    /// @param protoMsgs the messages executed by the interchain account
+    /// @param timeout the timeout of the packet in seconds relative to the current block time
+    /// @return sequence the sequence of the sent packet
+    function sendTx(
+        string memory connectionId,
+        ProtoMsg[] memory protoMsgs,
+        uint64 timeout
+    ) external returns (uint64 sequence);
+
+    /// @dev InterchainAccountAddress returns the address of the interchain account
+    /// of the owner on the host chain. It returns an empty string if the account
+    /// is not registered.
+    /// @param owner the address of the interchain account owner
+    /// @param connectionId the connection to the host chain
+    /// @return accountAddress the address of the interchain account on the host chain
+    function interchainAccountAddress(
+        address owner,
+        string memory connectionId
+    ) external view returns (string memory accountAddress);
+}
@@ -0,0 +1,12 @@
+package ica
+
+const (
+	// ErrInvalidConnectionID is raised when the connection ID is invalid.
+	ErrInvalidConnectionID = "invalid connection ID: %v"
+	// ErrInvalidOwner is raised when the interchain account owner is invalid.
+	ErrInvalidOwner = "invalid owner: %v"
+	// ErrInvalidTimeout is raised when the relative timeout is invalid.
+	ErrInvalidTimeout = "invalid timeout: %v"
+	// ErrEmptyMsgs is raised when no message is sent to the interchain account.
+	ErrEmptyMsgs = "no messages to send to the interchain account"
+)
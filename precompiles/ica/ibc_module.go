@@ -0,0 +1,170 @@
+package ica
+
+import (
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+)
+
+// ICA controller events
+const (
+	EventTypeChannelOpen     = "ica_channel_open"
+	EventTypeAcknowledgement = "ica_acknowledgement"
+	EventTypeTimeout         = "ica_timeout"
+
+	AttributeKeyOwner     = "owner"
+	AttributeKeyPortID    = "port_id"
+	AttributeKeyChannelID = "channel_id"
+	AttributeKeySequence  = "sequence"
+	AttributeKeySuccess   = "success"
+	AttributeKeyError     = "error"
+)
+
+var _ porttypes.IBCModule = IBCModule{}
+
+// IBCModule is the authentication module under the ICA controller middleware
+// for the interchain accounts registered through the precompile. The packets
+// of these accounts are sent by EVM contracts, which learn about the outcome
+// of the channel handshakes, acknowledgements and timeouts from the events
+// emitted by this module.
+type IBCModule struct{}
+
+// NewIBCModule creates a new IBCModule.
+func NewIBCModule() IBCModule {
+	return IBCModule{}
+}
+
+// OnChanOpenInit implements the IBCModule interface. The version is set by the
+// ICA controller middleware.
+func (IBCModule) OnChanOpenInit(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_ string,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	return version, nil
+}
+
+// OnChanOpenTry implements the IBCModule interface. The handshake is always
+// initiated by the controller chain.
+func (IBCModule) OnChanOpenTry(
+	_ sdk.Context,
+	_ channeltypes.Order,
+	_ []string,
+	_,
+	_ string,
+	_ *capabilitytypes.Capability,
+	_ channeltypes.Counterparty,
+	_ string,
+) (string, error) {
+	return "", errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanOpenAck implements the IBCModule interface. It emits the event of the
+// interchain account channel being opened.
+func (IBCModule) OnChanOpenAck(
+	ctx sdk.Context,
+	portID,
+	channelID string,
+	_ string,
+	_ string,
+) error {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			EventTypeChannelOpen,
+			sdk.NewAttribute(AttributeKeyOwner, ownerFromPort(portID)),
+			sdk.NewAttribute(AttributeKeyPortID, portID),
+			sdk.NewAttribute(AttributeKeyChannelID, channelID),
+		),
+	)
+	return nil
+}
+
+// OnChanOpenConfirm implements the IBCModule interface.
+func (IBCModule) OnChanOpenConfirm(_ sdk.Context, _, _ string) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "channel handshake must be initiated by controller chain")
+}
+
+// OnChanCloseInit implements the IBCModule interface.
+func (IBCModule) OnChanCloseInit(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnChanCloseConfirm implements the IBCModule interface.
+func (IBCModule) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+// OnRecvPacket implements the IBCModule interface. The controller chain
+// doesn't receive packets.
+func (IBCModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	return channeltypes.NewErrorAcknowledgement(errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive packet on controller chain"))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. It emits the
+// event of the acknowledgement of the messages executed by the interchain
+// account.
+func (IBCModule) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	_ sdk.AccAddress,
+) error {
+	var ack channeltypes.Acknowledgement
+	if err := icatypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(icatypes.ErrUnknownDataType, "cannot unmarshal ICS-27 packet acknowledgement: %v", err)
+	}
+
+	attributes := packetAttributes(packet)
+	attributes = append(attributes, sdk.NewAttribute(AttributeKeySuccess, strconv.FormatBool(ack.Success())))
+	if !ack.Success() {
+		attributes = append(attributes, sdk.NewAttribute(AttributeKeyError, ack.GetError()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeAcknowledgement, attributes...))
+	ctx.Logger().Debug(
+		"interchain account packet acknowledged",
+		"port-id", packet.SourcePort, "sequence", packet.Sequence, "success", ack.Success(),
+	)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface. It emits the event of
+// the timeout of the messages sent to the interchain account, whose channel is
+// closed by the timeout.
+func (IBCModule) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, _ sdk.AccAddress) error {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeTimeout, packetAttributes(packet)...))
+	ctx.Logger().Debug(
+		"interchain account packet timed out",
+		"port-id", packet.SourcePort, "sequence", packet.Sequence,
+	)
+	return nil
+}
+
+// packetAttributes returns the event attributes identifying the packet and the
+// owner of its interchain account.
+func packetAttributes(packet channeltypes.Packet) []sdk.Attribute {
+	return []sdk.Attribute{
+		sdk.NewAttribute(AttributeKeyOwner, ownerFromPort(packet.SourcePort)),
+		sdk.NewAttribute(AttributeKeyPortID, packet.SourcePort),
+		sdk.NewAttribute(AttributeKeyChannelID, packet.SourceChannel),
+		sdk.NewAttribute(AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+	}
+}
+
+// ownerFromPort returns the owner of the interchain account of a controller
+// port.
+func ownerFromPort(portID string) string {
+	return strings.TrimPrefix(portID, icatypes.ControllerPortPrefix)
+}
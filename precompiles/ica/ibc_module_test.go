@@ -0,0 +1,105 @@
+package ica
+
+import (
+	"testing"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+)
+
+// eventAttributes returns the attributes of the single event of the given type
+// emitted on the context.
+func eventAttributes(t *testing.T, ctx sdk.Context, eventType string) map[string]string {
+	attributes := make(map[string]string)
+	found := false
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		require.False(t, found, "event %s emitted twice", eventType)
+		found = true
+		for _, attribute := range event.Attributes {
+			attributes[attribute.Key] = attribute.Value
+		}
+	}
+	require.True(t, found, "event %s not emitted", eventType)
+	return attributes
+}
+
+func TestIBCModuleCallbacks(t *testing.T) {
+	ownerAddr := sdk.AccAddress(owner.Bytes()).String()
+	portID, err := icatypes.NewControllerPortID(ownerAddr)
+	require.NoError(t, err)
+
+	packet := channeltypes.NewPacket(
+		[]byte("data"), 7, portID, "channel-3", icatypes.HostPortID, "channel-5",
+		clienttypes.ZeroHeight(), 100,
+	)
+	newContext := func() sdk.Context {
+		return testutil.DefaultContext(storetypes.NewKVStoreKey("ica"), storetypes.NewTransientStoreKey("transient_ica"))
+	}
+	module := NewIBCModule()
+
+	t.Run("channel open", func(t *testing.T) {
+		ctx := newContext()
+		require.NoError(t, module.OnChanOpenAck(ctx, portID, "channel-3", "channel-5", ""))
+
+		attributes := eventAttributes(t, ctx, EventTypeChannelOpen)
+		require.Equal(t, ownerAddr, attributes[AttributeKeyOwner])
+		require.Equal(t, "channel-3", attributes[AttributeKeyChannelID])
+	})
+
+	t.Run("success acknowledgement", func(t *testing.T) {
+		ctx := newContext()
+		ack := channeltypes.NewResultAcknowledgement([]byte{1})
+		require.NoError(t, module.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil))
+
+		attributes := eventAttributes(t, ctx, EventTypeAcknowledgement)
+		require.Equal(t, ownerAddr, attributes[AttributeKeyOwner])
+		require.Equal(t, portID, attributes[AttributeKeyPortID])
+		require.Equal(t, "7", attributes[AttributeKeySequence])
+		require.Equal(t, "true", attributes[AttributeKeySuccess])
+		require.NotContains(t, attributes, AttributeKeyError)
+	})
+
+	t.Run("error acknowledgement", func(t *testing.T) {
+		ctx := newContext()
+		ack := channeltypes.NewErrorAcknowledgement(icatypes.ErrUnknownDataType)
+		require.NoError(t, module.OnAcknowledgementPacket(ctx, packet, ack.Acknowledgement(), nil))
+
+		attributes := eventAttributes(t, ctx, EventTypeAcknowledgement)
+		require.Equal(t, "false", attributes[AttributeKeySuccess])
+		require.Equal(t, ack.GetError(), attributes[AttributeKeyError])
+	})
+
+	t.Run("invalid acknowledgement", func(t *testing.T) {
+		ctx := newContext()
+		require.ErrorIs(t, module.OnAcknowledgementPacket(ctx, packet, []byte("ack"), nil), icatypes.ErrUnknownDataType)
+		require.Empty(t, ctx.EventManager().Events())
+	})
+
+	t.Run("timeout", func(t *testing.T) {
+		ctx := newContext()
+		require.NoError(t, module.OnTimeoutPacket(ctx, packet, nil))
+
+		attributes := eventAttributes(t, ctx, EventTypeTimeout)
+		require.Equal(t, ownerAddr, attributes[AttributeKeyOwner])
+		require.Equal(t, "channel-3", attributes[AttributeKeyChannelID])
+		require.Equal(t, "7", attributes[AttributeKeySequence])
+	})
+
+	t.Run("receive packet", func(t *testing.T) {
+		ack := module.OnRecvPacket(newContext(), packet, nil)
+		require.False(t, ack.Success())
+	})
+
+	t.Run("channel open try", func(t *testing.T) {
+		_, err := module.OnChanOpenTry(newContext(), channeltypes.ORDERED, nil, icatypes.HostPortID, "channel-5", nil, channeltypes.Counterparty{}, "")
+		require.ErrorIs(t, err, icatypes.ErrInvalidChannelFlow)
+	})
+}
@@ -0,0 +1,29 @@
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// InterchainAccountAddressMethod defines the ABI method name for the ICA
+// InterchainAccountAddress query.
+const InterchainAccountAddressMethod = "interchainAccountAddress"
+
+// InterchainAccountAddress returns the address on the host chain of the
+// interchain account of the owner. An empty address is returned if the
+// account is not registered.
+func (p Precompile) InterchainAccountAddress(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	portID, connectionID, err := NewInterchainAccountAddressRequest(args)
+	if err != nil {
+		return nil, err
+	}
+
+	address, _ := p.icaControllerKeeper.GetInterchainAccountAddress(ctx, connectionID, portID)
+	return method.Outputs.Pack(address)
+}
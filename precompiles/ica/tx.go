@@ -0,0 +1,77 @@
+package ica
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// RegisterInterchainAccountMethod defines the ABI method name for the ICA
+	// RegisterInterchainAccount transaction.
+	RegisterInterchainAccountMethod = "registerInterchainAccount"
+	// SendTxMethod defines the ABI method name for the ICA SendTx transaction.
+	SendTxMethod = "sendTx"
+)
+
+// RegisterInterchainAccount starts the channel opening handshake with the host
+// chain of the connection, which registers an interchain account owned by the
+// caller of the precompile.
+func (p Precompile) RegisterInterchainAccount(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner := contract.CallerAddress
+	msg, err := NewMsgRegisterInterchainAccount(owner, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(&p.icaControllerKeeper)
+	res, err := msgSrv.RegisterInterchainAccount(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	// route the callbacks of the channel to the precompile IBC module, which
+	// emits the events of the acknowledgements and timeouts
+	p.icaControllerKeeper.SetMiddlewareEnabled(ctx, res.PortId, msg.ConnectionId)
+
+	if err = p.EmitRegisterInterchainAccountEvent(ctx, stateDB, owner, msg.ConnectionId, res.PortId, res.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.ChannelId)
+}
+
+// SendTx sends the messages to be executed by the interchain account owned by
+// the caller of the precompile.
+func (p Precompile) SendTx(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	owner := contract.CallerAddress
+	msg, err := NewMsgSendTx(method, owner, args)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := icacontrollerkeeper.NewMsgServerImpl(&p.icaControllerKeeper)
+	res, err := msgSrv.SendTx(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSendTxEvent(ctx, stateDB, owner, msg.ConnectionId, res.Sequence); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Sequence)
+}
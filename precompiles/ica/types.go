@@ -0,0 +1,132 @@
+package ica
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	icacontrollertypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ProtoMsg is a Cosmos SDK message executed by the interchain account,
+// encoded as a protobuf Any.
+type ProtoMsg struct {
+	TypeUrl string //nolint:revive,stylecheck // the field name matches the ABI struct
+	Value   []byte
+}
+
+// protoMsgs is a struct used to parse the ProtoMsgs parameter
+// used as input in the send tx method
+type protoMsgs struct {
+	ProtoMsgs []ProtoMsg
+}
+
+// NewMsgRegisterInterchainAccount creates a new MsgRegisterInterchainAccount
+// instance for the given owner.
+func NewMsgRegisterInterchainAccount(owner common.Address, args []interface{}) (*icacontrollertypes.MsgRegisterInterchainAccount, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	connectionID, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidConnectionID, args[0])
+	}
+
+	// the default ICS-27 version is negotiated when the version is empty
+	msg := icacontrollertypes.NewMsgRegisterInterchainAccount(connectionID, sdk.AccAddress(owner.Bytes()).String(), "")
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgSendTx creates a new MsgSendTx instance for the given owner. The
+// messages are executed atomically by the interchain account on the host chain.
+func NewMsgSendTx(method *abi.Method, owner common.Address, args []interface{}) (*icacontrollertypes.MsgSendTx, error) {
+	if len(args) != 3 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	connectionID, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidConnectionID, args[0])
+	}
+
+	var input protoMsgs
+	msgsArg := abi.Arguments{method.Inputs[1]}
+	if err := msgsArg.Copy(&input, []interface{}{args[1]}); err != nil {
+		return nil, fmt.Errorf("error while unpacking args to protoMsgs struct: %s", err)
+	}
+
+	if len(input.ProtoMsgs) == 0 {
+		return nil, fmt.Errorf(ErrEmptyMsgs)
+	}
+
+	timeout, ok := args[2].(uint64)
+	if !ok || timeout == 0 || timeout > math.MaxInt64/uint64(time.Second) {
+		return nil, fmt.Errorf(ErrInvalidTimeout, args[2])
+	}
+
+	// the messages are encoded as received, they don't need to be registered
+	// on this chain as they are only decoded by the host chain
+	cosmosTx := icatypes.CosmosTx{
+		Messages: make([]*codectypes.Any, len(input.ProtoMsgs)),
+	}
+	for i, protoMsg := range input.ProtoMsgs {
+		cosmosTx.Messages[i] = &codectypes.Any{
+			TypeUrl: protoMsg.TypeUrl,
+			Value:   protoMsg.Value,
+		}
+	}
+
+	data, err := cosmosTx.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	packetData := icatypes.InterchainAccountPacketData{
+		Type: icatypes.EXECUTE_TX,
+		Data: data,
+	}
+
+	relativeTimeout := timeout * uint64(time.Second)
+	msg := icacontrollertypes.NewMsgSendTx(sdk.AccAddress(owner.Bytes()).String(), connectionID, relativeTimeout, packetData)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewInterchainAccountAddressRequest returns the controller port of the owner
+// and the connection ID of the interchain account address query.
+func NewInterchainAccountAddressRequest(args []interface{}) (string, string, error) {
+	if len(args) != 2 {
+		return "", "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	owner, ok := args[0].(common.Address)
+	if !ok || owner == (common.Address{}) {
+		return "", "", fmt.Errorf(ErrInvalidOwner, args[0])
+	}
+
+	connectionID, ok := args[1].(string)
+	if !ok {
+		return "", "", fmt.Errorf(ErrInvalidConnectionID, args[1])
+	}
+
+	portID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	if err != nil {
+		return "", "", err
+	}
+
+	return portID, connectionID, nil
+}
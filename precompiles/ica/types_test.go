@@ -0,0 +1,116 @@
+package ica
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
+	icatypes "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var owner = common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")
+
+func TestNewMsgRegisterInterchainAccount(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []interface{}
+		expectErr bool
+	}{
+		{"valid", []interface{}{"connection-0"}, false},
+		{"invalid number of args", []interface{}{"connection-0", "version"}, true},
+		{"invalid connection type", []interface{}{uint64(0)}, true},
+		{"invalid connection ID", []interface{}{"conn"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgRegisterInterchainAccount(owner, tc.args)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "connection-0", msg.ConnectionId)
+			require.Equal(t, owner.Bytes(), []byte(msg.GetSigners()[0]))
+		})
+	}
+}
+
+func TestNewMsgSendTx(t *testing.T) {
+	p, err := NewPrecompile(icacontrollerkeeper.Keeper{}, authzkeeper.Keeper{})
+	require.NoError(t, err)
+	method := p.ABI.Methods[SendTxMethod]
+
+	msgs := []ProtoMsg{
+		{TypeUrl: "/cosmos.staking.v1beta1.MsgDelegate", Value: []byte{1, 2, 3}},
+		{TypeUrl: "/cosmos.gov.v1beta1.MsgVote", Value: []byte{4}},
+	}
+
+	testCases := []struct {
+		name      string
+		args      []interface{}
+		expectErr bool
+	}{
+		{"valid", []interface{}{"connection-0", msgs, uint64(600)}, false},
+		{"invalid number of args", []interface{}{"connection-0", msgs}, true},
+		{"invalid connection ID", []interface{}{"conn", msgs, uint64(600)}, true},
+		{"no messages", []interface{}{"connection-0", []ProtoMsg{}, uint64(600)}, true},
+		{"zero timeout", []interface{}{"connection-0", msgs, uint64(0)}, true},
+		{"timeout overflow", []interface{}{"connection-0", msgs, uint64(math.MaxUint64)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgSendTx(&method, owner, tc.args)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, uint64(600*time.Second), msg.RelativeTimeout)
+			require.Equal(t, icatypes.EXECUTE_TX, msg.PacketData.Type)
+
+			var cosmosTx icatypes.CosmosTx
+			require.NoError(t, cosmosTx.Unmarshal(msg.PacketData.Data))
+			require.Len(t, cosmosTx.Messages, len(msgs))
+			for i, protoMsg := range msgs {
+				require.Equal(t, protoMsg.TypeUrl, cosmosTx.Messages[i].TypeUrl)
+				require.Equal(t, protoMsg.Value, cosmosTx.Messages[i].Value)
+			}
+		})
+	}
+}
+
+func TestNewInterchainAccountAddressRequest(t *testing.T) {
+	expPortID, err := icatypes.NewControllerPortID(sdk.AccAddress(owner.Bytes()).String())
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		args      []interface{}
+		expectErr bool
+	}{
+		{"valid", []interface{}{owner, "connection-0"}, false},
+		{"invalid number of args", []interface{}{owner}, true},
+		{"empty owner", []interface{}{common.Address{}, "connection-0"}, true},
+		{"invalid connection type", []interface{}{owner, 0}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			portID, connectionID, err := NewInterchainAccountAddressRequest(tc.args)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "connection-0", connectionID)
+			require.Equal(t, expPortID, portID)
+		})
+	}
+}
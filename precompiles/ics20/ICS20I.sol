@@ -27,6 +27,21 @@ struct Allocation {
     string[] allowList;
 }
 
+/// @dev ForwardHop defines a hop of the route of a forwarded transfer, executed by the
+/// packet forward middleware of the chain receiving the transfer.
+struct ForwardHop {
+    // receiver is the address of the receiver on the next chain.
+    string receiver;
+    // port is the port on which the packet is forwarded, defaults to transfer when empty.
+    string port;
+    // channel is the channel by which the packet is forwarded.
+    string channel;
+    // timeout is the relative timeout of the forwarded packet in seconds, the default is used when set to 0.
+    uint64 timeout;
+    // retries is the number of times the forwarded packet is sent again after it times out.
+    uint8 retries;
+}
+
 /// @author Anryton Team
 /// @title ICS20 Transfer Precompiled Contract
 /// @dev The interface through which solidity contracts will interact with IBC Transfer (ICS20)
@@ -55,6 +70,57 @@ interface ICS20I {
         string memory memo
     ) external returns (uint64 nextSequence);
 
+    /// @dev TransferWithCallback defines a method for performing an IBC transfer
+    /// from a contract that receives the onAcknowledgement or onTimeout callback
+    /// of the packet. The calling contract must implement IICS20Callbacks.
+    /// @param sourcePort the port on which the packet will be sent
+    /// @param sourceChannel the channel by which the packet will be sent
+    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param amount the amount of the Coin to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the bech32 address of the receiver
+    /// @param timeoutHeight the timeout height relative to the current block height. The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch. The timeout is disabled when set to 0
+    /// @param memo optional memo
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transferWithCallback(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo
+    ) external returns (uint64 nextSequence);
+
+    /// @dev TransferWithForward defines a method for performing an IBC transfer that is
+    /// forwarded through the given hops to the last chain of the route.
+    /// @param sourcePort the port on which the packet will be sent
+    /// @param sourceChannel the channel by which the packet will be sent
+    /// @param denom the denomination of the Coin to be transferred to the receiver
+    /// @param amount the amount of the Coin to be transferred to the receiver
+    /// @param sender the hex address of the sender
+    /// @param receiver the bech32 address of the receiver on the first chain of the route
+    /// @param timeoutHeight the timeout height relative to the current block height. The timeout is disabled when set to 0
+    /// @param timeoutTimestamp the timeout timestamp in absolute nanoseconds since unix epoch. The timeout is disabled when set to 0
+    /// @param memo optional memo forwarded to the last chain of the route
+    /// @param hops the hops of the route after the first chain, in order
+    /// @return nextSequence sequence number of the transfer packet sent
+    function transferWithForward(
+        string memory sourcePort,
+        string memory sourceChannel,
+        string memory denom,
+        uint256 amount,
+        address sender,
+        string memory receiver,
+        Height memory timeoutHeight,
+        uint64 timeoutTimestamp,
+        string memory memo,
+        ForwardHop[] memory hops
+    ) external returns (uint64 nextSequence);
+
     /// @dev DenomTraces Defines a method for returning all denom traces.
     /// @param pageRequest Defines the pagination parameters to for the request.
     function denomTraces(
@@ -185,3 +251,35 @@ interface ICS20I {
         uint256[] values
     );
 }
+
+/// @author Anryton Team
+/// @title ICS20 Transfer Callbacks
+/// @dev The interface implemented by contracts sending transfers with
+/// transferWithCallback. The callbacks are called by the ICS20 precompile
+/// (msg.sender is ICS20_PRECOMPILE_ADDRESS) with a limited amount of gas, and a
+/// reverted callback doesn't affect the refund of a failed transfer.
+interface IICS20Callbacks {
+    /// @dev OnAcknowledgement is called when the acknowledgement of the packet is received.
+    /// @param sourcePort the port on which the packet was sent
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence number of the packet
+    /// @param success whether the transfer succeeded on the counterparty chain
+    /// @param acknowledgement the raw acknowledgement of the packet
+    function onAcknowledgement(
+        string memory sourcePort,
+        string memory sourceChannel,
+        uint64 sequence,
+        bool success,
+        bytes memory acknowledgement
+    ) external;
+
+    /// @dev OnTimeout is called when the packet timed out and the transfer was refunded.
+    /// @param sourcePort the port on which the packet was sent
+    /// @param sourceChannel the channel by which the packet was sent
+    /// @param sequence the sequence number of the packet
+    function onTimeout(
+        string memory sourcePort,
+        string memory sourceChannel,
+        uint64 sequence
+    ) external;
+}
@@ -19,6 +19,8 @@ const (
 	ErrNoMatchingAllocation = "no matching allocation found for source port: %s, source channel: %s, and denom: %s"
 	// ErrDifferentOriginFromSender is raised when the origin address is not the same as the sender address.
 	ErrDifferentOriginFromSender = "origin address %s is not the same as sender address %s"
+	// ErrCallbackCallerNotContract is raised when a transfer with callback is not called by a contract.
+	ErrCallbackCallerNotContract = "transfer with callback must be called by a contract, caller: %s"
 	// ErrTraceNotFound is raised when the denom trace for the specified request does not exist.
 	ErrTraceNotFound = "denomination trace not found"
 )
@@ -0,0 +1,35 @@
+package ics20
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/anryton/anryton/v2/precompiles/authorization"
+)
+
+// DefaultMethodGas is the base gas charged for each method of the ICS20
+// precompile, on top of the cost of its arguments and of its state accesses.
+// The transfers are charged more as they also send an IBC packet.
+var DefaultMethodGas = map[string]uint64{
+	// Authorization transactions
+	authorization.ApproveMethod:           2000,
+	authorization.RevokeMethod:            2000,
+	authorization.IncreaseAllowanceMethod: 2000,
+	authorization.DecreaseAllowanceMethod: 2000,
+	// ICS20 transactions
+	TransferMethod:             6000,
+	TransferWithCallbackMethod: 7000,
+	TransferWithForwardMethod:  7000,
+	// ICS20 queries
+	DenomTraceMethod:  1000,
+	DenomTracesMethod: 3000,
+	DenomHashMethod:   1000,
+	// Authorization queries
+	authorization.AllowanceMethod: 1000,
+}
+
+// WithMethodGas returns a copy of the precompile charging the given base gas
+// for each method.
+func (p Precompile) WithMethodGas(methodGas map[string]uint64) vm.PrecompiledContract {
+	p.MethodGas = methodGas
+	return &p
+}
@@ -7,6 +7,8 @@ import (
 
 	"github.com/anryton/anryton/v2/precompiles/authorization"
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	ibchookskeeper "github.com/anryton/anryton/v2/x/ibc/hooks/keeper"
 	transferkeeper "github.com/anryton/anryton/v2/x/ibc/transfer/keeper"
 	storetypes "github.com/cosmos/cosmos-sdk/store/types"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
@@ -27,6 +29,7 @@ type Precompile struct {
 	cmn.Precompile
 	transferKeeper transferkeeper.Keeper
 	channelKeeper  channelkeeper.Keeper
+	ibcHooksKeeper ibchookskeeper.Keeper
 }
 
 // NewPrecompile creates a new staking Precompile instance as a
@@ -34,6 +37,7 @@ type Precompile struct {
 func NewPrecompile(
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	ibcHooksKeeper ibchookskeeper.Keeper,
 	authzKeeper authzkeeper.Keeper,
 	// approvalExpiration time.Duration,
 ) (*Precompile, error) {
@@ -54,16 +58,18 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			MethodGas:            DefaultMethodGas,
 		},
 		transferKeeper: transferKeeper,
 		channelKeeper:  channelKeeper,
+		ibcHooksKeeper: ibcHooksKeeper,
 	}, nil
 }
 
 // Address defines the address of the ICS-20 compile contract.
 // address: 0x0000000000000000000000000000000000000802
 func (Precompile) Address() common.Address {
-	return common.HexToAddress("0x0000000000000000000000000000000000000802")
+	return common.HexToAddress(evmtypes.ICS20PrecompileAddress)
 }
 
 // IsStateful returns true since the precompile contract has access to the
@@ -110,6 +116,10 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 	// ICS20 transactions
 	case TransferMethod:
 		bz, err = p.Transfer(ctx, evm.Origin, contract, stateDB, method, args)
+	case TransferWithCallbackMethod:
+		bz, err = p.TransferWithCallback(ctx, evm.Origin, contract, stateDB, method, args)
+	case TransferWithForwardMethod:
+		bz, err = p.TransferWithForward(ctx, evm.Origin, contract, stateDB, method, args)
 	// ICS20 queries
 	case DenomTraceMethod:
 		bz, err = p.DenomTrace(ctx, contract, method, args)
@@ -140,6 +150,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //
 // Available ics20 transactions are:
 //   - Transfer
+//   - TransferWithCallback
+//   - TransferWithForward
 //
 // Available authorization transactions are:
 //   - Approve
@@ -149,6 +161,8 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 func (Precompile) IsTransaction(method string) bool {
 	switch method {
 	case TransferMethod,
+		TransferWithCallbackMethod,
+		TransferWithForwardMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
@@ -18,6 +18,14 @@ const (
 	// TransferMethod defines the ABI method name for the ICS20 Transfer
 	// transaction.
 	TransferMethod = "transfer"
+	// TransferWithCallbackMethod defines the ABI method name for the ICS20
+	// Transfer transaction that registers the calling contract to receive the
+	// acknowledgement and timeout callbacks.
+	TransferWithCallbackMethod = "transferWithCallback"
+	// TransferWithForwardMethod defines the ABI method name for the ICS20
+	// Transfer transaction that is forwarded through the given hops by the
+	// packet forward middleware of the intermediate chains.
+	TransferWithForwardMethod = "transferWithForward"
 )
 
 // Transfer implements the ICS20 transfer transactions.
@@ -29,14 +37,87 @@ func (p Precompile) Transfer(
 	method *abi.Method,
 	args []interface{},
 ) ([]byte, error) {
-	msg, sender, err := NewMsgTransfer(method, args)
+	sequence, err := p.transfer(ctx, origin, contract, stateDB, method, args)
 	if err != nil {
 		return nil, err
 	}
 
+	return method.Outputs.Pack(sequence)
+}
+
+// TransferWithCallback implements the ICS20 transfer transaction that
+// registers the calling contract to receive the onAcknowledgement or onTimeout
+// callback of the packet. It can only be called by a contract.
+func (p Precompile) TransferWithCallback(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if contract.CallerAddress == origin {
+		return nil, fmt.Errorf(ErrCallbackCallerNotContract, contract.CallerAddress)
+	}
+
+	sequence, err := p.transfer(ctx, origin, contract, stateDB, method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	// the source port and channel have been validated by the transfer
+	sourcePort, _ := args[0].(string)
+	sourceChannel, _ := args[1].(string)
+	p.ibcHooksKeeper.SetCallback(ctx, sourcePort, sourceChannel, sequence, contract.CallerAddress)
+
+	return method.Outputs.Pack(sequence)
+}
+
+// TransferWithForward implements the ICS20 transfer transaction that is
+// forwarded to the next chains of the route. The packet memo is built from
+// the route hops, the memo argument is forwarded to the last chain.
+func (p Precompile) TransferWithForward(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	memo, err := NewForwardMemo(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	transferArgs := make([]interface{}, 9)
+	copy(transferArgs, args[:8])
+	transferArgs[8] = memo
+
+	sequence, err := p.transfer(ctx, origin, contract, stateDB, method, transferArgs)
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(sequence)
+}
+
+// transfer executes the ICS20 transfer and returns the sequence of the packet.
+func (p Precompile) transfer(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) (uint64, error) {
+	msg, sender, err := NewMsgTransfer(method, args)
+	if err != nil {
+		return 0, err
+	}
+
 	// check if channel exists and is open
 	if !p.channelKeeper.HasChannel(ctx, msg.SourcePort, msg.SourceChannel) {
-		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.SourcePort, msg.SourceChannel)
 	}
 
 	// The provided sender address should always be equal to the origin address.
@@ -47,7 +128,7 @@ func (p Precompile) Transfer(
 	if contract.CallerAddress == sender {
 		sender = origin
 	} else if origin != sender {
-		return nil, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), sender.String())
+		return 0, fmt.Errorf(ErrDifferentOriginFromSender, origin.String(), sender.String())
 	}
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
@@ -62,26 +143,26 @@ func (p Precompile) Transfer(
 		// check if authorization exists
 		auth, expiration, err = authorization.CheckAuthzExists(ctx, p.AuthzKeeper, contract.CallerAddress, origin, TransferMsg)
 		if err != nil {
-			return nil, fmt.Errorf(authorization.ErrAuthzDoesNotExistOrExpired, contract.CallerAddress, origin)
+			return 0, fmt.Errorf(authorization.ErrAuthzDoesNotExistOrExpired, contract.CallerAddress, origin)
 		}
 
 		// Accept the grant and return an error if the grant is not accepted
 		resp, err = p.AcceptGrant(ctx, contract.CallerAddress, origin, msg, auth)
 		if err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
 	res, err := p.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
 	if err != nil {
-		return nil, err
+		return 0, err
 	}
 
 	// Update grant only if is needed
 	if contract.CallerAddress != origin {
 		// accepts and updates the grant adjusting the spending limit
 		if err = p.UpdateGrant(ctx, contract.CallerAddress, origin, expiration, resp); err != nil {
-			return nil, err
+			return 0, err
 		}
 	}
 
@@ -95,8 +176,8 @@ func (p Precompile) Transfer(
 		msg.Token,
 		msg.Memo,
 	); err != nil {
-		return nil, err
+		return 0, err
 	}
 
-	return method.Outputs.Pack(res.Sequence)
+	return res.Sequence, nil
 }
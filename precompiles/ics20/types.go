@@ -3,11 +3,13 @@ package ics20
 import (
 	"fmt"
 	"math/big"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
 	"github.com/anryton/anryton/v2/precompiles/authorization"
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	forwardtypes "github.com/anryton/anryton/v2/x/ibc/forward/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
@@ -73,6 +75,21 @@ type height struct {
 	TimeoutHeight clienttypes.Height
 }
 
+// ForwardHop defines a hop of the route of a forwarded transfer.
+type ForwardHop struct {
+	Receiver string
+	Port     string
+	Channel  string
+	Timeout  uint64
+	Retries  uint8
+}
+
+// forwardHops is a struct used to parse the Hops parameter
+// used as input in the transfer with forward method
+type forwardHops struct {
+	Hops []ForwardHop
+}
+
 // allocs is a struct used to parse the Allocations parameter
 // used as input in the transfer authorization method
 type allocs struct {
@@ -329,3 +346,37 @@ func checkAllocationExists(allocations []transfertypes.Allocation, sourcePort, s
 
 	return spendLimit, 0, fmt.Errorf(ErrNoMatchingAllocation, sourcePort, sourceChannel, denom)
 }
+
+// NewForwardMemo returns the packet forward memo of the transfer with forward
+// method from the given arguments. The transfer memo is forwarded to the last
+// chain of the route.
+func NewForwardMemo(method *abi.Method, args []interface{}) (string, error) {
+	if len(args) != 10 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 10, len(args))
+	}
+
+	memo, ok := args[8].(string)
+	if !ok {
+		return "", fmt.Errorf(ErrInvalidMemo, args[8])
+	}
+
+	var input forwardHops
+	hopsArg := abi.Arguments{method.Inputs[9]}
+	if err := hopsArg.Copy(&input, []interface{}{args[9]}); err != nil {
+		return "", fmt.Errorf("error while unpacking args to forwardHops struct: %s", err)
+	}
+
+	hops := make([]forwardtypes.ForwardMetadata, len(input.Hops))
+	for i, hop := range input.Hops {
+		retries := hop.Retries
+		hops[i] = forwardtypes.ForwardMetadata{
+			Receiver: hop.Receiver,
+			Port:     hop.Port,
+			Channel:  hop.Channel,
+			Timeout:  forwardtypes.Duration(time.Duration(hop.Timeout) * time.Second),
+			Retries:  &retries,
+		}
+	}
+
+	return forwardtypes.NewForwardMemo(hops, memo)
+}
@@ -15,6 +15,17 @@ string constant MSG_DELEGATE = "/cosmos.staking.v1beta1.MsgDelegate";
 string constant MSG_UNDELEGATE = "/cosmos.staking.v1beta1.MsgUndelegate";
 string constant MSG_REDELEGATE = "/cosmos.staking.v1beta1.MsgBeginRedelegate";
 string constant MSG_CANCEL_UNDELEGATION = "/cosmos.staking.v1beta1.MsgCancelUnbondingDelegation";
+string constant MSG_CREATE_VALIDATOR = "/cosmos.staking.v1beta1.MsgCreateValidator";
+string constant MSG_EDIT_VALIDATOR = "/cosmos.staking.v1beta1.MsgEditValidator";
+
+/// @dev Defines the description of a validator.
+struct Description {
+    string moniker;
+    string identity;
+    string website;
+    string securityContact;
+    string details;
+}
 
 /// @dev Defines the initial commission rates to be used for creating
 /// a validator.
@@ -114,6 +125,40 @@ enum BondStatus {
 /// wraps the pallet.
 /// @custom:address 0x0000000000000000000000000000000000000800
 interface StakingI is authorization.AuthorizationI {
+    /// @dev Defines a method for creating a new validator. The validator address is
+    /// both the operator and the self-delegator of the validator.
+    /// @param description The initial description of the validator
+    /// @param commissionRates The initial commission rates of the validator
+    /// @param minSelfDelegation The validator's self declared minimum self delegation
+    /// @param validatorAddress The address of the validator operator
+    /// @param pubkey The base64 encoded ed25519 consensus public key of the validator
+    /// @param value The amount of the Coin to be self delegated to the validator
+    /// @return success Whether or not the create validator was successful
+    function createValidator(
+        Description calldata description,
+        CommissionRates calldata commissionRates,
+        uint256 minSelfDelegation,
+        address validatorAddress,
+        string memory pubkey,
+        uint256 value
+    ) external returns (bool success);
+
+    /// @dev Defines a method for editing an existing validator.
+    /// @param description The description of the validator. Use the string "[do-not-modify]"
+    /// as the value of the fields that should not be updated.
+    /// @param validatorAddress The address of the validator operator
+    /// @param commissionRate The new commission rate of the validator.
+    /// Use -1 to keep the current value.
+    /// @param minSelfDelegation The new minimum self delegation of the validator.
+    /// Use -1 to keep the current value.
+    /// @return success Whether or not the edit validator was successful
+    function editValidator(
+        Description calldata description,
+        address validatorAddress,
+        int256 commissionRate,
+        int256 minSelfDelegation
+    ) external returns (bool success);
+
     /// @dev Defines a method for performing a delegation of coins from a delegator to a validator.
     /// @param delegatorAddress The address of the delegator
     /// @param validatorAddress The address of the validator
@@ -125,6 +170,18 @@ interface StakingI is authorization.AuthorizationI {
         uint256 amount
     ) external returns (bool success);
 
+    /// @dev Defines a method for performing delegations of coins from a delegator
+    /// to several validators at once, up to 10 validators.
+    /// @param delegatorAddress The address of the delegator
+    /// @param validatorAddresses The addresses of the validators
+    /// @param amounts The amounts of the Coin to be delegated to each validator
+    /// @return success Whether or not the delegations were successful
+    function delegateMultiple(
+        address delegatorAddress,
+        string[] memory validatorAddresses,
+        uint256[] memory amounts
+    ) external returns (bool success);
+
     /// @dev Defines a method for performing an undelegation from a delegate and a validator.
     /// @param delegatorAddress The address of the delegator
     /// @param validatorAddress The address of the validator
@@ -238,6 +295,21 @@ interface StakingI is authorization.AuthorizationI {
             PageResponse calldata pageResponse
         );
 
+    /// @dev CreateValidator defines an Event emitted when a new validator is created.
+    /// @param validatorAddress The address of the validator operator
+    /// @param value The amount of Coin self delegated to the validator
+    event CreateValidator(address indexed validatorAddress, uint256 value);
+
+    /// @dev EditValidator defines an Event emitted when a validator is edited.
+    /// @param validatorAddress The address of the validator operator
+    /// @param commissionRate The new commission rate, or -1 if unchanged
+    /// @param minSelfDelegation The new minimum self delegation, or -1 if unchanged
+    event EditValidator(
+        address indexed validatorAddress,
+        int256 commissionRate,
+        int256 minSelfDelegation
+    );
+
     /// @dev Delegate defines an Event emitted when a given amount of tokens are delegated from the
     /// delegator address to the validator address.
     /// @param delegatorAddress The address of the delegator
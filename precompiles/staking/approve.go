@@ -25,6 +25,10 @@ var (
 	RedelegateMsg = sdk.MsgTypeURL(&stakingtypes.MsgBeginRedelegate{})
 	// CancelUnbondingDelegationMsg defines the authorization type for MsgCancelUnbondingDelegation
 	CancelUnbondingDelegationMsg = sdk.MsgTypeURL(&stakingtypes.MsgCancelUnbondingDelegation{})
+	// CreateValidatorMsg defines the authorization type for MsgCreateValidator
+	CreateValidatorMsg = sdk.MsgTypeURL(&stakingtypes.MsgCreateValidator{})
+	// EditValidatorMsg defines the authorization type for MsgEditValidator
+	EditValidatorMsg = sdk.MsgTypeURL(&stakingtypes.MsgEditValidator{})
 )
 
 // Approve sets amount as the allowance of a grantee over the caller’s tokens.
@@ -51,6 +55,10 @@ func (p Precompile) Approve(
 			if err = p.grantOrDeleteStakingAuthz(ctx, grantee, origin, coin, authzType); err != nil {
 				return nil, err
 			}
+		case CreateValidatorMsg, EditValidatorMsg:
+			if err = p.grantOrDeleteGenericAuthz(ctx, grantee, origin, coin, typeURL); err != nil {
+				return nil, err
+			}
 		default:
 			// TODO: do we need to return an error here or just no-op?
 			// Implications of returning an error could be that we approve some parts of the txs but not all
@@ -83,7 +91,7 @@ func (p Precompile) Revoke(
 
 	for _, typeURL := range typeURLs {
 		switch typeURL {
-		case DelegateMsg, UndelegateMsg, RedelegateMsg, CancelUnbondingDelegationMsg:
+		case DelegateMsg, UndelegateMsg, RedelegateMsg, CancelUnbondingDelegationMsg, CreateValidatorMsg, EditValidatorMsg:
 			if err = p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), origin.Bytes(), typeURL); err != nil {
 				return nil, err
 			}
@@ -220,6 +228,29 @@ func (p Precompile) grantOrDeleteStakingAuthz(
 	return p.createStakingAuthz(ctx, grantee, granter, coin, authzType)
 }
 
+// grantOrDeleteGenericAuthz grants the authorization of the validator operator
+// messages to the precompiled contract for a spender. These messages have no
+// allowance, so the amount only tells whether the authorization is granted or,
+// if it is zero, deleted.
+func (p Precompile) grantOrDeleteGenericAuthz(
+	ctx sdk.Context,
+	grantee, granter common.Address,
+	coin *sdk.Coin,
+	typeURL string,
+) error {
+	if coin != nil && !coin.IsNil() && !coin.Amount.IsPositive() {
+		p.Logger(ctx).Debug(
+			"deleting authorization",
+			"grantee", grantee.String(),
+			"granter", granter.String(),
+		)
+		return p.AuthzKeeper.DeleteGrant(ctx, grantee.Bytes(), granter.Bytes(), typeURL)
+	}
+
+	expiration := ctx.BlockTime().Add(p.ApprovalExpiration).UTC()
+	return p.AuthzKeeper.SaveGrant(ctx, grantee.Bytes(), granter.Bytes(), authz.NewGenericAuthorization(typeURL), &expiration)
+}
+
 // createStakingAuthz creates a staking authorization for a spender.
 func (p Precompile) createStakingAuthz(
 	ctx sdk.Context,
@@ -344,6 +344,26 @@ func (s *PrecompileTestSuite) TestApprove() {
 			false,
 			"",
 		},
+		{
+			"success - MsgCreateValidator and MsgEditValidator are granted without limit",
+			func(_ *vm.Contract) []interface{} {
+				return []interface{}{
+					s.address,
+					big.NewInt(1e18),
+					[]string{staking.CreateValidatorMsg, staking.EditValidatorMsg},
+				}
+			},
+			func(data []byte, inputArgs []interface{}) {
+				for _, msgURL := range []string{staking.CreateValidatorMsg, staking.EditValidatorMsg} {
+					grant, expirationTime := s.app.AuthzKeeper.GetAuthorization(s.ctx, s.address.Bytes(), s.address.Bytes(), msgURL)
+					s.Require().NotNil(expirationTime)
+					s.Require().Equal(sdkauthz.NewGenericAuthorization(msgURL), grant)
+				}
+			},
+			20000,
+			false,
+			"",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -6,6 +6,20 @@ const (
 	ErrDecreaseAmountTooBig = "amount by which the allowance should be decreased is greater than the authorization limit: %s > %s"
 	// ErrDifferentOriginFromDelegator is raised when the origin address is not the same as the delegator address.
 	ErrDifferentOriginFromDelegator = "origin address %s is not the same as delegator address %s"
+	// ErrDifferentOriginFromValidator is raised when the origin address is not the same as the validator address.
+	ErrDifferentOriginFromValidator = "origin address %s is not the same as validator address %s"
+	// ErrInvalidValidatorAddress is raised when the validator address is not a valid hex address.
+	ErrInvalidValidatorAddress = "invalid validator address: %v"
+	// ErrInvalidConsensusPubkey is raised when the consensus public key is not a base64 encoded ed25519 key.
+	ErrInvalidConsensusPubkey = "invalid consensus pubkey %s: %s"
+	// ErrInvalidCommissionRate is raised when the commission rate is negative and not the do-not-modify value.
+	ErrInvalidCommissionRate = "invalid commission rate: %s"
+	// ErrInvalidMinSelfDelegation is raised when the min self delegation is negative and not the do-not-modify value.
+	ErrInvalidMinSelfDelegation = "invalid min self delegation: %s"
+	// ErrInvalidDelegateMultiple is raised when the validators and amounts of a delegateMultiple call don't match.
+	ErrInvalidDelegateMultiple = "expected the same non-zero number of validators and amounts, got %d validators and %d amounts"
+	// ErrTooManyDelegations is raised when a delegateMultiple call exceeds the maximum number of delegations.
+	ErrTooManyDelegations = "too many delegations: %d > %d"
 	// ErrNoDelegationFound is raised when no delegation is found for the given delegator and validator addresses.
 	ErrNoDelegationFound = "delegation with delegator %s not found for validator %s"
 )
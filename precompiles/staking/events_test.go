@@ -6,6 +6,7 @@ import (
 	"github.com/anryton/anryton/v2/precompiles/authorization"
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
 	"github.com/anryton/anryton/v2/precompiles/staking"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
@@ -484,3 +485,55 @@ func (s *PrecompileTestSuite) TestCancelUnbondingDelegationEvent() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestCreateValidatorEvent() {
+	s.SetupTest()
+	s.createSelfValidator()
+
+	log := s.stateDB.Logs()[0]
+	s.Require().Equal(log.Address, s.precompile.Address())
+
+	// Check event signature matches the one emitted
+	event := s.precompile.ABI.Events[staking.EventTypeCreateValidator]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+	s.Require().Equal(log.BlockNumber, uint64(s.ctx.BlockHeight()))
+
+	// Check the fully unpacked event matches the one emitted
+	var createValidatorEvent staking.EventCreateValidator
+	err := cmn.UnpackLog(s.precompile.ABI, &createValidatorEvent, staking.EventTypeCreateValidator, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.address, createValidatorEvent.ValidatorAddress)
+	s.Require().Equal(big.NewInt(1e18), createValidatorEvent.Value)
+}
+
+func (s *PrecompileTestSuite) TestEditValidatorEvent() {
+	s.SetupTest()
+	s.createSelfValidator()
+
+	method := s.precompile.Methods[staking.EditValidatorMethod]
+	contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 200000)
+	_, err := s.precompile.EditValidator(s.ctx, s.address, contract, s.stateDB, &method, []interface{}{
+		staking.Description{
+			Moniker:         "node0-renamed",
+			Identity:        stakingtypes.DoNotModifyDesc,
+			Website:         stakingtypes.DoNotModifyDesc,
+			SecurityContact: stakingtypes.DoNotModifyDesc,
+			Details:         stakingtypes.DoNotModifyDesc,
+		},
+		s.address,
+		big.NewInt(staking.DoNotModifyCommissionRate),
+		big.NewInt(2),
+	})
+	s.Require().NoError(err)
+
+	log := s.stateDB.Logs()[1]
+	event := s.precompile.ABI.Events[staking.EventTypeEditValidator]
+	s.Require().Equal(crypto.Keccak256Hash([]byte(event.Sig)), common.HexToHash(log.Topics[0].Hex()))
+
+	var editValidatorEvent staking.EventEditValidator
+	err = cmn.UnpackLog(s.precompile.ABI, &editValidatorEvent, staking.EventTypeEditValidator, *log)
+	s.Require().NoError(err)
+	s.Require().Equal(s.address, editValidatorEvent.ValidatorAddress)
+	s.Require().Equal(big.NewInt(staking.DoNotModifyCommissionRate), editValidatorEvent.CommissionRate)
+	s.Require().Equal(big.NewInt(2), editValidatorEvent.MinSelfDelegation)
+}
@@ -0,0 +1,43 @@
+package staking
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/anryton/anryton/v2/precompiles/authorization"
+)
+
+// DefaultMethodGas is the base gas charged for each method of the staking
+// precompile, on top of the cost of its arguments and of its state accesses.
+// The methods iterating over the unbonding and redelegation entries or over the
+// validators are charged more.
+var DefaultMethodGas = map[string]uint64{
+	// Authorization transactions
+	authorization.ApproveMethod:           2000,
+	authorization.RevokeMethod:            2000,
+	authorization.IncreaseAllowanceMethod: 2000,
+	authorization.DecreaseAllowanceMethod: 2000,
+	// Staking transactions
+	DelegateMethod:                  4000,
+	UndelegateMethod:                5000,
+	RedelegateMethod:                8000,
+	CancelUnbondingDelegationMethod: 6000,
+	CreateValidatorMethod:           10000,
+	EditValidatorMethod:             3000,
+	DelegateMultipleMethod:          8000,
+	// Staking queries
+	DelegationMethod:          1000,
+	UnbondingDelegationMethod: 1500,
+	ValidatorMethod:           1000,
+	ValidatorsMethod:          4000,
+	RedelegationMethod:        1500,
+	RedelegationsMethod:       4000,
+	// Authorization queries
+	authorization.AllowanceMethod: 1000,
+}
+
+// WithMethodGas returns a copy of the precompile charging the given base gas
+// for each method.
+func (p Precompile) WithMethodGas(methodGas map[string]uint64) vm.PrecompiledContract {
+	p.MethodGas = methodGas
+	return &p
+}
@@ -1219,6 +1219,7 @@ var _ = Describe("Calling staking precompile directly", func() {
 
 	It("Should refund leftover gas", func() {
 		balancePre := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), s.bondDenom)
+		_, _, quotaUsedPre, _ := s.app.GasQuotaKeeper.GetRemainingGas(s.ctx, s.address.Bytes())
 		gasPrice := big.NewInt(1e9)
 
 		// Call the precompile with a lot of gas
@@ -1230,6 +1231,9 @@ var _ = Describe("Calling staking precompile directly", func() {
 
 		res, _, err := contracts.CallContractAndCheckLogs(s.ctx, s.app, approveArgs, approvalCheck)
 		Expect(err).To(BeNil(), "error while calling the smart contract: %v", err)
+		// the gas covered by the gas quota of the staker is not charged
+		_, _, quotaUsedPost, _ := s.app.GasQuotaKeeper.GetRemainingGas(s.ctx, s.address.Bytes())
+		coveredGas := int64(quotaUsedPost - quotaUsedPre)
 
 		s.NextBlock()
 
@@ -1237,7 +1241,7 @@ var _ = Describe("Calling staking precompile directly", func() {
 		difference := balancePre.Sub(balancePost)
 
 		// NOTE: the expected difference is the gas price multiplied by the gas used, because the rest should be refunded
-		expDifference := gasPrice.Int64() * res.GasUsed
+		expDifference := gasPrice.Int64() * (res.GasUsed - coveredGas)
 		Expect(difference.Amount.Int64()).To(Equal(expDifference), "expected different total transaction cost")
 	})
 })
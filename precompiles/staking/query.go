@@ -8,6 +8,7 @@ import (
 	"github.com/anryton/anryton/v2/precompiles/authorization"
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
@@ -211,6 +212,11 @@ func (p Precompile) Allowance(
 		return method.Outputs.Pack(big.NewInt(0))
 	}
 
+	// the authorizations of the validator operator messages have no limit
+	if _, ok := msgAuthz.(*authz.GenericAuthorization); ok {
+		return method.Outputs.Pack(abi.MaxUint256)
+	}
+
 	stakeAuthz, ok := msgAuthz.(*stakingtypes.StakeAuthorization)
 	if !ok {
 		return nil, fmt.Errorf(cmn.ErrInvalidType, "staking authorization", &stakingtypes.StakeAuthorization{}, stakeAuthz)
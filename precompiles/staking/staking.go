@@ -67,6 +67,7 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			MethodGas:            DefaultMethodGas,
 		},
 		stakingKeeper: stakingKeeper,
 	}, nil
@@ -112,6 +113,12 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 		bz, err = p.Redelegate(ctx, evm.Origin, contract, stateDB, method, args)
 	case CancelUnbondingDelegationMethod:
 		bz, err = p.CancelUnbondingDelegation(ctx, evm.Origin, contract, stateDB, method, args)
+	case CreateValidatorMethod:
+		bz, err = p.CreateValidator(ctx, evm.Origin, contract, stateDB, method, args)
+	case EditValidatorMethod:
+		bz, err = p.EditValidator(ctx, evm.Origin, contract, stateDB, method, args)
+	case DelegateMultipleMethod:
+		bz, err = p.DelegateMultiple(ctx, evm.Origin, contract, stateDB, method, args)
 	// Staking queries
 	case DelegationMethod:
 		bz, err = p.Delegation(ctx, contract, method, args)
@@ -150,6 +157,9 @@ func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz [
 //   - Undelegate
 //   - Redelegate
 //   - CancelUnbondingDelegation
+//   - CreateValidator
+//   - EditValidator
+//   - DelegateMultiple
 //
 // Available authorization transactions are:
 //   - Approve
@@ -162,6 +172,9 @@ func (Precompile) IsTransaction(method string) bool {
 		UndelegateMethod,
 		RedelegateMethod,
 		CancelUnbondingDelegationMethod,
+		CreateValidatorMethod,
+		EditValidatorMethod,
+		DelegateMultipleMethod,
 		authorization.ApproveMethod,
 		authorization.RevokeMethod,
 		authorization.IncreaseAllowanceMethod,
@@ -96,7 +96,7 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 				s.Require().NoError(err)
 				return input
 			},
-			7760,
+			9760,
 		},
 		{
 			"success - undelegate transaction with correct gas estimation",
@@ -110,7 +110,7 @@ func (s *PrecompileTestSuite) TestRequiredGas() {
 				s.Require().NoError(err)
 				return input
 			},
-			7760,
+			10760,
 		},
 	}
 
@@ -441,8 +441,7 @@ func (s *PrecompileTestSuite) TestRun() {
 			)
 
 			params := s.app.EvmKeeper.GetParams(s.ctx)
-			activePrecompiles := params.GetActivePrecompilesAddrs()
-			precompileMap := s.app.EvmKeeper.Precompiles(activePrecompiles...)
+			precompileMap, activePrecompiles := s.app.EvmKeeper.Precompiles(s.ctx, params)
 			err = vm.ValidatePrecompiles(precompileMap, activePrecompiles)
 			s.Require().NoError(err, "invalid precompiles", activePrecompiles)
 			evm.WithPrecompiles(precompileMap, activePrecompiles)
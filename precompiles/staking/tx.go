@@ -27,6 +27,27 @@ const (
 	// CancelUnbondingDelegationMethod defines the ABI method name for the staking
 	// CancelUnbondingDelegation transaction.
 	CancelUnbondingDelegationMethod = "cancelUnbondingDelegation"
+	// CreateValidatorMethod defines the ABI method name for the staking
+	// CreateValidator transaction.
+	CreateValidatorMethod = "createValidator"
+	// EditValidatorMethod defines the ABI method name for the staking
+	// EditValidator transaction.
+	EditValidatorMethod = "editValidator"
+	// DelegateMultipleMethod defines the ABI method name for the staking
+	// delegations to several validators in a single transaction.
+	DelegateMultipleMethod = "delegateMultiple"
+)
+
+const (
+	// DoNotModifyCommissionRate is the commission rate of the editValidator
+	// transaction leaving the commission rate unchanged.
+	DoNotModifyCommissionRate = -1
+	// DoNotModifyMinSelfDelegation is the min self delegation of the editValidator
+	// transaction leaving the min self delegation unchanged.
+	DoNotModifyMinSelfDelegation = -1
+	// MaxDelegateMultiple is the maximum number of delegations of a single
+	// delegateMultiple transaction.
+	MaxDelegateMultiple = 10
 )
 
 const (
@@ -65,6 +86,58 @@ func (p Precompile) Delegate(
 		),
 	)
 
+	if err = p.delegate(ctx, origin, contract, stateDB, msg, delegatorHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// DelegateMultiple performs delegations of coins from a delegator to several
+// validators. Each delegation is checked against the authorization grant of
+// the caller as a single Delegate transaction would be.
+func (p Precompile) DelegateMultiple(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msgs, delegatorHexAddr, err := NewMsgsDelegateMultiple(args, p.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ delegator_address: %s, delegations: %d }",
+			delegatorHexAddr,
+			len(msgs),
+		),
+	)
+
+	for _, msg := range msgs {
+		if err = p.delegate(ctx, origin, contract, stateDB, msg, delegatorHexAddr); err != nil {
+			return nil, err
+		}
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// delegate checks the delegator and the authorization grant of the caller,
+// executes the delegation and emits the Delegate event.
+func (p Precompile) delegate(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	msg *stakingtypes.MsgDelegate,
+	delegatorHexAddr common.Address,
+) (err error) {
 	var (
 		// stakeAuthz is the authorization grant for the caller and the delegator address
 		stakeAuthz *stakingtypes.StakeAuthorization
@@ -85,7 +158,7 @@ func (p Precompile) Delegate(
 	if isCallerDelegator {
 		delegatorHexAddr = origin
 	} else if origin != delegatorHexAddr {
-		return nil, fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
+		return fmt.Errorf(ErrDifferentOriginFromDelegator, origin.String(), delegatorHexAddr.String())
 	}
 
 	// no need to have authorization when the contract caller is the same as origin (owner of funds)
@@ -93,35 +166,38 @@ func (p Precompile) Delegate(
 		// Check if the authorization grant exists for the caller and the origin
 		stakeAuthz, expiration, err = authorization.CheckAuthzAndAllowanceForGranter(ctx, p.AuthzKeeper, contract.CallerAddress, delegatorHexAddr, &msg.Amount, DelegateMsg)
 		if err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// Execute the transaction using the message server
 	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
 	if _, err = msgSrv.Delegate(sdk.WrapSDKContext(ctx), msg); err != nil {
-		return nil, err
+		return err
 	}
 
 	// Only update the authorization if the contract caller is different from the origin
 	if !isCallerOrigin {
 		if err := p.UpdateStakingAuthorization(ctx, contract.CallerAddress, delegatorHexAddr, stakeAuthz, expiration, DelegateMsg, msg); err != nil {
-			return nil, err
+			return err
 		}
 	}
 
 	// Emit the event for the delegate transaction
 	if err = p.EmitDelegateEvent(ctx, stateDB, msg, delegatorHexAddr); err != nil {
-		return nil, err
+		return err
 	}
 
 	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
 	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	// With an authorization grant, the delegated coins are spent from the origin.
 	if isCallerDelegator {
 		stateDB.(*statedb.StateDB).SubBalance(contract.CallerAddress, msg.Amount.Amount.BigInt())
+	} else {
+		stateDB.(*statedb.StateDB).SubBalance(origin, msg.Amount.Amount.BigInt())
 	}
 
-	return method.Outputs.Pack(true)
+	return nil
 }
 
 // Undelegate performs the undelegation of coins from a validator for a delegate.
@@ -362,3 +438,130 @@ func (p Precompile) CancelUnbondingDelegation(
 
 	return method.Outputs.Pack(true)
 }
+
+// CreateValidator creates a new validator operated by the validator address,
+// which also self-delegates the value.
+func (p Precompile) CreateValidator(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, validatorHexAddr, err := NewMsgCreateValidator(method, args, p.stakingKeeper.BondDenom(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ validator_address: %s, moniker: %s, commission_rate: %s, min_self_delegation: %s, value: %s }",
+			validatorHexAddr,
+			msg.Description.Moniker,
+			msg.Commission.Rate,
+			msg.MinSelfDelegation,
+			msg.Value.Amount,
+		),
+	)
+
+	isCallerValidator, err := p.checkValidatorOperator(ctx, origin, contract, validatorHexAddr, CreateValidatorMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	if _, err = msgSrv.CreateValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitCreateValidatorEvent(ctx, stateDB, msg, validatorHexAddr); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	// With an authorization grant, the self-delegated coins are spent from the origin.
+	if isCallerValidator {
+		stateDB.(*statedb.StateDB).SubBalance(contract.CallerAddress, msg.Value.Amount.BigInt())
+	} else {
+		stateDB.(*statedb.StateDB).SubBalance(origin, msg.Value.Amount.BigInt())
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// EditValidator edits the description, commission rate and min self delegation
+// of the validator operated by the validator address.
+func (p Precompile) EditValidator(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	msg, validatorHexAddr, err := NewMsgEditValidator(method, args)
+	if err != nil {
+		return nil, err
+	}
+
+	p.Logger(ctx).Debug(
+		"tx called",
+		"method", method.Name,
+		"args", fmt.Sprintf(
+			"{ validator_address: %s, moniker: %s, commission_rate: %v, min_self_delegation: %v }",
+			validatorHexAddr,
+			msg.Description.Moniker,
+			msg.CommissionRate,
+			msg.MinSelfDelegation,
+		),
+	)
+
+	if _, err = p.checkValidatorOperator(ctx, origin, contract, validatorHexAddr, EditValidatorMsg); err != nil {
+		return nil, err
+	}
+
+	msgSrv := stakingkeeper.NewMsgServerImpl(&p.stakingKeeper)
+	if _, err = msgSrv.EditValidator(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitEditValidatorEvent(ctx, stateDB, msg, validatorHexAddr); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// checkValidatorOperator checks that the caller may operate the validator and
+// returns true if the caller is the validator operator itself.
+// A contract operating its own validator, e.g. a multisig, doesn't need an origin
+// check. Otherwise the origin must be the validator operator, and a contract
+// calling on its behalf must have been granted the message type with approve.
+func (p Precompile) checkValidatorOperator(
+	ctx sdk.Context,
+	origin common.Address,
+	contract *vm.Contract,
+	validatorHexAddr common.Address,
+	msgURL string,
+) (bool, error) {
+	if contract.CallerAddress == validatorHexAddr {
+		return true, nil
+	}
+
+	if origin != validatorHexAddr {
+		return false, fmt.Errorf(ErrDifferentOriginFromValidator, origin.String(), validatorHexAddr.String())
+	}
+
+	// no need to have authorization when the contract caller is the same as origin
+	if contract.CallerAddress != origin {
+		if _, _, err := authorization.CheckAuthzExists(ctx, p.AuthzKeeper, contract.CallerAddress, origin, msgURL); err != nil {
+			return false, err
+		}
+	}
+
+	return false, nil
+}
@@ -1,14 +1,19 @@
 package staking_test
 
 import (
+	"encoding/base64"
 	"fmt"
 	"math/big"
+	"time"
 
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
 	"github.com/anryton/anryton/v2/precompiles/staking"
 	"github.com/anryton/anryton/v2/precompiles/testutil"
 	anrytonutiltx "github.com/anryton/anryton/v2/testutil/tx"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	geth "github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core/vm"
 	"github.com/ethereum/go-ethereum/crypto"
@@ -674,3 +679,338 @@ func (s *PrecompileTestSuite) TestCancelUnbondingDelegation() {
 		})
 	}
 }
+
+func (s *PrecompileTestSuite) TestCreateValidator() {
+	method := s.precompile.Methods[staking.CreateValidatorMethod]
+
+	description := staking.Description{Moniker: "node0", Details: "multisig operated validator"}
+	commission := staking.CommissionRates{
+		Rate:          big.NewInt(5e16),
+		MaxRate:       big.NewInt(2e17),
+		MaxChangeRate: big.NewInt(1e16),
+	}
+	pubkey := base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes())
+	var caller geth.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 6, 0),
+		},
+		{
+			"fail - different origin than validator",
+			func() []interface{} {
+				return []interface{}{description, commission, big.NewInt(1), anrytonutiltx.GenerateAddress(), pubkey, big.NewInt(1e18)}
+			},
+			true,
+			"is not the same as validator address",
+		},
+		{
+			"fail - invalid consensus pubkey",
+			func() []interface{} {
+				return []interface{}{description, commission, big.NewInt(1), s.address, "foo", big.NewInt(1e18)}
+			},
+			true,
+			"invalid consensus pubkey",
+		},
+		{
+			"fail - commission rate above max rate",
+			func() []interface{} {
+				invalidCommission := commission
+				invalidCommission.Rate = big.NewInt(3e17)
+				return []interface{}{description, invalidCommission, big.NewInt(1), s.address, pubkey, big.NewInt(1e18)}
+			},
+			true,
+			"commission cannot be more than the max rate",
+		},
+		{
+			"fail - contract creating the validator of the origin without authorization",
+			func() []interface{} {
+				caller = anrytonutiltx.GenerateAddress()
+				return []interface{}{description, commission, big.NewInt(1), s.address, pubkey, big.NewInt(1e18)}
+			},
+			true,
+			"does not exist or is expired",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{description, commission, big.NewInt(1), s.address, pubkey, big.NewInt(1e18)}
+			},
+			false,
+			"",
+		},
+		{
+			"success - contract creating the validator of the origin with authorization",
+			func() []interface{} {
+				caller = anrytonutiltx.GenerateAddress()
+				expiration := time.Now().Add(cmn.DefaultExpirationDuration).UTC()
+				err := s.app.AuthzKeeper.SaveGrant(s.ctx, caller.Bytes(), s.address.Bytes(), authz.NewGenericAuthorization(staking.CreateValidatorMsg), &expiration)
+				s.Require().NoError(err)
+				return []interface{}{description, commission, big.NewInt(1), s.address, pubkey, big.NewInt(1e18)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			caller = s.address
+			args := tc.malleate()
+			// the origin is loaded in the stateDB before the precompile runs
+			s.stateDB.GetBalance(s.address)
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, caller, s.precompile, 200000)
+
+			bz, err := s.precompile.CreateValidator(s.ctx, s.address, contract, s.stateDB, &method, args)
+
+			validator, found := s.app.StakingKeeper.GetValidator(s.ctx, s.address.Bytes())
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				s.Require().False(found)
+			} else {
+				s.Require().NoError(err)
+				s.Require().True(found)
+				s.Require().Equal(description.Moniker, validator.Description.Moniker)
+				s.Require().Equal(sdk.NewDecWithPrec(5, 2), validator.Commission.Rate)
+				s.Require().Equal(sdk.NewInt(1e18), validator.Tokens)
+
+				// the self-delegation is mirrored to the origin balance of the stateDB
+				balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), s.bondDenom)
+				s.Require().Equal(balance.Amount.BigInt(), s.stateDB.GetBalance(s.address))
+
+				success, err := s.precompile.Unpack(staking.CreateValidatorMethod, bz)
+				s.Require().NoError(err)
+				s.Require().Equal(true, success[0])
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestEditValidator() {
+	method := s.precompile.Methods[staking.EditValidatorMethod]
+
+	description := staking.Description{
+		Moniker:         "node0-renamed",
+		Identity:        stakingtypes.DoNotModifyDesc,
+		Website:         stakingtypes.DoNotModifyDesc,
+		SecurityContact: stakingtypes.DoNotModifyDesc,
+		Details:         stakingtypes.DoNotModifyDesc,
+	}
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 4, 0),
+		},
+		{
+			"fail - different origin than validator",
+			func() []interface{} {
+				return []interface{}{description, anrytonutiltx.GenerateAddress(), big.NewInt(-1), big.NewInt(-1)}
+			},
+			true,
+			"is not the same as validator address",
+		},
+		{
+			"fail - negative min self delegation",
+			func() []interface{} {
+				return []interface{}{description, s.address, big.NewInt(-1), big.NewInt(-2)}
+			},
+			true,
+			"invalid min self delegation",
+		},
+		{
+			"success - update the description and the min self delegation",
+			func() []interface{} {
+				return []interface{}{description, s.address, big.NewInt(staking.DoNotModifyCommissionRate), big.NewInt(2)}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			s.createSelfValidator()
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, s.address, s.precompile, 200000)
+
+			bz, err := s.precompile.EditValidator(s.ctx, s.address, contract, s.stateDB, &method, tc.malleate())
+
+			validator, found := s.app.StakingKeeper.GetValidator(s.ctx, s.address.Bytes())
+			s.Require().True(found)
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				s.Require().Equal("node0", validator.Description.Moniker)
+			} else {
+				s.Require().NoError(err)
+				s.Require().Equal(description.Moniker, validator.Description.Moniker)
+				s.Require().Equal("multisig operated validator", validator.Description.Details)
+				s.Require().Equal(sdk.NewDecWithPrec(5, 2), validator.Commission.Rate)
+				s.Require().Equal(sdk.NewInt(2), validator.MinSelfDelegation)
+			}
+		})
+	}
+}
+
+func (s *PrecompileTestSuite) TestDelegateMultiple() {
+	method := s.precompile.Methods[staking.DelegateMultipleMethod]
+	var caller geth.Address
+
+	testCases := []struct {
+		name        string
+		malleate    func() []interface{}
+		expError    bool
+		errContains string
+	}{
+		{
+			"fail - empty input args",
+			func() []interface{} {
+				return []interface{}{}
+			},
+			true,
+			fmt.Sprintf(cmn.ErrInvalidNumberOfArgs, 3, 0),
+		},
+		{
+			"fail - mismatched validators and amounts",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					[]string{s.validators[0].OperatorAddress, s.validators[1].OperatorAddress},
+					[]*big.Int{big.NewInt(1e18)},
+				}
+			},
+			true,
+			fmt.Sprintf(staking.ErrInvalidDelegateMultiple, 2, 1),
+		},
+		{
+			"fail - too many delegations",
+			func() []interface{} {
+				validators := make([]string, staking.MaxDelegateMultiple+1)
+				amounts := make([]*big.Int, staking.MaxDelegateMultiple+1)
+				for i := range validators {
+					validators[i] = s.validators[0].OperatorAddress
+					amounts[i] = big.NewInt(1)
+				}
+				return []interface{}{s.address, validators, amounts}
+			},
+			true,
+			fmt.Sprintf(staking.ErrTooManyDelegations, staking.MaxDelegateMultiple+1, staking.MaxDelegateMultiple),
+		},
+		{
+			"fail - different origin than delegator",
+			func() []interface{} {
+				return []interface{}{
+					anrytonutiltx.GenerateAddress(),
+					[]string{s.validators[0].OperatorAddress},
+					[]*big.Int{big.NewInt(1e18)},
+				}
+			},
+			true,
+			"is not the same as delegator address",
+		},
+		{
+			"fail - insufficient funds for the second delegation",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					[]string{s.validators[0].OperatorAddress, s.validators[1].OperatorAddress},
+					[]*big.Int{big.NewInt(1e18), big.NewInt(9e18)},
+				}
+			},
+			true,
+			"insufficient funds",
+		},
+		{
+			"success",
+			func() []interface{} {
+				return []interface{}{
+					s.address,
+					[]string{s.validators[0].OperatorAddress, s.validators[1].OperatorAddress},
+					[]*big.Int{big.NewInt(1e18), big.NewInt(2e18)},
+				}
+			},
+			false,
+			"",
+		},
+		{
+			"success - contract delegating on behalf of the origin with authorization",
+			func() []interface{} {
+				caller = anrytonutiltx.GenerateAddress()
+				err := s.CreateAuthorization(caller, staking.DelegateAuthz, &sdk.Coin{Denom: s.bondDenom, Amount: sdk.NewInt(3e18)})
+				s.Require().NoError(err)
+				return []interface{}{
+					s.address,
+					[]string{s.validators[0].OperatorAddress, s.validators[1].OperatorAddress},
+					[]*big.Int{big.NewInt(1e18), big.NewInt(2e18)},
+				}
+			},
+			false,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		s.Run(tc.name, func() {
+			s.SetupTest()
+			caller = s.address
+			args := tc.malleate()
+			// the origin is loaded in the stateDB before the precompile runs
+			s.stateDB.GetBalance(s.address)
+
+			var contract *vm.Contract
+			contract, s.ctx = testutil.NewPrecompileContract(s.T(), s.ctx, caller, s.precompile, 200000)
+
+			bz, err := s.precompile.DelegateMultiple(s.ctx, s.address, contract, s.stateDB, &method, args)
+
+			if tc.expError {
+				s.Require().ErrorContains(err, tc.errContains)
+				s.Require().Empty(bz)
+				return
+			}
+
+			s.Require().NoError(err)
+			for i, expShares := range []int64{2, 3} {
+				delegation := s.app.StakingKeeper.Delegation(s.ctx, s.address.Bytes(), s.validators[i].GetOperator())
+				s.Require().NotNil(delegation)
+				s.Require().Equal(sdk.NewInt(expShares), delegation.GetShares().TruncateInt())
+			}
+
+			// the delegations are mirrored to the origin balance of the stateDB
+			balance := s.app.BankKeeper.GetBalance(s.ctx, s.address.Bytes(), s.bondDenom)
+			s.Require().Equal(balance.Amount.BigInt(), s.stateDB.GetBalance(s.address))
+
+			// one delegate event is emitted for each delegation
+			s.Require().Len(s.stateDB.Logs(), 2)
+			event := s.precompile.ABI.Events[staking.EventTypeDelegate]
+			for _, log := range s.stateDB.Logs() {
+				s.Require().Equal(event.ID, log.Topics[0])
+			}
+		})
+	}
+}
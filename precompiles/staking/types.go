@@ -1,11 +1,14 @@
 package staking
 
 import (
+	"encoding/base64"
 	"errors"
 	"fmt"
 	"math/big"
 
 	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
@@ -46,6 +49,213 @@ type EventCancelUnbonding struct {
 	CreationHeight   *big.Int
 }
 
+// EventCreateValidator defines the event data for the staking CreateValidator transaction.
+type EventCreateValidator struct {
+	ValidatorAddress common.Address
+	Value            *big.Int
+}
+
+// EventEditValidator defines the event data for the staking EditValidator transaction.
+type EventEditValidator struct {
+	ValidatorAddress  common.Address
+	CommissionRate    *big.Int
+	MinSelfDelegation *big.Int
+}
+
+// Description defines a validator description.
+type Description struct {
+	Moniker         string
+	Identity        string
+	Website         string
+	SecurityContact string
+	Details         string
+}
+
+// ToStakingDescription converts the description to the staking module type.
+func (d Description) ToStakingDescription() stakingtypes.Description {
+	return stakingtypes.NewDescription(d.Moniker, d.Identity, d.Website, d.SecurityContact, d.Details)
+}
+
+// CommissionRates defines the commission rates of a validator, in 18 decimals
+// precision.
+type CommissionRates struct {
+	Rate          *big.Int
+	MaxRate       *big.Int
+	MaxChangeRate *big.Int
+}
+
+// ToStakingCommissionRates converts the commission rates to the staking module type.
+func (c CommissionRates) ToStakingCommissionRates() stakingtypes.CommissionRates {
+	return stakingtypes.NewCommissionRates(
+		sdk.NewDecFromBigIntWithPrec(c.Rate, sdk.Precision),
+		sdk.NewDecFromBigIntWithPrec(c.MaxRate, sdk.Precision),
+		sdk.NewDecFromBigIntWithPrec(c.MaxChangeRate, sdk.Precision),
+	)
+}
+
+// CreateValidatorInput is a struct to represent the input information for
+// the createValidator transaction. Needed to unpack the description and
+// commission rates tuples.
+type CreateValidatorInput struct {
+	Description       Description
+	CommissionRates   CommissionRates
+	MinSelfDelegation *big.Int
+	ValidatorAddress  common.Address
+	Pubkey            string
+	Value             *big.Int
+}
+
+// EditValidatorInput is a struct to represent the input information for
+// the editValidator transaction. Needed to unpack the description tuple.
+type EditValidatorInput struct {
+	Description       Description
+	ValidatorAddress  common.Address
+	CommissionRate    *big.Int
+	MinSelfDelegation *big.Int
+}
+
+// NewMsgCreateValidator creates a new MsgCreateValidator instance and does sanity checks
+// on the given arguments before populating the message. The validator address is both
+// the operator and the self-delegator of the validator, and the pubkey is the base64
+// encoded ed25519 consensus public key.
+func NewMsgCreateValidator(method *abi.Method, args []interface{}, denom string) (*stakingtypes.MsgCreateValidator, common.Address, error) {
+	if len(args) != 6 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 6, len(args))
+	}
+
+	var input CreateValidatorInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to CreateValidatorInput struct: %s", err)
+	}
+
+	if input.ValidatorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidValidatorAddress, args[3])
+	}
+
+	pubkeyBz, err := base64.StdEncoding.DecodeString(input.Pubkey)
+	if err != nil {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidConsensusPubkey, input.Pubkey, err)
+	}
+	if len(pubkeyBz) != ed25519.PubKeySize {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidConsensusPubkey, input.Pubkey, fmt.Errorf("expected %d bytes, got %d", ed25519.PubKeySize, len(pubkeyBz)))
+	}
+
+	pubkey, err := codectypes.NewAnyWithValue(&ed25519.PubKey{Key: pubkeyBz})
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	msg := &stakingtypes.MsgCreateValidator{
+		Description:       input.Description.ToStakingDescription(),
+		Commission:        input.CommissionRates.ToStakingCommissionRates(),
+		MinSelfDelegation: sdk.NewIntFromBigInt(input.MinSelfDelegation),
+		DelegatorAddress:  sdk.AccAddress(input.ValidatorAddress.Bytes()).String(), // bech32 formatted
+		ValidatorAddress:  sdk.ValAddress(input.ValidatorAddress.Bytes()).String(),
+		Pubkey:            pubkey,
+		Value: sdk.Coin{
+			Denom:  denom,
+			Amount: sdk.NewIntFromBigInt(input.Value),
+		},
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.ValidatorAddress, nil
+}
+
+// NewMsgEditValidator creates a new MsgEditValidator instance and does sanity checks
+// on the given arguments before populating the message. The commission rate and the
+// min self delegation are left unchanged when they are -1.
+func NewMsgEditValidator(method *abi.Method, args []interface{}) (*stakingtypes.MsgEditValidator, common.Address, error) {
+	if len(args) != 4 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	var input EditValidatorInput
+	if err := method.Inputs.Copy(&input, args); err != nil {
+		return nil, common.Address{}, fmt.Errorf("error while unpacking args to EditValidatorInput struct: %s", err)
+	}
+
+	if input.ValidatorAddress == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidValidatorAddress, args[1])
+	}
+
+	var commissionRate *sdk.Dec
+	if input.CommissionRate.Cmp(big.NewInt(DoNotModifyCommissionRate)) != 0 {
+		if input.CommissionRate.Sign() < 0 {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidCommissionRate, input.CommissionRate)
+		}
+		rate := sdk.NewDecFromBigIntWithPrec(input.CommissionRate, sdk.Precision)
+		commissionRate = &rate
+	}
+
+	var minSelfDelegation *sdk.Int
+	if input.MinSelfDelegation.Cmp(big.NewInt(DoNotModifyMinSelfDelegation)) != 0 {
+		if input.MinSelfDelegation.Sign() < 0 {
+			return nil, common.Address{}, fmt.Errorf(ErrInvalidMinSelfDelegation, input.MinSelfDelegation)
+		}
+		amount := sdk.NewIntFromBigInt(input.MinSelfDelegation)
+		minSelfDelegation = &amount
+	}
+
+	msg := &stakingtypes.MsgEditValidator{
+		Description:       input.Description.ToStakingDescription(),
+		ValidatorAddress:  sdk.ValAddress(input.ValidatorAddress.Bytes()).String(),
+		CommissionRate:    commissionRate,
+		MinSelfDelegation: minSelfDelegation,
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, input.ValidatorAddress, nil
+}
+
+// NewMsgsDelegateMultiple creates a MsgDelegate instance for each of the validators
+// and amounts and does sanity checks on the given arguments before populating the messages.
+func NewMsgsDelegateMultiple(args []interface{}, denom string) ([]*stakingtypes.MsgDelegate, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	delegatorAddr, ok := args[0].(common.Address)
+	if !ok || delegatorAddr == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidDelegator, args[0])
+	}
+
+	validatorAddresses, ok := args[1].([]string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "validatorAddresses", []string{}, args[1])
+	}
+
+	amounts, ok := args[2].([]*big.Int)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidType, "amounts", []*big.Int{}, args[2])
+	}
+
+	if len(validatorAddresses) == 0 || len(validatorAddresses) != len(amounts) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidDelegateMultiple, len(validatorAddresses), len(amounts))
+	}
+
+	if len(validatorAddresses) > MaxDelegateMultiple {
+		return nil, common.Address{}, fmt.Errorf(ErrTooManyDelegations, len(validatorAddresses), MaxDelegateMultiple)
+	}
+
+	msgs := make([]*stakingtypes.MsgDelegate, len(validatorAddresses))
+	for i, validatorAddress := range validatorAddresses {
+		msg, _, err := NewMsgDelegate([]interface{}{delegatorAddr, validatorAddress, amounts[i]}, denom)
+		if err != nil {
+			return nil, common.Address{}, err
+		}
+		msgs[i] = msg
+	}
+
+	return msgs, delegatorAddr, nil
+}
+
 // NewMsgDelegate creates a new MsgDelegate instance and does sanity checks
 // on the given arguments before populating the message.
 func NewMsgDelegate(args []interface{}, denom string) (*stakingtypes.MsgDelegate, common.Address, error) {
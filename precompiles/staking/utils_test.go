@@ -1,6 +1,7 @@
 package staking_test
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"math/big"
@@ -27,16 +28,19 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
 	"github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/testutil/mock"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
 	"golang.org/x/exp/slices"
 )
 
@@ -196,9 +200,9 @@ func (s *PrecompileTestSuite) DoSetupTest() {
 
 	coins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(5000000000000000000)))
 	distrCoins := sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, sdk.NewInt(2000000000000000000)))
-	err = s.app.BankKeeper.MintCoins(s.ctx, "", coins)
+	err = s.app.BankKeeper.MintCoins(s.ctx, minttypes.ModuleName, coins)
 	s.Require().NoError(err)
-	err = s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, "", authtypes.FeeCollectorName, distrCoins)
+	err = s.app.BankKeeper.SendCoinsFromModuleToModule(s.ctx, minttypes.ModuleName, authtypes.FeeCollectorName, distrCoins)
 	s.Require().NoError(err)
 
 	queryHelperEvm := baseapp.NewQueryServerTestHelper(s.ctx, s.app.InterfaceRegistry())
@@ -513,3 +517,20 @@ func (s *PrecompileTestSuite) CheckValidatorOutput(valOut staking.ValidatorInfo)
 	Expect(slices.Contains(validatorAddrs, valOut.OperatorAddress)).To(BeTrue(), "operator address not found in test suite validators")
 	Expect(valOut.DelegatorShares).To(Equal(big.NewInt(1e18)), "expected different delegator shares")
 }
+
+// createSelfValidator creates a validator operated by the test address through
+// the precompile.
+func (s *PrecompileTestSuite) createSelfValidator() {
+	method := s.precompile.Methods[staking.CreateValidatorMethod]
+	contract := vm.NewContract(vm.AccountRef(s.address), s.precompile, big.NewInt(0), 200000)
+
+	_, err := s.precompile.CreateValidator(s.ctx, s.address, contract, s.stateDB, &method, []interface{}{
+		staking.Description{Moniker: "node0", Details: "multisig operated validator"},
+		staking.CommissionRates{Rate: big.NewInt(5e16), MaxRate: big.NewInt(2e17), MaxChangeRate: big.NewInt(1e16)},
+		big.NewInt(1),
+		s.address,
+		base64.StdEncoding.EncodeToString(ed25519.GenPrivKey().PubKey().Bytes()),
+		big.NewInt(1e18),
+	})
+	s.Require().NoError(err)
+}
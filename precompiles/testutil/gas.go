@@ -0,0 +1,63 @@
+package testutil
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// PrecompileGas is the gas charged upfront for a precompile call compared to the
+// gas consumed by the state accesses of its execution.
+type PrecompileGas struct {
+	// Charged is the gas returned by RequiredGas
+	Charged uint64
+	// Consumed is the SDK gas consumed by the execution
+	Consumed uint64
+}
+
+// MeasurePrecompileGas runs the precompile call from the caller and returns the
+// gas charged by RequiredGas along with the gas consumed by the execution.
+func MeasurePrecompileGas(
+	evm *vm.EVM,
+	precompile vm.PrecompiledContract,
+	caller common.Address,
+	input []byte,
+	gasLimit uint64,
+) (PrecompileGas, error) {
+	contract := vm.NewContract(vm.AccountRef(caller), precompile, big.NewInt(0), gasLimit)
+	contract.Input = input
+
+	charged := precompile.RequiredGas(input)
+	if _, err := precompile.Run(evm, contract, false); err != nil {
+		return PrecompileGas{}, err
+	}
+
+	return PrecompileGas{
+		Charged:  charged,
+		Consumed: gasLimit - contract.Gas,
+	}, nil
+}
+
+// ReportPrecompileGas reports the average charged and consumed gas of the
+// measurements as benchmark metrics, along with the ratio of the charged gas
+// over the consumed gas used to calibrate the base gas of the methods.
+func ReportPrecompileGas(b *testing.B, measurements []PrecompileGas) {
+	if len(measurements) == 0 {
+		return
+	}
+
+	var charged, consumed uint64
+	for _, m := range measurements {
+		charged += m.Charged
+		consumed += m.Consumed
+	}
+
+	n := float64(len(measurements))
+	b.ReportMetric(float64(charged)/n, "charged-gas/op")
+	b.ReportMetric(float64(consumed)/n, "consumed-gas/op")
+	if consumed > 0 {
+		b.ReportMetric(float64(charged)/float64(consumed), "charged/consumed")
+	}
+}
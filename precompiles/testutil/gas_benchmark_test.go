@@ -0,0 +1,142 @@
+package testutil_test
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	anrytonapp "github.com/anryton/anryton/v2/app"
+	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	"github.com/anryton/anryton/v2/precompiles/distribution"
+	"github.com/anryton/anryton/v2/precompiles/ics20"
+	"github.com/anryton/anryton/v2/precompiles/staking"
+	"github.com/anryton/anryton/v2/precompiles/testutil"
+	"github.com/anryton/anryton/v2/precompiles/vesting"
+	anrytonutil "github.com/anryton/anryton/v2/testutil"
+	utiltx "github.com/anryton/anryton/v2/testutil/tx"
+	"github.com/anryton/anryton/v2/x/evm/statedb"
+)
+
+// gasBenchmark holds the app used to measure the gas of the precompile calls.
+type gasBenchmark struct {
+	app       *anrytonapp.Anryton
+	ctx       sdk.Context
+	delegator common.Address
+	funder    common.Address
+	validator string
+}
+
+func setupGasBenchmark(b *testing.B) *gasBenchmark {
+	app := anrytonapp.Setup(false, nil, cmn.DefaultChainID)
+	ctx := app.BaseApp.NewContext(false, anrytonutil.NewHeader(1, time.Now().UTC(), cmn.DefaultChainID, nil, nil, nil))
+
+	validators := app.StakingKeeper.GetAllValidators(ctx)
+	require.NotEmpty(b, validators)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(b, err)
+
+	header := ctx.BlockHeader()
+	header.ProposerAddress = consAddr.Bytes()
+	ctx = ctx.WithBlockHeader(header)
+
+	gb := &gasBenchmark{
+		app:       app,
+		ctx:       ctx,
+		delegator: utiltx.GenerateAddress(),
+		funder:    utiltx.GenerateAddress(),
+		validator: validators[0].GetOperator().String(),
+	}
+
+	for _, addr := range []common.Address{gb.delegator, gb.funder} {
+		err = anrytonutil.FundAccountWithBaseDenom(ctx, app.BankKeeper, addr.Bytes(), 1e18)
+		require.NoError(b, err)
+	}
+
+	// delegate so that the delegation and rewards queries find the delegation
+	_, err = app.StakingKeeper.Delegate(ctx, gb.delegator.Bytes(), sdk.NewInt(1e17), stakingtypes.Unbonded, validators[0], true)
+	require.NoError(b, err)
+
+	return gb
+}
+
+// measure runs the method of the precompile on a cached context from the
+// delegator and returns the gas charged and consumed by the call.
+func (gb *gasBenchmark) measure(b *testing.B, address common.Address, method string, args ...interface{}) testutil.PrecompileGas {
+	ctx, _ := gb.ctx.CacheContext()
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	params := gb.app.EvmKeeper.GetParams(ctx)
+	precompiles, _ := gb.app.EvmKeeper.Precompiles(ctx, params)
+	precompile, found := precompiles[address]
+	require.True(b, found, "precompile %s is not active", address)
+
+	abiPrecompile, ok := precompile.(interface{ GetABI() abi.ABI })
+	require.True(b, ok)
+	input, err := abiPrecompile.GetABI().Pack(method, args...)
+	require.NoError(b, err)
+
+	cfg, err := gb.app.EvmKeeper.EVMConfig(ctx, ctx.BlockHeader().ProposerAddress, gb.app.EvmKeeper.ChainID())
+	require.NoError(b, err)
+
+	gasLimit := uint64(10_000_000)
+	msg := ethtypes.NewMessage(gb.delegator, &address, 0, nil, gasLimit, big.NewInt(0), big.NewInt(0), big.NewInt(0), input, nil, false)
+	stateDB := statedb.New(ctx, gb.app.EvmKeeper, statedb.NewEmptyTxConfig(common.BytesToHash(ctx.HeaderHash().Bytes())))
+	evm := gb.app.EvmKeeper.NewEVM(ctx, msg, cfg, nil, stateDB)
+
+	gas, err := testutil.MeasurePrecompileGas(evm, precompile, gb.delegator, input, gasLimit)
+	require.NoError(b, err)
+	return gas
+}
+
+// benchmarkMethod reports the average gas charged and consumed by the method of
+// the precompile.
+func (gb *gasBenchmark) benchmarkMethod(b *testing.B, address common.Address, method string, args ...interface{}) {
+	b.Run(method, func(b *testing.B) {
+		measurements := make([]testutil.PrecompileGas, 0, b.N)
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			measurements = append(measurements, gb.measure(b, address, method, args...))
+		}
+		testutil.ReportPrecompileGas(b, measurements)
+	})
+}
+
+func BenchmarkStakingPrecompileGas(b *testing.B) {
+	gb := setupGasBenchmark(b)
+	address := staking.Precompile{}.Address()
+
+	gb.benchmarkMethod(b, address, staking.DelegateMethod, gb.delegator, gb.validator, big.NewInt(1e18/2))
+	gb.benchmarkMethod(b, address, staking.DelegationMethod, gb.delegator, gb.validator)
+	gb.benchmarkMethod(b, address, staking.ValidatorMethod, gb.validator)
+}
+
+func BenchmarkDistributionPrecompileGas(b *testing.B) {
+	gb := setupGasBenchmark(b)
+	address := distribution.Precompile{}.Address()
+
+	gb.benchmarkMethod(b, address, distribution.DelegationRewardsMethod, gb.delegator, gb.validator)
+	gb.benchmarkMethod(b, address, distribution.DelegationTotalRewardsMethod, gb.delegator)
+	gb.benchmarkMethod(b, address, distribution.ValidatorOutstandingRewardsMethod, gb.validator)
+}
+
+func BenchmarkICS20PrecompileGas(b *testing.B) {
+	gb := setupGasBenchmark(b)
+	address := ics20.Precompile{}.Address()
+
+	gb.benchmarkMethod(b, address, ics20.DenomHashMethod, "transfer/channel-0/uatom")
+}
+
+func BenchmarkVestingPrecompileGas(b *testing.B) {
+	gb := setupGasBenchmark(b)
+	address := vesting.Precompile{}.Address()
+
+	// the vesting account must be the origin of the call
+	gb.benchmarkMethod(b, address, vesting.CreateClawbackVestingAccountMethod, gb.funder, gb.delegator, false)
+}
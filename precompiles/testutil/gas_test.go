@@ -0,0 +1,53 @@
+package testutil_test
+
+import (
+	"testing"
+
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anryton/anryton/v2/precompiles/distribution"
+	"github.com/anryton/anryton/v2/precompiles/ics20"
+	"github.com/anryton/anryton/v2/precompiles/staking"
+	"github.com/anryton/anryton/v2/precompiles/vesting"
+	ibchookskeeper "github.com/anryton/anryton/v2/x/ibc/hooks/keeper"
+	transferkeeper "github.com/anryton/anryton/v2/x/ibc/transfer/keeper"
+	vestingkeeper "github.com/anryton/anryton/v2/x/vesting/keeper"
+)
+
+func TestDefaultMethodGas(t *testing.T) {
+	stakingPrecompile, err := staking.NewPrecompile(stakingkeeper.Keeper{}, authzkeeper.Keeper{})
+	require.NoError(t, err)
+	distributionPrecompile, err := distribution.NewPrecompile(distributionkeeper.Keeper{}, stakingkeeper.Keeper{}, authzkeeper.Keeper{})
+	require.NoError(t, err)
+	ics20Precompile, err := ics20.NewPrecompile(transferkeeper.Keeper{}, channelkeeper.Keeper{}, ibchookskeeper.Keeper{}, authzkeeper.Keeper{})
+	require.NoError(t, err)
+	vestingPrecompile, err := vesting.NewPrecompile(vestingkeeper.Keeper{}, authzkeeper.Keeper{})
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		abi       abi.ABI
+		methodGas map[string]uint64
+	}{
+		{"staking", stakingPrecompile.ABI, staking.DefaultMethodGas},
+		{"distribution", distributionPrecompile.ABI, distribution.DefaultMethodGas},
+		{"ics20", ics20Precompile.ABI, ics20.DefaultMethodGas},
+		{"vesting", vestingPrecompile.ABI, vesting.DefaultMethodGas},
+	}
+
+	for _, tc := range testCases {
+		// every method of the precompile has a base gas
+		for name := range tc.abi.Methods {
+			require.Contains(t, tc.methodGas, name, tc.name)
+		}
+		// every base gas belongs to a method of the precompile
+		for name := range tc.methodGas {
+			require.Contains(t, tc.abi.Methods, name, tc.name)
+		}
+	}
+}
@@ -0,0 +1,131 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.18;
+
+/// @dev The ITokenFactory contract's address.
+address constant TOKENFACTORY_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000805;
+
+/// @dev The ITokenFactory contract's instance.
+ITokenFactory constant TOKENFACTORY_CONTRACT = ITokenFactory(TOKENFACTORY_PRECOMPILE_ADDRESS);
+
+/// @author Anryton Team
+/// @title Token Factory Precompiled Contract
+/// @dev The interface through which solidity contracts will create and
+/// administer token factory denoms. The caller of the precompile is the creator
+/// and admin of the denoms it creates.
+/// @custom:address 0x0000000000000000000000000000000000000805
+interface ITokenFactory {
+    /// @dev CreateDenom defines an Event emitted when a denom is created.
+    /// @param creator the address of the denom creator
+    /// @param denom the created factory/{creator}/{subdenom} denom
+    /// @param erc20Address the address of the ERC20 representation of the denom
+    event CreateDenom(
+        address indexed creator,
+        string denom,
+        address erc20Address
+    );
+
+    /// @dev Mint defines an Event emitted when coins of a denom are minted.
+    /// @param admin the address of the denom admin
+    /// @param to the address receiving the minted coins
+    /// @param denom the minted denom
+    /// @param amount the minted amount
+    event Mint(
+        address indexed admin,
+        address indexed to,
+        string denom,
+        uint256 amount
+    );
+
+    /// @dev Burn defines an Event emitted when coins of a denom are burned.
+    /// @param admin the address of the denom admin
+    /// @param denom the burned denom
+    /// @param amount the burned amount
+    event Burn(address indexed admin, string denom, uint256 amount);
+
+    /// @dev ChangeAdmin defines an Event emitted when the admin of a denom is changed.
+    /// @param admin the address of the previous denom admin
+    /// @param newAdmin the address of the new denom admin
+    /// @param denom the denom
+    event ChangeAdmin(
+        address indexed admin,
+        address indexed newAdmin,
+        string denom
+    );
+
+    /// @dev SetDenomMetadata defines an Event emitted when the metadata of a denom is set.
+    /// @param admin the address of the denom admin
+    /// @param denom the denom
+    event SetDenomMetadata(address indexed admin, string denom);
+
+    /// @dev CreateDenom creates the factory/{caller}/{subdenom} denom administered
+    /// by the caller, and registers its ERC20 representation. The denom creation
+    /// fee is charged to the caller.
+    /// @param subdenom the subdenom of the created denom
+    /// @return denom the created denom
+    /// @return erc20Address the address of the ERC20 representation of the denom,
+    /// the zero address if the erc20 module is disabled
+    function createDenom(
+        string memory subdenom
+    ) external returns (string memory denom, address erc20Address);
+
+    /// @dev Mint mints coins of a denom administered by the caller.
+    /// @param denom the denom to mint
+    /// @param amount the amount to mint
+    /// @param to the address receiving the minted coins
+    /// @return success true if the coins were minted
+    function mint(
+        string memory denom,
+        uint256 amount,
+        address to
+    ) external returns (bool success);
+
+    /// @dev Burn burns coins of a denom administered by the caller from the
+    /// caller balance.
+    /// @param denom the denom to burn
+    /// @param amount the amount to burn
+    /// @return success true if the coins were burned
+    function burn(
+        string memory denom,
+        uint256 amount
+    ) external returns (bool success);
+
+    /// @dev ChangeAdmin transfers the administration of a denom administered by
+    /// the caller.
+    /// @param denom the denom
+    /// @param newAdmin the address of the new admin
+    /// @return success true if the admin was changed
+    function changeAdmin(
+        string memory denom,
+        address newAdmin
+    ) external returns (bool success);
+
+    /// @dev SetDenomMetadata sets the name, symbol and description of the bank
+    /// metadata of a denom administered by the caller. The ERC20 representation
+    /// of the denom keeps the name and symbol it was deployed with.
+    /// @param denom the denom
+    /// @param name the name of the denom
+    /// @param symbol the symbol of the denom
+    /// @param description the description of the denom
+    /// @return success true if the metadata was set
+    function setDenomMetadata(
+        string memory denom,
+        string memory name,
+        string memory symbol,
+        string memory description
+    ) external returns (bool success);
+
+    /// @dev DenomAdmin returns the admin of a token factory denom.
+    /// @param denom the denom
+    /// @return admin the address of the denom admin, the zero address if the
+    /// denom has no admin
+    function denomAdmin(
+        string memory denom
+    ) external view returns (address admin);
+
+    /// @dev DenomsFromCreator returns the denoms created by an address.
+    /// @param creator the address of the creator
+    /// @return denoms the denoms created by the address
+    function denomsFromCreator(
+        address creator
+    ) external view returns (string[] memory denoms);
+}
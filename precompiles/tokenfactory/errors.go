@@ -0,0 +1,12 @@
+package tokenfactory
+
+const (
+	// ErrInvalidSubdenom is raised when the subdenom is invalid.
+	ErrInvalidSubdenom = "invalid subdenom: %v"
+	// ErrInvalidAddress is raised when an address argument is invalid.
+	ErrInvalidAddress = "invalid address: %v"
+	// ErrInvalidMetadata is raised when a metadata field is invalid.
+	ErrInvalidMetadata = "invalid metadata %s: %v"
+	// ErrMetadataNotFound is raised when the denom has no bank metadata.
+	ErrMetadataNotFound = "metadata of denom %s not found"
+)
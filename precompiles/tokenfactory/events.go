@@ -0,0 +1,176 @@
+package tokenfactory
+
+import (
+	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// EventTypeCreateDenom defines the event type for the token factory CreateDenom transaction.
+	EventTypeCreateDenom = "CreateDenom"
+	// EventTypeMint defines the event type for the token factory Mint transaction.
+	EventTypeMint = "Mint"
+	// EventTypeBurn defines the event type for the token factory Burn transaction.
+	EventTypeBurn = "Burn"
+	// EventTypeChangeAdmin defines the event type for the token factory ChangeAdmin transaction.
+	EventTypeChangeAdmin = "ChangeAdmin"
+	// EventTypeSetDenomMetadata defines the event type for the token factory SetDenomMetadata transaction.
+	EventTypeSetDenomMetadata = "SetDenomMetadata"
+)
+
+// EmitCreateDenomEvent creates a new event emitted on a CreateDenom transaction.
+func (p Precompile) EmitCreateDenomEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	creator common.Address,
+	denom string,
+	erc20Address common.Address,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeCreateDenom]
+	topics, err := p.makeTopics(event, creator)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: denom, erc20Address
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(denom, erc20Address)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitMintEvent creates a new event emitted on a Mint transaction.
+func (p Precompile) EmitMintEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	admin, to common.Address,
+	coin sdk.Coin,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeMint]
+	topics, err := p.makeTopics(event, admin, to)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: denom, amount
+	arguments := abi.Arguments{event.Inputs[2], event.Inputs[3]}
+	packed, err := arguments.Pack(coin.Denom, coin.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitBurnEvent creates a new event emitted on a Burn transaction.
+func (p Precompile) EmitBurnEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	admin common.Address,
+	coin sdk.Coin,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeBurn]
+	topics, err := p.makeTopics(event, admin)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: denom, amount
+	arguments := abi.Arguments{event.Inputs[1], event.Inputs[2]}
+	packed, err := arguments.Pack(coin.Denom, coin.Amount.BigInt())
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitChangeAdminEvent creates a new event emitted on a ChangeAdmin transaction.
+func (p Precompile) EmitChangeAdminEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	admin, newAdmin common.Address,
+	denom string,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeChangeAdmin]
+	topics, err := p.makeTopics(event, admin, newAdmin)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: denom
+	arguments := abi.Arguments{event.Inputs[2]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// EmitSetDenomMetadataEvent creates a new event emitted on a SetDenomMetadata transaction.
+func (p Precompile) EmitSetDenomMetadataEvent(
+	ctx sdk.Context,
+	stateDB vm.StateDB,
+	admin common.Address,
+	denom string,
+) error {
+	// Prepare the event topics
+	event := p.ABI.Events[EventTypeSetDenomMetadata]
+	topics, err := p.makeTopics(event, admin)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event data: denom
+	arguments := abi.Arguments{event.Inputs[1]}
+	packed, err := arguments.Pack(denom)
+	if err != nil {
+		return err
+	}
+
+	p.addLog(ctx, stateDB, topics, packed)
+	return nil
+}
+
+// makeTopics returns the topics of an event with the given indexed addresses.
+func (p Precompile) makeTopics(event abi.Event, indexed ...common.Address) ([]common.Hash, error) {
+	topics := make([]common.Hash, len(indexed)+1)
+
+	// The first topic is always the signature of the event.
+	topics[0] = event.ID
+
+	var err error
+	for i, address := range indexed {
+		topics[i+1], err = cmn.MakeTopic(address)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return topics, nil
+}
+
+// addLog adds the log of an event emitted by the precompile.
+func (p Precompile) addLog(ctx sdk.Context, stateDB vm.StateDB, topics []common.Hash, data []byte) {
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()),
+	})
+}
@@ -0,0 +1,69 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// DenomAdminMethod defines the ABI method name for the token factory
+	// DenomAdmin query.
+	DenomAdminMethod = "denomAdmin"
+	// DenomsFromCreatorMethod defines the ABI method name for the token factory
+	// DenomsFromCreator query.
+	DenomsFromCreatorMethod = "denomsFromCreator"
+)
+
+// DenomAdmin returns the admin of a token factory denom. The zero address is
+// returned if the denom doesn't exist or has no admin.
+func (p Precompile) DenomAdmin(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, err := ParseDenomArg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	var admin common.Address
+	if metadata, found := p.tokenFactoryKeeper.GetAuthorityMetadata(ctx, denom); found && metadata.Admin != "" {
+		adminAddr, err := sdk.AccAddressFromBech32(metadata.Admin)
+		if err != nil {
+			return nil, err
+		}
+		admin = common.BytesToAddress(adminAddr)
+	}
+
+	return method.Outputs.Pack(admin)
+}
+
+// DenomsFromCreator returns the denoms created by an address.
+func (p Precompile) DenomsFromCreator(
+	ctx sdk.Context,
+	_ *vm.Contract,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	creator, ok := args[0].(common.Address)
+	if !ok || creator == (common.Address{}) {
+		return nil, fmt.Errorf(ErrInvalidAddress, args[0])
+	}
+
+	denoms := p.tokenFactoryKeeper.GetDenomsFromCreator(ctx, sdk.AccAddress(creator.Bytes()).String())
+	return method.Outputs.Pack(denoms)
+}
@@ -0,0 +1,162 @@
+package tokenfactory
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+
+	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	tokenfactorykeeper "github.com/anryton/anryton/v2/x/tokenfactory/keeper"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+var _ vm.PrecompiledContract = &Precompile{}
+
+// Embed abi json file to the executable binary. Needed when importing as dependency.
+//
+//go:embed abi.json
+var f embed.FS
+
+// EVMKeeper defines the expected EVM keeper, used to look up the EVM denom
+// whose balance changes are mirrored to the EVM state.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// Precompile defines the precompiled contract for the token factory.
+type Precompile struct {
+	cmn.Precompile
+	tokenFactoryKeeper tokenfactorykeeper.Keeper
+	bankKeeper         bankkeeper.Keeper
+	evmKeeper          EVMKeeper
+}
+
+// NewPrecompile creates a new token factory Precompile instance as a
+// PrecompiledContract interface.
+func NewPrecompile(
+	tokenFactoryKeeper tokenfactorykeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	evmKeeper EVMKeeper,
+	authzKeeper authzkeeper.Keeper,
+) (*Precompile, error) {
+	abiBz, err := f.ReadFile("abi.json")
+	if err != nil {
+		return nil, fmt.Errorf("error loading the token factory ABI %s", err)
+	}
+
+	newAbi, err := abi.JSON(bytes.NewReader(abiBz))
+	if err != nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidABI, err)
+	}
+
+	return &Precompile{
+		Precompile: cmn.Precompile{
+			ABI:                  newAbi,
+			AuthzKeeper:          authzKeeper,
+			KvGasConfig:          storetypes.KVGasConfig(),
+			TransientKVGasConfig: storetypes.TransientGasConfig(),
+			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+		},
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		bankKeeper:         bankKeeper,
+		evmKeeper:          evmKeeper,
+	}, nil
+}
+
+// Address defines the address of the token factory compile contract.
+// address: 0x0000000000000000000000000000000000000805
+func (Precompile) Address() common.Address {
+	return common.HexToAddress("0x0000000000000000000000000000000000000805")
+}
+
+// IsStateful returns true since the precompile contract has access to the
+// chain state.
+func (Precompile) IsStateful() bool {
+	return true
+}
+
+// RequiredGas calculates the precompiled contract's base gas rate.
+func (p Precompile) RequiredGas(input []byte) uint64 {
+	methodID := input[:4]
+
+	method, err := p.MethodById(methodID)
+	if err != nil {
+		// This should never happen since this method is going to fail during Run
+		return 0
+	}
+
+	return p.Precompile.RequiredGas(input, p.IsTransaction(method.Name))
+}
+
+// Run executes the precompiled contract token factory methods defined in the ABI.
+func (p Precompile) Run(evm *vm.EVM, contract *vm.Contract, readOnly bool) (bz []byte, err error) {
+	ctx, stateDB, method, initialGas, args, err := p.RunSetup(evm, contract, readOnly, p.IsTransaction)
+	if err != nil {
+		return nil, err
+	}
+
+	// This handles any out of gas errors that may occur during the execution of a precompile tx or query.
+	// It avoids panics and returns the out of gas error so the EVM can continue gracefully.
+	defer cmn.HandleGasError(ctx, contract, initialGas, &err)()
+
+	switch method.Name {
+	// Token factory transactions
+	case CreateDenomMethod:
+		bz, err = p.CreateDenom(ctx, contract, stateDB, method, args)
+	case MintMethod:
+		bz, err = p.Mint(ctx, contract, stateDB, method, args)
+	case BurnMethod:
+		bz, err = p.Burn(ctx, contract, stateDB, method, args)
+	case ChangeAdminMethod:
+		bz, err = p.ChangeAdmin(ctx, contract, stateDB, method, args)
+	case SetDenomMetadataMethod:
+		bz, err = p.SetDenomMetadata(ctx, contract, stateDB, method, args)
+	// Token factory queries
+	case DenomAdminMethod:
+		bz, err = p.DenomAdmin(ctx, contract, method, args)
+	case DenomsFromCreatorMethod:
+		bz, err = p.DenomsFromCreator(ctx, contract, method, args)
+	default:
+		return nil, fmt.Errorf(cmn.ErrUnknownMethod, method.Name)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	cost := ctx.GasMeter().GasConsumed() - initialGas
+
+	if !contract.UseGas(cost) {
+		return nil, vm.ErrOutOfGas
+	}
+
+	return bz, nil
+}
+
+// IsTransaction checks if the given method name corresponds to a transaction or query.
+//
+// Available token factory transactions are:
+//   - CreateDenom
+//   - Mint
+//   - Burn
+//   - ChangeAdmin
+//   - SetDenomMetadata
+func (Precompile) IsTransaction(method string) bool {
+	switch method {
+	case CreateDenomMethod,
+		MintMethod,
+		BurnMethod,
+		ChangeAdminMethod,
+		SetDenomMetadataMethod:
+		return true
+	default:
+		return false
+	}
+}
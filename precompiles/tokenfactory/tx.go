@@ -0,0 +1,178 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	"github.com/anryton/anryton/v2/x/evm/statedb"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+const (
+	// CreateDenomMethod defines the ABI method name for the token factory
+	// CreateDenom transaction.
+	CreateDenomMethod = "createDenom"
+	// MintMethod defines the ABI method name for the token factory Mint
+	// transaction.
+	MintMethod = "mint"
+	// BurnMethod defines the ABI method name for the token factory Burn
+	// transaction.
+	BurnMethod = "burn"
+	// ChangeAdminMethod defines the ABI method name for the token factory
+	// ChangeAdmin transaction.
+	ChangeAdminMethod = "changeAdmin"
+	// SetDenomMetadataMethod defines the ABI method name for the token factory
+	// SetDenomMetadata transaction.
+	SetDenomMetadataMethod = "setDenomMetadata"
+)
+
+// CreateDenom creates a denom administered by the caller of the precompile and
+// returns the address of its ERC20 representation. The denom creation fee is
+// paid by the caller.
+func (p Precompile) CreateDenom(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	creator := contract.CallerAddress
+	msg, err := NewMsgCreateDenom(creator, args)
+	if err != nil {
+		return nil, err
+	}
+
+	fee := p.tokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+	res, err := p.tokenFactoryKeeper.CreateDenom(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return nil, err
+	}
+
+	erc20Address := common.HexToAddress(res.Erc20Address)
+	if err = p.EmitCreateDenomEvent(ctx, stateDB, creator, res.NewTokenDenom, erc20Address); err != nil {
+		return nil, err
+	}
+
+	// NOTE: This ensures that the changes in the bank keeper are correctly mirrored to the EVM stateDB.
+	// This prevents the stateDB from overwriting the changed balance in the bank keeper when committing the EVM state.
+	if evmFee := fee.AmountOf(p.evmKeeper.GetParams(ctx).EvmDenom); evmFee.IsPositive() {
+		stateDB.(*statedb.StateDB).SubBalance(creator, evmFee.BigInt())
+	}
+
+	return method.Outputs.Pack(res.NewTokenDenom, erc20Address)
+}
+
+// Mint mints coins of a denom administered by the caller of the precompile.
+func (p Precompile) Mint(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	admin := contract.CallerAddress
+	msg, to, err := NewMsgMint(admin, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.tokenFactoryKeeper.Mint(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitMintEvent(ctx, stateDB, admin, to, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// Burn burns coins of a denom administered by the caller of the precompile
+// from its balance.
+func (p Precompile) Burn(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	admin := contract.CallerAddress
+	msg, err := NewMsgBurn(admin, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.tokenFactoryKeeper.Burn(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitBurnEvent(ctx, stateDB, admin, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// ChangeAdmin transfers the administration of a denom administered by the
+// caller of the precompile.
+func (p Precompile) ChangeAdmin(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	admin := contract.CallerAddress
+	msg, newAdmin, err := NewMsgChangeAdmin(admin, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.tokenFactoryKeeper.ChangeAdmin(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitChangeAdminEvent(ctx, stateDB, admin, newAdmin, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
+
+// SetDenomMetadata sets the name, symbol and description of a denom
+// administered by the caller of the precompile.
+func (p Precompile) SetDenomMetadata(
+	ctx sdk.Context,
+	contract *vm.Contract,
+	stateDB vm.StateDB,
+	method *abi.Method,
+	args []interface{},
+) ([]byte, error) {
+	denom, err := ParseDenomArg(args)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata, found := p.bankKeeper.GetDenomMetaData(ctx, denom)
+	if !found {
+		return nil, fmt.Errorf(ErrMetadataNotFound, denom)
+	}
+
+	admin := contract.CallerAddress
+	msg, err := NewMsgSetDenomMetadata(admin, metadata, args)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = p.tokenFactoryKeeper.SetDenomMetadata(sdk.WrapSDKContext(ctx), msg); err != nil {
+		return nil, err
+	}
+
+	if err = p.EmitSetDenomMetadataEvent(ctx, stateDB, admin, denom); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(true)
+}
@@ -0,0 +1,162 @@
+package tokenfactory
+
+import (
+	"fmt"
+	"math/big"
+
+	cmn "github.com/anryton/anryton/v2/precompiles/common"
+	tokenfactorytypes "github.com/anryton/anryton/v2/x/tokenfactory/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewMsgCreateDenom creates a new MsgCreateDenom instance for the given
+// creator.
+func NewMsgCreateDenom(creator common.Address, args []interface{}) (*tokenfactorytypes.MsgCreateDenom, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	subdenom, ok := args[0].(string)
+	if !ok {
+		return nil, fmt.Errorf(ErrInvalidSubdenom, args[0])
+	}
+
+	msg := tokenfactorytypes.NewMsgCreateDenom(sdk.AccAddress(creator.Bytes()).String(), subdenom)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgMint creates a new MsgMint instance for the given admin.
+func NewMsgMint(admin common.Address, args []interface{}) (*tokenfactorytypes.MsgMint, common.Address, error) {
+	if len(args) != 3 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 3, len(args))
+	}
+
+	coin, err := parseCoin(args[0], args[1])
+	if err != nil {
+		return nil, common.Address{}, err
+	}
+
+	to, ok := args[2].(common.Address)
+	if !ok || to == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidAddress, args[2])
+	}
+
+	msg := tokenfactorytypes.NewMsgMint(sdk.AccAddress(admin.Bytes()).String(), coin, sdk.AccAddress(to.Bytes()).String())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, to, nil
+}
+
+// NewMsgBurn creates a new MsgBurn instance for the given admin.
+func NewMsgBurn(admin common.Address, args []interface{}) (*tokenfactorytypes.MsgBurn, error) {
+	if len(args) != 2 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	coin, err := parseCoin(args[0], args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := tokenfactorytypes.NewMsgBurn(sdk.AccAddress(admin.Bytes()).String(), coin)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// NewMsgChangeAdmin creates a new MsgChangeAdmin instance for the given admin.
+func NewMsgChangeAdmin(admin common.Address, args []interface{}) (*tokenfactorytypes.MsgChangeAdmin, common.Address, error) {
+	if len(args) != 2 {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 2, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return nil, common.Address{}, fmt.Errorf(cmn.ErrInvalidDenom, args[0])
+	}
+
+	newAdmin, ok := args[1].(common.Address)
+	if !ok || newAdmin == (common.Address{}) {
+		return nil, common.Address{}, fmt.Errorf(ErrInvalidAddress, args[1])
+	}
+
+	msg := tokenfactorytypes.NewMsgChangeAdmin(sdk.AccAddress(admin.Bytes()).String(), denom, sdk.AccAddress(newAdmin.Bytes()).String())
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, common.Address{}, err
+	}
+
+	return msg, newAdmin, nil
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance for the
+// given admin, which overwrites the name, symbol and description of the
+// current bank metadata of the denom.
+func NewMsgSetDenomMetadata(admin common.Address, metadata banktypes.Metadata, args []interface{}) (*tokenfactorytypes.MsgSetDenomMetadata, error) {
+	if len(args) != 4 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 4, len(args))
+	}
+
+	fields := []string{"denom", "name", "symbol", "description"}
+	values := make([]string, len(fields))
+	for i, arg := range args {
+		value, ok := arg.(string)
+		if !ok {
+			return nil, fmt.Errorf(ErrInvalidMetadata, fields[i], arg)
+		}
+		values[i] = value
+	}
+
+	if metadata.Base != values[0] {
+		return nil, fmt.Errorf(ErrMetadataNotFound, values[0])
+	}
+
+	metadata.Name = values[1]
+	metadata.Symbol = values[2]
+	metadata.Description = values[3]
+
+	msg := tokenfactorytypes.NewMsgSetDenomMetadata(sdk.AccAddress(admin.Bytes()).String(), metadata)
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// ParseDenomArg returns the denom argument of a method.
+func ParseDenomArg(args []interface{}) (string, error) {
+	if len(args) < 1 {
+		return "", fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	denom, ok := args[0].(string)
+	if !ok {
+		return "", fmt.Errorf(cmn.ErrInvalidDenom, args[0])
+	}
+
+	return denom, nil
+}
+
+// parseCoin returns the coin of the denom and amount arguments.
+func parseCoin(denomArg, amountArg interface{}) (sdk.Coin, error) {
+	denom, ok := denomArg.(string)
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidDenom, denomArg)
+	}
+
+	amount, ok := amountArg.(*big.Int)
+	if !ok || amount == nil || amount.Sign() <= 0 {
+		return sdk.Coin{}, fmt.Errorf(cmn.ErrInvalidAmount, amountArg)
+	}
+
+	return sdk.Coin{Denom: denom, Amount: sdk.NewIntFromBigInt(amount)}, nil
+}
@@ -0,0 +1,134 @@
+package tokenfactory
+
+import (
+	"math/big"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	admin = common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")
+	to    = common.HexToAddress("0x1c2Be4C6d3E47D1E8cd49bb4D0b0B2fF0d6aC8e1")
+	denom = "factory/" + sdk.AccAddress(admin.Bytes()).String() + "/bitcoin"
+)
+
+func TestNewMsgCreateDenom(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []interface{}
+		expectErr bool
+	}{
+		{"valid", []interface{}{"bitcoin"}, false},
+		{"invalid number of args", []interface{}{"bitcoin", "btc"}, true},
+		{"invalid subdenom type", []interface{}{uint64(0)}, true},
+		{"invalid subdenom", []interface{}{"bit*coin"}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgCreateDenom(admin, tc.args)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "bitcoin", msg.Subdenom)
+			require.Equal(t, admin.Bytes(), []byte(msg.GetSigners()[0]))
+		})
+	}
+}
+
+func TestNewMsgMint(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []interface{}
+		expectErr bool
+	}{
+		{"valid", []interface{}{denom, big.NewInt(100), to}, false},
+		{"invalid number of args", []interface{}{denom, big.NewInt(100)}, true},
+		{"invalid denom", []interface{}{"aanry", big.NewInt(100), to}, true},
+		{"zero amount", []interface{}{denom, big.NewInt(0), to}, true},
+		{"invalid amount type", []interface{}{denom, uint64(100), to}, true},
+		{"zero address", []interface{}{denom, big.NewInt(100), common.Address{}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, recipient, err := NewMsgMint(admin, tc.args)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sdk.NewInt64Coin(denom, 100), msg.Amount)
+			require.Equal(t, sdk.AccAddress(to.Bytes()).String(), msg.MintToAddress)
+			require.Equal(t, to, recipient)
+		})
+	}
+}
+
+func TestNewMsgChangeAdmin(t *testing.T) {
+	testCases := []struct {
+		name      string
+		args      []interface{}
+		expectErr bool
+	}{
+		{"valid", []interface{}{denom, to}, false},
+		{"invalid number of args", []interface{}{denom}, true},
+		{"invalid denom", []interface{}{"aanry", to}, true},
+		{"zero address", []interface{}{denom, common.Address{}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, newAdmin, err := NewMsgChangeAdmin(admin, tc.args)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, sdk.AccAddress(to.Bytes()).String(), msg.NewAdmin)
+			require.Equal(t, to, newAdmin)
+		})
+	}
+}
+
+func TestNewMsgSetDenomMetadata(t *testing.T) {
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+		Base:       denom,
+		Display:    denom,
+		Name:       "bitcoin",
+		Symbol:     "bitcoin",
+	}
+
+	testCases := []struct {
+		name      string
+		args      []interface{}
+		expectErr bool
+	}{
+		{"valid", []interface{}{denom, "Bitcoin", "BTC", "The bitcoin"}, false},
+		{"invalid number of args", []interface{}{denom, "Bitcoin", "BTC"}, true},
+		{"other denom", []interface{}{denom + "x", "Bitcoin", "BTC", ""}, true},
+		{"empty symbol", []interface{}{denom, "Bitcoin", "", ""}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg, err := NewMsgSetDenomMetadata(admin, metadata, tc.args)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "Bitcoin", msg.Metadata.Name)
+			require.Equal(t, "BTC", msg.Metadata.Symbol)
+			require.Equal(t, "The bitcoin", msg.Metadata.Description)
+			require.Equal(t, metadata.DenomUnits, msg.Metadata.DenomUnits)
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package vesting
+
+import (
+	"github.com/ethereum/go-ethereum/core/vm"
+)
+
+// DefaultMethodGas is the base gas charged for each method of the vesting
+// precompile, on top of the cost of its arguments and of its state accesses.
+// The clawback is charged more as it iterates over the vesting periods.
+var DefaultMethodGas = map[string]uint64{
+	// Vesting transactions
+	CreateClawbackVestingAccountMethod: 5000,
+	FundVestingAccountMethod:           6000,
+	ClawbackMethod:                     8000,
+	UpdateVestingFunderMethod:          2000,
+	ConvertVestingAccountMethod:        4000,
+	// Vesting queries
+	BalancesMethod: 1500,
+}
+
+// WithMethodGas returns a copy of the precompile charging the given base gas
+// for each method.
+func (p Precompile) WithMethodGas(methodGas map[string]uint64) vm.PrecompiledContract {
+	p.MethodGas = methodGas
+	return &p
+}
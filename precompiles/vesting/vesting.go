@@ -66,6 +66,7 @@ func NewPrecompile(
 			KvGasConfig:          storetypes.KVGasConfig(),
 			TransientKVGasConfig: storetypes.TransientGasConfig(),
 			ApprovalExpiration:   cmn.DefaultExpirationDuration, // should be configurable in the future.
+			MethodGas:            DefaultMethodGas,
 		},
 		vestingKeeper: vestingKeeper,
 	}, nil
@@ -25,6 +25,13 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 		return nil, err
 	}
 
+	key := cacheKey(cachePrefixCode, address.Hex(), blockNum.Int64())
+	if isHistoricalHeight(blockNum.Int64()) {
+		if cached, ok := b.cache.Get(key); ok {
+			return cached.(hexutil.Bytes), nil
+		}
+	}
+
 	req := &evmtypes.QueryCodeRequest{
 		Address: address.String(),
 	}
@@ -34,6 +41,10 @@ func (b *Backend) GetCode(address common.Address, blockNrOrHash rpctypes.BlockNu
 		return nil, err
 	}
 
+	if isHistoricalHeight(blockNum.Int64()) {
+		b.cache.Add(key, hexutil.Bytes(res.Code))
+	}
+
 	return res.Code, nil
 }
 
@@ -147,6 +158,13 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, err
 	}
 
+	key := cacheKey(cachePrefixBalance, address.Hex(), blockNum.Int64())
+	if isHistoricalHeight(blockNum.Int64()) {
+		if cached, ok := b.cache.Get(key); ok {
+			return cached.(*hexutil.Big), nil
+		}
+	}
+
 	req := &evmtypes.QueryBalanceRequest{
 		Address: address.String(),
 	}
@@ -171,7 +189,12 @@ func (b *Backend) GetBalance(address common.Address, blockNrOrHash rpctypes.Bloc
 		return nil, errors.New("couldn't fetch balance. Node state is pruned")
 	}
 
-	return (*hexutil.Big)(val.BigInt()), nil
+	balance := (*hexutil.Big)(val.BigInt())
+	if isHistoricalHeight(blockNum.Int64()) {
+		b.cache.Add(key, balance)
+	}
+
+	return balance, nil
 }
 
 // GetTransactionCount returns the number of transactions at the given address up to the given block number.
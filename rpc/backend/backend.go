@@ -2,9 +2,11 @@ package backend
 
 import (
 	"context"
+	"errors"
 	"math/big"
 	"time"
 
+	"github.com/anryton/anryton/v2/rpc/signer"
 	rpctypes "github.com/anryton/anryton/v2/rpc/types"
 	"github.com/anryton/anryton/v2/server/config"
 	anrytontypes "github.com/anryton/anryton/v2/types"
@@ -23,6 +25,10 @@ import (
 	"github.com/ethereum/go-ethereum/signer/core/apitypes"
 )
 
+// errExternalSigner is returned by the keyring management methods when the
+// accounts are managed by an external signer.
+var errExternalSigner = errors.New("accounts are managed by the external signer")
+
 // BackendI implements the Cosmos and EVM backend.
 type BackendI interface { //nolint: revive
 	CosmosBackend
@@ -110,6 +116,8 @@ type EVMBackend interface {
 	// Send Transaction
 	Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error)
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionConditional(data hexutil.Bytes, conditional rpctypes.TransactionConditional) (common.Hash, error)
+	SendRawTransactionSync(data hexutil.Bytes, timeout *hexutil.Uint64) (map[string]interface{}, error)
 	SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error)
 	EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rpctypes.BlockNumber) (hexutil.Uint64, error)
 	DoCall(args evmtypes.TransactionArgs, blockNr rpctypes.BlockNumber) (*evmtypes.MsgEthereumTxResponse, error)
@@ -137,6 +145,8 @@ type Backend struct {
 	cfg                 config.Config
 	allowUnprotectedTxs bool
 	indexer             anrytontypes.EVMTxIndexer
+	cache               *queryCache
+	signer              signer.Signer // external signer, nil to sign with the keyring
 }
 
 // NewBackend creates a new Backend instance for cosmos and ethereum namespaces
@@ -166,5 +176,16 @@ func NewBackend(
 		cfg:                 appConf,
 		allowUnprotectedTxs: allowUnprotectedTxs,
 		indexer:             indexer,
+		cache:               newQueryCache(appConf.JSONRPC.QueryCacheSize, appConf.JSONRPC.QueryCacheTTL),
+		signer:              newExternalSigner(appConf.JSONRPC.ExternalSigner, appConf.JSONRPC.ExternalSignerTimeout),
+	}
+}
+
+// newExternalSigner returns the external signer of the endpoint or nil if the
+// endpoint is empty.
+func newExternalSigner(endpoint string, timeout time.Duration) signer.Signer {
+	if endpoint == "" {
+		return nil
 	}
+	return signer.NewExternalSigner(endpoint, timeout)
 }
@@ -171,6 +171,12 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		}
 		height = int64(n) //#nosec G701 -- checked for int overflow already
 	}
+
+	key := cacheKey(cachePrefixBlock, height)
+	if cached, ok := b.cache.Get(key); ok {
+		return cached.(*tmrpctypes.ResultBlock), nil
+	}
+
 	resBlock, err := b.clientCtx.Client.Block(b.ctx, &height)
 	if err != nil {
 		b.logger.Debug("tendermint client failed to get block", "height", height, "error", err.Error())
@@ -182,6 +188,7 @@ func (b *Backend) TendermintBlockByNumber(blockNum rpctypes.BlockNumber) (*tmrpc
 		return nil, nil
 	}
 
+	b.cache.Add(key, resBlock)
 	return resBlock, nil
 }
 
@@ -192,7 +199,24 @@ func (b *Backend) TendermintBlockResultByNumber(height *int64) (*tmrpctypes.Resu
 	if !ok {
 		return nil, errors.New("invalid rpc client")
 	}
-	return sc.BlockResults(b.ctx, height)
+
+	// only results of a fixed height are immutable, the latest one is not cached
+	if height == nil || !isHistoricalHeight(*height) {
+		return sc.BlockResults(b.ctx, height)
+	}
+
+	key := cacheKey(cachePrefixBlockResults, *height)
+	if cached, ok := b.cache.Get(key); ok {
+		return cached.(*tmrpctypes.ResultBlockResults), nil
+	}
+
+	blockRes, err := sc.BlockResults(b.ctx, height)
+	if err != nil {
+		return nil, err
+	}
+
+	b.cache.Add(key, blockRes)
+	return blockRes, nil
 }
 
 // TendermintBlockByHash returns a Tendermint-formatted block by block number
@@ -201,6 +225,12 @@ func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 	if !ok {
 		return nil, errors.New("invalid rpc client")
 	}
+
+	key := cacheKey(cachePrefixBlockByHash, blockHash.Hex())
+	if cached, ok := b.cache.Get(key); ok {
+		return cached.(*tmrpctypes.ResultBlock), nil
+	}
+
 	resBlock, err := sc.BlockByHash(b.ctx, blockHash.Bytes())
 	if err != nil {
 		b.logger.Debug("tendermint client failed to get block", "blockHash", blockHash.Hex(), "error", err.Error())
@@ -212,6 +242,7 @@ func (b *Backend) TendermintBlockByHash(blockHash common.Hash) (*tmrpctypes.Resu
 		return nil, nil
 	}
 
+	b.cache.Add(key, resBlock)
 	return resBlock, nil
 }
 
@@ -373,6 +404,11 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 	ethRPCTxs := []interface{}{}
 	block := resBlock.Block
 
+	key := cacheKey(cachePrefixRPCBlock, block.Height, hexutil.Encode(block.Hash()), fullTx)
+	if cached, ok := b.cache.Get(key); ok {
+		return cached.(map[string]interface{}), nil
+	}
+
 	baseFee, err := b.BaseFee(blockRes)
 	if err != nil {
 		// handle the error for pruned node.
@@ -457,6 +493,8 @@ func (b *Backend) RPCBlockFromTendermintBlock(
 		gasLimit, new(big.Int).SetUint64(gasUsed),
 		ethRPCTxs, bloom, validatorAddr, baseFee,
 	)
+
+	b.cache.Add(key, formattedBlock)
 	return formattedBlock, nil
 }
 
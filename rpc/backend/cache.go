@@ -0,0 +1,368 @@
+package backend
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/metrics"
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/exp/slices"
+
+	rpctypes "github.com/anryton/anryton/v2/rpc/types"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+var (
+	queryCacheHitMeter  = metrics.NewRegisteredMeter("rpc/cache/hit", nil)
+	queryCacheMissMeter = metrics.NewRegisteredMeter("rpc/cache/miss", nil)
+)
+
+// Cache key prefixes for the different kinds of historical results.
+const (
+	cachePrefixBlock        = "block"
+	cachePrefixBlockByHash  = "blockhash"
+	cachePrefixBlockResults = "blockresults"
+	cachePrefixRPCBlock     = "rpcblock"
+	cachePrefixReceipt      = "receipt"
+	cachePrefixCode         = "code"
+	cachePrefixBalance      = "balance"
+	cachePrefixCall         = "call"
+)
+
+// queryCache is an in-process LRU cache for immutable historical query results
+// (i.e blocks, block results, receipts and state queries at a fixed past
+// height). A nil queryCache is valid and behaves as a disabled cache.
+//
+// The values are copied when they are added and returned, so that the callers
+// can't alter the cache by mutating the results they get.
+type queryCache struct {
+	lru *lru.Cache
+	ttl time.Duration
+}
+
+// cacheEntry wraps a cached value with its expiration time.
+type cacheEntry struct {
+	value   interface{}
+	expires time.Time
+}
+
+// newQueryCache returns a cache holding up to size entries, each of them kept
+// at most ttl (0 = no expiry). It returns nil if size is not positive.
+func newQueryCache(size int, ttl time.Duration) *queryCache {
+	if size <= 0 {
+		return nil
+	}
+
+	c, err := lru.New(size)
+	if err != nil {
+		panic(err)
+	}
+
+	return &queryCache{
+		lru: c,
+		ttl: ttl,
+	}
+}
+
+// cacheKey builds a cache key from a prefix and its identifying values.
+func cacheKey(prefix string, values ...interface{}) string {
+	key := prefix
+	for _, v := range values {
+		key += fmt.Sprintf("/%v", v)
+	}
+	return key
+}
+
+// isHistoricalHeight returns true if the height refers to a fixed block instead
+// of one of the "latest", "pending" or "earliest" tags.
+func isHistoricalHeight(height int64) bool {
+	return height > 0
+}
+
+// Get returns the cached value for the given key, if present and not expired.
+func (c *queryCache) Get(key string) (interface{}, bool) {
+	if c == nil {
+		return nil, false
+	}
+
+	v, ok := c.lru.Get(key)
+	if !ok {
+		queryCacheMissMeter.Mark(1)
+		return nil, false
+	}
+
+	entry := v.(cacheEntry)
+	if !entry.expires.IsZero() && time.Now().After(entry.expires) {
+		c.lru.Remove(key)
+		queryCacheMissMeter.Mark(1)
+		return nil, false
+	}
+
+	value, err := copyCacheValue(entry.value)
+	if err != nil {
+		c.lru.Remove(key)
+		queryCacheMissMeter.Mark(1)
+		return nil, false
+	}
+
+	queryCacheHitMeter.Mark(1)
+	return value, true
+}
+
+// Add caches the value under the given key.
+func (c *queryCache) Add(key string, value interface{}) {
+	if c == nil {
+		return
+	}
+
+	value, err := copyCacheValue(value)
+	if err != nil {
+		// values that can't be copied are not cached
+		return
+	}
+
+	entry := cacheEntry{value: value}
+	if c.ttl > 0 {
+		entry.expires = time.Now().Add(c.ttl)
+	}
+
+	c.lru.Add(key, entry)
+}
+
+// Len returns the number of cached entries.
+func (c *queryCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	return c.lru.Len()
+}
+
+// copyCacheValue returns a deep copy of a cached value. The RPC responses built
+// as maps are copied recursively, and the query results by value or through
+// their encoding.
+func copyCacheValue(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if v == nil {
+			return v, nil
+		}
+		m := make(map[string]interface{}, len(v))
+		for key, val := range v {
+			cpy, err := copyCacheValue(val)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = cpy
+		}
+		return m, nil
+	case []interface{}:
+		if v == nil {
+			return v, nil
+		}
+		s := make([]interface{}, len(v))
+		for i, val := range v {
+			cpy, err := copyCacheValue(val)
+			if err != nil {
+				return nil, err
+			}
+			s[i] = cpy
+		}
+		return s, nil
+	case hexutil.Bytes:
+		return hexutil.Bytes(common.CopyBytes(v)), nil
+	case *hexutil.Big:
+		if v == nil {
+			return v, nil
+		}
+		return (*hexutil.Big)(new(big.Int).Set(v.ToInt())), nil
+	case hexutil.Big:
+		return hexutil.Big(*new(big.Int).Set(v.ToInt())), nil
+	case *common.Address:
+		if v == nil {
+			return v, nil
+		}
+		addr := *v
+		return &addr, nil
+	case []common.Hash:
+		return slices.Clone(v), nil
+	case []*ethtypes.Log:
+		return copyLogs(v), nil
+	case [][]*ethtypes.Log:
+		if v == nil {
+			return v, nil
+		}
+		logs := make([][]*ethtypes.Log, len(v))
+		for i := range v {
+			logs[i] = copyLogs(v[i])
+		}
+		return logs, nil
+	case *rpctypes.RPCTransaction:
+		if v == nil {
+			return v, nil
+		}
+		bz, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		tx := new(rpctypes.RPCTransaction)
+		if err := json.Unmarshal(bz, tx); err != nil {
+			return nil, err
+		}
+		return tx, nil
+	case *evmtypes.MsgEthereumTxResponse:
+		if v == nil {
+			return v, nil
+		}
+		return proto.Clone(v), nil
+	case *tmrpctypes.ResultBlock:
+		if v == nil {
+			return v, nil
+		}
+		return copyResultBlock(v)
+	case *tmrpctypes.ResultBlockResults:
+		if v == nil {
+			return v, nil
+		}
+		return copyResultBlockResults(v), nil
+	default:
+		// immutable values, such as hashes, addresses, numbers and strings
+		return v, nil
+	}
+}
+
+// copyLogs returns a deep copy of the logs.
+func copyLogs(logs []*ethtypes.Log) []*ethtypes.Log {
+	if logs == nil {
+		return nil
+	}
+	cpy := make([]*ethtypes.Log, len(logs))
+	for i, log := range logs {
+		if log == nil {
+			continue
+		}
+		l := *log
+		l.Topics = slices.Clone(log.Topics)
+		l.Data = common.CopyBytes(log.Data)
+		cpy[i] = &l
+	}
+	return cpy
+}
+
+// copyResultBlock returns a deep copy of the block. The block is copied field
+// by field, as decoding it from its protobuf encoding also validates it.
+func copyResultBlock(resBlock *tmrpctypes.ResultBlock) (*tmrpctypes.ResultBlock, error) {
+	cpy := &tmrpctypes.ResultBlock{BlockID: copyBlockID(resBlock.BlockID)}
+	if resBlock.Block == nil {
+		return cpy, nil
+	}
+
+	block := resBlock.Block
+	cpy.Block = &tmtypes.Block{
+		Header: block.Header,
+		Data:   tmtypes.Data{Txs: copyTxs(block.Data.Txs)},
+	}
+
+	header := &cpy.Block.Header
+	header.LastBlockID = copyBlockID(block.LastBlockID)
+	header.LastCommitHash = common.CopyBytes(block.LastCommitHash)
+	header.DataHash = common.CopyBytes(block.DataHash)
+	header.ValidatorsHash = common.CopyBytes(block.ValidatorsHash)
+	header.NextValidatorsHash = common.CopyBytes(block.NextValidatorsHash)
+	header.ConsensusHash = common.CopyBytes(block.ConsensusHash)
+	header.AppHash = common.CopyBytes(block.AppHash)
+	header.LastResultsHash = common.CopyBytes(block.LastResultsHash)
+	header.EvidenceHash = common.CopyBytes(block.EvidenceHash)
+	header.ProposerAddress = common.CopyBytes(block.ProposerAddress)
+
+	if block.Evidence.Evidence != nil {
+		cpy.Block.Evidence.Evidence = make(tmtypes.EvidenceList, len(block.Evidence.Evidence))
+		for i, ev := range block.Evidence.Evidence {
+			pb, err := tmtypes.EvidenceToProto(ev)
+			if err != nil {
+				return nil, err
+			}
+			if cpy.Block.Evidence.Evidence[i], err = tmtypes.EvidenceFromProto(proto.Clone(pb).(*tmproto.Evidence)); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if block.LastCommit != nil {
+		commit := *block.LastCommit
+		commit.BlockID = copyBlockID(commit.BlockID)
+		if commit.Signatures != nil {
+			commit.Signatures = make([]tmtypes.CommitSig, len(block.LastCommit.Signatures))
+			for i, sig := range block.LastCommit.Signatures {
+				sig.ValidatorAddress = common.CopyBytes(sig.ValidatorAddress)
+				sig.Signature = common.CopyBytes(sig.Signature)
+				commit.Signatures[i] = sig
+			}
+		}
+		cpy.Block.LastCommit = &commit
+	}
+	return cpy, nil
+}
+
+// copyBlockID returns a deep copy of the block ID.
+func copyBlockID(blockID tmtypes.BlockID) tmtypes.BlockID {
+	blockID.Hash = common.CopyBytes(blockID.Hash)
+	blockID.PartSetHeader.Hash = common.CopyBytes(blockID.PartSetHeader.Hash)
+	return blockID
+}
+
+// copyTxs returns a deep copy of the txs.
+func copyTxs(txs tmtypes.Txs) tmtypes.Txs {
+	if txs == nil {
+		return nil
+	}
+	cpy := make(tmtypes.Txs, len(txs))
+	for i, tx := range txs {
+		cpy[i] = common.CopyBytes(tx)
+	}
+	return cpy
+}
+
+// copyResultBlockResults returns a deep copy of the block results.
+func copyResultBlockResults(blockRes *tmrpctypes.ResultBlockResults) *tmrpctypes.ResultBlockResults {
+	cpy := &tmrpctypes.ResultBlockResults{Height: blockRes.Height}
+	if blockRes.TxsResults != nil {
+		cpy.TxsResults = make([]*abci.ResponseDeliverTx, len(blockRes.TxsResults))
+		for i, txResult := range blockRes.TxsResults {
+			cpy.TxsResults[i] = proto.Clone(txResult).(*abci.ResponseDeliverTx)
+		}
+	}
+	cpy.BeginBlockEvents = copyEvents(blockRes.BeginBlockEvents)
+	cpy.EndBlockEvents = copyEvents(blockRes.EndBlockEvents)
+	if blockRes.ValidatorUpdates != nil {
+		cpy.ValidatorUpdates = make([]abci.ValidatorUpdate, len(blockRes.ValidatorUpdates))
+		for i := range blockRes.ValidatorUpdates {
+			cpy.ValidatorUpdates[i] = *proto.Clone(&blockRes.ValidatorUpdates[i]).(*abci.ValidatorUpdate)
+		}
+	}
+	if blockRes.ConsensusParamUpdates != nil {
+		cpy.ConsensusParamUpdates = proto.Clone(blockRes.ConsensusParamUpdates).(*tmproto.ConsensusParams)
+	}
+	return cpy
+}
+
+// copyEvents returns a deep copy of the events.
+func copyEvents(events []abci.Event) []abci.Event {
+	if events == nil {
+		return nil
+	}
+	cpy := make([]abci.Event, len(events))
+	for i := range events {
+		cpy[i] = *proto.Clone(&events[i]).(*abci.Event)
+	}
+	return cpy
+}
@@ -0,0 +1,211 @@
+package backend
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	tmrpctypes "github.com/cometbft/cometbft/rpc/core/types"
+	tmtypes "github.com/cometbft/cometbft/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/anryton/anryton/v2/rpc/backend/mocks"
+	rpctypes "github.com/anryton/anryton/v2/rpc/types"
+)
+
+func TestQueryCache(t *testing.T) {
+	testCases := []struct {
+		name     string
+		size     int
+		ttl      time.Duration
+		malleate func(c *queryCache)
+		expPass  bool
+	}{
+		{
+			"disabled cache - nothing is stored",
+			0,
+			0,
+			func(c *queryCache) {},
+			false,
+		},
+		{
+			"cached value without expiry",
+			10,
+			0,
+			func(c *queryCache) {},
+			true,
+		},
+		{
+			"cached value before expiry",
+			10,
+			time.Hour,
+			func(c *queryCache) {},
+			true,
+		},
+		{
+			"cached value after expiry",
+			10,
+			time.Millisecond,
+			func(c *queryCache) {
+				time.Sleep(5 * time.Millisecond)
+			},
+			false,
+		},
+		{
+			"evicted value",
+			1,
+			0,
+			func(c *queryCache) {
+				c.Add("other", 2)
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			c := newQueryCache(tc.size, tc.ttl)
+			c.Add("key", 1)
+			tc.malleate(c)
+
+			v, ok := c.Get("key")
+			require.Equal(t, tc.expPass, ok)
+			if tc.expPass {
+				require.Equal(t, 1, v)
+			} else {
+				require.Nil(t, v)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestTendermintBlockByNumberCached() {
+	testCases := []struct {
+		name         string
+		blockNumber  rpctypes.BlockNumber
+		ttl          time.Duration
+		malleate     func(block *tmrpctypes.ResultBlock)
+		expBlockCall int
+	}{
+		{
+			"pass - historical block is fetched once",
+			rpctypes.BlockNumber(1),
+			0,
+			func(block *tmrpctypes.ResultBlock) {},
+			1,
+		},
+		{
+			"pass - mutating the returned block doesn't alter the cache",
+			rpctypes.BlockNumber(1),
+			0,
+			func(block *tmrpctypes.ResultBlock) {
+				block.Block.ChainID = "other"
+				block.Block.Txs[0][0]++
+				block.Block.Txs = append(block.Block.Txs, []byte{1})
+			},
+			1,
+		},
+		{
+			"pass - expired block is fetched again",
+			rpctypes.BlockNumber(1),
+			time.Millisecond,
+			func(block *tmrpctypes.ResultBlock) {
+				time.Sleep(5 * time.Millisecond)
+			},
+			2,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cache = newQueryCache(10, tc.ttl)
+
+			client := suite.backend.clientCtx.Client.(*mocks.Client)
+			height := tc.blockNumber.Int64()
+			expBlock, err := RegisterBlock(client, height, []byte{0})
+			suite.Require().NoError(err)
+			expHash := expBlock.Block.Hash()
+
+			first, err := suite.backend.TendermintBlockByNumber(tc.blockNumber)
+			suite.Require().NoError(err)
+			tc.malleate(first)
+
+			second, err := suite.backend.TendermintBlockByNumber(tc.blockNumber)
+			suite.Require().NoError(err)
+
+			suite.Require().Equal(expHash, second.Block.Hash())
+			suite.Require().Equal(ChainID, second.Block.ChainID)
+			suite.Require().Equal(tmtypes.Txs{[]byte{0}}, second.Block.Txs)
+			client.AssertNumberOfCalls(suite.T(), "Block", tc.expBlockCall)
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestGetTransactionReceiptCached() {
+	txHash := common.BytesToHash([]byte{1})
+	newReceipt := func() map[string]interface{} {
+		return map[string]interface{}{
+			"status":          hexutil.Uint(ethtypes.ReceiptStatusSuccessful),
+			"transactionHash": txHash,
+			"logs": []*ethtypes.Log{
+				{Address: common.BytesToAddress([]byte{2}), Topics: []common.Hash{txHash}, Data: []byte{3}},
+			},
+			"to":                &common.Address{},
+			"effectiveGasPrice": (*hexutil.Big)(big.NewInt(1)),
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func(receipt map[string]interface{})
+	}{
+		{
+			"pass - receipt fields are replaced",
+			func(receipt map[string]interface{}) {
+				receipt["status"] = hexutil.Uint(ethtypes.ReceiptStatusFailed)
+				delete(receipt, "transactionHash")
+			},
+		},
+		{
+			"pass - receipt logs are mutated",
+			func(receipt map[string]interface{}) {
+				log := receipt["logs"].([]*ethtypes.Log)[0]
+				log.Data[0]++
+				log.Topics[0] = common.Hash{}
+				log.Removed = true
+			},
+		},
+		{
+			"pass - receipt references are mutated",
+			func(receipt map[string]interface{}) {
+				receipt["to"].(*common.Address)[0] = 1
+				receipt["effectiveGasPrice"].(*hexutil.Big).ToInt().SetInt64(2)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset test and queries
+			suite.backend.cache = newQueryCache(10, 0)
+
+			receipt := newReceipt()
+			suite.backend.cache.Add(cacheKey(cachePrefixReceipt, txHash.Hex()), receipt)
+			// mutating the added receipt doesn't alter the cache either
+			tc.malleate(receipt)
+
+			first, err := suite.backend.GetTransactionReceipt(txHash)
+			suite.Require().NoError(err)
+			suite.Require().Equal(newReceipt(), first)
+			tc.malleate(first)
+
+			second, err := suite.backend.GetTransactionReceipt(txHash)
+			suite.Require().NoError(err)
+			suite.Require().Equal(newReceipt(), second)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"math/big"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	rpctypes "github.com/anryton/anryton/v2/rpc/types"
@@ -17,11 +18,22 @@ import (
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
+const (
+	// DefaultSendRawTransactionSyncTimeout is the default time eth_sendRawTransactionSync
+	// waits for the transaction to be included in a block.
+	DefaultSendRawTransactionSyncTimeout = 10 * time.Second
+
+	// sendRawTransactionSyncPollInterval is the interval at which the receipt
+	// of a transaction sent with eth_sendRawTransactionSync is queried.
+	sendRawTransactionSyncPollInterval = 200 * time.Millisecond
+)
+
 // Resend accepts an existing transaction and a new gas price and limit. It will remove
 // the given transaction from the pool and reinsert it with the new gas price and limit.
 func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, gasLimit *hexutil.Uint64) (common.Hash, error) {
@@ -102,6 +114,68 @@ func (b *Backend) Resend(args evmtypes.TransactionArgs, gasPrice *hexutil.Big, g
 
 // SendRawTransaction send a raw Ethereum transaction.
 func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
+	return b.sendRawTransaction(data, nil)
+}
+
+// SendRawTransactionConditional sends a raw Ethereum transaction that is only
+// included if the given conditions are met by the block and the state.
+func (b *Backend) SendRawTransactionConditional(data hexutil.Bytes, conditional rpctypes.TransactionConditional) (common.Hash, error) {
+	option, err := conditional.ToExtensionOption()
+	if err != nil {
+		b.logger.Debug("invalid transaction conditions", "error", err.Error())
+		return common.Hash{}, err
+	}
+
+	return b.sendRawTransaction(data, option)
+}
+
+// SendRawTransactionSync sends a raw Ethereum transaction and waits for its
+// inclusion in a block until the timeout (in milliseconds) expires. It returns
+// the receipt of the transaction.
+func (b *Backend) SendRawTransactionSync(data hexutil.Bytes, timeout *hexutil.Uint64) (map[string]interface{}, error) {
+	wait := DefaultSendRawTransactionSyncTimeout
+	if timeout != nil {
+		wait = time.Duration(*timeout) * time.Millisecond
+	}
+	// the http server would drop the request anyway
+	if httpTimeout := b.cfg.JSONRPC.HTTPTimeout; httpTimeout > 0 && wait > httpTimeout {
+		wait = httpTimeout
+	}
+
+	txHash, err := b.sendRawTransaction(data, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ticker := time.NewTicker(sendRawTransactionSyncPollInterval)
+	defer ticker.Stop()
+
+	deadline := time.NewTimer(wait)
+	defer deadline.Stop()
+
+	for {
+		receipt, err := b.GetTransactionReceipt(txHash)
+		if err != nil {
+			return nil, err
+		}
+		if receipt != nil {
+			return receipt, nil
+		}
+
+		select {
+		case <-deadline.C:
+			return nil, fmt.Errorf("transaction %s was not included within %s", txHash.Hex(), wait)
+		case <-ticker.C:
+		}
+	}
+}
+
+// sendRawTransaction decodes, wraps and broadcasts a raw Ethereum transaction.
+// The conditional extension option is attached to the cosmos tx if not nil.
+func (b *Backend) sendRawTransaction(
+	data hexutil.Bytes,
+	conditional *evmtypes.ExtensionOptionsEthereumTxConditional,
+) (common.Hash, error) {
 	// RLP decode raw transaction bytes
 	tx := &ethtypes.Transaction{}
 	if err := tx.UnmarshalBinary(data); err != nil {
@@ -133,7 +207,12 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 		return common.Hash{}, err
 	}
 
-	cosmosTx, err := ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
+	var cosmosTx sdk.Tx
+	if conditional != nil {
+		cosmosTx, err = ethereumTx.BuildConditionalTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom, conditional)
+	} else {
+		cosmosTx, err = ethereumTx.BuildTx(b.clientCtx.TxConfig.NewTxBuilder(), res.Params.EvmDenom)
+	}
 	if err != nil {
 		b.logger.Error("failed to build cosmos tx", "error", err.Error())
 		return common.Hash{}, err
@@ -164,8 +243,8 @@ func (b *Backend) SendRawTransaction(data hexutil.Bytes) (common.Hash, error) {
 // SetTxDefaults populates tx message with default values in case they are not
 // provided on the args
 func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.TransactionArgs, error) {
-	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
-		return args, errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+	if err := rpctypes.ValidateFeeArgs(args); err != nil {
+		return args, err
 	}
 
 	head := b.CurrentHeader()
@@ -173,54 +252,8 @@ func (b *Backend) SetTxDefaults(args evmtypes.TransactionArgs) (evmtypes.Transac
 		return args, errors.New("latest header is nil")
 	}
 
-	// If user specifies both maxPriorityfee and maxFee, then we do not
-	// need to consult the chain for defaults. It's definitely a London tx.
-	if args.MaxPriorityFeePerGas == nil || args.MaxFeePerGas == nil {
-		// In this clause, user left some fields unspecified.
-		if head.BaseFee != nil && args.GasPrice == nil {
-			if args.MaxPriorityFeePerGas == nil {
-				tip, err := b.SuggestGasTipCap(head.BaseFee)
-				if err != nil {
-					return args, err
-				}
-				args.MaxPriorityFeePerGas = (*hexutil.Big)(tip)
-			}
-
-			if args.MaxFeePerGas == nil {
-				gasFeeCap := new(big.Int).Add(
-					(*big.Int)(args.MaxPriorityFeePerGas),
-					new(big.Int).Mul(head.BaseFee, big.NewInt(2)),
-				)
-				args.MaxFeePerGas = (*hexutil.Big)(gasFeeCap)
-			}
-
-			if args.MaxFeePerGas.ToInt().Cmp(args.MaxPriorityFeePerGas.ToInt()) < 0 {
-				return args, fmt.Errorf("maxFeePerGas (%v) < maxPriorityFeePerGas (%v)", args.MaxFeePerGas, args.MaxPriorityFeePerGas)
-			}
-		} else {
-			if args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil {
-				return args, errors.New("maxFeePerGas or maxPriorityFeePerGas specified but london is not active yet")
-			}
-
-			if args.GasPrice == nil {
-				price, err := b.SuggestGasTipCap(head.BaseFee)
-				if err != nil {
-					return args, err
-				}
-				if head.BaseFee != nil {
-					// The legacy tx gas price suggestion should not add 2x base fee
-					// because all fees are consumed, so it would result in a spiral
-					// upwards.
-					price.Add(price, head.BaseFee)
-				}
-				args.GasPrice = (*hexutil.Big)(price)
-			}
-		}
-	} else {
-		// Both maxPriorityfee and maxFee set by caller. Sanity-check their internal relation
-		if args.MaxFeePerGas.ToInt().Cmp(args.MaxPriorityFeePerGas.ToInt()) < 0 {
-			return args, fmt.Errorf("maxFeePerGas (%v) < maxPriorityFeePerGas (%v)", args.MaxFeePerGas, args.MaxPriorityFeePerGas)
-		}
+	if err := rpctypes.SetFeeDefaults(&args, head.BaseFee, b.SuggestGasTipCap); err != nil {
+		return args, err
 	}
 
 	if args.Value == nil {
@@ -317,11 +350,7 @@ func (b *Backend) EstimateGas(args evmtypes.TransactionArgs, blockNrOptional *rp
 	// From ContextWithHeight: if the provided height is 0,
 	// it will return an empty context and the gRPC query will use
 	// the latest block height for querying.
-	res, err := b.queryClient.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), &req)
-	if err != nil {
-		return 0, err
-	}
-	return hexutil.Uint64(res.Gas), nil
+	return rpctypes.EstimateGas(rpctypes.ContextWithHeight(blockNr.Int64()), b.queryClient, &req)
 }
 
 // DoCall performs a simulated call operation through the evmtypes. It returns the
@@ -333,6 +362,15 @@ func (b *Backend) DoCall(
 	if err != nil {
 		return nil, err
 	}
+
+	// calls at a fixed past height always return the same result
+	key := cacheKey(cachePrefixCall, blockNr.Int64(), crypto.Keccak256Hash(bz).Hex())
+	if isHistoricalHeight(blockNr.Int64()) {
+		if cached, ok := b.cache.Get(key); ok {
+			return cached.(*evmtypes.MsgEthereumTxResponse), nil
+		}
+	}
+
 	header, err := b.TendermintBlockByNumber(blockNr)
 	if err != nil {
 		// the error message imitates geth behavior
@@ -377,6 +415,10 @@ func (b *Backend) DoCall(
 		return nil, evmtypes.NewExecErrorWithReason(res.Ret)
 	}
 
+	if isHistoricalHeight(blockNr.Int64()) {
+		b.cache.Add(key, res)
+	}
+
 	return res, nil
 }
 
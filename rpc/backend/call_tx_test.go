@@ -385,6 +385,153 @@ func (suite *BackendTestSuite) TestSendRawTransaction() {
 	}
 }
 
+func (suite *BackendTestSuite) TestSendRawTransactionConditional() {
+	ethTx, _ := suite.buildEthereumTx()
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+	err := ethTx.Sign(ethSigner, suite.signer)
+	suite.Require().NoError(err)
+
+	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
+
+	blockNumberMax := hexutil.Uint64(10)
+	conditional := rpctypes.TransactionConditional{BlockNumberMax: &blockNumberMax}
+	option, err := conditional.ToExtensionOption()
+	suite.Require().NoError(err)
+	cosmosTx, _ := ethTx.BuildConditionalTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom, option)
+	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+
+	blockNumberMin := hexutil.Uint64(11)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		conditional  rpctypes.TransactionConditional
+		expPass      bool
+	}{
+		{
+			"fail - invalid conditions",
+			func() {},
+			rpctypes.TransactionConditional{BlockNumberMin: &blockNumberMin, BlockNumberMax: &blockNumberMax},
+			false,
+		},
+		{
+			"pass - broadcasts the tx with the conditions",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTx(client, txBytes)
+			},
+			conditional,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			hash, err := suite.backend.SendRawTransactionConditional(rlpEncodedBz, tc.conditional)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(common.HexToHash(ethTx.Hash), hash)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *BackendTestSuite) TestSendRawTransactionSync() {
+	ethTx, _ := suite.buildEthereumTx()
+
+	queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+	RegisterParamsWithoutHeader(queryClient, 1)
+	ethSigner := ethtypes.LatestSigner(suite.backend.ChainConfig())
+	err := ethTx.Sign(ethSigner, suite.signer)
+	suite.Require().NoError(err)
+
+	rlpEncodedBz, _ := rlp.EncodeToBytes(ethTx.AsTransaction())
+	cosmosTx, _ := ethTx.BuildTx(suite.backend.clientCtx.TxConfig.NewTxBuilder(), evmtypes.DefaultEVMDenom)
+	txBytes, _ := suite.backend.clientCtx.TxConfig.TxEncoder()(cosmosTx)
+
+	receipt := map[string]interface{}{
+		"transactionHash": common.HexToHash(ethTx.Hash),
+		"status":          hexutil.Uint(ethtypes.ReceiptStatusSuccessful),
+	}
+	timeout := hexutil.Uint64(10)
+
+	testCases := []struct {
+		name         string
+		registerMock func()
+		expReceipt   map[string]interface{}
+		expPass      bool
+	}{
+		{
+			"fail - failed to broadcast transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTxError(client, txBytes)
+			},
+			nil,
+			false,
+		},
+		{
+			"fail - transaction not included before the timeout",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTx(client, txBytes)
+			},
+			nil,
+			false,
+		},
+		{
+			"pass - returns the receipt of the included transaction",
+			func() {
+				client := suite.backend.clientCtx.Client.(*mocks.Client)
+				queryClient := suite.backend.queryClient.QueryClient.(*mocks.EVMQueryClient)
+				suite.backend.allowUnprotectedTxs = true
+				RegisterParamsWithoutHeader(queryClient, 1)
+				RegisterBroadcastTx(client, txBytes)
+
+				// the receipt is served from the cache once the tx is included
+				suite.backend.cache = newQueryCache(10, 0)
+				suite.backend.cache.Add(cacheKey(cachePrefixReceipt, common.HexToHash(ethTx.Hash).Hex()), receipt)
+			},
+			receipt,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("case %s", tc.name), func() {
+			suite.SetupTest() // reset test and queries
+			tc.registerMock()
+
+			res, err := suite.backend.SendRawTransactionSync(rlpEncodedBz, &timeout)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expReceipt, res)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *BackendTestSuite) TestDoCall() {
 	_, bz := suite.buildEthereumTx()
 	gasPrice := (*hexutil.Big)(big.NewInt(1))
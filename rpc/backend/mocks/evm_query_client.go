@@ -77,6 +77,36 @@ func (_m *EVMQueryClient) Balance(ctx context.Context, in *types.QueryBalanceReq
 	return r0, r1
 }
 
+// AvailablePrecompiles provides a mock function with given fields: ctx, in, opts
+func (_m *EVMQueryClient) AvailablePrecompiles(ctx context.Context, in *types.QueryAvailablePrecompilesRequest, opts ...grpc.CallOption) (*types.QueryAvailablePrecompilesResponse, error) {
+	_va := make([]interface{}, len(opts))
+	for _i := range opts {
+		_va[_i] = opts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, in)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *types.QueryAvailablePrecompilesResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *types.QueryAvailablePrecompilesRequest, ...grpc.CallOption) *types.QueryAvailablePrecompilesResponse); ok {
+		r0 = rf(ctx, in, opts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*types.QueryAvailablePrecompilesResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *types.QueryAvailablePrecompilesRequest, ...grpc.CallOption) error); ok {
+		r1 = rf(ctx, in, opts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // BaseFee provides a mock function with given fields: ctx, in, opts
 func (_m *EVMQueryClient) BaseFee(ctx context.Context, in *types.QueryBaseFeeRequest, opts ...grpc.CallOption) (*types.QueryBaseFeeResponse, error) {
 	_va := make([]interface{}, len(opts))
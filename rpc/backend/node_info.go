@@ -28,6 +28,10 @@ import (
 
 // Accounts returns the list of accounts available to this node.
 func (b *Backend) Accounts() ([]common.Address, error) {
+	if b.signer != nil {
+		return b.signer.Accounts()
+	}
+
 	addresses := make([]common.Address, 0) // return [] instead of nil if empty
 
 	infos, err := b.clientCtx.Keyring.List()
@@ -182,6 +186,10 @@ func (b *Backend) SetEtherbase(etherbase common.Address) bool {
 //
 // NOTE: The key will be both armored and encrypted using the same passphrase.
 func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error) {
+	if b.signer != nil {
+		return common.Address{}, errExternalSigner
+	}
+
 	priv, err := crypto.HexToECDSA(privkey)
 	if err != nil {
 		return common.Address{}, err
@@ -214,6 +222,10 @@ func (b *Backend) ImportRawKey(privkey, password string) (common.Address, error)
 
 // ListAccounts will return a list of addresses for accounts this node manages.
 func (b *Backend) ListAccounts() ([]common.Address, error) {
+	if b.signer != nil {
+		return b.signer.Accounts()
+	}
+
 	addrs := []common.Address{}
 
 	list, err := b.clientCtx.Keyring.List()
@@ -239,6 +251,10 @@ func (b *Backend) NewMnemonic(uid string,
 	bip39Passphrase string,
 	algo keyring.SignatureAlgo,
 ) (*keyring.Record, error) {
+	if b.signer != nil {
+		return nil, errExternalSigner
+	}
+
 	info, _, err := b.clientCtx.Keyring.NewMnemonic(uid, keyring.English, hdPath, bip39Passphrase, algo)
 	if err != nil {
 		return nil, err
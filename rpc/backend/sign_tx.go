@@ -8,6 +8,7 @@ import (
 	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -18,20 +19,24 @@ import (
 	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
 )
 
-// SendTransaction sends transaction based on received args using Node's key to sign it
+// SendTransaction sends transaction based on received args using Node's key, or
+// the external signer if configured, to sign it
 func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
-	// Look up the wallet containing the requested signer
-	_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
-	if err != nil {
-		b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
-		return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+	// Look up the wallet containing the requested signer, the external signer
+	// checks it when signing
+	if b.signer == nil {
+		_, err := b.clientCtx.Keyring.KeyByAddress(sdk.AccAddress(args.GetFrom().Bytes()))
+		if err != nil {
+			b.logger.Error("failed to find key in keyring", "address", args.GetFrom(), "error", err.Error())
+			return common.Hash{}, fmt.Errorf("failed to find key in the node's keyring; %s; %s", keystore.ErrNoMatch, err.Error())
+		}
 	}
 
 	if args.ChainID != nil && (b.chainID).Cmp((*big.Int)(args.ChainID)) != 0 {
 		return common.Hash{}, fmt.Errorf("chainId does not match node's (have=%v, want=%v)", args.ChainID, (*hexutil.Big)(b.chainID))
 	}
 
-	args, err = b.SetTxDefaults(args)
+	args, err := b.SetTxDefaults(args)
 	if err != nil {
 		return common.Hash{}, err
 	}
@@ -49,7 +54,7 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 
 	// Sign transaction
 	msg := args.ToTransaction()
-	if err := msg.Sign(signer, b.clientCtx.Keyring); err != nil {
+	if err := b.signTransaction(msg, signer); err != nil {
 		b.logger.Debug("failed to sign tx", "error", err.Error())
 		return common.Hash{}, err
 	}
@@ -107,8 +112,39 @@ func (b *Backend) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, e
 	return txHash, nil
 }
 
+// signTransaction signs the msg with the external signer if configured or with
+// the node's keyring otherwise.
+func (b *Backend) signTransaction(msg *evmtypes.MsgEthereumTx, ethSigner ethtypes.Signer) error {
+	if b.signer == nil {
+		return msg.Sign(ethSigner, b.clientCtx.Keyring)
+	}
+
+	from := common.BytesToAddress(msg.GetFrom())
+	tx, err := b.signer.SignTransaction(from, msg.AsTransaction(), b.chainID)
+	if err != nil {
+		return err
+	}
+
+	// make sure the external signer didn't sign with another account
+	sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(b.chainID), tx)
+	if err != nil {
+		return err
+	}
+	if sender != from {
+		return fmt.Errorf("transaction signed by %s instead of %s", sender.Hex(), from.Hex())
+	}
+
+	return msg.FromEthereumTx(tx)
+}
+
 // Sign signs the provided data using the private key of address via Geth's signature standard.
+// The data is prefixed with the Ethereum signed message prefix (EIP-191) before
+// being hashed, both when signing with the keyring and with the external signer.
 func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if b.signer != nil {
+		return b.signer.SignText(address, data)
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
 	_, err := b.clientCtx.Keyring.KeyByAddress(from)
@@ -117,8 +153,8 @@ func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Byte
 		return nil, fmt.Errorf("%s; %s", keystore.ErrNoMatch, err.Error())
 	}
 
-	// Sign the requested hash with the wallet
-	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, data)
+	// Sign the Ethereum signed message hash of the data with the wallet
+	signature, _, err := b.clientCtx.Keyring.SignByAddress(from, accounts.TextHash(data))
 	if err != nil {
 		b.logger.Error("keyring.SignByAddress failed", "address", address.Hex())
 		return nil, err
@@ -130,6 +166,10 @@ func (b *Backend) Sign(address common.Address, data hexutil.Bytes) (hexutil.Byte
 
 // SignTypedData signs EIP-712 conformant typed data
 func (b *Backend) SignTypedData(address common.Address, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	if b.signer != nil {
+		return b.signer.SignTypedData(address, typedData)
+	}
+
 	from := sdk.AccAddress(address.Bytes())
 
 	_, err := b.clientCtx.Keyring.KeyByAddress(from)
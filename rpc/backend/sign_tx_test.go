@@ -7,6 +7,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts"
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -16,6 +17,7 @@ import (
 
 	"github.com/anryton/anryton/v2/crypto/ethsecp256k1"
 	"github.com/anryton/anryton/v2/rpc/backend/mocks"
+	"github.com/anryton/anryton/v2/rpc/signer"
 	utiltx "github.com/anryton/anryton/v2/testutil/tx"
 	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
 )
@@ -171,6 +173,17 @@ func (suite *BackendTestSuite) TestSign() {
 			nil,
 			true,
 		},
+		{
+			"pass - sign data",
+			func() {
+				armor := crypto.EncryptArmorPrivKey(priv, "", "eth_secp256k1")
+				err := suite.backend.clientCtx.Keyring.ImportPrivKey("test_key", armor, "")
+				suite.Require().NoError(err)
+			},
+			from,
+			[]byte("hello world"),
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -180,10 +193,17 @@ func (suite *BackendTestSuite) TestSign() {
 
 			responseBz, err := suite.backend.Sign(tc.fromAddr, tc.inputBz)
 			if tc.expPass {
-				signature, _, err := suite.backend.clientCtx.Keyring.SignByAddress((sdk.AccAddress)(from.Bytes()), tc.inputBz)
+				signature, _, err := suite.backend.clientCtx.Keyring.SignByAddress((sdk.AccAddress)(from.Bytes()), accounts.TextHash(tc.inputBz))
 				signature[goethcrypto.RecoveryIDOffset] += 27
 				suite.Require().NoError(err)
 				suite.Require().Equal((hexutil.Bytes)(signature), responseBz)
+
+				// the signature matches the one of an external signer holding the same key
+				ecdsaKey, err := priv.ToECDSA()
+				suite.Require().NoError(err)
+				externalSignature, err := signer.NewLocalSigner(ecdsaKey).SignText(from, tc.inputBz)
+				suite.Require().NoError(err)
+				suite.Require().Equal((hexutil.Bytes)(externalSignature), responseBz)
 			} else {
 				suite.Require().Error(err)
 			}
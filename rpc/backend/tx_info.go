@@ -141,6 +141,11 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 	hexTx := hash.Hex()
 	b.logger.Debug("eth_getTransactionReceipt", "hash", hexTx)
 
+	key := cacheKey(cachePrefixReceipt, hexTx)
+	if cached, ok := b.cache.Get(key); ok {
+		return cached.(map[string]interface{}), nil
+	}
+
 	res, err := b.GetTxByEthHash(hash)
 	if err != nil {
 		b.logger.Debug("tx not found", "hash", hexTx, "error", err.Error())
@@ -257,6 +262,18 @@ func (b *Backend) GetTransactionReceipt(hash common.Hash) (map[string]interface{
 		}
 	}
 
+	if res.Failed {
+		revertReason := res.RevertReason
+		if revertReason == "" {
+			// txs indexed before the revert reason was stored
+			revertReason = rpctypes.TxRevertReason(blockRes.TxsResults[res.TxIndex], msgIndex)
+		}
+		if revertReason != "" {
+			receipt["revertReason"] = revertReason
+		}
+	}
+
+	b.cache.Add(key, receipt)
 	return receipt, nil
 }
 
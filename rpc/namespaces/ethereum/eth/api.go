@@ -52,6 +52,8 @@ type EthereumAPI interface {
 	// Allows developers to both send ETH from one address to another, write data
 	// on-chain, and interact with smart contracts.
 	SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
+	SendRawTransactionConditional(data hexutil.Bytes, conditional rpctypes.TransactionConditional) (common.Hash, error)
+	SendRawTransactionSync(data hexutil.Bytes, timeout *hexutil.Uint64) (map[string]interface{}, error)
 	SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error)
 	// eth_sendPrivateTransaction
 	// eth_cancel	PrivateTransaction
@@ -215,6 +217,23 @@ func (e *PublicAPI) SendRawTransaction(data hexutil.Bytes) (common.Hash, error)
 	return e.backend.SendRawTransaction(data)
 }
 
+// SendRawTransactionConditional sends a raw Ethereum transaction that is only
+// included if the block number, timestamp and known account storage match
+// the given conditions. The known accounts must be given as storage slots, their
+// storage root is not supported (see rpctypes.ErrStorageRootNotSupported). The
+// conditions must be signed by the sender of the transaction.
+func (e *PublicAPI) SendRawTransactionConditional(data hexutil.Bytes, conditional rpctypes.TransactionConditional) (common.Hash, error) {
+	e.logger.Debug("eth_sendRawTransactionConditional", "length", len(data))
+	return e.backend.SendRawTransactionConditional(data, conditional)
+}
+
+// SendRawTransactionSync sends a raw Ethereum transaction and returns its
+// receipt once included, waiting at most timeout milliseconds.
+func (e *PublicAPI) SendRawTransactionSync(data hexutil.Bytes, timeout *hexutil.Uint64) (map[string]interface{}, error) {
+	e.logger.Debug("eth_sendRawTransactionSync", "length", len(data))
+	return e.backend.SendRawTransactionSync(data, timeout)
+}
+
 // SendTransaction sends an Ethereum transaction.
 func (e *PublicAPI) SendTransaction(args evmtypes.TransactionArgs) (common.Hash, error) {
 	e.logger.Debug("eth_sendTransaction", "args", args.String())
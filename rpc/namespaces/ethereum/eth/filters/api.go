@@ -2,7 +2,9 @@ package filters
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"math/big"
 	"sync"
 	"time"
 
@@ -16,6 +18,7 @@ import (
 	tmtypes "github.com/cometbft/cometbft/types"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/eth/filters"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -46,6 +49,7 @@ type Backend interface {
 	BlockBloom(blockRes *coretypes.ResultBlockResults) (ethtypes.Bloom, error)
 
 	BloomStatus() (uint64, uint64)
+	ChainID() (*hexutil.Big, error)
 
 	RPCFilterCap() int32
 	RPCLogsCap() int32
@@ -75,6 +79,8 @@ type PublicFilterAPI struct {
 	events    *EventSystem
 	filtersMu sync.Mutex
 	filters   map[rpc.ID]*filter
+	// subscriptions is the number of active subscriptions accounted in the filter cap
+	subscriptions int
 }
 
 // NewPublicAPI returns a new PublicFilterAPI instance.
@@ -127,7 +133,7 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
-	if len(api.filters) >= int(api.backend.RPCFilterCap()) {
+	if len(api.filters)+api.subscriptions >= int(api.backend.RPCFilterCap()) {
 		return rpc.ID("error creating pending tx filter: max limit reached")
 	}
 
@@ -192,12 +198,27 @@ func (api *PublicFilterAPI) NewPendingTransactionFilter() rpc.ID {
 
 // NewPendingTransactions creates a subscription that is triggered each time a transaction
 // enters the transaction pool and was signed from one of the transactions this nodes manages.
-func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Subscription, error) {
+// If fullTx is true the full transaction objects are sent, otherwise only their hashes.
+func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context, fullTx *bool) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
 		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
 	}
 
+	var chainID *big.Int
+	if fullTx != nil && *fullTx {
+		id, err := api.backend.ChainID()
+		if err != nil {
+			return nil, err
+		}
+		chainID = id.ToInt()
+	}
+
+	release, err := api.reserveSubscription()
+	if err != nil {
+		return nil, fmt.Errorf("error creating pending tx subscription: %s", err.Error())
+	}
+
 	rpcSub := notifier.CreateSubscription()
 
 	ctx, cancelFn := context.WithTimeout(context.Background(), deadline)
@@ -207,11 +228,13 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 
 	pendingTxSub, cancelSubs, err := api.events.SubscribePendingTxs()
 	if err != nil {
+		release()
 		return nil, err
 	}
 
 	go func(txsCh <-chan coretypes.ResultEvent) {
 		defer cancelSubs()
+		defer release()
 
 		for {
 			select {
@@ -229,17 +252,14 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 					continue
 				}
 
-				tx, err := api.clientCtx.TxConfig.TxDecoder()(data.Tx)
+				txs, err := PendingTxNotifications(api.clientCtx, data.Tx, chainID != nil, chainID)
 				if err != nil {
 					api.logger.Debug("fail to decode tx", "error", err.Error())
 					continue
 				}
 
-				for _, msg := range tx.GetMsgs() {
-					ethTx, ok := msg.(*evmtypes.MsgEthereumTx)
-					if ok {
-						_ = notifier.Notify(rpcSub.ID, ethTx.AsTransaction().Hash()) // #nosec G703
-					}
+				for _, tx := range txs {
+					_ = notifier.Notify(rpcSub.ID, tx) // #nosec G703
 				}
 			case <-rpcSub.Err():
 				pendingTxSub.Unsubscribe(api.events)
@@ -254,6 +274,61 @@ func (api *PublicFilterAPI) NewPendingTransactions(ctx context.Context) (*rpc.Su
 	return rpcSub, err
 }
 
+// Syncing creates a subscription that is triggered each time the node starts or
+// stops catching up with the network, and on progress while catching up.
+func (api *PublicFilterAPI) Syncing(ctx context.Context) (*rpc.Subscription, error) {
+	notifier, supported := rpc.NotifierFromContext(ctx)
+	if !supported {
+		return &rpc.Subscription{}, rpc.ErrNotificationsUnsupported
+	}
+
+	release, err := api.reserveSubscription()
+	if err != nil {
+		return nil, fmt.Errorf("error creating syncing subscription: %s", err.Error())
+	}
+
+	rpcSub := notifier.CreateSubscription()
+
+	pollCtx, cancelFn := context.WithCancel(context.Background())
+	go PollSyncing(pollCtx, api.clientCtx.Client, func(result interface{}) {
+		_ = notifier.Notify(rpcSub.ID, result) // #nosec G703
+	})
+
+	go func() {
+		defer release()
+		defer cancelFn()
+
+		select {
+		case <-rpcSub.Err():
+		case <-notifier.Closed():
+		}
+	}()
+
+	return rpcSub, nil
+}
+
+// reserveSubscription accounts for a new subscription if the total number of
+// filters and subscriptions is below the filter cap. The returned function
+// releases the subscription slot.
+func (api *PublicFilterAPI) reserveSubscription() (func(), error) {
+	api.filtersMu.Lock()
+	defer api.filtersMu.Unlock()
+
+	if len(api.filters)+api.subscriptions >= int(api.backend.RPCFilterCap()) {
+		return nil, errors.New("max limit reached")
+	}
+	api.subscriptions++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			api.filtersMu.Lock()
+			api.subscriptions--
+			api.filtersMu.Unlock()
+		})
+	}, nil
+}
+
 // NewBlockFilter creates a filter that fetches blocks that are imported into the chain.
 // It is part of the filter package since polling goes with eth_getFilterChanges.
 //
@@ -262,7 +337,7 @@ func (api *PublicFilterAPI) NewBlockFilter() rpc.ID {
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
-	if len(api.filters) >= int(api.backend.RPCFilterCap()) {
+	if len(api.filters)+api.subscriptions >= int(api.backend.RPCFilterCap()) {
 		return rpc.ID("error creating block filter: max limit reached")
 	}
 
@@ -442,7 +517,7 @@ func (api *PublicFilterAPI) NewFilter(criteria filters.FilterCriteria) (rpc.ID,
 	api.filtersMu.Lock()
 	defer api.filtersMu.Unlock()
 
-	if len(api.filters) >= int(api.backend.RPCFilterCap()) {
+	if len(api.filters)+api.subscriptions >= int(api.backend.RPCFilterCap()) {
 		return rpc.ID(""), fmt.Errorf("error creating filter: max limit reached")
 	}
 
@@ -0,0 +1,85 @@
+package filters
+
+import (
+	"context"
+	"time"
+
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// SyncingPollInterval is the interval at which the CometBFT node status is
+// polled to detect changes of the syncing state.
+var SyncingPollInterval = time.Second
+
+// SyncingStatus is the sync progress pushed to the "syncing" subscribers while
+// the node is catching up.
+//
+// NOTE: the highest block known by the peers is not exposed by the CometBFT
+// node status, so it is omitted as in eth_syncing.
+type SyncingStatus struct {
+	StartingBlock hexutil.Uint64 `json:"startingBlock"`
+	CurrentBlock  hexutil.Uint64 `json:"currentBlock"`
+}
+
+// SyncingResult is the geth compatible notification of the "syncing"
+// subscription.
+type SyncingResult struct {
+	Syncing bool          `json:"syncing"`
+	Status  SyncingStatus `json:"status"`
+}
+
+// SyncingResultFromStatus converts the CometBFT node status into the
+// notification sent to the "syncing" subscribers. It returns false if the node
+// is not catching up.
+func SyncingResultFromStatus(status *coretypes.ResultStatus) interface{} {
+	if !status.SyncInfo.CatchingUp {
+		return false
+	}
+
+	return &SyncingResult{
+		Syncing: true,
+		Status: SyncingStatus{
+			StartingBlock: hexutil.Uint64(status.SyncInfo.EarliestBlockHeight),
+			CurrentBlock:  hexutil.Uint64(status.SyncInfo.LatestBlockHeight),
+		},
+	}
+}
+
+// PollSyncing polls the CometBFT node status until the context is done and
+// calls notify with the current syncing state the first time and every time
+// the node starts or stops catching up, or makes progress while catching up.
+func PollSyncing(ctx context.Context, client tmrpcclient.StatusClient, notify func(result interface{})) {
+	ticker := time.NewTicker(SyncingPollInterval)
+	defer ticker.Stop()
+
+	var (
+		last      *coretypes.SyncInfo
+		firstPoll = true
+	)
+
+	for {
+		status, err := client.Status(ctx)
+		if err == nil && (firstPoll || syncInfoChanged(last, &status.SyncInfo)) {
+			notify(SyncingResultFromStatus(status))
+			last = &status.SyncInfo
+			firstPoll = false
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// syncInfoChanged returns true if the catching up flag changed or if the
+// node imported new blocks while catching up.
+func syncInfoChanged(prev, curr *coretypes.SyncInfo) bool {
+	if prev.CatchingUp != curr.CatchingUp {
+		return true
+	}
+	return curr.CatchingUp && prev.LatestBlockHeight != curr.LatestBlockHeight
+}
@@ -0,0 +1,75 @@
+package filters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	coretypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+// statusClient returns the statuses in order, repeating the last one.
+type statusClient struct {
+	statuses []*coretypes.ResultStatus
+	calls    int
+}
+
+func (c *statusClient) Status(context.Context) (*coretypes.ResultStatus, error) {
+	i := c.calls
+	if i >= len(c.statuses) {
+		i = len(c.statuses) - 1
+	}
+	c.calls++
+	return c.statuses[i], nil
+}
+
+func newStatus(catchingUp bool, height int64) *coretypes.ResultStatus {
+	return &coretypes.ResultStatus{
+		SyncInfo: coretypes.SyncInfo{
+			CatchingUp:          catchingUp,
+			EarliestBlockHeight: 1,
+			LatestBlockHeight:   height,
+		},
+	}
+}
+
+func TestSyncingResultFromStatus(t *testing.T) {
+	require.Equal(t, false, SyncingResultFromStatus(newStatus(false, 10)))
+
+	res, ok := SyncingResultFromStatus(newStatus(true, 10)).(*SyncingResult)
+	require.True(t, ok)
+	require.True(t, res.Syncing)
+	require.Equal(t, uint64(1), uint64(res.Status.StartingBlock))
+	require.Equal(t, uint64(10), uint64(res.Status.CurrentBlock))
+}
+
+func TestPollSyncing(t *testing.T) {
+	pollInterval := SyncingPollInterval
+	SyncingPollInterval = time.Millisecond
+	defer func() { SyncingPollInterval = pollInterval }()
+
+	client := &statusClient{
+		statuses: []*coretypes.ResultStatus{
+			newStatus(true, 5),
+			newStatus(true, 5), // no progress, not notified
+			newStatus(true, 6),
+			newStatus(false, 7),
+		},
+	}
+
+	results := make(chan interface{}, 10)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go PollSyncing(ctx, client, func(result interface{}) {
+		results <- result
+	})
+
+	expHeights := []uint64{5, 6}
+	for _, height := range expHeights {
+		res := (<-results).(*SyncingResult)
+		require.Equal(t, height, uint64(res.Status.CurrentBlock))
+	}
+	require.Equal(t, false, <-results)
+}
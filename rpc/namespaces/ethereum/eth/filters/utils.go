@@ -3,8 +3,15 @@ package filters
 import (
 	"math/big"
 
+	"github.com/cosmos/cosmos-sdk/client"
+
+	tmtypes "github.com/cometbft/cometbft/types"
+
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/anryton/anryton/v2/rpc/types"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
 )
 
 // FilterLogs creates a slice of logs matching the given criteria.
@@ -104,3 +111,35 @@ func returnLogs(logs []*ethtypes.Log) []*ethtypes.Log {
 	}
 	return logs
 }
+
+// PendingTxNotifications decodes the Ethereum transactions contained in the
+// given CometBFT transaction and returns the values to notify to the
+// "newPendingTransactions" subscribers: the transaction hashes or, if fullTx
+// is set, the RPC representation of the transactions.
+func PendingTxNotifications(clientCtx client.Context, txBz tmtypes.Tx, fullTx bool, chainID *big.Int) ([]interface{}, error) {
+	tx, err := clientCtx.TxConfig.TxDecoder()(txBz)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []interface{}
+	for _, msg := range tx.GetMsgs() {
+		ethMsg, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		if !fullTx {
+			res = append(res, ethMsg.AsTransaction().Hash())
+			continue
+		}
+
+		rpcTx, err := types.NewTransactionFromMsg(ethMsg, common.Hash{}, 0, 0, nil, chainID)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, rpcTx)
+	}
+
+	return res, nil
+}
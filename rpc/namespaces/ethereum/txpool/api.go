@@ -1,24 +1,48 @@
 package txpool
 
 import (
+	"fmt"
+	"math/big"
+
 	"github.com/cometbft/cometbft/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
 
 	"github.com/anryton/anryton/v2/rpc/types"
+	anrytontypes "github.com/anryton/anryton/v2/types"
 )
 
 // PublicAPI offers and API for the transaction pool. It only operates on data that is non-confidential.
-// NOTE: For more info about the current status of this endpoints see https://github.com/anryton/ethermint/issues/124
+// The content of the pool is read from the application mempool, and is empty when it is disabled.
 type PublicAPI struct {
-	logger log.Logger
+	logger  log.Logger
+	txPool  anrytontypes.EVMTxPool
+	chainID *big.Int
 }
 
 // NewPublicAPI creates a new tx pool service that gives information about the transaction pool.
-func NewPublicAPI(logger log.Logger) *PublicAPI {
+func NewPublicAPI(logger log.Logger, clientCtx client.Context, txPool anrytontypes.EVMTxPool) *PublicAPI {
+	chainID, err := anrytontypes.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		panic(err)
+	}
+
 	return &PublicAPI{
-		logger: logger.With("module", "txpool"),
+		logger:  logger.With("module", "txpool"),
+		txPool:  txPool,
+		chainID: chainID,
+	}
+}
+
+// content returns the pending and queued txs of the application mempool.
+func (api *PublicAPI) content() (map[common.Address]ethtypes.Transactions, map[common.Address]ethtypes.Transactions) {
+	if api.txPool == nil {
+		return nil, nil
 	}
+	return api.txPool.Content()
 }
 
 // Content returns the transactions contained within the transaction pool
@@ -28,6 +52,21 @@ func (api *PublicAPI) Content() (map[string]map[string]map[string]*types.RPCTran
 		"pending": make(map[string]map[string]*types.RPCTransaction),
 		"queued":  make(map[string]map[string]*types.RPCTransaction),
 	}
+
+	pending, queued := api.content()
+	for status, txs := range map[string]map[common.Address]ethtypes.Transactions{"pending": pending, "queued": queued} {
+		for sender, senderTxs := range txs {
+			dump := make(map[string]*types.RPCTransaction, len(senderTxs))
+			for _, tx := range senderTxs {
+				rpcTx, err := types.NewRPCTransaction(tx, common.Hash{}, 0, 0, nil, api.chainID)
+				if err != nil {
+					return nil, err
+				}
+				dump[fmt.Sprintf("%d", tx.Nonce())] = rpcTx
+			}
+			content[status][sender.Hex()] = dump
+		}
+	}
 	return content, nil
 }
 
@@ -38,14 +77,43 @@ func (api *PublicAPI) Inspect() (map[string]map[string]map[string]string, error)
 		"pending": make(map[string]map[string]string),
 		"queued":  make(map[string]map[string]string),
 	}
+
+	pending, queued := api.content()
+	for status, txs := range map[string]map[common.Address]ethtypes.Transactions{"pending": pending, "queued": queued} {
+		for sender, senderTxs := range txs {
+			dump := make(map[string]string, len(senderTxs))
+			for _, tx := range senderTxs {
+				dump[fmt.Sprintf("%d", tx.Nonce())] = format(tx)
+			}
+			content[status][sender.Hex()] = dump
+		}
+	}
 	return content, nil
 }
 
 // Status returns the number of pending and queued transaction in the pool.
 func (api *PublicAPI) Status() map[string]hexutil.Uint {
 	api.logger.Debug("txpool_status")
+	pending, queued := api.content()
 	return map[string]hexutil.Uint{
-		"pending": hexutil.Uint(0),
-		"queued":  hexutil.Uint(0),
+		"pending": hexutil.Uint(count(pending)),
+		"queued":  hexutil.Uint(count(queued)),
+	}
+}
+
+// format returns the summary of the tx shown by txpool_inspect.
+func format(tx *ethtypes.Transaction) string {
+	if to := tx.To(); to != nil {
+		return fmt.Sprintf("%s: %v wei + %v gas × %v wei", to.Hex(), tx.Value(), tx.Gas(), tx.GasPrice())
+	}
+	return fmt.Sprintf("contract creation: %v wei + %v gas × %v wei", tx.Value(), tx.Gas(), tx.GasPrice())
+}
+
+// count returns the number of txs of all the senders.
+func count(txs map[common.Address]ethtypes.Transactions) int {
+	n := 0
+	for _, senderTxs := range txs {
+		n += len(senderTxs)
 	}
+	return n
 }
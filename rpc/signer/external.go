@@ -0,0 +1,179 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// textPlainMimeType is the Clef content type of the data signed with the
+// Ethereum signed message header.
+const textPlainMimeType = "text/plain"
+
+// SignTransactionResult is the result of the Clef account_signTransaction method.
+type SignTransactionResult struct {
+	Raw hexutil.Bytes         `json:"raw"`
+	Tx  *ethtypes.Transaction `json:"tx"`
+}
+
+var _ Signer = (*ExternalSigner)(nil)
+
+// ExternalSigner is a Signer that forwards the requests to a Clef compatible
+// external signer through its account_* JSON-RPC methods.
+type ExternalSigner struct {
+	endpoint string
+	timeout  time.Duration
+
+	mu     sync.Mutex
+	client *rpc.Client
+}
+
+// NewExternalSigner returns a signer for the Clef compatible endpoint, which can
+// be an http(s)://, ws(s):// URL or an IPC path. The connection is established
+// on the first request. A zero timeout means no timeout.
+func NewExternalSigner(endpoint string, timeout time.Duration) *ExternalSigner {
+	return &ExternalSigner{
+		endpoint: endpoint,
+		timeout:  timeout,
+	}
+}
+
+// Accounts returns the accounts listed by the external signer (account_list).
+func (s *ExternalSigner) Accounts() ([]common.Address, error) {
+	var accounts []common.Address
+	if err := s.call(&accounts, "account_list"); err != nil {
+		return nil, err
+	}
+	return accounts, nil
+}
+
+// SignTransaction requests the external signer to sign the transaction
+// (account_signTransaction).
+func (s *ExternalSigner) SignTransaction(from common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	args := NewSendTxArgs(from, tx, chainID)
+
+	var res SignTransactionResult
+	// NOTE: pass a pointer so that the mixed case addresses are marshaled
+	if err := s.call(&res, "account_signTransaction", &args); err != nil {
+		return nil, err
+	}
+
+	signedTx := new(ethtypes.Transaction)
+	if err := signedTx.UnmarshalBinary(res.Raw); err != nil {
+		return nil, fmt.Errorf("invalid signed transaction: %w", err)
+	}
+
+	return signedTx, nil
+}
+
+// SignText requests the external signer to sign the data as text/plain
+// (account_signData).
+func (s *ExternalSigner) SignText(address common.Address, data []byte) ([]byte, error) {
+	var signature hexutil.Bytes
+	if err := s.call(&signature, "account_signData", textPlainMimeType, address, hexutil.Bytes(data)); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// SignTypedData requests the external signer to sign the EIP-712 typed data
+// (account_signTypedData).
+func (s *ExternalSigner) SignTypedData(address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	var signature hexutil.Bytes
+	if err := s.call(&signature, "account_signTypedData", address, typedData); err != nil {
+		return nil, err
+	}
+	return signature, nil
+}
+
+// Close closes the connection to the external signer.
+func (s *ExternalSigner) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		s.client.Close()
+		s.client = nil
+	}
+}
+
+// call performs the JSON-RPC call, dialing the external signer if needed.
+func (s *ExternalSigner) call(result interface{}, method string, args ...interface{}) error {
+	ctx := context.Background()
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	client, err := s.dial(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err := client.CallContext(ctx, result, method, args...); err != nil {
+		return fmt.Errorf("external signer %s failed: %w", method, err)
+	}
+	return nil
+}
+
+// dial returns the client connected to the external signer.
+func (s *ExternalSigner) dial(ctx context.Context) (*rpc.Client, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.client != nil {
+		return s.client, nil
+	}
+
+	client, err := rpc.DialContext(ctx, s.endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to external signer: %w", err)
+	}
+
+	s.client = client
+	return client, nil
+}
+
+// NewSendTxArgs converts the transaction into the Clef account_signTransaction
+// arguments.
+func NewSendTxArgs(from common.Address, tx *ethtypes.Transaction, chainID *big.Int) apitypes.SendTxArgs {
+	data := hexutil.Bytes(tx.Data())
+	args := apitypes.SendTxArgs{
+		From:    common.NewMixedcaseAddress(from),
+		Gas:     hexutil.Uint64(tx.Gas()),
+		Value:   hexutil.Big(*tx.Value()),
+		Nonce:   hexutil.Uint64(tx.Nonce()),
+		Data:    &data,
+		ChainID: (*hexutil.Big)(chainID),
+	}
+
+	if to := tx.To(); to != nil {
+		mixedTo := common.NewMixedcaseAddress(*to)
+		args.To = &mixedTo
+	}
+
+	switch tx.Type() {
+	case ethtypes.DynamicFeeTxType:
+		accessList := tx.AccessList()
+		args.MaxFeePerGas = (*hexutil.Big)(tx.GasFeeCap())
+		args.MaxPriorityFeePerGas = (*hexutil.Big)(tx.GasTipCap())
+		args.AccessList = &accessList
+	case ethtypes.AccessListTxType:
+		accessList := tx.AccessList()
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+		args.AccessList = &accessList
+	default:
+		args.GasPrice = (*hexutil.Big)(tx.GasPrice())
+	}
+
+	return args
+}
@@ -0,0 +1,168 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+var _ Signer = (*LocalSigner)(nil)
+
+// LocalSigner is an in-memory Signer holding its private keys. It is a stand-in
+// for an external signer in tests and local setups and can be served over
+// JSON-RPC as a Clef compatible signer through NewClefServer.
+type LocalSigner struct {
+	mu   sync.RWMutex
+	keys map[common.Address]*ecdsa.PrivateKey
+}
+
+// NewLocalSigner returns a signer managing the given private keys.
+func NewLocalSigner(keys ...*ecdsa.PrivateKey) *LocalSigner {
+	s := &LocalSigner{
+		keys: make(map[common.Address]*ecdsa.PrivateKey),
+	}
+	for _, key := range keys {
+		s.AddKey(key)
+	}
+	return s
+}
+
+// AddKey adds the private key to the signer and returns its address.
+func (s *LocalSigner) AddKey(key *ecdsa.PrivateKey) common.Address {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	address := crypto.PubkeyToAddress(key.PublicKey)
+	s.keys[address] = key
+	return address
+}
+
+// Accounts returns the sorted addresses of the managed keys.
+func (s *LocalSigner) Accounts() ([]common.Address, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	addresses := make([]common.Address, 0, len(s.keys))
+	for address := range s.keys {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Hex() < addresses[j].Hex()
+	})
+	return addresses, nil
+}
+
+// SignTransaction signs the transaction with the latest signer of the chain.
+func (s *LocalSigner) SignTransaction(from common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error) {
+	key, err := s.key(from)
+	if err != nil {
+		return nil, err
+	}
+	return ethtypes.SignTx(tx, ethtypes.LatestSignerForChainID(chainID), key)
+}
+
+// SignText signs the Ethereum signed message hash of the data.
+func (s *LocalSigner) SignText(address common.Address, data []byte) ([]byte, error) {
+	return s.signHash(address, accounts.TextHash(data))
+}
+
+// SignTypedData signs the EIP-712 hash of the typed data.
+func (s *LocalSigner) SignTypedData(address common.Address, typedData apitypes.TypedData) ([]byte, error) {
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, err
+	}
+	return s.signHash(address, sigHash)
+}
+
+// signHash signs the hash and transforms V from 0/1 to 27/28.
+func (s *LocalSigner) signHash(address common.Address, hash []byte) ([]byte, error) {
+	key, err := s.key(address)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := crypto.Sign(hash, key)
+	if err != nil {
+		return nil, err
+	}
+
+	signature[crypto.RecoveryIDOffset] += 27
+	return signature, nil
+}
+
+// key returns the private key of the address.
+func (s *LocalSigner) key(address common.Address) (*ecdsa.PrivateKey, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	key, ok := s.keys[address]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownAccount, address.Hex())
+	}
+	return key, nil
+}
+
+// ClefAPI exposes a Signer through the Clef account_* JSON-RPC methods.
+type ClefAPI struct {
+	signer Signer
+}
+
+// NewClefServer returns a JSON-RPC server serving the Clef compatible account_*
+// methods backed by the given signer. It implements http.Handler.
+func NewClefServer(signer Signer) (*rpc.Server, error) {
+	server := rpc.NewServer()
+	if err := server.RegisterName("account", &ClefAPI{signer: signer}); err != nil {
+		return nil, err
+	}
+	return server, nil
+}
+
+// List implements account_list.
+func (api *ClefAPI) List(_ context.Context) ([]common.Address, error) {
+	return api.signer.Accounts()
+}
+
+// SignTransaction implements account_signTransaction.
+func (api *ClefAPI) SignTransaction(_ context.Context, args apitypes.SendTxArgs, _ *string) (*SignTransactionResult, error) {
+	if args.ChainID == nil {
+		return nil, fmt.Errorf("chain id not specified")
+	}
+
+	tx, err := api.signer.SignTransaction(args.From.Address(), args.ToTransaction(), args.ChainID.ToInt())
+	if err != nil {
+		return nil, err
+	}
+
+	raw, err := tx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignTransactionResult{Raw: raw, Tx: tx}, nil
+}
+
+// SignData implements account_signData. Only the text/plain content type is
+// supported.
+func (api *ClefAPI) SignData(_ context.Context, contentType string, addr common.MixedcaseAddress, data hexutil.Bytes) (hexutil.Bytes, error) {
+	if contentType != textPlainMimeType {
+		return nil, fmt.Errorf("unsupported content type %s", contentType)
+	}
+	return api.signer.SignText(addr.Address(), data)
+}
+
+// SignTypedData implements account_signTypedData.
+func (api *ClefAPI) SignTypedData(_ context.Context, addr common.MixedcaseAddress, typedData apitypes.TypedData) (hexutil.Bytes, error) {
+	return api.signer.SignTypedData(addr.Address(), typedData)
+}
@@ -0,0 +1,29 @@
+package signer
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+)
+
+// ErrUnknownAccount is returned when the signer doesn't manage the requested account.
+var ErrUnknownAccount = errors.New("unknown account")
+
+// Signer signs transactions and data on behalf of the accounts it manages,
+// without exposing their private keys to the node.
+type Signer interface {
+	// Accounts returns the addresses of the accounts managed by the signer.
+	Accounts() ([]common.Address, error)
+	// SignTransaction signs the transaction with the key of the from account.
+	SignTransaction(from common.Address, tx *ethtypes.Transaction, chainID *big.Int) (*ethtypes.Transaction, error)
+	// SignText signs the hash of the given data prefixed with the Ethereum signed
+	// message header (EIP-191 version 0x45). The recovery id of the signature
+	// is 27 or 28.
+	SignText(address common.Address, data []byte) ([]byte, error)
+	// SignTypedData signs the EIP-712 hash of the typed data. The recovery id of
+	// the signature is 27 or 28.
+	SignTypedData(address common.Address, typedData apitypes.TypedData) ([]byte, error)
+}
@@ -0,0 +1,158 @@
+package signer
+
+import (
+	"bytes"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestSigners returns a local signer with a single key and an external
+// signer connected to it through a Clef compatible server.
+func newTestSigners(t *testing.T) (*LocalSigner, *ExternalSigner, common.Address) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	local := NewLocalSigner(key)
+	server, err := NewClefServer(local)
+	require.NoError(t, err)
+
+	httpServer := httptest.NewServer(server)
+	external := NewExternalSigner(httpServer.URL, 5*time.Second)
+	t.Cleanup(func() {
+		external.Close()
+		httpServer.Close()
+		server.Stop()
+	})
+
+	return local, external, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestExternalSignerAccounts(t *testing.T) {
+	_, external, address := newTestSigners(t)
+
+	accs, err := external.Accounts()
+	require.NoError(t, err)
+	require.Equal(t, []common.Address{address}, accs)
+}
+
+func TestExternalSignerSignTransaction(t *testing.T) {
+	_, external, address := newTestSigners(t)
+	chainID := big.NewInt(9000)
+	to := common.BytesToAddress([]byte{1})
+
+	testCases := []struct {
+		name    string
+		tx      *ethtypes.Transaction
+		from    common.Address
+		expPass bool
+	}{
+		{
+			"legacy tx",
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, To: &to, Gas: 21000, GasPrice: big.NewInt(10), Value: big.NewInt(1)}),
+			address,
+			true,
+		},
+		{
+			"access list tx",
+			ethtypes.NewTx(&ethtypes.AccessListTx{
+				ChainID: chainID, Nonce: 2, To: &to, Gas: 30000, GasPrice: big.NewInt(10),
+				AccessList: ethtypes.AccessList{{Address: to, StorageKeys: []common.Hash{{1}}}},
+			}),
+			address,
+			true,
+		},
+		{
+			"dynamic fee contract creation",
+			ethtypes.NewTx(&ethtypes.DynamicFeeTx{
+				ChainID: chainID, Nonce: 3, Gas: 100000, GasFeeCap: big.NewInt(20), GasTipCap: big.NewInt(2), Data: []byte{0x60, 0x80},
+			}),
+			address,
+			true,
+		},
+		{
+			"unknown account",
+			ethtypes.NewTx(&ethtypes.LegacyTx{Nonce: 1, To: &to, Gas: 21000, GasPrice: big.NewInt(10)}),
+			to,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		signedTx, err := external.SignTransaction(tc.from, tc.tx, chainID)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.tx.Type(), signedTx.Type(), tc.name)
+		require.Equal(t, tc.tx.Nonce(), signedTx.Nonce(), tc.name)
+		require.True(t, bytes.Equal(tc.tx.Data(), signedTx.Data()), tc.name)
+		require.Equal(t, tc.tx.To(), signedTx.To(), tc.name)
+
+		sender, err := ethtypes.Sender(ethtypes.LatestSignerForChainID(chainID), signedTx)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.from, sender, tc.name)
+	}
+}
+
+func TestExternalSignerSignText(t *testing.T) {
+	_, external, address := newTestSigners(t)
+	data := []byte("hello world")
+
+	signature, err := external.SignText(address, data)
+	require.NoError(t, err)
+	require.Len(t, signature, crypto.SignatureLength)
+	require.Contains(t, []byte{27, 28}, signature[crypto.RecoveryIDOffset])
+
+	signature[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(accounts.TextHash(data), signature)
+	require.NoError(t, err)
+	require.Equal(t, address, crypto.PubkeyToAddress(*pubKey))
+
+	_, err = external.SignText(common.BytesToAddress([]byte{1}), data)
+	require.Error(t, err)
+}
+
+func TestExternalSignerSignTypedData(t *testing.T) {
+	_, external, address := newTestSigners(t)
+
+	typedData := apitypes.TypedData{
+		Types: apitypes.Types{
+			"EIP712Domain": {{Name: "name", Type: "string"}, {Name: "chainId", Type: "uint256"}},
+			"Mail":         {{Name: "contents", Type: "string"}},
+		},
+		PrimaryType: "Mail",
+		Domain:      apitypes.TypedDataDomain{Name: "test", ChainId: math.NewHexOrDecimal256(9000)},
+		Message:     apitypes.TypedDataMessage{"contents": "hello"},
+	}
+
+	signature, err := external.SignTypedData(address, typedData)
+	require.NoError(t, err)
+
+	sigHash, _, err := apitypes.TypedDataAndHash(typedData)
+	require.NoError(t, err)
+
+	signature[crypto.RecoveryIDOffset] -= 27
+	pubKey, err := crypto.SigToPub(sigHash, signature)
+	require.NoError(t, err)
+	require.Equal(t, address, crypto.PubkeyToAddress(*pubKey))
+}
+
+func TestExternalSignerUnreachable(t *testing.T) {
+	external := NewExternalSigner("http://127.0.0.1:1", time.Second)
+	defer external.Close()
+
+	_, err := external.Accounts()
+	require.Error(t, err)
+}
@@ -0,0 +1,107 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+// TransactionConditional represents the inclusion conditions of the
+// eth_sendRawTransactionConditional method. The signature is the personal_sign
+// signature by the sender of the transaction of the hash returned by
+// ExtensionOptionsEthereumTxConditional.SigHash, so that the conditions cannot
+// be altered by the nodes relaying the transaction.
+type TransactionConditional struct {
+	KnownAccounts  map[common.Address]KnownAccount `json:"knownAccounts"`
+	BlockNumberMin *hexutil.Uint64                 `json:"blockNumberMin,omitempty"`
+	BlockNumberMax *hexutil.Uint64                 `json:"blockNumberMax,omitempty"`
+	TimestampMin   *hexutil.Uint64                 `json:"timestampMin,omitempty"`
+	TimestampMax   *hexutil.Uint64                 `json:"timestampMax,omitempty"`
+	Signature      hexutil.Bytes                   `json:"signature"`
+}
+
+// ErrStorageRootNotSupported is returned when a known account is given as a
+// storage root hash. The EVM storage is not kept in a per account trie, so the
+// storage root of an account can only be computed by iterating over all of its
+// slots, which is too expensive to be done for every conditional transaction in
+// the ante handler. The known accounts must be given as storage slots instead.
+var ErrStorageRootNotSupported = errors.New("known account storage root is not supported, use storage slots instead")
+
+// KnownAccount is the expected state of an account, given as the values of some
+// of its storage slots. Unlike in geth, the storage root of the account is not
+// accepted, see ErrStorageRootNotSupported.
+type KnownAccount struct {
+	StorageSlots map[common.Hash]common.Hash
+}
+
+// UnmarshalJSON decodes a storage slots object and rejects a storage root hash.
+func (ka *KnownAccount) UnmarshalJSON(data []byte) error {
+	var root common.Hash
+	if err := json.Unmarshal(data, &root); err == nil {
+		return ErrStorageRootNotSupported
+	}
+
+	return json.Unmarshal(data, &ka.StorageSlots)
+}
+
+// MarshalJSON encodes the storage slots.
+func (ka KnownAccount) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ka.StorageSlots)
+}
+
+// ToExtensionOption converts the conditions into the tx extension option
+// checked by the ante handler.
+func (tc TransactionConditional) ToExtensionOption() (*evmtypes.ExtensionOptionsEthereumTxConditional, error) {
+	option := &evmtypes.ExtensionOptionsEthereumTxConditional{
+		Signature: tc.Signature,
+	}
+
+	if tc.BlockNumberMin != nil {
+		option.BlockNumberMin = uint64(*tc.BlockNumberMin)
+	}
+	if tc.BlockNumberMax != nil {
+		option.BlockNumberMax = uint64(*tc.BlockNumberMax)
+	}
+	if tc.TimestampMin != nil {
+		option.TimestampMin = uint64(*tc.TimestampMin)
+	}
+	if tc.TimestampMax != nil {
+		option.TimestampMax = uint64(*tc.TimestampMax)
+	}
+
+	// sort the accounts and slots so that the resulting tx is deterministic
+	addresses := make([]common.Address, 0, len(tc.KnownAccounts))
+	for address := range tc.KnownAccounts {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].Hex() < addresses[j].Hex()
+	})
+
+	for _, address := range addresses {
+		account := tc.KnownAccounts[address]
+		storage := make(evmtypes.Storage, 0, len(account.StorageSlots))
+		for key, value := range account.StorageSlots {
+			storage = append(storage, evmtypes.NewState(key, value))
+		}
+		sort.Slice(storage, func(i, j int) bool {
+			return storage[i].Key < storage[j].Key
+		})
+
+		option.KnownAccounts = append(option.KnownAccounts, evmtypes.KnownAccount{
+			Address: address.Hex(),
+			Storage: storage,
+		})
+	}
+
+	if err := option.Validate(); err != nil {
+		return nil, err
+	}
+
+	return option, nil
+}
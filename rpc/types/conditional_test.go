@@ -0,0 +1,91 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransactionConditionalToExtensionOption(t *testing.T) {
+	testCases := []struct {
+		msg      string
+		input    string
+		malleate func(tc TransactionConditional)
+		expPass  bool
+	}{
+		{
+			"empty conditions",
+			`{}`,
+			nil,
+			true,
+		},
+		{
+			"block number, timestamp and storage slots",
+			`{
+				"blockNumberMin": "0x1",
+				"blockNumberMax": "0xa",
+				"timestampMax": "0x64",
+				"signature": "0x0102",
+				"knownAccounts": {
+					"0x0000000000000000000000000000000000000002": {
+						"0x0000000000000000000000000000000000000000000000000000000000000002": "0x0000000000000000000000000000000000000000000000000000000000000003",
+						"0x0000000000000000000000000000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000004"
+					},
+					"0x0000000000000000000000000000000000000001": {}
+				}
+			}`,
+			func(tc TransactionConditional) {
+				option, err := tc.ToExtensionOption()
+				require.NoError(t, err)
+				require.Equal(t, uint64(1), option.BlockNumberMin)
+				require.Equal(t, uint64(10), option.BlockNumberMax)
+				require.Equal(t, uint64(0), option.TimestampMin)
+				require.Equal(t, uint64(100), option.TimestampMax)
+				require.Equal(t, []byte{1, 2}, option.Signature)
+				require.Len(t, option.KnownAccounts, 2)
+				require.Equal(t, common.BytesToAddress([]byte{1}).Hex(), option.KnownAccounts[0].Address)
+				require.Equal(t, common.BytesToAddress([]byte{2}).Hex(), option.KnownAccounts[1].Address)
+				require.Len(t, option.KnownAccounts[1].Storage, 2)
+				require.Equal(t, common.BytesToHash([]byte{1}).Hex(), option.KnownAccounts[1].Storage[0].Key)
+				require.Equal(t, common.BytesToHash([]byte{4}).Hex(), option.KnownAccounts[1].Storage[0].Value)
+			},
+			true,
+		},
+		{
+			"block number min greater than max",
+			`{"blockNumberMin": "0xa", "blockNumberMax": "0x1"}`,
+			nil,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		var conditional TransactionConditional
+		require.NoError(t, json.Unmarshal([]byte(tc.input), &conditional), tc.msg)
+
+		if tc.malleate != nil {
+			tc.malleate(conditional)
+		}
+
+		_, err := conditional.ToExtensionOption()
+		if tc.expPass {
+			require.NoError(t, err, tc.msg)
+		} else {
+			require.Error(t, err, tc.msg)
+		}
+	}
+}
+
+func TestTransactionConditionalStorageRootNotSupported(t *testing.T) {
+	input := `{
+		"knownAccounts": {
+			"0x0000000000000000000000000000000000000001": "0x0000000000000000000000000000000000000000000000000000000000000001"
+		}
+	}`
+
+	var conditional TransactionConditional
+	err := json.Unmarshal([]byte(input), &conditional)
+	require.ErrorIs(t, err, ErrStorageRootNotSupported)
+}
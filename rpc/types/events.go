@@ -157,6 +157,7 @@ func ParseTxIndexerResult(txResult *tmrpctypes.ResultTx, tx sdk.Tx, getter func(
 		Failed:            parsedTx.Failed,
 		GasUsed:           parsedTx.GasUsed,
 		CumulativeGasUsed: txs.AccumulativeGasUsed(parsedTx.MsgIndex),
+		RevertReason:      TxRevertReason(&txResult.TxResult, parsedTx.MsgIndex),
 	}, nil
 }
 
@@ -0,0 +1,122 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
+)
+
+// MaxBaseFeeDelta returns the maximum base fee change of a block, assuming the
+// whole block gas limit is consumed. It is used as the suggested gas tip cap so
+// that clients can absorb the base fee changes.
+//
+//	GasTarget = GasLimit / ElasticityMultiplier
+//	Delta = BaseFee * (GasUsed - GasTarget) / GasTarget / Denominator
+//
+// The delta is at maximum when GasUsed is equal to GasLimit, which is:
+//
+//	MaxDelta = BaseFee * (ElasticityMultiplier - 1) / Denominator
+func MaxBaseFeeDelta(baseFee *big.Int, params feemarkettypes.Params) *big.Int {
+	if baseFee == nil || params.BaseFeeChangeDenominator == 0 {
+		return big.NewInt(0)
+	}
+
+	maxDelta := new(big.Int).Mul(baseFee, big.NewInt(int64(params.ElasticityMultiplier)-1))
+	maxDelta.Quo(maxDelta, big.NewInt(int64(params.BaseFeeChangeDenominator)))
+	if maxDelta.Sign() < 0 {
+		// impossible if the parameter validation passed.
+		return big.NewInt(0)
+	}
+	return maxDelta
+}
+
+// ValidateFeeArgs checks that the legacy gas price and the EIP-1559 fee fields
+// of the transaction args are not both set. It is checked before querying the
+// chain for the fee defaults.
+func ValidateFeeArgs(args evmtypes.TransactionArgs) error {
+	if args.GasPrice != nil && (args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil) {
+		return errors.New("both gasPrice and (maxFeePerGas or maxPriorityFeePerGas) specified")
+	}
+	return nil
+}
+
+// SetFeeDefaults populates the fee fields of the transaction args which are not
+// provided, given the current base fee and a gas tip cap suggestion function.
+// It is shared by the JSON-RPC eth_sendTransaction and the CLI tx defaults.
+func SetFeeDefaults(
+	args *evmtypes.TransactionArgs,
+	baseFee *big.Int,
+	suggestGasTipCap func(baseFee *big.Int) (*big.Int, error),
+) error {
+	if err := ValidateFeeArgs(*args); err != nil {
+		return err
+	}
+
+	// If user specifies both maxPriorityfee and maxFee, then we do not
+	// need to consult the chain for defaults. It's definitely a London tx.
+	if args.MaxPriorityFeePerGas == nil || args.MaxFeePerGas == nil {
+		// In this clause, user left some fields unspecified.
+		if baseFee != nil && args.GasPrice == nil {
+			if args.MaxPriorityFeePerGas == nil {
+				tip, err := suggestGasTipCap(baseFee)
+				if err != nil {
+					return err
+				}
+				args.MaxPriorityFeePerGas = (*hexutil.Big)(tip)
+			}
+
+			if args.MaxFeePerGas == nil {
+				gasFeeCap := new(big.Int).Add(
+					(*big.Int)(args.MaxPriorityFeePerGas),
+					new(big.Int).Mul(baseFee, big.NewInt(2)),
+				)
+				args.MaxFeePerGas = (*hexutil.Big)(gasFeeCap)
+			}
+		} else {
+			if args.MaxFeePerGas != nil || args.MaxPriorityFeePerGas != nil {
+				return errors.New("maxFeePerGas or maxPriorityFeePerGas specified but london is not active yet")
+			}
+
+			if args.GasPrice == nil {
+				price, err := suggestGasTipCap(baseFee)
+				if err != nil {
+					return err
+				}
+				if baseFee != nil {
+					// The legacy tx gas price suggestion should not add 2x base fee
+					// because all fees are consumed, so it would result in a spiral
+					// upwards.
+					price = new(big.Int).Add(price, baseFee)
+				}
+				args.GasPrice = (*hexutil.Big)(price)
+			}
+			return nil
+		}
+	}
+
+	// Sanity-check the internal relation of maxPriorityfee and maxFee
+	if args.MaxFeePerGas.ToInt().Cmp(args.MaxPriorityFeePerGas.ToInt()) < 0 {
+		return fmt.Errorf("maxFeePerGas (%v) < maxPriorityFeePerGas (%v)", args.MaxFeePerGas, args.MaxPriorityFeePerGas)
+	}
+	return nil
+}
+
+// EstimateGas queries the gas used by the call and rebuilds the revert error,
+// since its data is lost when returned through gRPC.
+func EstimateGas(ctx context.Context, queryClient evmtypes.QueryClient, req *evmtypes.EthCallRequest) (hexutil.Uint64, error) {
+	res, err := queryClient.EstimateGas(ctx, req)
+	if err != nil {
+		if revertErr, ok := evmtypes.RevertErrorFromMessage(err.Error()); ok {
+			return 0, revertErr
+		}
+		return 0, err
+	}
+
+	return hexutil.Uint64(res.Gas), nil
+}
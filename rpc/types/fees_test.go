@@ -0,0 +1,105 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMaxBaseFeeDelta(t *testing.T) {
+	params := feemarkettypes.DefaultParams()
+	params.ElasticityMultiplier = 2
+	params.BaseFeeChangeDenominator = 8
+
+	require.Equal(t, big.NewInt(0), MaxBaseFeeDelta(nil, params))
+	require.Equal(t, big.NewInt(125), MaxBaseFeeDelta(big.NewInt(1000), params))
+}
+
+func TestSetFeeDefaults(t *testing.T) {
+	tip := func(*big.Int) (*big.Int, error) { return big.NewInt(10), nil }
+	baseFee := big.NewInt(100)
+
+	testCases := []struct {
+		name      string
+		args      evmtypes.TransactionArgs
+		baseFee   *big.Int
+		expPass   bool
+		expMaxFee *big.Int
+		expTip    *big.Int
+		expPrice  *big.Int
+	}{
+		{
+			"pass - dynamic fee defaults",
+			evmtypes.TransactionArgs{},
+			baseFee,
+			true,
+			big.NewInt(210),
+			big.NewInt(10),
+			nil,
+		},
+		{
+			"pass - legacy gas price without base fee",
+			evmtypes.TransactionArgs{},
+			nil,
+			true,
+			nil,
+			nil,
+			big.NewInt(10),
+		},
+		{
+			"pass - legacy gas price is kept",
+			evmtypes.TransactionArgs{GasPrice: (*hexutil.Big)(big.NewInt(7))},
+			baseFee,
+			true,
+			nil,
+			nil,
+			big.NewInt(7),
+		},
+		{
+			"fail - both gas price and max fee",
+			evmtypes.TransactionArgs{GasPrice: (*hexutil.Big)(big.NewInt(7)), MaxFeePerGas: (*hexutil.Big)(big.NewInt(7))},
+			baseFee,
+			false,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"fail - max fee lower than tip",
+			evmtypes.TransactionArgs{MaxFeePerGas: (*hexutil.Big)(big.NewInt(1)), MaxPriorityFeePerGas: (*hexutil.Big)(big.NewInt(2))},
+			baseFee,
+			false,
+			nil,
+			nil,
+			nil,
+		},
+		{
+			"fail - dynamic fee without base fee",
+			evmtypes.TransactionArgs{MaxFeePerGas: (*hexutil.Big)(big.NewInt(1))},
+			nil,
+			false,
+			nil,
+			nil,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			args := tc.args
+			err := SetFeeDefaults(&args, tc.baseFee, tip)
+			if !tc.expPass {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expMaxFee, (*big.Int)(args.MaxFeePerGas))
+			require.Equal(t, tc.expTip, (*big.Int)(args.MaxPriorityFeePerGas))
+			require.Equal(t, tc.expPrice, (*big.Int)(args.GasPrice))
+		})
+	}
+}
@@ -269,3 +269,27 @@ func TxExceedBlockGasLimit(res *abci.ResponseDeliverTx) bool {
 func TxSuccessOrExceedsBlockGasLimit(res *abci.ResponseDeliverTx) bool {
 	return res.Code == 0 || TxExceedBlockGasLimit(res)
 }
+
+// TxRevertReason returns the decoded revert reason of the ethereum msg at the
+// given index if its execution was reverted, or the hex encoded revert data if
+// it can't be decoded. It returns an empty string otherwise.
+func TxRevertReason(res *abci.ResponseDeliverTx, msgIndex int) string {
+	if res.Code != abci.CodeTypeOK {
+		return ""
+	}
+
+	responses, err := evmtypes.DecodeTxResponses(res.Data)
+	if err != nil || msgIndex >= len(responses) {
+		return ""
+	}
+
+	revert := responses[msgIndex].Revert()
+	if len(revert) == 0 {
+		return ""
+	}
+
+	if reason, ok := evmtypes.DecodeRevertReason(revert); ok {
+		return reason
+	}
+	return hexutil.Encode(revert)
+}
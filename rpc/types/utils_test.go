@@ -0,0 +1,84 @@
+package types
+
+import (
+	"testing"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTxRevertReason(t *testing.T) {
+	// Error(string) with "not owner" as reason
+	revertData := hexutil.MustDecode("0x08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000009" +
+		"6e6f74206f776e65720000000000000000000000000000000000000000000000")
+
+	encode := func(responses ...*evmtypes.MsgEthereumTxResponse) []byte {
+		txMsgData := sdk.TxMsgData{}
+		for _, res := range responses {
+			anyRes, err := codectypes.NewAnyWithValue(res)
+			require.NoError(t, err)
+			txMsgData.MsgResponses = append(txMsgData.MsgResponses, anyRes)
+		}
+		bz, err := proto.Marshal(&txMsgData)
+		require.NoError(t, err)
+		return bz
+	}
+
+	testCases := []struct {
+		name      string
+		response  abci.ResponseDeliverTx
+		msgIndex  int
+		expReason string
+	}{
+		{
+			"failed cosmos tx",
+			abci.ResponseDeliverTx{Code: 11},
+			0,
+			"",
+		},
+		{
+			"successful execution",
+			abci.ResponseDeliverTx{Data: encode(&evmtypes.MsgEthereumTxResponse{Ret: []byte{1}})},
+			0,
+			"",
+		},
+		{
+			"reverted with decodable reason",
+			abci.ResponseDeliverTx{Data: encode(
+				&evmtypes.MsgEthereumTxResponse{},
+				&evmtypes.MsgEthereumTxResponse{VmError: vm.ErrExecutionReverted.Error(), Ret: revertData},
+			)},
+			1,
+			"not owner",
+		},
+		{
+			"reverted with unknown data",
+			abci.ResponseDeliverTx{Data: encode(
+				&evmtypes.MsgEthereumTxResponse{VmError: vm.ErrExecutionReverted.Error(), Ret: []byte{1, 2, 3, 4}},
+			)},
+			0,
+			"0x01020304",
+		},
+		{
+			"msg index out of range",
+			abci.ResponseDeliverTx{Data: encode(
+				&evmtypes.MsgEthereumTxResponse{VmError: vm.ErrExecutionReverted.Error(), Ret: revertData},
+			)},
+			1,
+			"",
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		require.Equal(t, tc.expReason, TxRevertReason(&tc.response, tc.msgIndex), tc.name)
+	}
+}
@@ -31,6 +31,7 @@ import (
 	rpcfilters "github.com/anryton/anryton/v2/rpc/namespaces/ethereum/eth/filters"
 	"github.com/anryton/anryton/v2/rpc/types"
 	"github.com/anryton/anryton/v2/server/config"
+	anrytontypes "github.com/anryton/anryton/v2/types"
 	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
 )
 
@@ -84,7 +85,7 @@ func NewWebsocketsServer(clientCtx client.Context, logger log.Logger, tmWSClient
 		wsAddr:   cfg.JSONRPC.WsAddress,
 		certFile: cfg.TLS.CertificatePath,
 		keyFile:  cfg.TLS.KeyPath,
-		api:      newPubSubAPI(clientCtx, logger, tmWSClient),
+		api:      newPubSubAPI(clientCtx, logger, tmWSClient, cfg.JSONRPC.FilterCap),
 		logger:   logger,
 	}
 }
@@ -342,16 +343,52 @@ type pubSubAPI struct {
 	events    *rpcfilters.EventSystem
 	logger    log.Logger
 	clientCtx client.Context
+	chainID   *big.Int
+
+	// filterCap is the max number of active pending txs and syncing subscriptions
+	filterCap int32
+	subsMu    sync.Mutex
+	subs      int32
 }
 
 // newPubSubAPI creates an instance of the ethereum PubSub API.
-func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient) *pubSubAPI {
+func newPubSubAPI(clientCtx client.Context, logger log.Logger, tmWSClient *rpcclient.WSClient, filterCap int32) *pubSubAPI {
 	logger = logger.With("module", "websocket-client")
+
+	chainID, err := anrytontypes.ParseChainID(clientCtx.ChainID)
+	if err != nil {
+		logger.Debug("failed to parse chain id", "chain-id", clientCtx.ChainID, "error", err.Error())
+	}
+
 	return &pubSubAPI{
 		events:    rpcfilters.NewEventSystem(logger, tmWSClient),
 		logger:    logger,
 		clientCtx: clientCtx,
+		chainID:   chainID,
+		filterCap: filterCap,
+	}
+}
+
+// reserveSubscription accounts for a new subscription if the number of active
+// subscriptions is below the filter cap. The returned function releases the
+// subscription slot.
+func (api *pubSubAPI) reserveSubscription() (func(), error) {
+	api.subsMu.Lock()
+	defer api.subsMu.Unlock()
+
+	if api.subs >= api.filterCap {
+		return nil, errors.New("max limit reached")
 	}
+	api.subs++
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			api.subsMu.Lock()
+			api.subs--
+			api.subsMu.Unlock()
+		})
+	}, nil
 }
 
 func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface{}) (pubsub.UnsubscribeFunc, error) {
@@ -370,7 +407,14 @@ func (api *pubSubAPI) subscribe(wsConn *wsConn, subID rpc.ID, params []interface
 		}
 		return api.subscribeLogs(wsConn, subID, nil)
 	case "newPendingTransactions":
-		return api.subscribePendingTransactions(wsConn, subID)
+		var fullTx bool
+		if len(params) > 1 {
+			fullTx, ok = params[1].(bool)
+			if !ok {
+				return nil, errors.New("invalid parameters: fullTx must be a boolean")
+			}
+		}
+		return api.subscribePendingTransactions(wsConn, subID, fullTx)
 	case "syncing":
 		return api.subscribeSyncing(wsConn, subID)
 	default:
@@ -618,9 +662,15 @@ func (api *pubSubAPI) subscribeLogs(wsConn *wsConn, subID rpc.ID, extra interfac
 	return unsubFn, nil
 }
 
-func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID, fullTx bool) (pubsub.UnsubscribeFunc, error) {
+	release, err := api.reserveSubscription()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating pending tx subscription")
+	}
+
 	sub, unsubFn, err := api.events.SubscribePendingTxs()
 	if err != nil {
+		release()
 		return nil, errors.Wrap(err, "error creating block filter: %s")
 	}
 
@@ -636,20 +686,20 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 					continue
 				}
 
-				ethTxs, err := types.RawTxToEthTx(api.clientCtx, data.Tx)
+				txs, err := rpcfilters.PendingTxNotifications(api.clientCtx, data.Tx, fullTx, api.chainID)
 				if err != nil {
-					// not ethereum tx
+					api.logger.Debug("fail to decode tx", "error", err.Error())
 					continue
 				}
 
-				for _, ethTx := range ethTxs {
+				for _, tx := range txs {
 					// write to ws conn
 					res := &SubscriptionNotification{
 						Jsonrpc: "2.0",
 						Method:  "eth_subscription",
 						Params: &SubscriptionResult{
 							Subscription: subID,
-							Result:       ethTx.Hash,
+							Result:       tx,
 						},
 					}
 
@@ -673,11 +723,45 @@ func (api *pubSubAPI) subscribePendingTransactions(wsConn *wsConn, subID rpc.ID)
 		}
 	}()
 
-	return unsubFn, nil
+	return func() {
+		unsubFn()
+		release()
+	}, nil
 }
 
-func (api *pubSubAPI) subscribeSyncing(_ *wsConn, _ rpc.ID) (pubsub.UnsubscribeFunc, error) {
-	return nil, errors.New("syncing subscription is not implemented")
+func (api *pubSubAPI) subscribeSyncing(wsConn *wsConn, subID rpc.ID) (pubsub.UnsubscribeFunc, error) {
+	release, err := api.reserveSubscription()
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating syncing subscription")
+	}
+
+	ctx, cancelFn := context.WithCancel(context.Background())
+	go rpcfilters.PollSyncing(ctx, api.clientCtx.Client, func(result interface{}) {
+		// write to ws conn
+		res := &SubscriptionNotification{
+			Jsonrpc: "2.0",
+			Method:  "eth_subscription",
+			Params: &SubscriptionResult{
+				Subscription: subID,
+				Result:       result,
+			},
+		}
+
+		if err := wsConn.WriteJSON(res); err != nil {
+			api.logger.Debug("error writing syncing status, will drop peer", "error", err.Error())
+
+			try(func() {
+				if err != websocket.ErrCloseSent {
+					_ = wsConn.Close() // #nosec G703
+				}
+			}, api.logger, "closing websocket peer sub")
+		}
+	})
+
+	return func() {
+		cancelFn()
+		release()
+	}, nil
 }
 
 // copy from github.com/ethereum/go-ethereum/rpc/json.go
@@ -55,6 +55,12 @@ const (
 	// DefaultMaxTxGasWanted is the default gas wanted for each eth tx returned in ante handler in check tx mode
 	DefaultMaxTxGasWanted = 0
 
+	// DefaultMempoolPriceBump is the default minimum price bump, in percent, to replace a tx in the mempool
+	DefaultMempoolPriceBump uint64 = 10
+
+	// DefaultMempoolLifetime is the default maximum time a tx is kept in the mempool
+	DefaultMempoolLifetime = 3 * time.Hour
+
 	// DefaultGasCap is the default cap on gas that can be used in eth_call/estimateGas
 	DefaultGasCap uint64 = 25000000
 
@@ -90,6 +96,15 @@ const (
 
 	// DefaultGasAdjustment value to use as default in gas-adjustment flag
 	DefaultGasAdjustment = 1.2
+
+	// DefaultQueryCacheSize is the default number of historical query results kept in the JSON-RPC cache
+	DefaultQueryCacheSize = 4096
+
+	// DefaultQueryCacheTTL is the default lifetime of a cached historical query result
+	DefaultQueryCacheTTL = 10 * time.Minute
+
+	// DefaultExternalSignerTimeout is the default timeout of the requests sent to the external signer
+	DefaultExternalSignerTimeout = 60 * time.Second
 )
 
 var evmTracers = []string{"json", "markdown", "struct", "access_list"}
@@ -111,6 +126,10 @@ type EVMConfig struct {
 	Tracer string `mapstructure:"tracer"`
 	// MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 	MaxTxGasWanted uint64 `mapstructure:"max-tx-gas-wanted"`
+	// MempoolPriceBump defines the minimum price bump, in percent, to replace a tx of the same sender and nonce in the mempool.
+	MempoolPriceBump uint64 `mapstructure:"mempool-price-bump"`
+	// MempoolLifetime defines the maximum time a tx is kept in the mempool.
+	MempoolLifetime time.Duration `mapstructure:"mempool-lifetime"`
 }
 
 // JSONRPCConfig defines configuration for the EVM RPC server.
@@ -153,6 +172,17 @@ type JSONRPCConfig struct {
 	MetricsAddress string `mapstructure:"metrics-address"`
 	// FixRevertGasRefundHeight defines the upgrade height for fix of revert gas refund logic when transaction reverted
 	FixRevertGasRefundHeight int64 `mapstructure:"fix-revert-gas-refund-height"`
+	// QueryCacheSize defines the max number of immutable historical query results (blocks, receipts,
+	// block results and state queries at past heights) kept in memory. Set to 0 to disable the cache.
+	QueryCacheSize int `mapstructure:"query-cache-size"`
+	// QueryCacheTTL defines how long a cached historical query result is kept (0 = no expiry).
+	QueryCacheTTL time.Duration `mapstructure:"query-cache-ttl"`
+	// ExternalSigner defines the Clef compatible external signer endpoint (http(s)://, ws(s):// or IPC path)
+	// used to sign instead of the node's keyring. Leave empty to use the keyring.
+	ExternalSigner string `mapstructure:"external-signer"`
+	// ExternalSignerTimeout is the timeout of the requests sent to the external signer, which may wait
+	// for a manual approval.
+	ExternalSignerTimeout time.Duration `mapstructure:"external-signer-timeout"`
 }
 
 // TLSConfig defines the certificate and matching private key for the server.
@@ -211,8 +241,10 @@ func DefaultConfig() *Config {
 // DefaultEVMConfig returns the default EVM configuration
 func DefaultEVMConfig() *EVMConfig {
 	return &EVMConfig{
-		Tracer:         DefaultEVMTracer,
-		MaxTxGasWanted: DefaultMaxTxGasWanted,
+		Tracer:           DefaultEVMTracer,
+		MaxTxGasWanted:   DefaultMaxTxGasWanted,
+		MempoolPriceBump: DefaultMempoolPriceBump,
+		MempoolLifetime:  DefaultMempoolLifetime,
 	}
 }
 
@@ -222,6 +254,10 @@ func (c EVMConfig) Validate() error {
 		return fmt.Errorf("invalid tracer type %s, available types: %v", c.Tracer, evmTracers)
 	}
 
+	if c.MempoolLifetime < 0 {
+		return fmt.Errorf("mempool lifetime cannot be negative: %s", c.MempoolLifetime)
+	}
+
 	return nil
 }
 
@@ -256,6 +292,9 @@ func DefaultJSONRPCConfig() *JSONRPCConfig {
 		EnableIndexer:            false,
 		MetricsAddress:           DefaultJSONRPCMetricsAddress,
 		FixRevertGasRefundHeight: DefaultFixRevertGasRefundHeight,
+		QueryCacheSize:           DefaultQueryCacheSize,
+		QueryCacheTTL:            DefaultQueryCacheTTL,
+		ExternalSignerTimeout:    DefaultExternalSignerTimeout,
 	}
 }
 
@@ -297,6 +336,18 @@ func (c JSONRPCConfig) Validate() error {
 		return errors.New("JSON-RPC HTTP idle timeout duration cannot be negative")
 	}
 
+	if c.QueryCacheSize < 0 {
+		return errors.New("JSON-RPC query cache size cannot be negative")
+	}
+
+	if c.QueryCacheTTL < 0 {
+		return errors.New("JSON-RPC query cache TTL cannot be negative")
+	}
+
+	if c.ExternalSignerTimeout < 0 {
+		return errors.New("JSON-RPC external signer timeout duration cannot be negative")
+	}
+
 	// check for duplicates
 	seenAPIs := make(map[string]bool)
 	for _, api := range c.API {
@@ -16,6 +16,12 @@ tracer = "{{ .EVM.Tracer }}"
 # MaxTxGasWanted defines the gas wanted for each eth tx returned in ante handler in check tx mode.
 max-tx-gas-wanted = {{ .EVM.MaxTxGasWanted }}
 
+# MempoolPriceBump defines the minimum price bump, in percent, to replace a tx of the same sender and nonce in the mempool.
+mempool-price-bump = {{ .EVM.MempoolPriceBump }}
+
+# MempoolLifetime defines the maximum time a tx is kept in the mempool.
+mempool-lifetime = "{{ .EVM.MempoolLifetime }}"
+
 ###############################################################################
 ###                           JSON RPC Configuration                        ###
 ###############################################################################
@@ -80,6 +86,20 @@ metrics-address = "{{ .JSONRPC.MetricsAddress }}"
 # Upgrade height for fix of revert gas refund logic when transaction reverted.
 fix-revert-gas-refund-height = {{ .JSONRPC.FixRevertGasRefundHeight }}
 
+# QueryCacheSize defines the max number of immutable historical query results (blocks, receipts,
+# block results and state queries at past heights) cached in memory (0 = disabled).
+query-cache-size = {{ .JSONRPC.QueryCacheSize }}
+
+# QueryCacheTTL defines how long a cached historical query result is kept (0 = no expiry).
+query-cache-ttl = "{{ .JSONRPC.QueryCacheTTL }}"
+
+# ExternalSigner defines the Clef compatible external signer endpoint (http(s)://, ws(s):// or IPC path)
+# used to sign instead of the node's keyring. Leave empty to use the keyring.
+external-signer = "{{ .JSONRPC.ExternalSigner }}"
+
+# ExternalSignerTimeout is the timeout of the requests sent to the external signer.
+external-signer-timeout = "{{ .JSONRPC.ExternalSignerTimeout }}"
+
 ###############################################################################
 ###                             TLS Configuration                           ###
 ###############################################################################
@@ -55,12 +55,18 @@ const (
 	// https://github.com/ethereum/go-ethereum/blob/master/metrics/metrics.go#L35-L55
 	JSONRPCEnableMetrics            = "metrics"
 	JSONRPCFixRevertGasRefundHeight = "json-rpc.fix-revert-gas-refund-height"
+	JSONRPCQueryCacheSize           = "json-rpc.query-cache-size"
+	JSONRPCQueryCacheTTL            = "json-rpc.query-cache-ttl"
+	JSONRPCExternalSigner           = "json-rpc.external-signer"
+	JSONRPCExternalSignerTimeout    = "json-rpc.external-signer-timeout"
 )
 
 // EVM flags
 const (
-	EVMTracer         = "evm.tracer"
-	EVMMaxTxGasWanted = "evm.max-tx-gas-wanted"
+	EVMTracer           = "evm.tracer"
+	EVMMaxTxGasWanted   = "evm.max-tx-gas-wanted"
+	EVMMempoolPriceBump = "evm.mempool-price-bump"
+	EVMMempoolLifetime  = "evm.mempool-lifetime"
 )
 
 // TLS flags
@@ -195,10 +195,16 @@ which accepts a path for the resulting pprof file.
 	cmd.Flags().Int32(srvflags.JSONRPCBlockRangeCap, config.DefaultBlockRangeCap, "Sets the max block range allowed for `eth_getLogs` query")
 	cmd.Flags().Int(srvflags.JSONRPCMaxOpenConnections, config.DefaultMaxOpenConnections, "Sets the maximum number of simultaneous connections for the server listener") //nolint:lll
 	cmd.Flags().Bool(srvflags.JSONRPCEnableIndexer, false, "Enable the custom tx indexer for json-rpc")
+	cmd.Flags().Int(srvflags.JSONRPCQueryCacheSize, config.DefaultQueryCacheSize, "Sets the max number of historical query results cached by the json-rpc server (0=disabled)") //nolint:lll
+	cmd.Flags().Duration(srvflags.JSONRPCQueryCacheTTL, config.DefaultQueryCacheTTL, "Sets the lifetime of cached historical json-rpc query results (0=no expiry)")
+	cmd.Flags().String(srvflags.JSONRPCExternalSigner, "", "Sets the Clef compatible external signer endpoint used instead of the node's keyring")
+	cmd.Flags().Duration(srvflags.JSONRPCExternalSignerTimeout, config.DefaultExternalSignerTimeout, "Sets the timeout of the requests sent to the external signer")
 	cmd.Flags().Bool(srvflags.JSONRPCEnableMetrics, false, "Define if EVM rpc metrics server should be enabled")
 
 	cmd.Flags().String(srvflags.EVMTracer, config.DefaultEVMTracer, "the EVM tracer type to collect execution traces from the EVM transaction execution (json|struct|access_list|markdown)") //nolint:lll
 	cmd.Flags().Uint64(srvflags.EVMMaxTxGasWanted, config.DefaultMaxTxGasWanted, "the gas wanted for each eth tx returned in ante handler in check tx mode")                                 //nolint:lll
+	cmd.Flags().Uint64(srvflags.EVMMempoolPriceBump, config.DefaultMempoolPriceBump, "the minimum price bump, in percent, to replace a tx of the same sender and nonce in the mempool")
+	cmd.Flags().Duration(srvflags.EVMMempoolLifetime, config.DefaultMempoolLifetime, "the maximum time a tx is kept in the mempool")
 
 	cmd.Flags().String(srvflags.TLSCertPath, "", "the cert.pem file path for the server TLS configuration")
 	cmd.Flags().String(srvflags.TLSKeyPath, "", "the key.pem file path for the server TLS configuration")
@@ -548,7 +554,13 @@ func startInProcess(ctx *server.Context, clientCtx client.Context, opts StartOpt
 
 		tmEndpoint := "/websocket"
 		tmRPCAddr := cfg.RPC.ListenAddress
-		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer)
+		// the txpool namespace reads the application mempool when the app exposes it
+		var txPool anrytontypes.EVMTxPool
+		if provider, ok := app.(anrytontypes.EVMTxPoolProvider); ok {
+			txPool = provider.EVMTxPool()
+		}
+
+		httpSrv, httpSrvDone, err = StartJSONRPC(ctx, clientCtx, tmRPCAddr, tmEndpoint, &config, idxer, txPool)
 		if err != nil {
 			return err
 		}
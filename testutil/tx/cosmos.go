@@ -54,6 +54,8 @@ func PrepareCosmosTx(
 	var fees sdk.Coins
 	if args.GasPrice != nil {
 		fees = sdk.Coins{{Denom: utils.BaseDenom, Amount: args.GasPrice.MulRaw(int64(args.Gas))}}
+	} else if args.Fees != nil {
+		fees = args.Fees
 	} else {
 		fees = sdk.Coins{DefaultFee}
 	}
@@ -0,0 +1,37 @@
+package dbg
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	proto2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/anryton/anryton/v2/app"
+)
+
+var _ = app.Anryton{}
+
+func TestX(t *testing.T) {
+	for name := range proto.AllFileDescriptors() {
+		if !strings.HasPrefix(name, "anryton/") && !strings.HasPrefix(name, "ethermint/") && !strings.HasPrefix(name, "shido/") {
+			continue
+		}
+		b := proto.FileDescriptor(name)
+		r, _ := gzip.NewReader(bytes.NewReader(b))
+		u, _ := io.ReadAll(r)
+		fdRaw := &descriptorpb.FileDescriptorProto{}
+		proto2.Unmarshal(u, fdRaw)
+		for _, m := range fdRaw.MessageType {
+			full := fdRaw.GetPackage() + "." + m.GetName()
+			if proto.MessageType(full) == nil {
+				t.Log(name, fdRaw.GetName(), full)
+				break
+			}
+		}
+	}
+}
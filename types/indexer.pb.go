@@ -42,6 +42,8 @@ type TxResult struct {
 	// cumulative_gas_used specifies the cumulated amount of gas used for all
 	// processed messages within the current batch transaction.
 	CumulativeGasUsed uint64 `protobuf:"varint,7,opt,name=cumulative_gas_used,json=cumulativeGasUsed,proto3" json:"cumulative_gas_used,omitempty"`
+	// revert_reason is the decoded reason of a reverted transaction
+	RevertReason string `protobuf:"bytes,8,opt,name=revert_reason,json=revertReason,proto3" json:"revert_reason,omitempty"`
 }
 
 func (m *TxResult) Reset()         { *m = TxResult{} }
@@ -84,26 +86,28 @@ func init() {
 func init() { proto.RegisterFile("ethermint/types/v1/indexer.proto", fileDescriptor_1197e10a8be8ed28) }
 
 var fileDescriptor_1197e10a8be8ed28 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x90, 0xb1, 0x4e, 0xf3, 0x30,
-	0x14, 0x85, 0xe3, 0xbf, 0x6d, 0x9a, 0xdf, 0x82, 0x81, 0x80, 0xaa, 0x00, 0x92, 0x6b, 0x31, 0x65,
-	0x4a, 0x54, 0x98, 0x60, 0x64, 0x41, 0xac, 0x56, 0x59, 0x58, 0xa2, 0xb4, 0xb9, 0xd8, 0x96, 0x9a,
-	0xba, 0xaa, 0x6f, 0xa2, 0xf0, 0x06, 0x8c, 0x3c, 0x02, 0x8f, 0xc3, 0xd8, 0x91, 0x11, 0xb5, 0xe2,
-	0x3d, 0x50, 0x9d, 0xa8, 0x2c, 0x96, 0x8f, 0xbe, 0xef, 0xea, 0x48, 0x87, 0x72, 0x40, 0x05, 0xeb,
-	0x52, 0x2f, 0x31, 0xc5, 0xd7, 0x15, 0xd8, 0xb4, 0x9e, 0xa4, 0x7a, 0x59, 0x40, 0x03, 0xeb, 0x64,
-	0xb5, 0x36, 0x68, 0xc2, 0xf0, 0x60, 0x24, 0xce, 0x48, 0xea, 0xc9, 0xc5, 0x99, 0x34, 0xd2, 0x38,
-	0x9c, 0xee, 0x7f, 0xad, 0x79, 0xf5, 0x43, 0x68, 0x30, 0x6d, 0x04, 0xd8, 0x6a, 0x81, 0xe1, 0x88,
-	0xfa, 0x0a, 0xb4, 0x54, 0x18, 0x11, 0x4e, 0xe2, 0x9e, 0xe8, 0x52, 0x78, 0x4e, 0x03, 0x6c, 0x32,
-	0x57, 0x11, 0xfd, 0xe3, 0x24, 0x3e, 0x16, 0x43, 0x6c, 0x1e, 0xf7, 0x31, 0xbc, 0xa4, 0xff, 0x4b,
-	0x2b, 0x3b, 0xd6, 0x73, 0x2c, 0x28, 0xad, 0x6c, 0x21, 0xa7, 0x47, 0x80, 0x2a, 0x3b, 0xdc, 0xf6,
-	0x39, 0x89, 0x07, 0x82, 0x02, 0xaa, 0x69, 0x77, 0x3e, 0xa2, 0xfe, 0x4b, 0xae, 0x17, 0x50, 0x44,
-	0x03, 0x4e, 0xe2, 0x40, 0x74, 0x69, 0xdf, 0x28, 0x73, 0x9b, 0x55, 0x16, 0x8a, 0xc8, 0xe7, 0x24,
-	0xee, 0x8b, 0xa1, 0xcc, 0xed, 0x93, 0x85, 0x22, 0x4c, 0xe8, 0xe9, 0xbc, 0x2a, 0xab, 0x45, 0x8e,
-	0xba, 0x86, 0xec, 0x60, 0x0d, 0x9d, 0x75, 0xf2, 0x87, 0x1e, 0x5a, 0xff, 0xae, 0xff, 0xf6, 0x31,
-	0xf6, 0xee, 0x6f, 0x3f, 0xb7, 0x8c, 0x6c, 0xb6, 0x8c, 0x7c, 0x6f, 0x19, 0x79, 0xdf, 0x31, 0x6f,
-	0xb3, 0x63, 0xde, 0xd7, 0x8e, 0x79, 0xcf, 0x63, 0xa9, 0x51, 0x55, 0xb3, 0x64, 0x6e, 0xca, 0xd4,
-	0x2a, 0x5d, 0x98, 0xee, 0xad, 0xaf, 0xdb, 0x75, 0x67, 0xbe, 0x5b, 0xea, 0xe6, 0x37, 0x00, 0x00,
-	0xff, 0xff, 0xcb, 0x87, 0x3b, 0xa7, 0x77, 0x01, 0x00, 0x00,
+	// 321 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x44, 0x91, 0xbd, 0x4e, 0xf3, 0x30,
+	0x14, 0x86, 0xe3, 0xfe, 0xa4, 0xa9, 0xd5, 0x0e, 0x5f, 0x3e, 0x54, 0x05, 0x90, 0x82, 0x45, 0x97,
+	0x4c, 0x89, 0x0a, 0x1b, 0x12, 0x0b, 0x0b, 0x62, 0xb5, 0xca, 0xc2, 0x12, 0xa5, 0xcd, 0xc1, 0x89,
+	0xd4, 0x24, 0x95, 0x7d, 0x12, 0xa5, 0x77, 0x80, 0x98, 0xb8, 0x04, 0x2e, 0x87, 0xb1, 0x23, 0x23,
+	0x6a, 0x6f, 0x04, 0xd5, 0x89, 0xc2, 0x64, 0xbf, 0xe7, 0x79, 0x8e, 0x5e, 0xc9, 0xa6, 0x0c, 0x30,
+	0x01, 0x99, 0xa5, 0x39, 0x06, 0xb8, 0xdb, 0x82, 0x0a, 0xaa, 0x45, 0x90, 0xe6, 0x31, 0xd4, 0x20,
+	0xfd, 0xad, 0x2c, 0xb0, 0xb0, 0xed, 0xce, 0xf0, 0xb5, 0xe1, 0x57, 0x8b, 0x8b, 0x33, 0x51, 0x88,
+	0x42, 0xe3, 0xe0, 0x74, 0x6b, 0xcc, 0xeb, 0xf7, 0x1e, 0xb5, 0x96, 0x35, 0x07, 0x55, 0x6e, 0xd0,
+	0x9e, 0x51, 0x33, 0x81, 0x54, 0x24, 0xe8, 0x10, 0x46, 0xbc, 0x3e, 0x6f, 0x93, 0x7d, 0x4e, 0x2d,
+	0xac, 0x43, 0x5d, 0xe1, 0xf4, 0x18, 0xf1, 0xa6, 0x7c, 0x84, 0xf5, 0xd3, 0x29, 0xda, 0x97, 0x74,
+	0x9c, 0x29, 0xd1, 0xb2, 0xbe, 0x66, 0x56, 0xa6, 0x44, 0x03, 0x19, 0x9d, 0x00, 0x26, 0x61, 0xb7,
+	0x3b, 0x60, 0xc4, 0x1b, 0x72, 0x0a, 0x98, 0x2c, 0xdb, 0xf5, 0x19, 0x35, 0x5f, 0xa3, 0x74, 0x03,
+	0xb1, 0x33, 0x64, 0xc4, 0xb3, 0x78, 0x9b, 0x4e, 0x8d, 0x22, 0x52, 0x61, 0xa9, 0x20, 0x76, 0x4c,
+	0x46, 0xbc, 0x01, 0x1f, 0x89, 0x48, 0x3d, 0x2b, 0x88, 0x6d, 0x9f, 0xfe, 0x5f, 0x97, 0x59, 0xb9,
+	0x89, 0x30, 0xad, 0x20, 0xec, 0xac, 0x91, 0xb6, 0xfe, 0xfd, 0xa1, 0xc7, 0xd6, 0x9f, 0xd3, 0xa9,
+	0x84, 0x0a, 0x24, 0x86, 0x12, 0x22, 0x55, 0xe4, 0x8e, 0xc5, 0x88, 0x37, 0xe6, 0x93, 0x66, 0xc8,
+	0xf5, 0xec, 0x6e, 0xf0, 0xf6, 0x79, 0x65, 0x3c, 0xdc, 0x7f, 0x1d, 0x5c, 0xb2, 0x3f, 0xb8, 0xe4,
+	0xe7, 0xe0, 0x92, 0x8f, 0xa3, 0x6b, 0xec, 0x8f, 0xae, 0xf1, 0x7d, 0x74, 0x8d, 0x97, 0xb9, 0x48,
+	0x31, 0x29, 0x57, 0xfe, 0xba, 0xc8, 0x82, 0x28, 0x97, 0x3b, 0x2c, 0xf2, 0xee, 0xac, 0x6e, 0x9a,
+	0x6f, 0x58, 0x99, 0xfa, 0x49, 0x6f, 0x7f, 0x07, 0x00, 0xf8, 0x2d, 0x24, 0x0d, 0xa0, 0x01, 0x00,
+	0x00,
 }
 
 func (m *TxResult) Marshal() (dAtA []byte, err error) {
@@ -126,6 +130,13 @@ func (m *TxResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RevertReason) > 0 {
+		i -= len(m.RevertReason)
+		copy(dAtA[i:], m.RevertReason)
+		i = encodeVarintIndexer(dAtA, i, uint64(len(m.RevertReason)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if m.CumulativeGasUsed != 0 {
 		i = encodeVarintIndexer(dAtA, i, uint64(m.CumulativeGasUsed))
 		i--
@@ -207,6 +218,10 @@ func (m *TxResult) Size() (n int) {
 	if m.CumulativeGasUsed != 0 {
 		n += 1 + sovIndexer(uint64(m.CumulativeGasUsed))
 	}
+	l = len(m.RevertReason)
+	if l > 0 {
+		n += 1 + l + sovIndexer(uint64(l))
+	}
 	return n
 }
 
@@ -379,6 +394,38 @@ func (m *TxResult) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowIndexer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthIndexer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipIndexer(dAtA[iNdEx:])
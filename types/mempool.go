@@ -0,0 +1,21 @@
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// EVMTxPool defines the interface of the application mempool serving the txpool
+// JSON-RPC namespace.
+type EVMTxPool interface {
+	// Content returns the Ethereum txs grouped by sender, split into the pending
+	// and the queued ones.
+	Content() (pending, queued map[common.Address]ethtypes.Transactions)
+}
+
+// EVMTxPoolProvider defines the interface of the applications exposing their
+// mempool to the txpool JSON-RPC namespace.
+type EVMTxPoolProvider interface {
+	// EVMTxPool returns nil if the application mempool is disabled.
+	EVMTxPool() EVMTxPool
+}
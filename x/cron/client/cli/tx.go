@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+
+	"github.com/anryton/anryton/v2/x/cron/types"
+)
+
+// NewTxCmd returns a root CLI command handler for cron transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "cron subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterJobCmd(),
+		NewCancelJobCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterJobCmd returns a CLI command handler for registering a job
+func NewRegisterJobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-job EPOCH_IDENTIFIER CONTRACT_ADDRESS MSG GAS_LIMIT [NONCES]",
+		Short: "Register a contract call executed at the end of every epoch",
+		Long:  "Register a contract call executed at the end of every epoch. MSG is the hex encoded calldata of an EVM contract or the JSON sudo message of a CosmWasm contract. NONCES is the comma separated chain of deployment nonces from the sender to the EVM contract.",
+		Args:  cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := []byte(args[2])
+			if common.IsHexAddress(args[1]) {
+				msg, err = hexutil.Decode(args[2])
+				if err != nil {
+					return fmt.Errorf("invalid calldata %s: %w", args[2], err)
+				}
+			}
+
+			gasLimit, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid gas limit %s: %w", args[3], err)
+			}
+
+			var nonces []uint64
+			if len(args) == 5 {
+				for _, nonce := range strings.Split(args[4], ",") {
+					n, err := strconv.ParseUint(strings.TrimSpace(nonce), 10, 64)
+					if err != nil {
+						return fmt.Errorf("invalid nonce %s: %w", nonce, err)
+					}
+					nonces = append(nonces, n)
+				}
+			}
+
+			registerMsg := types.NewMsgRegisterJob(cliCtx.GetFromAddress(), args[0], args[1], msg, gasLimit, nonces)
+			if err := registerMsg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), registerMsg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelJobCmd returns a CLI command handler for removing a job
+func NewCancelJobCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-job ID",
+		Short: "Remove a job registered by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid job id %s: %w", args[0], err)
+			}
+
+			msg := types.NewMsgCancelJob(cliCtx.GetFromAddress(), id)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
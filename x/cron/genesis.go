@@ -0,0 +1,40 @@
+package cron
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/cron/keeper"
+	"github.com/anryton/anryton/v2/x/cron/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(
+	ctx sdk.Context,
+	k keeper.Keeper,
+	accountKeeper types.AccountKeeper,
+	data types.GenesisState,
+) {
+	// ensure cron module account is set on genesis, the senders of the EVM
+	// calls of the jobs are derived from its address
+	if acc := accountKeeper.GetModuleAccount(ctx, types.ModuleName); acc == nil {
+		panic("the cron module account has not been set")
+	}
+
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, job := range data.Jobs {
+		k.SetJob(ctx, job)
+	}
+	k.SetNextJobID(ctx, data.NextJobId)
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:    k.GetParams(ctx),
+		Jobs:      k.GetJobs(ctx),
+		NextJobId: k.GetNextJobID(ctx),
+	}
+}
@@ -0,0 +1,169 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/anryton/anryton/v2/x/cron/types"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+)
+
+// ExecuteJob runs a job in a cached context limited to the gas limit of the
+// job and returns the gas used. The state changes are only committed when the
+// execution succeeds, and the failures are reported in the emitted event
+// without aborting the block.
+//
+// The owner of the job pays for the gas used at the current gas price, and
+// the jobs that fail or whose owner can't pay for their gas limit are
+// disabled. The jobs registered by governance are free.
+func (k Keeper) ExecuteJob(ctx sdk.Context, job types.Job, epochNumber int64) uint64 {
+	owner := sdk.MustAccAddressFromBech32(job.Owner)
+	isAuthority := owner.Equals(k.authority)
+	gasPrice := k.GasPrice(ctx)
+
+	if !isAuthority {
+		maxFees := k.jobFees(ctx, gasPrice, job.GasLimit)
+		if spendable := k.bankKeeper.SpendableCoins(ctx, owner); !spendable.IsAllGTE(maxFees) {
+			err := errorsmod.Wrapf(types.ErrInsufficientFunds, "%s < %s", spendable, maxFees)
+			k.DisableJob(ctx, job, err)
+			return 0
+		}
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(job.GasLimit))
+
+	gasUsed, err := k.executeJob(cacheCtx, job)
+	if err == nil {
+		writeCache()
+	} else {
+		k.Logger(ctx).Debug("cron job failed", "id", job.Id, "error", err.Error())
+	}
+
+	fees := sdk.Coins{}
+	if !isAuthority {
+		fees = k.jobFees(ctx, gasPrice, gasUsed)
+		if chargeErr := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, authtypes.FeeCollectorName, fees); chargeErr != nil && err == nil {
+			err = errorsmod.Wrap(types.ErrInsufficientFunds, chargeErr.Error())
+		}
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyEpochIdentifier, job.EpochIdentifier),
+		sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+		sdk.NewAttribute(types.AttributeKeyContract, job.ContractAddress),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(err == nil)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(gasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeyFees, fees.String()),
+	}
+	if err != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeExecuteJob, attrs...))
+
+	if err != nil {
+		k.DisableJob(ctx, job, err)
+	}
+	return gasUsed
+}
+
+// DisableJob stops the executions of a job, which stays registered until it is
+// cancelled.
+func (k Keeper) DisableJob(ctx sdk.Context, job types.Job, reason error) {
+	job.Disabled = true
+	k.SetJob(ctx, job)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisableJob,
+			sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, job.Owner),
+			sdk.NewAttribute(types.AttributeKeyError, reason.Error()),
+		),
+	)
+}
+
+// GasPrice returns the price of the gas used by the jobs, which is the base
+// fee of the fee market, or its minimum gas price when the base fee is
+// disabled.
+func (k Keeper) GasPrice(ctx sdk.Context) sdk.Dec {
+	if baseFee := k.feeMarketKeeper.GetBaseFee(ctx); baseFee != nil && baseFee.Sign() > 0 {
+		return sdk.NewDecFromBigInt(baseFee)
+	}
+	return k.feeMarketKeeper.GetParams(ctx).MinGasPrice
+}
+
+// jobFees returns the fees in the EVM denom of the gas at the gas price
+func (k Keeper) jobFees(ctx sdk.Context, gasPrice sdk.Dec, gas uint64) sdk.Coins {
+	amount := gasPrice.MulInt(sdk.NewIntFromUint64(gas)).Ceil().TruncateInt()
+	return sdk.NewCoins(sdk.NewCoin(k.evmKeeper.GetParams(ctx).EvmDenom, amount))
+}
+
+// executeJob calls the EVM contract or sends the sudo message to the CosmWasm
+// contract of a job, recovering from the out of gas and the other panics.
+func (k Keeper) executeJob(ctx sdk.Context, job types.Job) (gasUsed uint64, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			gasUsed = job.GasLimit
+			if outOfGas, ok := r.(sdk.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(types.ErrJobExecution, "out of gas in location: %s", outOfGas.Descriptor)
+				return
+			}
+			err = errorsmod.Wrapf(types.ErrJobExecution, "panic: %v", r)
+		}
+	}()
+
+	if job.IsEVM() {
+		return k.callEVM(ctx, job)
+	}
+
+	contract, err := sdk.AccAddressFromBech32(job.ContractAddress)
+	if err != nil {
+		return 0, err
+	}
+	if _, err := k.wasmKeeper.Sudo(ctx, contract, job.Msg); err != nil {
+		return ctx.GasMeter().GasConsumed(), errorsmod.Wrap(types.ErrJobExecution, err.Error())
+	}
+	return ctx.GasMeter().GasConsumed(), nil
+}
+
+// callEVM calls the EVM contract of a job from the address of the job
+func (k Keeper) callEVM(ctx sdk.Context, job types.Job) (uint64, error) {
+	from := types.JobAddress(job.Id)
+	nonce := uint64(0)
+	if account := k.evmKeeper.GetAccountWithoutBalance(ctx, from); account != nil {
+		nonce = account.Nonce
+	}
+
+	contract := common.HexToAddress(job.ContractAddress)
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		nonce,
+		big.NewInt(0), // amount
+		job.GasLimit,  // gasLimit
+		big.NewInt(0), // gasFeeCap
+		big.NewInt(0), // gasTipCap
+		big.NewInt(0), // gasPrice
+		job.Msg,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return 0, errorsmod.Wrap(types.ErrJobExecution, err.Error())
+	}
+	if res.Failed() {
+		return res.GasUsed, errorsmod.Wrap(types.ErrJobExecution, res.VmError)
+	}
+	return res.GasUsed, nil
+}
@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/anryton/anryton/v2/x/cron/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// Jobs returns all the registered jobs
+func (k Keeper) Jobs(c context.Context, req *types.QueryJobsRequest) (*types.QueryJobsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var jobs []types.Job
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixJob)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var job types.Job
+		if err := k.cdc.Unmarshal(value, &job); err != nil {
+			return err
+		}
+		jobs = append(jobs, job)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryJobsResponse{
+		Jobs:       jobs,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Job returns a registered job
+func (k Keeper) Job(c context.Context, req *types.QueryJobRequest) (*types.QueryJobResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	job, found := k.GetJob(ctx, req.Id)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "job %d not found", req.Id)
+	}
+
+	return &types.QueryJobResponse{Job: job}, nil
+}
+
+// Params returns the cron module params
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
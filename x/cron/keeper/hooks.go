@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/cron/types"
+	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks wrapper struct for the cron keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct for the epoch hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd executes the jobs registered for the ended epoch in their
+// registration order. The jobs whose gas limit exceeds the gas left out of the
+// maximum gas per epoch are skipped.
+func (h Hooks) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	params := h.k.GetParams(ctx)
+	if !params.EnableCron {
+		return
+	}
+
+	gasUsed := uint64(0)
+	for _, job := range h.k.GetEpochJobs(ctx, epochIdentifier) {
+		if gasUsed+job.GasLimit > params.MaxGasPerEpoch {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeSkipJob,
+					sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.Id, 10)),
+					sdk.NewAttribute(types.AttributeKeyEpochIdentifier, epochIdentifier),
+					sdk.NewAttribute(types.AttributeKeyEpochNumber, strconv.FormatInt(epochNumber, 10)),
+				),
+			)
+			continue
+		}
+		gasUsed += h.k.ExecuteJob(ctx, job, epochNumber)
+	}
+}
+
+// BeforeEpochStart is a no-op for the cron module
+func (h Hooks) BeforeEpochStart(_ sdk.Context, _ string, _ int64) {}
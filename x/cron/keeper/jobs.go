@@ -0,0 +1,112 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/cron/types"
+)
+
+// GetJob returns a registered job
+func (k Keeper) GetJob(ctx sdk.Context, id uint64) (types.Job, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixJob)
+	bz := store.Get(types.GetJobIDBytes(id))
+	if len(bz) == 0 {
+		return types.Job{}, false
+	}
+
+	var job types.Job
+	k.cdc.MustUnmarshal(bz, &job)
+	return job, true
+}
+
+// SetJob stores a job and indexes it by owner, and by epoch identifier unless
+// it is disabled.
+func (k Keeper) SetJob(ctx sdk.Context, job types.Job) {
+	id := types.GetJobIDBytes(job.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixJob)
+	store.Set(id, k.cdc.MustMarshal(&job))
+
+	epochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixEpochJobs(job.EpochIdentifier))
+	if job.Disabled {
+		epochStore.Delete(id)
+	} else {
+		epochStore.Set(id, []byte{1})
+	}
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixOwnerJobs(job.Owner))
+	ownerStore.Set(id, []byte{1})
+}
+
+// DeleteJob removes a job and its indexes.
+func (k Keeper) DeleteJob(ctx sdk.Context, job types.Job) {
+	id := types.GetJobIDBytes(job.Id)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixJob)
+	store.Delete(id)
+
+	epochStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixEpochJobs(job.EpochIdentifier))
+	epochStore.Delete(id)
+
+	ownerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixOwnerJobs(job.Owner))
+	ownerStore.Delete(id)
+}
+
+// GetJobs returns all the registered jobs ordered by identifier.
+func (k Keeper) GetJobs(ctx sdk.Context) []types.Job {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixJob)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	jobs := []types.Job{}
+	for ; iterator.Valid(); iterator.Next() {
+		var job types.Job
+		k.cdc.MustUnmarshal(iterator.Value(), &job)
+		jobs = append(jobs, job)
+	}
+	return jobs
+}
+
+// GetEpochJobs returns the enabled jobs executed at the end of the epochs of
+// an identifier, ordered by registration.
+func (k Keeper) GetEpochJobs(ctx sdk.Context, epochIdentifier string) []types.Job {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixEpochJobs(epochIdentifier))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	jobs := []types.Job{}
+	for ; iterator.Valid(); iterator.Next() {
+		if job, found := k.GetJob(ctx, types.GetJobIDFromBytes(iterator.Key())); found {
+			jobs = append(jobs, job)
+		}
+	}
+	return jobs
+}
+
+// GetOwnerJobsCount returns the number of jobs registered by an owner.
+func (k Keeper) GetOwnerJobsCount(ctx sdk.Context, owner string) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixOwnerJobs(owner))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
+// GetNextJobID returns the identifier of the next registered job.
+func (k Keeper) GetNextJobID(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPrefixNextJobID)
+	if len(bz) == 0 {
+		return 1
+	}
+	return types.GetJobIDFromBytes(bz)
+}
+
+// SetNextJobID sets the identifier of the next registered job.
+func (k Keeper) SetNextJobID(ctx sdk.Context, id uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyPrefixNextJobID, types.GetJobIDBytes(id))
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/cron/types"
+)
+
+// Keeper of this module maintains the scheduled jobs and executes them at the
+// end of their epochs.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing a MsgUpdateParams message and of
+	// registering jobs without fee. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	accountKeeper      types.AccountKeeper
+	bankKeeper         types.BankKeeper
+	distributionKeeper types.DistributionKeeper
+	epochsKeeper       types.EpochsKeeper
+	evmKeeper          types.EVMKeeper
+	feeMarketKeeper    types.FeeMarketKeeper
+	wasmKeeper         types.WasmKeeper
+}
+
+// NewKeeper creates new instances of the cron Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	dk types.DistributionKeeper,
+	epochsKeeper types.EpochsKeeper,
+	evmKeeper types.EVMKeeper,
+	feeMarketKeeper types.FeeMarketKeeper,
+	wasmKeeper types.WasmKeeper,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:           storeKey,
+		cdc:                cdc,
+		authority:          authority,
+		accountKeeper:      ak,
+		bankKeeper:         bk,
+		distributionKeeper: dk,
+		epochsKeeper:       epochsKeeper,
+		evmKeeper:          evmKeeper,
+		feeMarketKeeper:    feeMarketKeeper,
+		wasmKeeper:         wasmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,255 @@
+package keeper_test
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/anryton/anryton/v2/x/cron/types"
+	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterJob() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRegisterJob
+		expErr error
+	}{
+		{"evm job of the deployer", types.NewMsgRegisterJob(owner, epochstypes.DayEpochID, evmContract.Hex(), []byte{0x01}, 100_000, []uint64{0}), nil},
+		{"evm job of the governance", types.NewMsgRegisterJob(authority, epochstypes.DayEpochID, evmContract.Hex(), []byte{0x01}, 100_000, nil), nil},
+		{"evm job with wrong nonces", types.NewMsgRegisterJob(owner, epochstypes.DayEpochID, evmContract.Hex(), []byte{0x01}, 100_000, []uint64{1}), types.ErrUnauthorized},
+		{"evm job of another account", types.NewMsgRegisterJob(other, epochstypes.DayEpochID, evmContract.Hex(), []byte{0x01}, 100_000, []uint64{0}), types.ErrUnauthorized},
+		{"evm job of a contract", types.NewMsgRegisterJob(sdk.AccAddress(revertContract.Bytes()), epochstypes.DayEpochID, evmContract.Hex(), nil, 100_000, []uint64{0}), types.ErrUnauthorized},
+		{"wasm job of the admin", types.NewMsgRegisterJob(owner, epochstypes.DayEpochID, wasmContract.String(), []byte(`{}`), 100_000, nil), nil},
+		{"wasm job of the governance", types.NewMsgRegisterJob(authority, epochstypes.DayEpochID, wasmContract.String(), []byte(`{}`), 100_000, nil), nil},
+		{"wasm job of another account", types.NewMsgRegisterJob(other, epochstypes.DayEpochID, wasmContract.String(), []byte(`{}`), 100_000, nil), types.ErrUnauthorized},
+		{"unknown epoch", types.NewMsgRegisterJob(owner, "minute", evmContract.Hex(), nil, 100_000, []uint64{0}), types.ErrEpochNotFound},
+		{"gas limit exceeded", types.NewMsgRegisterJob(owner, epochstypes.DayEpochID, evmContract.Hex(), nil, types.DefaultMaxGasLimit+1, []uint64{0}), types.ErrGasLimitExceeded},
+		{"evm account without code", types.NewMsgRegisterJob(owner, epochstypes.DayEpochID, common.BytesToAddress(other).Hex(), nil, 100_000, []uint64{0}), types.ErrContractNotFound},
+		{"unknown wasm contract", types.NewMsgRegisterJob(owner, epochstypes.DayEpochID, other.String(), []byte(`{}`), 100_000, nil), types.ErrContractNotFound},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+
+			res, err := suite.keeper.RegisterJob(sdk.WrapSDKContext(suite.ctx), tc.msg)
+			fees := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).Sub(communityPool)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().True(fees.IsZero())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(uint64(1), res.Id)
+			suite.Require().Equal(uint64(2), suite.keeper.GetNextJobID(suite.ctx))
+
+			job, found := suite.keeper.GetJob(suite.ctx, res.Id)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.msg.Owner, job.Owner)
+			suite.Require().False(job.Disabled)
+
+			// the governance jobs are free
+			if tc.msg.Owner == authority.String() {
+				suite.Require().True(fees.IsZero())
+			} else {
+				suite.Require().Equal(sdk.NewDecCoinsFromCoins(suite.keeper.GetParams(suite.ctx).RegistrationFee...), fees)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterJobLimits() {
+	suite.SetupTest()
+	msg := types.NewMsgRegisterJob(owner, epochstypes.DayEpochID, evmContract.Hex(), nil, 100_000, []uint64{0})
+
+	for i := 0; i < 2; i++ {
+		_, err := suite.keeper.RegisterJob(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().NoError(err)
+	}
+	_, err := suite.keeper.RegisterJob(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrMaxJobsReached)
+
+	// the governance account is not limited
+	msg.Owner = authority.String()
+	for i := 0; i < 3; i++ {
+		_, err := suite.keeper.RegisterJob(sdk.WrapSDKContext(suite.ctx), msg)
+		suite.Require().NoError(err)
+	}
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.EnableCron = false
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	_, err = suite.keeper.RegisterJob(sdk.WrapSDKContext(suite.ctx), msg)
+	suite.Require().ErrorIs(err, types.ErrCronDisabled)
+}
+
+func (suite *KeeperTestSuite) TestCancelJob() {
+	suite.SetupTest()
+	suite.keeper.SetJob(suite.ctx, types.NewJob(1, owner, epochstypes.DayEpochID, evmContract.Hex(), nil, 100_000))
+	suite.keeper.SetJob(suite.ctx, types.NewJob(2, owner, epochstypes.DayEpochID, evmContract.Hex(), nil, 100_000))
+
+	_, err := suite.keeper.CancelJob(sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelJob(owner, 3))
+	suite.Require().ErrorIs(err, types.ErrJobNotFound)
+
+	_, err = suite.keeper.CancelJob(sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelJob(other, 1))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = suite.keeper.CancelJob(sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelJob(owner, 1))
+	suite.Require().NoError(err)
+	_, err = suite.keeper.CancelJob(sdk.WrapSDKContext(suite.ctx), types.NewMsgCancelJob(authority, 2))
+	suite.Require().NoError(err)
+
+	suite.Require().Empty(suite.keeper.GetJobs(suite.ctx))
+	suite.Require().Empty(suite.keeper.GetEpochJobs(suite.ctx, epochstypes.DayEpochID))
+	suite.Require().Zero(suite.keeper.GetOwnerJobsCount(suite.ctx, owner.String()))
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEnd() {
+	testCases := []struct {
+		name       string
+		job        types.Job
+		expSuccess bool
+	}{
+		{"evm call", types.NewJob(1, owner, epochstypes.DayEpochID, evmContract.Hex(), nil, 100_000), true},
+		{"evm call reverted", types.NewJob(1, owner, epochstypes.DayEpochID, revertContract.Hex(), nil, 100_000), false},
+		{"evm call out of gas", types.NewJob(1, owner, epochstypes.DayEpochID, evmContract.Hex(), nil, 30_000), false},
+		{"wasm sudo", types.NewJob(1, owner, epochstypes.DayEpochID, wasmContract.String(), []byte(`{}`), 100_000), true},
+		{"wasm sudo failed", types.NewJob(1, owner, epochstypes.DayEpochID, wasmContract.String(), failSudo, 100_000), false},
+		{"wasm sudo out of gas", types.NewJob(1, owner, epochstypes.DayEpochID, wasmContract.String(), []byte(`{}`), 40_000), false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.keeper.SetJob(suite.ctx, tc.job)
+			suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+			balance := suite.balance(owner)
+
+			// the jobs of the other epochs are not executed
+			suite.keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.WeekEpochID, 1)
+			suite.Require().Empty(suite.ctx.EventManager().Events())
+
+			suite.keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+			events := suite.events(types.EventTypeExecuteJob)
+			suite.Require().Len(events, 1)
+			suite.Require().Equal(strconv.FormatBool(tc.expSuccess), events[0][types.AttributeKeySuccess])
+
+			// the owner pays for the gas used at the minimum gas price
+			gasUsed, ok := sdk.NewIntFromString(events[0][types.AttributeKeyGasUsed])
+			suite.Require().True(ok)
+			suite.Require().True(gasUsed.IsPositive())
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(suite.denom, gasUsed)).String(), events[0][types.AttributeKeyFees])
+			suite.Require().Equal(balance.Sub(gasUsed), suite.balance(owner))
+
+			// the state changes of the failed jobs are discarded and the jobs
+			// are disabled
+			_, hasError := events[0][types.AttributeKeyError]
+			suite.Require().Equal(!tc.expSuccess, hasError)
+			if tc.job.IsEVM() {
+				stored := suite.app.EvmKeeper.GetState(suite.ctx, common.HexToAddress(tc.job.ContractAddress), common.Hash{})
+				suite.Require().Equal(tc.expSuccess, stored == common.BigToHash(common.Big1))
+			} else {
+				suite.Require().Equal(tc.expSuccess, suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Has(executedKey))
+			}
+
+			job, found := suite.keeper.GetJob(suite.ctx, tc.job.Id)
+			suite.Require().True(found)
+			suite.Require().Equal(!tc.expSuccess, job.Disabled)
+			suite.Require().Len(suite.events(types.EventTypeDisableJob), map[bool]int{true: 0, false: 1}[tc.expSuccess])
+			suite.Require().Equal(tc.expSuccess, len(suite.keeper.GetEpochJobs(suite.ctx, epochstypes.DayEpochID)) == 1)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndSender() {
+	suite.SetupTest()
+	suite.keeper.SetJob(suite.ctx, types.NewJob(1, owner, epochstypes.DayEpochID, callerContract.Hex(), nil, 100_000))
+	suite.keeper.SetJob(suite.ctx, types.NewJob(2, authority, epochstypes.DayEpochID, callerContract.Hex(), nil, 100_000))
+	balance := suite.balance(authority)
+
+	// each job calls its contract from its own address
+	suite.keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	for _, id := range []uint64{1, 2} {
+		slot := common.BytesToHash(types.JobAddress(id).Bytes())
+		suite.Require().Equal(slot, suite.app.EvmKeeper.GetState(suite.ctx, callerContract, slot))
+	}
+
+	// the governance jobs are free
+	suite.Require().Equal(balance, suite.balance(authority))
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndInsufficientFunds() {
+	suite.SetupTest()
+	suite.keeper.SetJob(suite.ctx, types.NewJob(1, other, epochstypes.DayEpochID, wasmContract.String(), []byte(`{}`), 100_000))
+
+	// the owner can't pay for the gas limit of the job
+	err := suite.app.BankKeeper.SendCoins(suite.ctx, other, owner, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 950_000)))
+	suite.Require().NoError(err)
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+
+	suite.Require().Empty(suite.events(types.EventTypeExecuteJob))
+	suite.Require().Len(suite.events(types.EventTypeDisableJob), 1)
+	suite.Require().False(suite.ctx.KVStore(suite.app.GetKey(types.StoreKey)).Has(executedKey))
+	suite.Require().Equal(sdk.NewInt(50_000), suite.balance(other))
+
+	job, found := suite.keeper.GetJob(suite.ctx, 1)
+	suite.Require().True(found)
+	suite.Require().True(job.Disabled)
+	suite.Require().Empty(suite.keeper.GetEpochJobs(suite.ctx, epochstypes.DayEpochID))
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndMaxGasPerEpoch() {
+	suite.SetupTest()
+	params := suite.keeper.GetParams(suite.ctx)
+	params.MaxGasLimit = 100_000
+	params.MaxGasPerEpoch = 160_000
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	// each sudo message uses about 52k gas out of its 100k limit, so the
+	// third job exceeds the gas left
+	for id := uint64(1); id <= 3; id++ {
+		suite.keeper.SetJob(suite.ctx, types.NewJob(id, owner, epochstypes.DayEpochID, wasmContract.String(), []byte(`{}`), 100_000))
+	}
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+
+	suite.Require().Len(suite.events(types.EventTypeExecuteJob), 2)
+	skipped := suite.events(types.EventTypeSkipJob)
+	suite.Require().Len(skipped, 1)
+	suite.Require().Equal("3", skipped[0][types.AttributeKeyJobID])
+
+	// the skipped jobs stay enabled
+	suite.Require().Len(suite.keeper.GetEpochJobs(suite.ctx, epochstypes.DayEpochID), 3)
+}
+
+func (suite *KeeperTestSuite) TestAfterEpochEndDisabled() {
+	suite.SetupTest()
+	suite.keeper.SetJob(suite.ctx, types.NewJob(1, owner, epochstypes.DayEpochID, evmContract.Hex(), nil, 100_000))
+
+	params := suite.keeper.GetParams(suite.ctx)
+	params.EnableCron = false
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	suite.keeper.Hooks().AfterEpochEnd(suite.ctx, epochstypes.DayEpochID, 1)
+	suite.Require().Empty(suite.ctx.EventManager().Events())
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	suite.SetupTest()
+	params := types.NewParams(true, 500_000, 5, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 10)), 5_000_000)
+
+	_, err := suite.keeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{Authority: owner.String(), Params: params})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = suite.keeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,166 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/anryton/anryton/v2/x/cron/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterJob registers a contract call executed at the end of every epoch of
+// an x/epochs identifier. The jobs registered by accounts other than the
+// governance account pay the registration fee, and only the deployer of an
+// EVM contract or the admin of a CosmWasm contract can schedule its calls.
+func (k Keeper) RegisterJob(goCtx context.Context, msg *types.MsgRegisterJob) (*types.MsgRegisterJobResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if !params.EnableCron {
+		return nil, types.ErrCronDisabled
+	}
+
+	owner := sdk.MustAccAddressFromBech32(msg.Owner)
+	isAuthority := owner.Equals(k.authority)
+
+	if _, found := k.epochsKeeper.GetEpochInfo(ctx, msg.EpochIdentifier); !found {
+		return nil, errorsmod.Wrapf(types.ErrEpochNotFound, "identifier %s", msg.EpochIdentifier)
+	}
+
+	if msg.GasLimit > params.MaxGasLimit {
+		return nil, errorsmod.Wrapf(types.ErrGasLimitExceeded, "%d > %d", msg.GasLimit, params.MaxGasLimit)
+	}
+
+	contractAddress, err := k.validateContract(ctx, msg.ContractAddress, owner, isAuthority, msg.Nonces)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAuthority {
+		if k.GetOwnerJobsCount(ctx, msg.Owner) >= params.MaxJobsPerOwner {
+			return nil, errorsmod.Wrapf(types.ErrMaxJobsReached, "owner %s", msg.Owner)
+		}
+
+		if fee := params.RegistrationFee; !fee.IsZero() {
+			if err := k.distributionKeeper.FundCommunityPool(ctx, fee, owner); err != nil {
+				return nil, errorsmod.Wrap(err, "failed to pay the job registration fee")
+			}
+		}
+	}
+
+	id := k.GetNextJobID(ctx)
+	job := types.NewJob(id, owner, msg.EpochIdentifier, contractAddress, msg.Msg, msg.GasLimit)
+	k.SetJob(ctx, job)
+	k.SetNextJobID(ctx, id+1)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterJob,
+			sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, msg.Owner),
+			sdk.NewAttribute(types.AttributeKeyEpochIdentifier, msg.EpochIdentifier),
+			sdk.NewAttribute(types.AttributeKeyContract, contractAddress),
+		),
+	)
+
+	return &types.MsgRegisterJobResponse{Id: id}, nil
+}
+
+// CancelJob removes a job registered by the sender. The governance account can
+// remove any job.
+func (k Keeper) CancelJob(goCtx context.Context, msg *types.MsgCancelJob) (*types.MsgCancelJobResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	job, found := k.GetJob(ctx, msg.Id)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrJobNotFound, "id %d", msg.Id)
+	}
+
+	if msg.Sender != job.Owner && msg.Sender != k.authority.String() {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the owner of job %d", msg.Sender, msg.Id)
+	}
+
+	k.DeleteJob(ctx, job)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelJob,
+			sdk.NewAttribute(types.AttributeKeyJobID, strconv.FormatUint(job.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyOwner, job.Owner),
+		),
+	)
+
+	return &types.MsgCancelJobResponse{}, nil
+}
+
+// UpdateParams updates the module parameters
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// validateContract checks that the contract of a job exists and returns its
+// normalized address. The calls of an EVM contract can only be scheduled by
+// its EOA deployer, proven by the deployment nonces, and the sudo messages of a
+// CosmWasm contract by its admin, unless the job is registered by the
+// governance account.
+func (k Keeper) validateContract(ctx sdk.Context, contractAddress string, owner sdk.AccAddress, isAuthority bool, nonces []uint64) (string, error) {
+	if common.IsHexAddress(contractAddress) {
+		contract := common.HexToAddress(contractAddress)
+		account := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+		if account == nil || !account.IsContract() {
+			return "", errorsmod.Wrapf(types.ErrContractNotFound, "EVM contract %s", contract)
+		}
+		if !isAuthority {
+			if deployer := k.evmKeeper.GetAccountWithoutBalance(ctx, common.BytesToAddress(owner)); deployer != nil && deployer.IsContract() {
+				return "", errorsmod.Wrapf(types.ErrUnauthorized, "deployer %s is not an EOA", owner)
+			}
+			if !isDeployer(owner, contract, nonces) {
+				return "", errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the deployer of contract %s or wrong nonces", owner, contract)
+			}
+		}
+		return contract.Hex(), nil
+	}
+
+	contract, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return "", err
+	}
+
+	info := k.wasmKeeper.GetContractInfo(ctx, contract)
+	if info == nil {
+		return "", errorsmod.Wrapf(types.ErrContractNotFound, "CosmWasm contract %s", contract)
+	}
+	if !isAuthority && info.Admin != owner.String() {
+		return "", errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of contract %s", owner, contract)
+	}
+	return contract.String(), nil
+}
+
+// isDeployer returns true if the contract address is derived from the
+// deployer address and the chain of deployment nonces.
+func isDeployer(deployer sdk.AccAddress, contract common.Address, nonces []uint64) bool {
+	if len(nonces) == 0 {
+		return false
+	}
+
+	derivedContract := common.BytesToAddress(deployer)
+	for _, nonce := range nonces {
+		derivedContract = crypto.CreateAddress(derivedContract, nonce)
+	}
+	return derivedContract == contract
+}
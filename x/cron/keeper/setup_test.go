@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/anryton/anryton/v2/app"
+	"github.com/anryton/anryton/v2/x/cron/keeper"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.Anryton
+	consAddress sdk.ConsAddress
+
+	// keeper is the cron keeper of the app state whose CosmWasm contracts are
+	// served by a mock
+	keeper keeper.Keeper
+	denom  string
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.DoSetupTest()
+}
@@ -0,0 +1,143 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/anryton/anryton/v2/app"
+	"github.com/anryton/anryton/v2/testutil"
+	"github.com/anryton/anryton/v2/utils"
+	"github.com/anryton/anryton/v2/x/cron/keeper"
+	"github.com/anryton/anryton/v2/x/cron/types"
+	"github.com/anryton/anryton/v2/x/evm/statedb"
+	wasmtypes "github.com/anryton/anryton/v2/x/wasm/types"
+)
+
+var (
+	owner        = sdk.AccAddress([]byte("owner_______________"))
+	other        = sdk.AccAddress([]byte("other_______________"))
+	wasmContract = sdk.AccAddress([]byte("wasm_contract_address_padding_32"))
+	authority    = authtypes.NewModuleAddress(govtypes.ModuleName)
+
+	// the EVM contracts deployed by the owner, the first one storing 1 in
+	// its first slot, the second one reverting every call and the third one
+	// storing the caller in the slot of the caller
+	evmContract    = crypto.CreateAddress(common.BytesToAddress(owner), 0)
+	revertContract = crypto.CreateAddress(common.BytesToAddress(owner), 1)
+	callerContract = crypto.CreateAddress(common.BytesToAddress(owner), 2)
+	storeCode      = common.FromHex("0x600160005500") // SSTORE(0, 1)
+	revertCode     = common.FromHex("0x60006000fd")   // REVERT(0, 0)
+	callerCode     = common.FromHex("0x33805500")     // SSTORE(CALLER, CALLER)
+
+	// the sudo message failing in the mock
+	failSudo = []byte(`{"fail":{}}`)
+	// the key written in the store by the mock
+	executedKey = []byte("executed")
+)
+
+// Test helpers
+func (suite *KeeperTestSuite) DoSetupTest() {
+	checkTx := false
+
+	// init app
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(checkTx, nil, chainID)
+
+	// setup context
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, suite.consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, header)
+
+	// the genesis validator proposes the block, as the EVM coinbase
+	consAddress, err := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.consAddress = consAddress
+	suite.ctx = suite.ctx.WithProposer(consAddress)
+	suite.denom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	// the gas of the jobs costs 1 unit of the EVM denom
+	feemarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feemarketParams.NoBaseFee = true
+	feemarketParams.MinGasPrice = sdk.OneDec()
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feemarketParams))
+
+	for addr, code := range map[common.Address][]byte{
+		evmContract:    storeCode,
+		revertContract: revertCode,
+		callerContract: callerCode,
+	} {
+		account := statedb.NewEmptyAccount()
+		account.CodeHash = crypto.Keccak256(code)
+		suite.app.EvmKeeper.SetCode(suite.ctx, account.CodeHash, code)
+		suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, addr, *account))
+	}
+
+	for _, addr := range []sdk.AccAddress{owner, other} {
+		err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, addr, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1_000_000)))
+		suite.Require().NoError(err)
+	}
+
+	// the CosmWasm contract is served by a mock, as no CosmWasm code is
+	// stored on the test chain
+	suite.keeper = keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey), suite.app.AppCodec(), authority,
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.DistrKeeper, suite.app.EpochsKeeper,
+		suite.app.EvmKeeper, suite.app.FeeMarketKeeper, mockWasmKeeper{suite.app.GetKey(types.StoreKey)},
+	)
+
+	params := types.DefaultParams()
+	params.MaxJobsPerOwner = 2
+	params.RegistrationFee = sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 100))
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+}
+
+// balance returns the EVM denom balance of the address
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdk.Int {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, suite.denom).Amount
+}
+
+// events returns the attributes of the emitted events of a type
+func (suite *KeeperTestSuite) events(eventType string) []map[string]string {
+	var events []map[string]string
+	for _, event := range suite.ctx.EventManager().Events() {
+		if event.Type != eventType {
+			continue
+		}
+		attrs := map[string]string{}
+		for _, attr := range event.Attributes {
+			attrs[attr.Key] = attr.Value
+		}
+		events = append(events, attrs)
+	}
+	return events
+}
+
+// mockWasmKeeper writes to the store of the cron module on each sudo message,
+// fails the failSudo messages and consumes 50k gas for the other messages
+type mockWasmKeeper struct {
+	key storetypes.StoreKey
+}
+
+func (w mockWasmKeeper) GetContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	if !contractAddress.Equals(wasmContract) {
+		return nil
+	}
+	return &wasmtypes.ContractInfo{CodeID: 1, Creator: owner.String(), Admin: owner.String()}
+}
+
+func (w mockWasmKeeper) Sudo(ctx sdk.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	ctx.KVStore(w.key).Set(executedKey, msg)
+	if string(msg) == string(failSudo) {
+		return nil, fmt.Errorf("sudo failed")
+	}
+	ctx.GasMeter().ConsumeGas(50_000, "sudo")
+	return nil, nil
+}
@@ -0,0 +1,19 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrCronDisabled      = errorsmod.Register(ModuleName, 2, "cron module is disabled by governance")
+	ErrJobNotFound       = errorsmod.Register(ModuleName, 3, "job not found")
+	ErrUnauthorized      = errorsmod.Register(ModuleName, 4, "unauthorized account")
+	ErrEpochNotFound     = errorsmod.Register(ModuleName, 5, "epoch identifier not found")
+	ErrGasLimitExceeded  = errorsmod.Register(ModuleName, 6, "job gas limit exceeds the maximum gas limit")
+	ErrMaxJobsReached    = errorsmod.Register(ModuleName, 7, "maximum number of jobs reached")
+	ErrContractNotFound  = errorsmod.Register(ModuleName, 8, "contract not found")
+	ErrInvalidParams     = errorsmod.Register(ModuleName, 9, "invalid cron params")
+	ErrJobExecution      = errorsmod.Register(ModuleName, 10, "job execution failed")
+	ErrInsufficientFunds = errorsmod.Register(ModuleName, 11, "job owner cannot pay for the gas")
+)
@@ -0,0 +1,20 @@
+package types
+
+// cron events
+const (
+	EventTypeRegisterJob = "register_job"
+	EventTypeCancelJob   = "cancel_job"
+	EventTypeExecuteJob  = "execute_job"
+	EventTypeSkipJob     = "skip_job"
+	EventTypeDisableJob  = "disable_job"
+
+	AttributeKeyJobID           = "job_id"
+	AttributeKeyOwner           = "owner"
+	AttributeKeyEpochIdentifier = "epoch_identifier"
+	AttributeKeyEpochNumber     = "epoch_number"
+	AttributeKeyContract        = "contract"
+	AttributeKeySuccess         = "success"
+	AttributeKeyGasUsed         = "gas_used"
+	AttributeKeyFees            = "fees"
+	AttributeKeyError           = "error"
+)
@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state instance
+func NewGenesisState(params Params, jobs []Job, nextJobID uint64) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		Jobs:      jobs,
+		NextJobId: nextJobID,
+	}
+}
+
+// DefaultGenesisState returns the default cron genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Job{}, 1)
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if gs.NextJobId == 0 {
+		return fmt.Errorf("next job id cannot be zero")
+	}
+
+	seen := make(map[uint64]bool)
+	for _, job := range gs.Jobs {
+		if seen[job.Id] {
+			return fmt.Errorf("duplicated job %d", job.Id)
+		}
+		if job.Id >= gs.NextJobId {
+			return fmt.Errorf("job id %d must be lower than the next job id %d", job.Id, gs.NextJobId)
+		}
+		if err := job.Validate(); err != nil {
+			return err
+		}
+		seen[job.Id] = true
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	owner        = sdk.AccAddress([]byte("owner_______________"))
+	evmContract  = "0x5dCA2483280D9727c80b5518faC4556617fb1911"
+	wasmContract = sdk.AccAddress([]byte("wasm_contract_address_padding_32")).String()
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	job := NewJob(1, owner, "day", evmContract, []byte{0x01}, 100_000)
+
+	testCases := []struct {
+		name      string
+		genState  *GenesisState
+		expectErr bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState(DefaultParams(), []Job{job}, 2), false},
+		{"valid wasm job", NewGenesisState(DefaultParams(), []Job{NewJob(1, owner, "day", wasmContract, []byte(`{"tick":{}}`), 100_000)}, 2), false},
+		{"duplicated job", NewGenesisState(DefaultParams(), []Job{job, job}, 2), true},
+		{"job id not lower than next id", NewGenesisState(DefaultParams(), []Job{job}, 1), true},
+		{"zero next job id", NewGenesisState(DefaultParams(), nil, 0), true},
+		{"zero job id", NewGenesisState(DefaultParams(), []Job{NewJob(0, owner, "day", evmContract, nil, 100_000)}, 1), true},
+		{"invalid epoch identifier", NewGenesisState(DefaultParams(), []Job{NewJob(1, owner, "", evmContract, nil, 100_000)}, 2), true},
+		{"invalid sudo msg", NewGenesisState(DefaultParams(), []Job{NewJob(1, owner, "day", wasmContract, []byte("tick"), 100_000)}, 2), true},
+		{"zero max gas limit", NewGenesisState(NewParams(true, 0, 10, nil, DefaultMaxGasPerEpoch), nil, 1), true},
+		{"max gas per epoch lower than max gas limit", NewGenesisState(NewParams(true, 100_000, 10, nil, 99_999), nil, 1), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
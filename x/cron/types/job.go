@@ -0,0 +1,66 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
+)
+
+// NewJob returns an instance of Job
+func NewJob(id uint64, owner sdk.AccAddress, epochIdentifier, contractAddress string, msg []byte, gasLimit uint64) Job {
+	return Job{
+		Id:              id,
+		Owner:           owner.String(),
+		EpochIdentifier: epochIdentifier,
+		ContractAddress: contractAddress,
+		Msg:             msg,
+		GasLimit:        gasLimit,
+	}
+}
+
+// IsEVM returns true if the job calls an EVM contract, and false if it sends
+// a sudo message to a CosmWasm contract
+func (j Job) IsEVM() bool {
+	return common.IsHexAddress(j.ContractAddress)
+}
+
+// Validate performs a stateless validation of a Job
+func (j Job) Validate() error {
+	if j.Id == 0 {
+		return fmt.Errorf("job id cannot be zero")
+	}
+	if _, err := sdk.AccAddressFromBech32(j.Owner); err != nil {
+		return fmt.Errorf("invalid owner address %s: %w", j.Owner, err)
+	}
+	return ValidateJob(j.EpochIdentifier, j.ContractAddress, j.Msg, j.GasLimit)
+}
+
+// ValidateJob checks the fields of a job shared with the registration message
+func ValidateJob(epochIdentifier, contractAddress string, msg []byte, gasLimit uint64) error {
+	if err := epochstypes.ValidateEpochIdentifierString(epochIdentifier); err != nil {
+		return err
+	}
+
+	if gasLimit == 0 {
+		return fmt.Errorf("gas limit cannot be zero")
+	}
+
+	if common.IsHexAddress(contractAddress) {
+		if common.HexToAddress(contractAddress) == (common.Address{}) {
+			return fmt.Errorf("invalid contract address %s: zero address", contractAddress)
+		}
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(contractAddress); err != nil {
+		return fmt.Errorf("invalid contract address %s: must be a hex or a bech32 address", contractAddress)
+	}
+	if !json.Valid(msg) {
+		return fmt.Errorf("invalid sudo message: must be a JSON object")
+	}
+	return nil
+}
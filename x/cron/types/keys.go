@@ -0,0 +1,68 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "cron"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the cron persistent store
+const (
+	prefixParams = iota + 1
+	prefixJob
+	prefixEpochJob
+	prefixOwnerJob
+	prefixNextJobID
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixParams    = []byte{prefixParams}
+	KeyPrefixJob       = []byte{prefixJob}
+	KeyPrefixEpochJob  = []byte{prefixEpochJob}
+	KeyPrefixOwnerJob  = []byte{prefixOwnerJob}
+	KeyPrefixNextJobID = []byte{prefixNextJobID}
+)
+
+// GetJobIDBytes returns the big endian encoding of a job identifier, which
+// keeps the jobs ordered by registration in the store
+func GetJobIDBytes(id uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, id)
+}
+
+// JobAddress returns the address derived from the cron module address and the
+// identifier of a job, which is the sender of the EVM calls of the job. The
+// contracts can thus tell the calls of their own jobs apart.
+func JobAddress(id uint64) common.Address {
+	return common.BytesToAddress(address.Module(ModuleName, GetJobIDBytes(id)))
+}
+
+// GetJobIDFromBytes decodes a job identifier
+func GetJobIDFromBytes(bz []byte) uint64 {
+	return binary.BigEndian.Uint64(bz)
+}
+
+// GetKeyPrefixEpochJobs returns the key prefix of the jobs executed at the end
+// of the epochs of an identifier
+func GetKeyPrefixEpochJobs(epochIdentifier string) []byte {
+	return append(KeyPrefixEpochJob, []byte(epochIdentifier+"/")...)
+}
+
+// GetKeyPrefixOwnerJobs returns the key prefix of the jobs registered by an
+// owner
+func GetKeyPrefixOwnerJobs(owner string) []byte {
+	return append(KeyPrefixOwnerJob, []byte(owner+"/")...)
+}
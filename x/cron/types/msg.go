@@ -0,0 +1,94 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterJob{}
+	_ sdk.Msg = &MsgCancelJob{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgRegisterJob creates a new instance of MsgRegisterJob
+func NewMsgRegisterJob(owner sdk.AccAddress, epochIdentifier, contractAddress string, msg []byte, gasLimit uint64, nonces []uint64) *MsgRegisterJob {
+	return &MsgRegisterJob{
+		Owner:           owner.String(),
+		EpochIdentifier: epochIdentifier,
+		ContractAddress: contractAddress,
+		Msg:             msg,
+		GasLimit:        gasLimit,
+		Nonces:          nonces,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgRegisterJob) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Owner); err != nil {
+		return errorsmod.Wrap(err, "invalid owner address")
+	}
+	if err := ValidateJob(m.EpochIdentifier, m.ContractAddress, m.Msg, m.GasLimit); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgRegisterJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgRegisterJob) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Owner)}
+}
+
+// NewMsgCancelJob creates a new instance of MsgCancelJob
+func NewMsgCancelJob(sender sdk.AccAddress, id uint64) *MsgCancelJob {
+	return &MsgCancelJob{
+		Sender: sender.String(),
+		Id:     id,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgCancelJob) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	if m.Id == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "job id cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgCancelJob) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgCancelJob) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.Sender)}
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expectErr bool
+	}{
+		{"register evm job", NewMsgRegisterJob(owner, "day", evmContract, []byte{0x01}, 100_000, nil), false},
+		{"register wasm job", NewMsgRegisterJob(owner, "day", wasmContract, []byte(`{"tick":{}}`), 100_000, nil), false},
+		{"register invalid owner", &MsgRegisterJob{Owner: "owner", EpochIdentifier: "day", ContractAddress: evmContract, GasLimit: 1}, true},
+		{"register zero gas limit", NewMsgRegisterJob(owner, "day", evmContract, nil, 0, nil), true},
+		{"register zero address", NewMsgRegisterJob(owner, "day", "0x0000000000000000000000000000000000000000", nil, 1, nil), true},
+		{"register invalid contract", NewMsgRegisterJob(owner, "day", "contract", nil, 1, nil), true},
+		{"cancel", NewMsgCancelJob(owner, 1), false},
+		{"cancel zero id", NewMsgCancelJob(owner, 0), true},
+		{"update params", &MsgUpdateParams{Authority: owner.String(), Params: DefaultParams()}, false},
+		{"update params invalid fee", &MsgUpdateParams{Authority: owner.String(), Params: NewParams(true, 1, 1, sdk.Coins{{Denom: "aanryton", Amount: sdk.NewInt(-1)}}, 1)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMaxGasLimit is the default maximum gas limit of a job
+	DefaultMaxGasLimit uint64 = 1_000_000
+	// DefaultMaxGasPerEpoch is the default maximum gas consumed by the jobs
+	// executed at the end of an epoch
+	DefaultMaxGasPerEpoch uint64 = 10_000_000
+)
+
+// NewParams creates a new Params object
+func NewParams(enableCron bool, maxGasLimit, maxJobsPerOwner uint64, registrationFee sdk.Coins, maxGasPerEpoch uint64) Params {
+	return Params{
+		EnableCron:      enableCron,
+		MaxGasLimit:     maxGasLimit,
+		MaxJobsPerOwner: maxJobsPerOwner,
+		RegistrationFee: registrationFee,
+		MaxGasPerEpoch:  maxGasPerEpoch,
+	}
+}
+
+// DefaultParams returns the default cron params
+func DefaultParams() Params {
+	return NewParams(true, DefaultMaxGasLimit, 10, sdk.Coins{}, DefaultMaxGasPerEpoch)
+}
+
+// Validate performs a basic validation of the params
+func (p Params) Validate() error {
+	if p.MaxGasLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidParams, "max gas limit cannot be zero")
+	}
+	if p.MaxGasPerEpoch < p.MaxGasLimit {
+		return errorsmod.Wrapf(ErrInvalidParams, "max gas per epoch %d cannot be lower than the max gas limit %d", p.MaxGasPerEpoch, p.MaxGasLimit)
+	}
+	if err := p.RegistrationFee.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid registration fee: %s", err)
+	}
+	return nil
+}
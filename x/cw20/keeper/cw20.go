@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/cw20/types"
+)
+
+// QueryTokenInfo returns the token info of a CW20 contract
+func (k Keeper) QueryTokenInfo(ctx sdk.Context, contract sdk.AccAddress) (types.TokenInfoResponse, error) {
+	var tokenInfo types.TokenInfoResponse
+
+	res, err := k.wasmViewKeeper.QuerySmart(ctx, contract, types.TokenInfoQuery)
+	if err != nil {
+		return tokenInfo, errorsmod.Wrapf(types.ErrInvalidCW20, "failed to query token info of %s: %s", contract, err)
+	}
+
+	if err := json.Unmarshal(res, &tokenInfo); err != nil {
+		return tokenInfo, errorsmod.Wrapf(types.ErrInvalidCW20, "failed to decode token info of %s: %s", contract, err)
+	}
+
+	return tokenInfo, tokenInfo.Validate()
+}
+
+// QueryBalance returns the CW20 token balance of an address
+func (k Keeper) QueryBalance(ctx sdk.Context, contract, address sdk.AccAddress) (sdk.Int, error) {
+	req, err := types.NewBalanceQuery(address)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	res, err := k.wasmViewKeeper.QuerySmart(ctx, contract, req)
+	if err != nil {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrInvalidCW20, "failed to query balance of %s: %s", contract, err)
+	}
+
+	var balance types.BalanceResponse
+	if err := json.Unmarshal(res, &balance); err != nil {
+		return sdk.Int{}, errorsmod.Wrapf(types.ErrInvalidCW20, "failed to decode balance of %s: %s", contract, err)
+	}
+	if balance.Balance.IsNil() {
+		return sdk.ZeroInt(), nil
+	}
+
+	return balance.Balance, nil
+}
+
+// transfer executes a CW20 transfer of the amount of tokens from the caller to
+// the recipient
+func (k Keeper) transfer(
+	ctx sdk.Context,
+	contract, caller, recipient sdk.AccAddress,
+	amount sdk.Int,
+) error {
+	msg, err := types.NewTransferMsg(recipient, amount)
+	if err != nil {
+		return err
+	}
+
+	if _, err := k.wasmKeeper.Execute(ctx, contract, caller, msg, nil); err != nil {
+		return errorsmod.Wrapf(types.ErrCW20Execution, "failed to transfer %s tokens of %s: %s", amount, contract, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/cw20/types"
+)
+
+// Keeper of this module maintains the CW20 token pairs and converts the CW20
+// tokens to and from their native Cosmos coin representation.
+type Keeper struct {
+	storeKey storetypes.StoreKey
+	cdc      codec.BinaryCodec
+	// the address capable of executing the governance messages. Typically, this should be the x/gov module account.
+	authority sdk.AccAddress
+
+	accountKeeper  types.AccountKeeper
+	bankKeeper     types.BankKeeper
+	erc20Keeper    types.ERC20Keeper
+	wasmKeeper     types.WasmKeeper
+	wasmViewKeeper types.WasmViewKeeper
+}
+
+// NewKeeper creates new instances of the cw20 Keeper
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	erc20Keeper types.ERC20Keeper,
+	wasmKeeper types.WasmKeeper,
+	wasmViewKeeper types.WasmViewKeeper,
+) Keeper {
+	// ensure gov module account is set and is not nil
+	if err := sdk.VerifyAddressFormat(authority); err != nil {
+		panic(err)
+	}
+
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		authority:      authority,
+		accountKeeper:  ak,
+		bankKeeper:     bk,
+		erc20Keeper:    erc20Keeper,
+		wasmKeeper:     wasmKeeper,
+		wasmViewKeeper: wasmViewKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/anryton/anryton/v2/x/cw20/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterCW20() {
+	denom := types.CreateDenom(contract.String())
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		msg      *types.MsgRegisterCW20
+		expErr   error
+	}{
+		{
+			"fail - invalid authority",
+			func() {},
+			&types.MsgRegisterCW20{Authority: holder.String(), ContractAddress: contract.String()},
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"fail - not a CW20 contract",
+			func() {},
+			&types.MsgRegisterCW20{Authority: authority.String(), ContractAddress: holder.String()},
+			types.ErrInvalidCW20,
+		},
+		{
+			"fail - already registered",
+			func() {
+				_, err := suite.keeper.RegisterTokenPair(suite.ctx, contract)
+				suite.Require().NoError(err)
+			},
+			&types.MsgRegisterCW20{Authority: authority.String(), ContractAddress: contract.String()},
+			types.ErrTokenPairAlreadyExists,
+		},
+		{
+			"pass",
+			func() {},
+			&types.MsgRegisterCW20{Authority: authority.String(), ContractAddress: contract.String()},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			tc.malleate()
+
+			res, err := suite.keeper.RegisterCW20(sdk.WrapSDKContext(suite.ctx), tc.msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(denom, res.Denom)
+
+			metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+			suite.Require().True(found)
+			suite.Require().Equal("TEST", metadata.Symbol)
+			suite.Require().Equal("TestToken", metadata.Display)
+
+			pair, found := suite.keeper.GetTokenPair(suite.ctx, denom)
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewTokenPair(contract), pair)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestConvert() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	denom := types.CreateDenom(contract.String())
+
+	// conversions fail before the registration
+	_, err := suite.keeper.ConvertCW20(ctx, types.NewMsgConvertCW20(sdk.NewInt(100), receiver, contract, holder))
+	suite.Require().ErrorIs(err, types.ErrTokenPairNotFound)
+
+	_, err = suite.keeper.RegisterTokenPair(suite.ctx, contract)
+	suite.Require().NoError(err)
+
+	// CW20 -> bank, which registers the ERC20 representation
+	_, err = suite.keeper.ConvertCW20(ctx, types.NewMsgConvertCW20(sdk.NewInt(100), receiver, contract, holder))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(900), suite.cw20.balance(holder.String()))
+	suite.Require().Equal(sdk.NewInt(100), suite.cw20.balance(types.ModuleAddress.String()))
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, denom).Amount)
+	suite.Require().True(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, denom))
+
+	_, err = suite.keeper.ConvertCW20(ctx, types.NewMsgConvertCW20(sdk.NewInt(1000), receiver, contract, holder))
+	suite.Require().ErrorIs(err, types.ErrCW20Execution)
+
+	// bank -> CW20
+	_, err = suite.keeper.ConvertCoin(ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(denom, 40), holder, receiver))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(940), suite.cw20.balance(holder.String()))
+	suite.Require().Equal(sdk.NewInt(60), suite.cw20.balance(types.ModuleAddress.String()))
+	suite.Require().Equal(sdk.NewInt(60), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, denom).Amount)
+	suite.Require().Equal(sdk.NewInt(60), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+
+	// conversions fail when disabled
+	_, err = suite.keeper.ToggleConversion(ctx, &types.MsgToggleConversion{Authority: authority.String(), Token: denom})
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.ConvertCoin(ctx, types.NewMsgConvertCoin(sdk.NewInt64Coin(denom, 10), holder, receiver))
+	suite.Require().ErrorIs(err, types.ErrConversionDisabled)
+
+	// the module account can't receive the coins
+	_, err = suite.keeper.ToggleConversion(ctx, &types.MsgToggleConversion{Authority: authority.String(), Token: contract.String()})
+	suite.Require().NoError(err)
+
+	_, err = suite.keeper.ConvertCW20(ctx, types.NewMsgConvertCW20(sdk.NewInt(10), types.ModuleAddress, contract, holder))
+	suite.Require().Error(err)
+}
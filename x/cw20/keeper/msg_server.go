@@ -0,0 +1,185 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/anryton/anryton/v2/x/cw20/types"
+)
+
+var _ types.MsgServer = &Keeper{}
+
+// ConvertCW20 converts CW20 tokens into native Cosmos coins:
+//   - escrow the CW20 tokens on the module account
+//   - check that the escrowed balance increased by the amount
+//   - mint the coins and send them to the receiver
+//   - register the ERC20 representation of the coins if needed
+func (k Keeper) ConvertCW20(
+	goCtx context.Context,
+	msg *types.MsgConvertCW20,
+) (*types.MsgConvertCW20Response, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	contract := sdk.MustAccAddressFromBech32(msg.ContractAddress)
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	pair, err := k.conversionEnabled(ctx, receiver, msg.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceBefore, err := k.QueryBalance(ctx, contract, types.ModuleAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.transfer(ctx, contract, sender, types.ModuleAddress, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	balanceAfter, err := k.QueryBalance(ctx, contract, types.ModuleAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if !balanceAfter.Sub(balanceBefore).Equal(msg.Amount) {
+		return nil, errorsmod.Wrapf(
+			types.ErrInvalidCW20,
+			"invalid escrowed balance, expected %s, got %s", msg.Amount, balanceAfter.Sub(balanceBefore),
+		)
+	}
+
+	coins := sdk.Coins{sdk.NewCoin(pair.Denom, msg.Amount)}
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.registerERC20(ctx, pair); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertCW20,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyCW20Token, pair.ContractAddress),
+		),
+	)
+
+	return &types.MsgConvertCW20Response{}, nil
+}
+
+// ConvertCoin converts native Cosmos coins back into CW20 tokens:
+//   - burn the coins
+//   - release the escrowed CW20 tokens to the receiver
+func (k Keeper) ConvertCoin(
+	goCtx context.Context,
+	msg *types.MsgConvertCoin,
+) (*types.MsgConvertCoinResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// Error checked during msg validation
+	receiver := sdk.MustAccAddressFromBech32(msg.Receiver)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	pair, err := k.conversionEnabled(ctx, receiver, msg.Coin.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	coins := sdk.Coins{msg.Coin}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return nil, err
+	}
+
+	if err := k.transfer(ctx, pair.GetCW20Contract(), types.ModuleAddress, receiver, msg.Coin.Amount); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConvertCoin,
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, msg.Receiver),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Coin.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyCW20Token, pair.ContractAddress),
+		),
+	)
+
+	return &types.MsgConvertCoinResponse{}, nil
+}
+
+// RegisterCW20 implements the gRPC MsgServer interface. After a successful
+// governance vote it registers a token pair for a CW20 contract.
+func (k Keeper) RegisterCW20(
+	goCtx context.Context,
+	msg *types.MsgRegisterCW20,
+) (*types.MsgRegisterCW20Response, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	pair, err := k.RegisterTokenPair(ctx, sdk.MustAccAddressFromBech32(msg.ContractAddress))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCW20Response{Denom: pair.Denom}, nil
+}
+
+// ToggleConversion implements the gRPC MsgServer interface.
+func (k Keeper) ToggleConversion(
+	goCtx context.Context,
+	msg *types.MsgToggleConversion,
+) (*types.MsgToggleConversionResponse, error) {
+	if k.authority.String() != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, err := k.ToggleTokenPair(ctx, msg.Token); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgToggleConversionResponse{}, nil
+}
+
+// conversionEnabled checks that the token pair of the token is registered and
+// enabled and that the receiver is allowed to receive funds
+func (k Keeper) conversionEnabled(ctx sdk.Context, receiver sdk.AccAddress, token string) (types.TokenPair, error) {
+	pair, found := k.GetTokenPair(ctx, token)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrTokenPairNotFound, "token '%s' not registered", token)
+	}
+
+	if !pair.Enabled {
+		return types.TokenPair{}, errorsmod.Wrapf(types.ErrConversionDisabled, "token '%s'", token)
+	}
+
+	if k.bankKeeper.BlockedAddr(receiver) {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			errortypes.ErrUnauthorized, "%s is not allowed to receive transactions", receiver,
+		)
+	}
+
+	return pair, nil
+}
@@ -0,0 +1,153 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/anryton/anryton/v2/x/cw20/types"
+	erc20types "github.com/anryton/anryton/v2/x/erc20/types"
+)
+
+// RegisterTokenPair creates the Cosmos coin metadata representing the CW20 tokens
+// and registers the token pair between the coin and the CW20 contract
+func (k Keeper) RegisterTokenPair(ctx sdk.Context, contract sdk.AccAddress) (*types.TokenPair, error) {
+	if k.IsCW20Registered(ctx, contract) {
+		return nil, errorsmod.Wrapf(
+			types.ErrTokenPairAlreadyExists, "CW20 contract already registered: %s", contract,
+		)
+	}
+
+	metadata, err := k.CreateCoinMetadata(ctx, contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(
+			err, "failed to create wrapped coin denom metadata for CW20",
+		)
+	}
+
+	pair := types.NewTokenPair(contract)
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterCW20,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, metadata.Base),
+			sdk.NewAttribute(types.AttributeKeyCW20Token, pair.ContractAddress),
+		),
+	)
+
+	return &pair, nil
+}
+
+// CreateCoinMetadata generates the metadata to represent the CW20 token on
+// anryton.
+func (k Keeper) CreateCoinMetadata(ctx sdk.Context, contract sdk.AccAddress) (*banktypes.Metadata, error) {
+	strContract := contract.String()
+
+	tokenInfo, err := k.QueryTokenInfo(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+
+	// base denomination
+	base := types.CreateDenom(strContract)
+
+	// Check if metadata already exists
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, base); found {
+		return nil, errorsmod.Wrap(
+			types.ErrTokenPairAlreadyExists, "denom metadata already registered",
+		)
+	}
+
+	// metadata name should always be the base denomination as it's used by
+	// the erc20 module to register the ERC20 representation of the coin
+	metadata := banktypes.Metadata{
+		Description: types.CreateDenomDescription(strContract),
+		Base:        base,
+		// NOTE: Denom units MUST be increasing
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    base,
+				Exponent: 0,
+			},
+		},
+		Name:    base,
+		Symbol:  tokenInfo.Symbol,
+		Display: base,
+	}
+
+	// only append metadata if decimals > 0, otherwise validation fails
+	if tokenInfo.Decimals > 0 {
+		nameSanitized := erc20types.SanitizeERC20Name(tokenInfo.Name)
+		metadata.DenomUnits = append(
+			metadata.DenomUnits,
+			&banktypes.DenomUnit{
+				Denom:    nameSanitized,
+				Exponent: uint32(tokenInfo.Decimals),
+			},
+		)
+		metadata.Display = nameSanitized
+	}
+
+	if err := metadata.Validate(); err != nil {
+		return nil, errorsmod.Wrapf(
+			err, "CW20 token data is invalid for contract %s", strContract,
+		)
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	return &metadata, nil
+}
+
+// ToggleTokenPair toggles conversion for a given CW20 token pair
+func (k Keeper) ToggleTokenPair(ctx sdk.Context, token string) (types.TokenPair, error) {
+	pair, found := k.GetTokenPair(ctx, token)
+	if !found {
+		return types.TokenPair{}, errorsmod.Wrapf(
+			types.ErrTokenPairNotFound, "token '%s' not registered", token,
+		)
+	}
+
+	pair.Enabled = !pair.Enabled
+	k.SetTokenPair(ctx, pair)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeToggleConversion,
+			sdk.NewAttribute(types.AttributeKeyCosmosCoin, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyCW20Token, pair.ContractAddress),
+		),
+	)
+
+	return pair, nil
+}
+
+// registerERC20 registers the ERC20 representation of the Cosmos coin of the
+// token pair through the erc20 module, once the coin has a supply, so that
+// the CW20 tokens can also be used from the EVM.
+func (k Keeper) registerERC20(ctx sdk.Context, pair types.TokenPair) error {
+	if !k.erc20Keeper.IsERC20Enabled(ctx) || k.erc20Keeper.IsDenomRegistered(ctx, pair.Denom) {
+		return nil
+	}
+
+	metadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.Denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrTokenPairNotFound, "denom metadata not found for %s", pair.Denom)
+	}
+
+	erc20Pair, err := k.erc20Keeper.RegisterCoin(ctx, metadata)
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to register the ERC20 representation of %s", pair.Denom)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			erc20types.EventTypeRegisterCoin,
+			sdk.NewAttribute(erc20types.AttributeKeyCosmosCoin, erc20Pair.Denom),
+			sdk.NewAttribute(erc20types.AttributeKeyERC20Token, erc20Pair.Erc20Address),
+		),
+	)
+
+	return nil
+}
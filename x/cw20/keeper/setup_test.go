@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/anryton/anryton/v2/app"
+	"github.com/anryton/anryton/v2/x/cw20/keeper"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	ctx         sdk.Context
+	app         *app.Anryton
+	consAddress sdk.ConsAddress
+
+	// keeper is the cw20 keeper of the app state whose CW20 contracts are
+	// served by cw20
+	keeper keeper.Keeper
+	cw20   *mockCW20
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.DoSetupTest()
+}
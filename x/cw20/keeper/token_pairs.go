@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/cw20/types"
+)
+
+// GetTokenPairs gets all registered CW20 token pairs
+func (k Keeper) GetTokenPairs(ctx sdk.Context) []types.TokenPair {
+	tokenPairs := []types.TokenPair{}
+
+	k.IterateTokenPairs(ctx, func(tokenPair types.TokenPair) (stop bool) {
+		tokenPairs = append(tokenPairs, tokenPair)
+		return false
+	})
+
+	return tokenPairs
+}
+
+// IterateTokenPairs iterates over all the stored CW20 token pairs
+func (k Keeper) IterateTokenPairs(ctx sdk.Context, cb func(tokenPair types.TokenPair) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tokenPair types.TokenPair
+		k.cdc.MustUnmarshal(iterator.Value(), &tokenPair)
+
+		if cb(tokenPair) {
+			break
+		}
+	}
+}
+
+// GetTokenPair gets the CW20 token pair of a token, which can be either the
+// bech32 address of the CW20 contract or the Cosmos coin denomination
+func (k Keeper) GetTokenPair(ctx sdk.Context, token string) (types.TokenPair, bool) {
+	contract, err := sdk.AccAddressFromBech32(token)
+	if err != nil {
+		store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+		contract = store.Get([]byte(token))
+		if len(contract) == 0 {
+			return types.TokenPair{}, false
+		}
+	}
+
+	return k.GetTokenPairByContract(ctx, contract)
+}
+
+// GetTokenPairByContract gets the token pair of a CW20 contract
+func (k Keeper) GetTokenPairByContract(ctx sdk.Context, contract sdk.AccAddress) (types.TokenPair, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	bz := store.Get(contract)
+	if len(bz) == 0 {
+		return types.TokenPair{}, false
+	}
+
+	var tokenPair types.TokenPair
+	k.cdc.MustUnmarshal(bz, &tokenPair)
+	return tokenPair, true
+}
+
+// SetTokenPair stores a CW20 token pair and its denomination index
+func (k Keeper) SetTokenPair(ctx sdk.Context, tokenPair types.TokenPair) {
+	contract := tokenPair.GetCW20Contract()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	store.Set(contract, k.cdc.MustMarshal(&tokenPair))
+
+	denomStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPairByDenom)
+	denomStore.Set([]byte(tokenPair.Denom), contract)
+}
+
+// IsCW20Registered checks if a CW20 contract is registered
+func (k Keeper) IsCW20Registered(ctx sdk.Context, contract sdk.AccAddress) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTokenPair)
+	return store.Has(contract)
+}
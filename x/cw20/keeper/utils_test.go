@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/anryton/anryton/v2/app"
+	"github.com/anryton/anryton/v2/testutil"
+	"github.com/anryton/anryton/v2/utils"
+	"github.com/anryton/anryton/v2/x/cw20/keeper"
+	"github.com/anryton/anryton/v2/x/cw20/types"
+)
+
+var (
+	contract  = sdk.AccAddress([]byte("cw20_contract_address_padding_32"))
+	holder    = sdk.AccAddress([]byte("holder______________"))
+	receiver  = sdk.AccAddress([]byte("receiver____________"))
+	authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+)
+
+// Test helpers
+func (suite *KeeperTestSuite) DoSetupTest() {
+	checkTx := false
+
+	// init app
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(checkTx, nil, chainID)
+
+	// setup context
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, suite.consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, header)
+
+	// the genesis validator proposes the block, as the EVM coinbase
+	consAddress, err := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.consAddress = consAddress
+	suite.ctx = suite.ctx.WithProposer(consAddress)
+
+	// the CW20 contract is served by a mock, as no CW20 code is stored on the
+	// test chain, while the coins and ERC20 pairs are handled by the app
+	suite.cw20 = &mockCW20{balances: map[string]sdk.Int{holder.String(): sdk.NewInt(1000)}}
+	suite.keeper = keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey), suite.app.AppCodec(), authority,
+		suite.app.AccountKeeper, suite.app.BankKeeper, suite.app.Erc20Keeper,
+		suite.cw20, suite.cw20,
+	)
+}
+
+// mockCW20 is a CW20 contract keeping the token balances
+type mockCW20 struct {
+	balances map[string]sdk.Int
+}
+
+func (w *mockCW20) Execute(_ sdk.Context, contractAddr, caller sdk.AccAddress, msg []byte, _ sdk.Coins) ([]byte, error) {
+	if !contractAddr.Equals(contract) {
+		return nil, fmt.Errorf("contract not found")
+	}
+
+	var execute types.ExecuteMsg
+	if err := json.Unmarshal(msg, &execute); err != nil || execute.Transfer == nil {
+		return nil, fmt.Errorf("invalid execute msg")
+	}
+
+	balance := w.balance(caller.String())
+	if balance.LT(execute.Transfer.Amount) {
+		return nil, fmt.Errorf("insufficient funds")
+	}
+	w.balances[caller.String()] = balance.Sub(execute.Transfer.Amount)
+	w.balances[execute.Transfer.Recipient] = w.balance(execute.Transfer.Recipient).Add(execute.Transfer.Amount)
+	return nil, nil
+}
+
+func (w *mockCW20) QuerySmart(_ sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	if !contractAddr.Equals(contract) {
+		return nil, fmt.Errorf("contract not found")
+	}
+
+	if string(req) == string(types.TokenInfoQuery) {
+		return json.Marshal(types.TokenInfoResponse{Name: "Test Token", Symbol: "TEST", Decimals: 6, TotalSupply: sdk.NewInt(1000)})
+	}
+
+	var query types.QueryMsg
+	if err := json.Unmarshal(req, &query); err != nil || query.Balance == nil {
+		return nil, fmt.Errorf("invalid query msg")
+	}
+	return json.Marshal(types.BalanceResponse{Balance: w.balance(query.Balance.Address)})
+}
+
+func (w *mockCW20) balance(addr string) sdk.Int {
+	if balance, ok := w.balances[addr]; ok {
+		return balance
+	}
+	return sdk.ZeroInt()
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenInfoQuery is the CW20 query of the token info.
+var TokenInfoQuery = []byte(`{"token_info":{}}`)
+
+// TokenInfoResponse is the response of the CW20 token info query.
+type TokenInfoResponse struct {
+	Name        string  `json:"name"`
+	Symbol      string  `json:"symbol"`
+	Decimals    uint8   `json:"decimals"`
+	TotalSupply sdk.Int `json:"total_supply"`
+}
+
+// Validate checks the CW20 token info.
+func (ti TokenInfoResponse) Validate() error {
+	if ti.Name == "" {
+		return errorsmod.Wrap(ErrInvalidCW20, "empty token name")
+	}
+	if ti.Symbol == "" {
+		return errorsmod.Wrap(ErrInvalidCW20, "empty token symbol")
+	}
+	return nil
+}
+
+// QueryMsg is the CW20 query message used by the module.
+type QueryMsg struct {
+	Balance *BalanceQuery `json:"balance,omitempty"`
+}
+
+// BalanceQuery is the CW20 query of the balance of an address.
+type BalanceQuery struct {
+	Address string `json:"address"`
+}
+
+// BalanceResponse is the response of the CW20 balance query.
+type BalanceResponse struct {
+	Balance sdk.Int `json:"balance"`
+}
+
+// NewBalanceQuery returns the encoded CW20 query of the balance of the
+// address.
+func NewBalanceQuery(address sdk.AccAddress) ([]byte, error) {
+	return json.Marshal(QueryMsg{
+		Balance: &BalanceQuery{Address: address.String()},
+	})
+}
+
+// ExecuteMsg is the CW20 execute message used by the module.
+type ExecuteMsg struct {
+	Transfer *TransferMsg `json:"transfer,omitempty"`
+}
+
+// TransferMsg is the CW20 message that transfers tokens from the caller to
+// the recipient.
+type TransferMsg struct {
+	Recipient string  `json:"recipient"`
+	Amount    sdk.Int `json:"amount"`
+}
+
+// NewTransferMsg returns the encoded CW20 message transferring the amount of
+// tokens to the recipient.
+func NewTransferMsg(recipient sdk.AccAddress, amount sdk.Int) ([]byte, error) {
+	return json.Marshal(ExecuteMsg{
+		Transfer: &TransferMsg{
+			Recipient: recipient.String(),
+			Amount:    amount,
+		},
+	})
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrTokenPairNotFound      = errorsmod.Register(ModuleName, 2, "CW20 token pair not found")
+	ErrTokenPairAlreadyExists = errorsmod.Register(ModuleName, 3, "CW20 token pair already exists")
+	ErrConversionDisabled     = errorsmod.Register(ModuleName, 4, "CW20 token pair conversion is disabled")
+	ErrInvalidCW20            = errorsmod.Register(ModuleName, 5, "invalid CW20 contract")
+	ErrCW20Execution          = errorsmod.Register(ModuleName, 6, "CW20 contract execution failed")
+)
@@ -0,0 +1,13 @@
+package types
+
+// cw20 events
+const (
+	EventTypeConvertCW20      = "convert_cw20"
+	EventTypeConvertCoin      = "convert_coin"
+	EventTypeRegisterCW20     = "register_cw20"
+	EventTypeToggleConversion = "toggle_cw20_conversion"
+
+	AttributeKeyCosmosCoin = "cosmos_coin"
+	AttributeKeyCW20Token  = "cw20_token"
+	AttributeKeyReceiver   = "receiver"
+)
@@ -0,0 +1,31 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state instance
+func NewGenesisState(pairs []TokenPair) *GenesisState {
+	return &GenesisState{TokenPairs: pairs}
+}
+
+// DefaultGenesisState returns the default cw20 genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]TokenPair{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, pair := range gs.TokenPairs {
+		if seen[pair.ContractAddress] {
+			return fmt.Errorf("duplicated token pair for CW20 contract %s", pair.ContractAddress)
+		}
+		if err := pair.Validate(); err != nil {
+			return err
+		}
+		seen[pair.ContractAddress] = true
+	}
+	return nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	pair := NewTokenPair(contract)
+
+	testCases := []struct {
+		name      string
+		genState  *GenesisState
+		expectErr bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState([]TokenPair{pair}), false},
+		{"duplicated pair", NewGenesisState([]TokenPair{pair, pair}), true},
+		{"invalid contract", NewGenesisState([]TokenPair{{ContractAddress: "contract", Denom: CreateDenom("contract")}}), true},
+		{"invalid denom", NewGenesisState([]TokenPair{{ContractAddress: contract.String(), Denom: "cw20/other"}}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNewTransferMsg(t *testing.T) {
+	bz, err := NewTransferMsg(account, sdk.NewInt(42))
+	require.NoError(t, err)
+	require.JSONEq(t, `{"transfer":{"recipient":"`+account.String()+`","amount":"42"}}`, string(bz))
+
+	bz, err = NewBalanceQuery(account)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"balance":{"address":"`+account.String()+`"}}`, string(bz))
+}
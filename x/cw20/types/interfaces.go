@@ -0,0 +1,47 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	erc20types "github.com/anryton/anryton/v2/x/erc20/types"
+)
+
+// AccountKeeper defines the expected interface needed to retrieve the module
+// account.
+type AccountKeeper interface {
+	GetModuleAccount(ctx sdk.Context, moduleName string) authtypes.ModuleAccountI
+}
+
+// BankKeeper defines the expected interface needed to mint and burn the coins
+// representing the CW20 tokens.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx sdk.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	MintCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BurnCoins(ctx sdk.Context, moduleName string, amt sdk.Coins) error
+	BlockedAddr(addr sdk.AccAddress) bool
+	GetDenomMetaData(ctx sdk.Context, denom string) (banktypes.Metadata, bool)
+	SetDenomMetaData(ctx sdk.Context, denomMetaData banktypes.Metadata)
+}
+
+// ERC20Keeper defines the expected ERC20 keeper interface used to register
+// the ERC20 representation of the CW20 tokens.
+type ERC20Keeper interface {
+	IsERC20Enabled(ctx sdk.Context) bool
+	IsDenomRegistered(ctx sdk.Context, denom string) bool
+	RegisterCoin(ctx sdk.Context, coinMetadata banktypes.Metadata) (*erc20types.TokenPair, error)
+}
+
+// WasmKeeper defines the expected CosmWasm contract keeper used to transfer
+// the CW20 tokens.
+type WasmKeeper interface {
+	Execute(ctx sdk.Context, contractAddress, caller sdk.AccAddress, msg []byte, coins sdk.Coins) ([]byte, error)
+}
+
+// WasmViewKeeper defines the expected CosmWasm contract keeper used to query
+// the CW20 contracts.
+type WasmViewKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddress sdk.AccAddress, req []byte) ([]byte, error)
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "cw20"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the native module address for cw20, which escrows the
+// converted CW20 tokens
+var ModuleAddress = types.NewModuleAddress(ModuleName)
+
+// prefix bytes for the cw20 persistent store
+const (
+	prefixTokenPair = iota + 1
+	prefixTokenPairByDenom
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixTokenPair        = []byte{prefixTokenPair}
+	KeyPrefixTokenPairByDenom = []byte{prefixTokenPairByDenom}
+)
@@ -0,0 +1,147 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgConvertCW20{}
+	_ sdk.Msg = &MsgConvertCoin{}
+	_ sdk.Msg = &MsgRegisterCW20{}
+	_ sdk.Msg = &MsgToggleConversion{}
+)
+
+const (
+	TypeMsgConvertCW20 = "convert_cw20"
+	TypeMsgConvertCoin = "convert_coin"
+)
+
+// NewMsgConvertCW20 creates a new instance of MsgConvertCW20
+func NewMsgConvertCW20(amount sdk.Int, receiver, contract, sender sdk.AccAddress) *MsgConvertCW20 { //nolint: interfacer
+	return &MsgConvertCW20{
+		ContractAddress: contract.String(),
+		Amount:          amount,
+		Receiver:        receiver.String(),
+		Sender:          sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertCW20) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertCW20) Type() string { return TypeMsgConvertCW20 }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertCW20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+	if !msg.Amount.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "cannot convert non-positive amount %s", msg.Amount)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertCW20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertCW20) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// NewMsgConvertCoin creates a new instance of MsgConvertCoin
+func NewMsgConvertCoin(coin sdk.Coin, receiver, sender sdk.AccAddress) *MsgConvertCoin { //nolint: interfacer
+	return &MsgConvertCoin{
+		Coin:     coin,
+		Receiver: receiver.String(),
+		Sender:   sender.String(),
+	}
+}
+
+// Route should return the name of the module
+func (msg MsgConvertCoin) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConvertCoin) Type() string { return TypeMsgConvertCoin }
+
+// ValidateBasic runs stateless checks on the message
+func (msg MsgConvertCoin) ValidateBasic() error {
+	if !msg.Coin.IsValid() || !msg.Coin.IsPositive() {
+		return errorsmod.Wrapf(errortypes.ErrInvalidCoins, "invalid coin %s", msg.Coin)
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver address")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errorsmod.Wrap(err, "invalid sender address")
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg *MsgConvertCoin) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConvertCoin) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+// GetSigners returns the expected signers for a MsgRegisterCW20 message.
+func (m *MsgRegisterCW20) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgRegisterCW20) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(err, "invalid contract address")
+	}
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgRegisterCW20) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgToggleConversion message.
+func (m *MsgToggleConversion) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgToggleConversion) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Token); err == nil {
+		return nil
+	}
+	return sdk.ValidateDenom(m.Token)
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgToggleConversion) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -0,0 +1,90 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	contract = sdk.AccAddress([]byte("cw20_contract_address_padding_32"))
+	account  = sdk.AccAddress([]byte("account_address_____"))
+)
+
+func TestMsgConvertCW20ValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *MsgConvertCW20
+		expectErr bool
+	}{
+		{"valid", NewMsgConvertCW20(sdk.NewInt(100), account, contract, account), false},
+		{"zero amount", NewMsgConvertCW20(sdk.ZeroInt(), account, contract, account), true},
+		{"negative amount", NewMsgConvertCW20(sdk.NewInt(-1), account, contract, account), true},
+		{"invalid contract", &MsgConvertCW20{ContractAddress: "contract", Amount: sdk.NewInt(1), Receiver: account.String(), Sender: account.String()}, true},
+		{"invalid receiver", &MsgConvertCW20{ContractAddress: contract.String(), Amount: sdk.NewInt(1), Receiver: "0x", Sender: account.String()}, true},
+		{"invalid sender", &MsgConvertCW20{ContractAddress: contract.String(), Amount: sdk.NewInt(1), Receiver: account.String(), Sender: ""}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgConvertCoinValidateBasic(t *testing.T) {
+	denom := CreateDenom(contract.String())
+
+	testCases := []struct {
+		name      string
+		msg       *MsgConvertCoin
+		expectErr bool
+	}{
+		{"valid", NewMsgConvertCoin(sdk.NewInt64Coin(denom, 100), account, account), false},
+		{"zero coin", NewMsgConvertCoin(sdk.NewInt64Coin(denom, 0), account, account), true},
+		{"invalid denom", &MsgConvertCoin{Coin: sdk.Coin{Denom: "1", Amount: sdk.NewInt(1)}, Receiver: account.String(), Sender: account.String()}, true},
+		{"invalid receiver", &MsgConvertCoin{Coin: sdk.NewInt64Coin(denom, 1), Receiver: "", Sender: account.String()}, true},
+		{"invalid sender", &MsgConvertCoin{Coin: sdk.NewInt64Coin(denom, 1), Receiver: account.String(), Sender: ""}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgToggleConversionValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		token     string
+		expectErr bool
+	}{
+		{"contract address", contract.String(), false},
+		{"denom", CreateDenom(contract.String()), false},
+		{"invalid token", "1", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			msg := &MsgToggleConversion{Authority: account.String(), Token: tc.token}
+			err := msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// CreateDenom generates the name of the Cosmos coin
+// representing a CW20 contract
+func CreateDenom(contractAddress string) string {
+	return fmt.Sprintf("%s/%s", ModuleName, contractAddress)
+}
+
+// CreateDenomDescription generates a string with the coin description
+func CreateDenomDescription(contractAddress string) string {
+	return fmt.Sprintf("Cosmos coin token representation of the CW20 contract %s", contractAddress)
+}
+
+// NewTokenPair returns an instance of TokenPair
+func NewTokenPair(contractAddress sdk.AccAddress) TokenPair {
+	return TokenPair{
+		ContractAddress: contractAddress.String(),
+		Denom:           CreateDenom(contractAddress.String()),
+		Enabled:         true,
+	}
+}
+
+// GetCW20Contract casts the bech32 address of the CW20 contract to an
+// sdk.AccAddress
+func (tp TokenPair) GetCW20Contract() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(tp.ContractAddress)
+}
+
+// Validate performs a stateless validation of a TokenPair
+func (tp TokenPair) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(tp.ContractAddress); err != nil {
+		return fmt.Errorf("invalid CW20 contract address %s: %w", tp.ContractAddress, err)
+	}
+	if tp.Denom != CreateDenom(tp.ContractAddress) {
+		return fmt.Errorf("invalid denom %s for CW20 contract %s", tp.Denom, tp.ContractAddress)
+	}
+	return sdk.ValidateDenom(tp.Denom)
+}
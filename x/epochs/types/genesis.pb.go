@@ -51,7 +51,7 @@ func (m *EpochInfo) Reset()         { *m = EpochInfo{} }
 func (m *EpochInfo) String() string { return proto.CompactTextString(m) }
 func (*EpochInfo) ProtoMessage()    {}
 func (*EpochInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c82b2ecda9c4650, []int{0}
+	return fileDescriptor_d8121f8bbfef7f04, []int{0}
 }
 func (m *EpochInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -139,7 +139,7 @@ func (m *GenesisState) Reset()         { *m = GenesisState{} }
 func (m *GenesisState) String() string { return proto.CompactTextString(m) }
 func (*GenesisState) ProtoMessage()    {}
 func (*GenesisState) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c82b2ecda9c4650, []int{1}
+	return fileDescriptor_d8121f8bbfef7f04, []int{1}
 }
 func (m *GenesisState) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -180,39 +180,39 @@ func init() {
 	proto.RegisterType((*GenesisState)(nil), "anryton.epochs.v1.GenesisState")
 }
 
-func init() { proto.RegisterFile("anryton/epochs/v1/genesis.proto", fileDescriptor_1c82b2ecda9c4650) }
+func init() { proto.RegisterFile("anryton/epochs/v1/genesis.proto", fileDescriptor_d8121f8bbfef7f04) }
 
-var fileDescriptor_1c82b2ecda9c4650 = []byte{
+var fileDescriptor_d8121f8bbfef7f04 = []byte{
 	// 461 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x31, 0x6f, 0xd3, 0x40,
-	0x14, 0xce, 0x91, 0x10, 0x9a, 0xa3, 0xa8, 0xe2, 0x54, 0xc0, 0x44, 0xaa, 0x6d, 0x99, 0xc5, 0x12,
-	0xe8, 0x4e, 0x29, 0x0c, 0x08, 0x36, 0x03, 0xa2, 0xac, 0x0e, 0x03, 0x62, 0x89, 0x9c, 0xe4, 0x72,
-	0x3e, 0xa9, 0xf6, 0x59, 0xf6, 0x73, 0x44, 0x36, 0x66, 0xa6, 0x8e, 0xfc, 0xa4, 0x8e, 0x1d, 0x99,
-	0x0c, 0x4a, 0x36, 0xc6, 0xfe, 0x02, 0xe4, 0x3b, 0x3b, 0x84, 0x16, 0xc4, 0x62, 0xf9, 0xde, 0xf7,
-	0xbd, 0xef, 0xbb, 0xef, 0xe9, 0x1d, 0x3e, 0x2a, 0x62, 0x39, 0x57, 0x8c, 0x67, 0x6a, 0x16, 0x17,
-	0x6c, 0x39, 0x62, 0x82, 0xa7, 0xbc, 0x90, 0x05, 0xcd, 0x72, 0x05, 0x8a, 0x1c, 0x68, 0x98, 0x1a,
-	0x98, 0x2e, 0x47, 0xc3, 0x43, 0xa1, 0x84, 0xd2, 0x18, 0xab, 0xff, 0x0c, 0x6d, 0x68, 0x0b, 0xa5,
-	0xc4, 0x29, 0x67, 0xfa, 0x34, 0x2d, 0x17, 0x6c, 0x5e, 0xe6, 0x11, 0x48, 0x95, 0x36, 0xb8, 0x73,
-	0x15, 0x07, 0x99, 0xf0, 0x02, 0xa2, 0x24, 0x33, 0x04, 0xef, 0x4b, 0x0f, 0x0f, 0xde, 0xd4, 0x26,
-	0xef, 0xd2, 0x85, 0x22, 0x36, 0xc6, 0x72, 0xce, 0x53, 0x90, 0x0b, 0xc9, 0x73, 0x0b, 0xb9, 0xc8,
-	0x1f, 0x84, 0x3b, 0x15, 0xf2, 0x01, 0xe3, 0x02, 0xa2, 0x1c, 0x26, 0xb5, 0x8c, 0x75, 0xc3, 0x45,
-	0xfe, 0xed, 0xe3, 0x21, 0x35, 0x1e, 0xb4, 0xf5, 0xa0, 0xef, 0x5b, 0x8f, 0xe0, 0xe8, 0xbc, 0x72,
-	0x3a, 0x97, 0x95, 0x73, 0x77, 0x15, 0x25, 0xa7, 0x2f, 0xbc, 0xdf, 0xbd, 0xde, 0xd9, 0x77, 0x07,
-	0x85, 0x03, 0x5d, 0xa8, 0xe9, 0x24, 0xc6, 0x7b, 0xed, 0xd5, 0xad, 0xae, 0xd6, 0x7d, 0x78, 0x4d,
-	0xf7, 0x75, 0x43, 0x08, 0x46, 0xb5, 0xec, 0xcf, 0xca, 0x21, 0x6d, 0xcb, 0x13, 0x95, 0x48, 0xe0,
-	0x49, 0x06, 0xab, 0xcb, 0xca, 0x39, 0x30, 0x66, 0x2d, 0xe6, 0x7d, 0xad, 0xad, 0xb6, 0xea, 0xe4,
-	0x11, 0xbe, 0x33, 0x2b, 0xf3, 0x9c, 0xa7, 0x30, 0xd1, 0xd3, 0xb5, 0x7a, 0x2e, 0xf2, 0xbb, 0xe1,
-	0x7e, 0x53, 0xd4, 0xc3, 0x20, 0x9f, 0x11, 0xb6, 0xfe, 0x60, 0x4d, 0x76, 0x72, 0xdf, 0xfc, 0x6f,
-	0xee, 0xc7, 0x4d, 0x6e, 0xc7, 0x5c, 0xe5, 0x5f, 0x4a, 0x66, 0x0a, 0xf7, 0x76, 0x9d, 0xc7, 0xdb,
-	0x89, 0x3c, 0xc3, 0xf7, 0x0d, 0x7f, 0xa6, 0xca, 0x14, 0x64, 0x2a, 0x4c, 0x23, 0x9f, 0x5b, 0x7d,
-	0x17, 0xf9, 0x7b, 0xe1, 0xa1, 0x46, 0x5f, 0x35, 0xe0, 0xd8, 0x60, 0xe4, 0x25, 0x1e, 0xfe, 0xcd,
-	0x2d, 0xe6, 0x52, 0xc4, 0x60, 0xdd, 0xd2, 0x51, 0x1f, 0x5c, 0x33, 0x3c, 0xd1, 0xb0, 0x77, 0x82,
-	0xf7, 0xdf, 0x9a, 0x2d, 0x1c, 0x43, 0x04, 0x9c, 0x3c, 0xc7, 0x7d, 0xb3, 0x80, 0x16, 0x72, 0xbb,
-	0x3a, 0xf2, 0x95, 0xad, 0xa4, 0xdb, 0xd5, 0x09, 0x7a, 0x75, 0xe4, 0xb0, 0xe1, 0x07, 0xc1, 0xf9,
-	0xda, 0x46, 0x17, 0x6b, 0x1b, 0xfd, 0x58, 0xdb, 0xe8, 0x6c, 0x63, 0x77, 0x2e, 0x36, 0x76, 0xe7,
-	0xdb, 0xc6, 0xee, 0x7c, 0xf4, 0x85, 0x84, 0xb8, 0x9c, 0xd2, 0x99, 0x4a, 0x98, 0x79, 0x02, 0xe6,
-	0xbb, 0x3c, 0x66, 0x9f, 0xda, 0xd7, 0x00, 0xab, 0x8c, 0x17, 0xd3, 0xbe, 0x1e, 0xec, 0xd3, 0x5f,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x18, 0x8d, 0xce, 0x19, 0x2a, 0x03, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0xbf, 0x8f, 0xd3, 0x30,
+	0x14, 0xae, 0x69, 0x29, 0x57, 0xdf, 0x21, 0x74, 0xd6, 0x01, 0xa1, 0x82, 0x24, 0x0a, 0x4b, 0x24,
+	0x4e, 0x8e, 0x5a, 0x98, 0x8e, 0xad, 0xfc, 0x66, 0x4c, 0x19, 0x10, 0x4b, 0x95, 0xb6, 0x6e, 0x62,
+	0xe9, 0x62, 0x47, 0xc9, 0x4b, 0x45, 0x37, 0x66, 0xa6, 0x1b, 0xf9, 0x93, 0x6e, 0xbc, 0x91, 0xa9,
+	0xa0, 0x76, 0x63, 0xec, 0x5f, 0x80, 0x62, 0xc7, 0xa5, 0x50, 0x10, 0x53, 0xe2, 0xf7, 0x7d, 0xef,
+	0xfb, 0xfc, 0x3e, 0x3d, 0x63, 0x27, 0x12, 0xf9, 0x02, 0xa4, 0x08, 0x58, 0x26, 0x27, 0x49, 0x11,
+	0xcc, 0x7b, 0x41, 0xcc, 0x04, 0x2b, 0x78, 0x41, 0xb3, 0x5c, 0x82, 0x24, 0xc7, 0x35, 0x81, 0x6a,
+	0x02, 0x9d, 0xf7, 0xba, 0x27, 0xb1, 0x8c, 0xa5, 0x42, 0x83, 0xea, 0x4f, 0x13, 0xbb, 0x76, 0x2c,
+	0x65, 0x7c, 0xce, 0x02, 0x75, 0x1a, 0x97, 0xb3, 0x60, 0x5a, 0xe6, 0x11, 0x70, 0x29, 0x6a, 0xdc,
+	0xf9, 0x13, 0x07, 0x9e, 0xb2, 0x02, 0xa2, 0x34, 0xd3, 0x04, 0xef, 0x73, 0x0b, 0x77, 0x5e, 0x54,
+	0x26, 0x6f, 0xc4, 0x4c, 0x12, 0x1b, 0x63, 0x3e, 0x65, 0x02, 0xf8, 0x8c, 0xb3, 0xdc, 0x42, 0x2e,
+	0xf2, 0x3b, 0xe1, 0x4e, 0x85, 0xbc, 0xc7, 0xb8, 0x80, 0x28, 0x87, 0x51, 0x25, 0x63, 0x5d, 0x73,
+	0x91, 0x7f, 0xd8, 0xef, 0x52, 0xed, 0x41, 0x8d, 0x07, 0x7d, 0x67, 0x3c, 0x06, 0x0f, 0x2e, 0x97,
+	0x4e, 0x63, 0xb3, 0x74, 0x8e, 0x17, 0x51, 0x7a, 0x7e, 0xe6, 0xfd, 0xea, 0xf5, 0x2e, 0xbe, 0x39,
+	0x28, 0xec, 0xa8, 0x42, 0x45, 0x27, 0x09, 0x3e, 0x30, 0x57, 0xb7, 0x9a, 0x4a, 0xf7, 0xde, 0x9e,
+	0xee, 0xf3, 0x9a, 0x30, 0xe8, 0x55, 0xb2, 0x3f, 0x96, 0x0e, 0x31, 0x2d, 0xa7, 0x32, 0xe5, 0xc0,
+	0xd2, 0x0c, 0x16, 0x9b, 0xa5, 0x73, 0x4b, 0x9b, 0x19, 0xcc, 0xfb, 0x52, 0x59, 0x6d, 0xd5, 0xc9,
+	0x43, 0x7c, 0x73, 0x52, 0xe6, 0x39, 0x13, 0x30, 0x52, 0xe9, 0x5a, 0x2d, 0x17, 0xf9, 0xcd, 0xf0,
+	0xa8, 0x2e, 0xaa, 0x30, 0xc8, 0x27, 0x84, 0xad, 0xdf, 0x58, 0xa3, 0x9d, 0xb9, 0xaf, 0xff, 0x77,
+	0xee, 0x47, 0xf5, 0xdc, 0x8e, 0xbe, 0xca, 0xbf, 0x94, 0x74, 0x0a, 0xb7, 0x77, 0x9d, 0x87, 0xdb,
+	0x44, 0x9e, 0xe0, 0x3b, 0x9a, 0x3f, 0x91, 0xa5, 0x00, 0x2e, 0x62, 0xdd, 0xc8, 0xa6, 0x56, 0xdb,
+	0x45, 0xfe, 0x41, 0x78, 0xa2, 0xd0, 0x67, 0x35, 0x38, 0xd4, 0x18, 0x79, 0x8a, 0xbb, 0x7f, 0x73,
+	0x4b, 0x18, 0x8f, 0x13, 0xb0, 0x6e, 0xa8, 0x51, 0xef, 0xee, 0x19, 0xbe, 0x56, 0xb0, 0xf7, 0x16,
+	0x1f, 0xbd, 0xd2, 0x7b, 0x38, 0x84, 0x08, 0x18, 0x39, 0xc3, 0x6d, 0xbd, 0x80, 0x16, 0x72, 0x9b,
+	0xfe, 0x61, 0xff, 0x3e, 0xdd, 0xdb, 0x4b, 0xba, 0x5d, 0x9e, 0x41, 0xab, 0x1a, 0x3a, 0xac, 0x3b,
+	0x06, 0x2f, 0x2f, 0x57, 0x36, 0xba, 0x5a, 0xd9, 0xe8, 0xfb, 0xca, 0x46, 0x17, 0x6b, 0xbb, 0x71,
+	0xb5, 0xb6, 0x1b, 0x5f, 0xd7, 0x76, 0xe3, 0xc3, 0x69, 0xcc, 0x21, 0x29, 0xc7, 0x74, 0x22, 0xd3,
+	0xc0, 0x3c, 0x04, 0xf3, 0x9d, 0xf7, 0x83, 0x8f, 0xe6, 0x55, 0xc0, 0x22, 0x63, 0xc5, 0xb8, 0xad,
+	0xe2, 0x7d, 0xfc, 0x73, 0x00, 0x48, 0x09, 0x6e, 0xa4, 0x34, 0x03, 0x00, 0x00,
 }
 
 func (m *EpochInfo) Marshal() (dAtA []byte, err error) {
@@ -41,7 +41,7 @@ func (m *QueryEpochsInfoRequest) Reset()         { *m = QueryEpochsInfoRequest{}
 func (m *QueryEpochsInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochsInfoRequest) ProtoMessage()    {}
 func (*QueryEpochsInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4265dab7fbbe845, []int{0}
+	return fileDescriptor_dd75344032803c22, []int{0}
 }
 func (m *QueryEpochsInfoRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,7 +90,7 @@ func (m *QueryEpochsInfoResponse) Reset()         { *m = QueryEpochsInfoResponse
 func (m *QueryEpochsInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryEpochsInfoResponse) ProtoMessage()    {}
 func (*QueryEpochsInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4265dab7fbbe845, []int{1}
+	return fileDescriptor_dd75344032803c22, []int{1}
 }
 func (m *QueryEpochsInfoResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,7 +144,7 @@ func (m *QueryCurrentEpochRequest) Reset()         { *m = QueryCurrentEpochReque
 func (m *QueryCurrentEpochRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochRequest) ProtoMessage()    {}
 func (*QueryCurrentEpochRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4265dab7fbbe845, []int{2}
+	return fileDescriptor_dd75344032803c22, []int{2}
 }
 func (m *QueryCurrentEpochRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +191,7 @@ func (m *QueryCurrentEpochResponse) Reset()         { *m = QueryCurrentEpochResp
 func (m *QueryCurrentEpochResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCurrentEpochResponse) ProtoMessage()    {}
 func (*QueryCurrentEpochResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e4265dab7fbbe845, []int{3}
+	return fileDescriptor_dd75344032803c22, []int{3}
 }
 func (m *QueryCurrentEpochResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -234,39 +234,38 @@ func init() {
 	proto.RegisterType((*QueryCurrentEpochResponse)(nil), "anryton.epochs.v1.QueryCurrentEpochResponse")
 }
 
-func init() { proto.RegisterFile("anryton/epochs/v1/query.proto", fileDescriptor_e4265dab7fbbe845) }
+func init() { proto.RegisterFile("anryton/epochs/v1/query.proto", fileDescriptor_dd75344032803c22) }
 
-var fileDescriptor_e4265dab7fbbe845 = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x6b, 0x14, 0x31,
-	0x14, 0xc6, 0x37, 0x5b, 0x2d, 0x98, 0x56, 0x84, 0x20, 0x76, 0x3b, 0x6a, 0xba, 0x8c, 0xd0, 0x8e,
-	0x3d, 0x24, 0xcc, 0x78, 0x11, 0x4f, 0xb2, 0xa2, 0xe2, 0x4d, 0xe7, 0xe8, 0x45, 0x67, 0xa6, 0x69,
-	0x36, 0x60, 0x93, 0xe9, 0x24, 0x33, 0xd8, 0x9b, 0x78, 0x17, 0x04, 0x6f, 0xe2, 0x1f, 0xd4, 0x63,
-	0xc1, 0x8b, 0x27, 0x91, 0x5d, 0xff, 0x10, 0x99, 0x24, 0xd5, 0xd9, 0xdd, 0x81, 0xf5, 0x32, 0x84,
-	0xbc, 0xf7, 0x7d, 0xef, 0xf7, 0xbe, 0x09, 0xbc, 0xad, 0xa7, 0xe2, 0x48, 0x51, 0x56, 0xaa, 0x62,
-	0xaa, 0x69, 0x13, 0xd3, 0xd3, 0x9a, 0x55, 0x67, 0xa4, 0xac, 0x94, 0x51, 0xe8, 0x86, 0x2d, 0x12,
-	0x57, 0x24, 0x4d, 0x1c, 0x1c, 0x16, 0x4a, 0x9f, 0x28, 0x4d, 0xf3, 0x4c, 0x33, 0xd7, 0x49, 0x9b,
-	0x38, 0x67, 0x26, 0x8b, 0x69, 0x99, 0x71, 0x21, 0x33, 0x23, 0x94, 0x74, 0xe2, 0xe0, 0xee, 0xb2,
-	0x33, 0x67, 0x92, 0x69, 0xa1, 0x7d, 0xf9, 0x26, 0x57, 0x5c, 0xd9, 0x23, 0x6d, 0x4f, 0xfe, 0xf6,
-	0x0e, 0x57, 0x8a, 0xbf, 0x63, 0x34, 0x2b, 0x05, 0xcd, 0xa4, 0x54, 0xc6, 0x3a, 0x7a, 0x4d, 0xf8,
-	0x16, 0xde, 0x7a, 0xd5, 0x0e, 0x7d, 0x6a, 0x3d, 0x5f, 0xc8, 0x63, 0x95, 0xb2, 0xd3, 0x9a, 0x69,
-	0x83, 0x9e, 0x41, 0xf8, 0x0f, 0x60, 0x04, 0xc6, 0x20, 0xda, 0x4a, 0xf6, 0x89, 0xa3, 0x25, 0x2d,
-	0x2d, 0x71, 0x7b, 0x79, 0x5a, 0xf2, 0x32, 0xe3, 0xcc, 0x6b, 0xd3, 0x8e, 0x32, 0xfc, 0x06, 0xe0,
-	0xce, 0xca, 0x08, 0x5d, 0x2a, 0xa9, 0x19, 0x7a, 0x08, 0x37, 0xdd, 0x32, 0x23, 0x30, 0xde, 0x88,
-	0xb6, 0x92, 0x80, 0x2c, 0xc5, 0x43, 0xac, 0xa8, 0xd5, 0x4c, 0xae, 0x9c, 0xff, 0xdc, 0x1b, 0xa4,
-	0xbe, 0x1f, 0x3d, 0x5f, 0xa0, 0x1b, 0x5a, 0xba, 0x83, 0xb5, 0x74, 0x6e, 0xec, 0x02, 0xde, 0x23,
-	0x38, 0xb2, 0x74, 0x4f, 0xea, 0xaa, 0x62, 0xd2, 0xd8, 0x79, 0x97, 0x11, 0x60, 0x08, 0xc5, 0x11,
-	0x93, 0x46, 0x1c, 0x0b, 0x56, 0xd9, 0x08, 0xae, 0xa5, 0x9d, 0x9b, 0xf0, 0x31, 0xdc, 0xed, 0xd1,
-	0xfa, 0xdd, 0xee, 0xc1, 0xeb, 0x85, 0xbb, 0x7f, 0x63, 0x99, 0xad, 0x7e, 0x23, 0xdd, 0x2e, 0x3a,
-	0xcd, 0xc9, 0xd7, 0x21, 0xbc, 0x6a, 0x2d, 0xd0, 0x07, 0x00, 0xe1, 0xdf, 0x65, 0x35, 0x3a, 0x58,
-	0x49, 0xa2, 0xff, 0x37, 0x05, 0xd1, 0xfa, 0x46, 0x07, 0x14, 0xee, 0x7d, 0xfc, 0xfe, 0xfb, 0xcb,
-	0x70, 0x17, 0xed, 0xd0, 0xe5, 0x67, 0xe4, 0x33, 0xfd, 0x04, 0xe0, 0x76, 0x77, 0x15, 0x74, 0xbf,
-	0xdf, 0xbb, 0x27, 0xaa, 0xe0, 0xf0, 0x7f, 0x5a, 0x3d, 0xc8, 0xbe, 0x05, 0x19, 0x23, 0xbc, 0x02,
-	0xb2, 0x10, 0xd8, 0x64, 0x72, 0x3e, 0xc3, 0xe0, 0x62, 0x86, 0xc1, 0xaf, 0x19, 0x06, 0x9f, 0xe7,
-	0x78, 0x70, 0x31, 0xc7, 0x83, 0x1f, 0x73, 0x3c, 0x78, 0x1d, 0x71, 0x61, 0xa6, 0x75, 0x4e, 0x0a,
-	0x75, 0xe2, 0x3d, 0xdc, 0xb7, 0x49, 0xe8, 0xfb, 0x4b, 0x3b, 0x73, 0x56, 0x32, 0x9d, 0x6f, 0xda,
-	0x67, 0xfe, 0xe0, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37, 0x29, 0xa8, 0xa4, 0x95, 0x03, 0x00,
-	0x00,
+var fileDescriptor_dd75344032803c22 = []byte{
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xb1, 0x6f, 0xd4, 0x30,
+	0x14, 0xc6, 0xcf, 0x57, 0xa8, 0x84, 0x5b, 0x06, 0x2c, 0x04, 0xd7, 0x50, 0xd2, 0x23, 0x48, 0x70,
+	0x94, 0xca, 0x56, 0xc2, 0xd6, 0x09, 0x15, 0x51, 0xc4, 0x06, 0x19, 0x59, 0xc0, 0x09, 0xae, 0x6b,
+	0x89, 0xda, 0x69, 0xec, 0x44, 0xdc, 0xca, 0xc0, 0x8c, 0xd4, 0x9d, 0x91, 0xbf, 0xa5, 0x63, 0x25,
+	0x16, 0x26, 0x84, 0xee, 0xf8, 0x43, 0x50, 0x6c, 0x5f, 0xc9, 0x29, 0x41, 0xdc, 0x14, 0xeb, 0xf9,
+	0x7d, 0xdf, 0xfb, 0xbd, 0x2f, 0x86, 0x77, 0xa9, 0x2c, 0xa7, 0x46, 0x49, 0xc2, 0x0a, 0x95, 0x1f,
+	0x6b, 0x52, 0xc7, 0xe4, 0xb4, 0x62, 0xe5, 0x14, 0x17, 0xa5, 0x32, 0x0a, 0xdd, 0xf0, 0xd7, 0xd8,
+	0x5d, 0xe3, 0x3a, 0x0e, 0x76, 0x73, 0xa5, 0x4f, 0x94, 0x26, 0x19, 0xd5, 0xcc, 0xf5, 0x92, 0x3a,
+	0xce, 0x98, 0xa1, 0x31, 0x29, 0x28, 0x17, 0x92, 0x1a, 0xa1, 0xa4, 0x93, 0x07, 0x3b, 0x5d, 0x77,
+	0xce, 0x24, 0xd3, 0x42, 0xfb, 0x86, 0x9b, 0x5c, 0x71, 0x65, 0x8f, 0xa4, 0x39, 0xf9, 0xea, 0x36,
+	0x57, 0x8a, 0x7f, 0x60, 0x84, 0x16, 0x82, 0x50, 0x29, 0x95, 0xb1, 0x9e, 0x5e, 0x13, 0xbd, 0x83,
+	0xb7, 0x5e, 0x37, 0x63, 0x9f, 0x5b, 0xcf, 0x97, 0xf2, 0x48, 0xa5, 0xec, 0xb4, 0x62, 0xda, 0xa0,
+	0x43, 0x08, 0xff, 0x22, 0x8c, 0xc0, 0x18, 0x4c, 0x36, 0x92, 0x07, 0xd8, 0xf1, 0xe2, 0x86, 0x17,
+	0xbb, 0xdd, 0x3c, 0x2f, 0x7e, 0x45, 0x39, 0xf3, 0xda, 0xb4, 0xa5, 0x8c, 0xbe, 0x02, 0x78, 0xbb,
+	0x33, 0x42, 0x17, 0x4a, 0x6a, 0x86, 0xf6, 0xe1, 0xba, 0x5b, 0x66, 0x04, 0xc6, 0x6b, 0x93, 0x8d,
+	0x64, 0x1b, 0x77, 0x22, 0xc2, 0x56, 0xd6, 0xa8, 0x0e, 0xae, 0x9c, 0xff, 0xdc, 0x19, 0xa4, 0x5e,
+	0x81, 0x5e, 0x2c, 0xf1, 0x0d, 0x2d, 0xdf, 0xc3, 0xff, 0xf2, 0xb9, 0xc1, 0x4b, 0x80, 0xfb, 0x70,
+	0x64, 0xf9, 0x9e, 0x55, 0x65, 0xc9, 0xa4, 0xb1, 0xf3, 0x16, 0x21, 0x84, 0x10, 0x8a, 0xf7, 0x4c,
+	0x1a, 0x71, 0x24, 0x58, 0x69, 0x43, 0xb8, 0x96, 0xb6, 0x2a, 0xd1, 0x53, 0xb8, 0xd5, 0xa3, 0xf5,
+	0xdb, 0xdd, 0x87, 0xd7, 0x73, 0x57, 0x7f, 0x6b, 0x99, 0xad, 0x7e, 0x2d, 0xdd, 0xcc, 0x5b, 0xcd,
+	0xc9, 0xb7, 0x21, 0xbc, 0x6a, 0x2d, 0xd0, 0x67, 0x00, 0xe1, 0xe5, 0xb2, 0x1a, 0x3d, 0xea, 0xc9,
+	0xa2, 0xff, 0x57, 0x05, 0xbb, 0xab, 0xb4, 0x3a, 0xa8, 0xe8, 0xde, 0xa7, 0xef, 0xbf, 0xcf, 0x86,
+	0x77, 0xd0, 0x16, 0xe9, 0x3e, 0x27, 0x9f, 0xec, 0x19, 0x80, 0x9b, 0xed, 0x85, 0xd0, 0xe3, 0x7f,
+	0xf9, 0xf7, 0x44, 0x16, 0xec, 0xad, 0xd6, 0xec, 0x71, 0x26, 0x16, 0x27, 0x42, 0xe3, 0x1e, 0x9c,
+	0xa5, 0xf0, 0x0e, 0x0e, 0xcf, 0x67, 0x21, 0xb8, 0x98, 0x85, 0xe0, 0xd7, 0x2c, 0x04, 0x5f, 0xe6,
+	0xe1, 0xe0, 0x62, 0x1e, 0x0e, 0x7e, 0xcc, 0xc3, 0xc1, 0x9b, 0x3d, 0x2e, 0xcc, 0x71, 0x95, 0xe1,
+	0x5c, 0x9d, 0x5c, 0xba, 0x2c, 0xbe, 0x75, 0x42, 0x3e, 0x2e, 0x2c, 0xcd, 0xb4, 0x60, 0x3a, 0x5b,
+	0xb7, 0x0f, 0xff, 0xc9, 0x9f, 0x01, 0x00, 0x32, 0x9e, 0x67, 0x98, 0xad, 0x03, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -16,17 +16,27 @@ func (k Keeper) RegisterCoin(
 	ctx sdk.Context,
 	coinMetadata banktypes.Metadata,
 ) (*types.TokenPair, error) {
-	// Check if denomination is already registered
-	if k.IsDenomRegistered(ctx, coinMetadata.Name) {
+	// Check if the coin exists by ensuring the supply is set
+	if !k.bankKeeper.HasSupply(ctx, coinMetadata.Base) {
 		return nil, errorsmod.Wrapf(
-			types.ErrTokenPairAlreadyExists, "coin denomination already registered: %s", coinMetadata.Name,
+			errortypes.ErrInvalidCoins, "base denomination '%s' cannot have a supply of 0", coinMetadata.Base,
 		)
 	}
 
-	// Check if the coin exists by ensuring the supply is set
-	if !k.bankKeeper.HasSupply(ctx, coinMetadata.Base) {
+	return k.RegisterNewCoin(ctx, coinMetadata)
+}
+
+// RegisterNewCoin deploys an erc20 contract and creates the token pair for a
+// cosmos coin that has just been created and has no supply yet, e.g. a token
+// factory denom
+func (k Keeper) RegisterNewCoin(
+	ctx sdk.Context,
+	coinMetadata banktypes.Metadata,
+) (*types.TokenPair, error) {
+	// Check if denomination is already registered
+	if k.IsDenomRegistered(ctx, coinMetadata.Base) {
 		return nil, errorsmod.Wrapf(
-			errortypes.ErrInvalidCoins, "base denomination '%s' cannot have a supply of 0", coinMetadata.Base,
+			types.ErrTokenPairAlreadyExists, "coin denomination already registered: %s", coinMetadata.Base,
 		)
 	}
 
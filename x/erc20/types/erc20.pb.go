@@ -53,7 +53,7 @@ func (x Owner) String() string {
 }
 
 func (Owner) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_f8fbf28cf83220b8, []int{0}
+	return fileDescriptor_3c92a251bf8a0d43, []int{0}
 }
 
 // TokenPair defines an instance that records a pairing consisting of a native
@@ -73,7 +73,7 @@ func (m *TokenPair) Reset()         { *m = TokenPair{} }
 func (m *TokenPair) String() string { return proto.CompactTextString(m) }
 func (*TokenPair) ProtoMessage()    {}
 func (*TokenPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8fbf28cf83220b8, []int{0}
+	return fileDescriptor_3c92a251bf8a0d43, []int{0}
 }
 func (m *TokenPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -145,7 +145,7 @@ func (m *RegisterCoinProposal) Reset()         { *m = RegisterCoinProposal{} }
 func (m *RegisterCoinProposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterCoinProposal) ProtoMessage()    {}
 func (*RegisterCoinProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8fbf28cf83220b8, []int{1}
+	return fileDescriptor_3c92a251bf8a0d43, []int{1}
 }
 func (m *RegisterCoinProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +210,7 @@ func (m *RegisterERC20Proposal) Reset()         { *m = RegisterERC20Proposal{} }
 func (m *RegisterERC20Proposal) String() string { return proto.CompactTextString(m) }
 func (*RegisterERC20Proposal) ProtoMessage()    {}
 func (*RegisterERC20Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8fbf28cf83220b8, []int{2}
+	return fileDescriptor_3c92a251bf8a0d43, []int{2}
 }
 func (m *RegisterERC20Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -276,7 +276,7 @@ func (m *ToggleTokenConversionProposal) Reset()         { *m = ToggleTokenConver
 func (m *ToggleTokenConversionProposal) String() string { return proto.CompactTextString(m) }
 func (*ToggleTokenConversionProposal) ProtoMessage()    {}
 func (*ToggleTokenConversionProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8fbf28cf83220b8, []int{3}
+	return fileDescriptor_3c92a251bf8a0d43, []int{3}
 }
 func (m *ToggleTokenConversionProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -337,7 +337,7 @@ func (m *ProposalMetadata) Reset()         { *m = ProposalMetadata{} }
 func (m *ProposalMetadata) String() string { return proto.CompactTextString(m) }
 func (*ProposalMetadata) ProtoMessage()    {}
 func (*ProposalMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f8fbf28cf83220b8, []int{4}
+	return fileDescriptor_3c92a251bf8a0d43, []int{4}
 }
 func (m *ProposalMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -382,42 +382,42 @@ func init() {
 	proto.RegisterType((*ProposalMetadata)(nil), "anryton.erc20.v1.ProposalMetadata")
 }
 
-func init() { proto.RegisterFile("anryton/erc20/v1/erc20.proto", fileDescriptor_f8fbf28cf83220b8) }
+func init() { proto.RegisterFile("anryton/erc20/v1/erc20.proto", fileDescriptor_3c92a251bf8a0d43) }
 
-var fileDescriptor_f8fbf28cf83220b8 = []byte{
-	// 504 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_3c92a251bf8a0d43 = []byte{
+	// 502 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xf6, 0x35, 0x29, 0x34, 0xd7, 0x36, 0x0a, 0xa7, 0x44, 0xb2, 0x22, 0xd5, 0x8d, 0x82, 0x04,
-	0x11, 0x83, 0xdd, 0x98, 0x0d, 0x21, 0xa1, 0x34, 0x35, 0x52, 0x51, 0x9b, 0x44, 0x6e, 0x2a, 0x10,
-	0x4b, 0x74, 0xb6, 0x4f, 0xae, 0xd5, 0xc4, 0x2f, 0xba, 0x3b, 0x0c, 0x0c, 0xec, 0x8c, 0x2c, 0xec,
-	0x48, 0xf0, 0xc7, 0x74, 0xec, 0xc8, 0x84, 0x50, 0xb2, 0xf0, 0x67, 0x20, 0xdf, 0xd9, 0x88, 0x32,
-	0xd2, 0xc5, 0x7a, 0xdf, 0xf7, 0x7e, 0xf8, 0xbb, 0xef, 0xe9, 0xe1, 0xb6, 0xb8, 0x48, 0x22, 0x70,
-	0x18, 0x0f, 0xdd, 0x03, 0x27, 0xeb, 0xeb, 0xc0, 0x5e, 0x72, 0x90, 0x40, 0xea, 0x2a, 0x67, 0x6b,
-	0x2a, 0xeb, 0xb7, 0xad, 0x10, 0xc4, 0x02, 0x84, 0x13, 0xd0, 0xf4, 0xd2, 0xc9, 0xfa, 0x01, 0x93,
-	0xb4, 0xaf, 0x80, 0xae, 0x6f, 0x37, 0x63, 0x88, 0x41, 0x85, 0x4e, 0x1e, 0x69, 0xb6, 0xfb, 0x0d,
-	0xe1, 0xda, 0x14, 0x2e, 0x59, 0x3a, 0xa1, 0x09, 0x27, 0xf7, 0xf1, 0xae, 0x9a, 0x37, 0xa3, 0x51,
-	0xc4, 0x99, 0x10, 0x26, 0xea, 0xa0, 0x5e, 0xcd, 0xdf, 0x51, 0xe4, 0x40, 0x73, 0xa4, 0x89, 0x37,
-	0x23, 0x96, 0xc2, 0xc2, 0xdc, 0x50, 0x49, 0x0d, 0x88, 0x89, 0xef, 0xb2, 0x94, 0x06, 0x73, 0x16,
-	0x99, 0x95, 0x0e, 0xea, 0x6d, 0xf9, 0x25, 0x24, 0x4f, 0x71, 0x3d, 0x84, 0x54, 0x72, 0x1a, 0xca,
-	0x19, 0xbc, 0x4d, 0x19, 0x37, 0xab, 0x1d, 0xd4, 0xab, 0xbb, 0x2d, 0xfb, 0xe6, 0x0b, 0xec, 0x71,
-	0x9e, 0xf4, 0x77, 0xcb, 0x62, 0x05, 0x9f, 0x54, 0x7f, 0x7d, 0xd9, 0x47, 0xdd, 0xcf, 0x08, 0x37,
-	0x7d, 0x16, 0x27, 0x42, 0x32, 0x3e, 0x84, 0x24, 0x9d, 0x70, 0x58, 0x82, 0xa0, 0xf3, 0x5c, 0x8c,
-	0x4c, 0xe4, 0x9c, 0x15, 0x4a, 0x35, 0x20, 0x1d, 0xbc, 0x1d, 0x31, 0x11, 0xf2, 0x64, 0x29, 0x13,
-	0x48, 0x0b, 0xa1, 0x7f, 0x53, 0xe4, 0x19, 0xde, 0x5a, 0x30, 0x49, 0x23, 0x2a, 0xa9, 0x59, 0xe9,
-	0x54, 0x7a, 0xdb, 0xee, 0x9e, 0xad, 0x0d, 0xb4, 0x95, 0x67, 0x85, 0x81, 0xf6, 0x69, 0x51, 0x74,
-	0x58, 0xbd, 0xfa, 0xb1, 0x6f, 0xf8, 0x7f, 0x9a, 0x94, 0x2e, 0xa3, 0xfb, 0x01, 0xb7, 0x4a, 0x59,
-	0x9e, 0x3f, 0x74, 0x0f, 0x6e, 0xad, 0xeb, 0x01, 0xae, 0x2b, 0x3f, 0x8a, 0x05, 0x30, 0xa1, 0xd4,
-	0xd5, 0xfc, 0x7f, 0xd8, 0xe2, 0xf7, 0x02, 0xef, 0x4d, 0x21, 0x8e, 0xe7, 0x4c, 0xad, 0x70, 0x08,
-	0x69, 0xc6, 0xb8, 0x48, 0xe0, 0xf6, 0xf6, 0xe4, 0x7d, 0xf9, 0x48, 0xb5, 0xcb, 0xbc, 0x2f, 0x07,
-	0xc5, 0x2e, 0xce, 0x70, 0xa3, 0x9c, 0x5f, 0xba, 0x73, 0xc3, 0x4e, 0xf4, 0x1f, 0x76, 0x3e, 0x7a,
-	0x81, 0x37, 0xd5, 0xbe, 0x49, 0x0b, 0xdf, 0x1b, 0xbf, 0x1c, 0x79, 0xfe, 0xec, 0x7c, 0x74, 0x36,
-	0xf1, 0x86, 0xc7, 0xcf, 0x8f, 0xbd, 0xa3, 0x86, 0x41, 0x1a, 0x78, 0x47, 0xd3, 0xa7, 0xe3, 0xa3,
-	0xf3, 0x13, 0xaf, 0x81, 0x08, 0xc1, 0x75, 0xcd, 0x78, 0xaf, 0xa6, 0x9e, 0x3f, 0x1a, 0x9c, 0x34,
-	0x36, 0xda, 0xd5, 0x8f, 0x5f, 0x2d, 0xe3, 0x70, 0x70, 0xb5, 0xb2, 0xd0, 0xf5, 0xca, 0x42, 0x3f,
-	0x57, 0x16, 0xfa, 0xb4, 0xb6, 0x8c, 0xeb, 0xb5, 0x65, 0x7c, 0x5f, 0x5b, 0xc6, 0xeb, 0x87, 0x71,
-	0x22, 0x2f, 0xde, 0x04, 0x76, 0x08, 0x0b, 0x47, 0x9f, 0x96, 0xfe, 0x66, 0xae, 0xf3, 0xae, 0xb8,
-	0x32, 0xf9, 0x7e, 0xc9, 0x44, 0x70, 0x47, 0x5d, 0xc7, 0xe3, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff,
-	0x7c, 0xc4, 0x0f, 0x8f, 0x81, 0x03, 0x00, 0x00,
+	0x14, 0xf6, 0x35, 0x29, 0xb4, 0xd7, 0x36, 0x32, 0xa7, 0x54, 0x58, 0x11, 0x75, 0xa3, 0x20, 0xa1,
+	0x08, 0x24, 0xbb, 0x31, 0x1b, 0x03, 0xa8, 0x4d, 0x8d, 0x54, 0xd4, 0x26, 0x91, 0x9b, 0x0a, 0xc4,
+	0x12, 0x9d, 0xed, 0x93, 0xb1, 0x9a, 0xdc, 0x45, 0x77, 0x87, 0xa1, 0x03, 0x3b, 0x23, 0x0b, 0x3b,
+	0x12, 0x12, 0x7f, 0x4b, 0xc7, 0x8e, 0x4c, 0x08, 0x25, 0x0b, 0x7f, 0x06, 0xf2, 0xdd, 0x19, 0x41,
+	0x47, 0x3a, 0xf9, 0x7d, 0xdf, 0xfb, 0xe1, 0xef, 0xbe, 0xa7, 0x07, 0xef, 0x61, 0xca, 0x2f, 0x24,
+	0xa3, 0x3e, 0xe1, 0x49, 0xb0, 0xe7, 0x17, 0x3d, 0x1d, 0x78, 0x73, 0xce, 0x24, 0x43, 0xb6, 0xc9,
+	0x7a, 0x9a, 0x2c, 0x7a, 0x2d, 0x37, 0x61, 0x62, 0xc6, 0x84, 0x1f, 0x63, 0x7a, 0xee, 0x17, 0xbd,
+	0x98, 0x48, 0xdc, 0x53, 0x40, 0x77, 0xb4, 0x9a, 0x19, 0xcb, 0x98, 0x0a, 0xfd, 0x32, 0xd2, 0x6c,
+	0xe7, 0x1b, 0x80, 0xeb, 0x63, 0x76, 0x4e, 0xe8, 0x08, 0xe7, 0x1c, 0xdd, 0x87, 0x5b, 0x6a, 0xde,
+	0x04, 0xa7, 0x29, 0x27, 0x42, 0x38, 0xa0, 0x0d, 0xba, 0xeb, 0xd1, 0xa6, 0x22, 0xf7, 0x35, 0x87,
+	0x9a, 0x70, 0x35, 0x25, 0x94, 0xcd, 0x9c, 0x15, 0x95, 0xd4, 0x00, 0x39, 0xf0, 0x36, 0xa1, 0x38,
+	0x9e, 0x92, 0xd4, 0xa9, 0xb5, 0x41, 0x77, 0x2d, 0xaa, 0x20, 0x7a, 0x0a, 0x1b, 0x09, 0xa3, 0x92,
+	0xe3, 0x44, 0x4e, 0xd8, 0x3b, 0x4a, 0xb8, 0x53, 0x6f, 0x83, 0x6e, 0x23, 0xb8, 0xeb, 0x5d, 0x7f,
+	0x83, 0x37, 0x2c, 0xd3, 0xd1, 0x56, 0x55, 0xae, 0xe0, 0x93, 0xfa, 0xaf, 0x2f, 0xbb, 0xa0, 0xf3,
+	0x19, 0xc0, 0x66, 0x44, 0xb2, 0x5c, 0x48, 0xc2, 0xfb, 0x2c, 0xa7, 0x23, 0xce, 0xe6, 0x4c, 0xe0,
+	0x69, 0x29, 0x47, 0xe6, 0x72, 0x4a, 0x8c, 0x56, 0x0d, 0x50, 0x1b, 0x6e, 0xa4, 0x44, 0x24, 0x3c,
+	0x9f, 0xcb, 0x9c, 0x51, 0x23, 0xf5, 0x6f, 0x0a, 0x3d, 0x83, 0x6b, 0x33, 0x22, 0x71, 0x8a, 0x25,
+	0x76, 0x6a, 0xed, 0x5a, 0x77, 0x23, 0xd8, 0xf1, 0xb4, 0x85, 0x9e, 0x72, 0xcd, 0x58, 0xe8, 0x9d,
+	0x98, 0xa2, 0x83, 0xfa, 0xe5, 0x8f, 0x5d, 0x2b, 0xfa, 0xd3, 0xa4, 0x74, 0x59, 0x9d, 0x0f, 0x70,
+	0xbb, 0x92, 0x15, 0x46, 0xfd, 0x60, 0xef, 0xc6, 0xba, 0x1e, 0xc0, 0x86, 0xf2, 0xc3, 0xac, 0x80,
+	0x08, 0xa5, 0x6e, 0x3d, 0xba, 0xc6, 0x9a, 0xdf, 0x0b, 0xb8, 0x33, 0x66, 0x59, 0x36, 0x25, 0x6a,
+	0x89, 0x7d, 0x46, 0x0b, 0xc2, 0x45, 0xce, 0x6e, 0x6e, 0x4f, 0xd9, 0x57, 0x8e, 0x74, 0x6a, 0xa6,
+	0xaf, 0x04, 0x66, 0x17, 0xa7, 0xd0, 0xae, 0xe6, 0x57, 0xee, 0xfc, 0x63, 0x27, 0xf8, 0x0f, 0x3b,
+	0x1f, 0xbe, 0x80, 0xab, 0x6a, 0xdf, 0x68, 0x1b, 0xde, 0x19, 0xbe, 0x1c, 0x84, 0xd1, 0xe4, 0x6c,
+	0x70, 0x3a, 0x0a, 0xfb, 0x47, 0xcf, 0x8f, 0xc2, 0x43, 0xdb, 0x42, 0x36, 0xdc, 0xd4, 0xf4, 0xc9,
+	0xf0, 0xf0, 0xec, 0x38, 0xb4, 0x01, 0x42, 0xb0, 0xa1, 0x99, 0xf0, 0xd5, 0x38, 0x8c, 0x06, 0xfb,
+	0xc7, 0xf6, 0x4a, 0xab, 0xfe, 0xf1, 0xab, 0x6b, 0x1d, 0x84, 0x97, 0x0b, 0x17, 0x5c, 0x2d, 0x5c,
+	0xf0, 0x73, 0xe1, 0x82, 0x4f, 0x4b, 0xd7, 0xba, 0x5a, 0xba, 0xd6, 0xf7, 0xa5, 0x6b, 0xbd, 0x7e,
+	0x94, 0xe5, 0xf2, 0xcd, 0xdb, 0xd8, 0x4b, 0xd8, 0xcc, 0xaf, 0x0e, 0xac, 0xfa, 0x16, 0x81, 0xff,
+	0xde, 0x5c, 0x9b, 0xbc, 0x98, 0x13, 0x11, 0xdf, 0x52, 0x37, 0xf2, 0xf8, 0xf7, 0x00, 0x55, 0x5c,
+	0xf9, 0xcd, 0x8b, 0x03, 0x00, 0x00,
 }
 
 func (this *TokenPair) Equal(that interface{}) bool {
@@ -34,7 +34,7 @@ func (m *EventRegisterPair) Reset()         { *m = EventRegisterPair{} }
 func (m *EventRegisterPair) String() string { return proto.CompactTextString(m) }
 func (*EventRegisterPair) ProtoMessage()    {}
 func (*EventRegisterPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93cbd6ee451ccaa3, []int{0}
+	return fileDescriptor_7e0fde0c6139d5e2, []int{0}
 }
 func (m *EventRegisterPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -89,7 +89,7 @@ func (m *EventToggleTokenConversion) Reset()         { *m = EventToggleTokenConv
 func (m *EventToggleTokenConversion) String() string { return proto.CompactTextString(m) }
 func (*EventToggleTokenConversion) ProtoMessage()    {}
 func (*EventToggleTokenConversion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93cbd6ee451ccaa3, []int{1}
+	return fileDescriptor_7e0fde0c6139d5e2, []int{1}
 }
 func (m *EventToggleTokenConversion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -150,7 +150,7 @@ func (m *EventConvertCoin) Reset()         { *m = EventConvertCoin{} }
 func (m *EventConvertCoin) String() string { return proto.CompactTextString(m) }
 func (*EventConvertCoin) ProtoMessage()    {}
 func (*EventConvertCoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93cbd6ee451ccaa3, []int{2}
+	return fileDescriptor_7e0fde0c6139d5e2, []int{2}
 }
 func (m *EventConvertCoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -232,7 +232,7 @@ func (m *EventConvertERC20) Reset()         { *m = EventConvertERC20{} }
 func (m *EventConvertERC20) String() string { return proto.CompactTextString(m) }
 func (*EventConvertERC20) ProtoMessage()    {}
 func (*EventConvertERC20) Descriptor() ([]byte, []int) {
-	return fileDescriptor_93cbd6ee451ccaa3, []int{3}
+	return fileDescriptor_7e0fde0c6139d5e2, []int{3}
 }
 func (m *EventConvertERC20) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,30 +303,30 @@ func init() {
 	proto.RegisterType((*EventConvertERC20)(nil), "anryton.erc20.v1.EventConvertERC20")
 }
 
-func init() { proto.RegisterFile("anryton/erc20/v1/events.proto", fileDescriptor_93cbd6ee451ccaa3) }
+func init() { proto.RegisterFile("anryton/erc20/v1/events.proto", fileDescriptor_7e0fde0c6139d5e2) }
 
-var fileDescriptor_93cbd6ee451ccaa3 = []byte{
+var fileDescriptor_7e0fde0c6139d5e2 = []byte{
 	// 310 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xcf, 0x4a, 0x03, 0x31,
-	0x10, 0xc6, 0x1b, 0xb5, 0x45, 0x83, 0x7f, 0xea, 0x22, 0x52, 0x2a, 0x04, 0xa9, 0x07, 0xf5, 0xb2,
-	0xdb, 0xd6, 0x27, 0xa8, 0xa5, 0x57, 0x91, 0xa5, 0x20, 0x78, 0x91, 0xed, 0xee, 0xb0, 0x0d, 0xba,
-	0x99, 0x92, 0xa4, 0x41, 0xdf, 0xc2, 0xab, 0x37, 0x1f, 0xc7, 0x63, 0x8f, 0x1e, 0x65, 0xf7, 0x45,
-	0xa4, 0x49, 0x54, 0x10, 0xf1, 0x22, 0x78, 0x09, 0x7c, 0xf3, 0x31, 0x3f, 0x7e, 0x84, 0xa1, 0x07,
-	0x6a, 0xca, 0x33, 0x8c, 0x40, 0xa6, 0xfd, 0x6e, 0x64, 0x7a, 0x11, 0x18, 0x10, 0x5a, 0x85, 0x33,
-	0x89, 0x1a, 0x83, 0x6d, 0x5b, 0x86, 0xb6, 0x0c, 0x4d, 0xaf, 0x73, 0x41, 0x77, 0x47, 0xcb, 0x3e,
-	0x86, 0x9c, 0x2b, 0x0d, 0xf2, 0x32, 0xe1, 0x32, 0xd8, 0xa3, 0xf5, 0x0c, 0x04, 0x16, 0x2d, 0x72,
-	0x48, 0x4e, 0x36, 0x62, 0x17, 0x82, 0x23, 0xba, 0x65, 0xd7, 0x6e, 0x92, 0x2c, 0x93, 0xa0, 0x54,
-	0x6b, 0xc5, 0xb6, 0x9b, 0x76, 0x38, 0x70, 0xb3, 0xce, 0x15, 0x6d, 0x5b, 0xde, 0x18, 0xf3, 0xfc,
-	0x0e, 0xc6, 0x78, 0x0b, 0x62, 0x88, 0xc2, 0x80, 0x54, 0x1c, 0xc5, 0x5f, 0xc0, 0x4f, 0x84, 0x36,
-	0x2d, 0xd9, 0xe1, 0xf4, 0x10, 0xb9, 0x08, 0xf6, 0x69, 0x43, 0x81, 0xc8, 0x40, 0x7a, 0xa0, 0x4f,
-	0x41, 0x9b, 0xae, 0x4b, 0x48, 0x81, 0x1b, 0x90, 0x1e, 0xf6, 0x99, 0x97, 0x3b, 0x49, 0x81, 0x73,
-	0xa1, 0x5b, 0xab, 0x6e, 0xc7, 0xa5, 0x2f, 0xb7, 0xb5, 0x5f, 0xdd, 0xea, 0x3f, 0xb8, 0x3d, 0x13,
-	0xff, 0x8b, 0xde, 0x6d, 0x14, 0x0f, 0xfb, 0xdd, 0x7f, 0x90, 0x3b, 0xa5, 0xcd, 0x14, 0x85, 0x96,
-	0x49, 0xaa, 0xbf, 0xf9, 0xed, 0x7c, 0xcc, 0xbd, 0xe2, 0xf9, 0xe0, 0xa5, 0x64, 0x64, 0x51, 0x32,
-	0xf2, 0x56, 0x32, 0xf2, 0x58, 0xb1, 0xda, 0xa2, 0x62, 0xb5, 0xd7, 0x8a, 0xd5, 0xae, 0x8f, 0x73,
-	0xae, 0xa7, 0xf3, 0x49, 0x98, 0x62, 0x11, 0xb9, 0xcb, 0x71, 0xaf, 0xe9, 0x47, 0xf7, 0xfe, 0x88,
-	0xf4, 0xc3, 0x0c, 0xd4, 0xa4, 0x61, 0x2f, 0xe8, 0xec, 0x3d, 0x00, 0x00, 0xff, 0xff, 0x9b, 0x13,
-	0x54, 0xea, 0x60, 0x02, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x3b, 0xf7, 0xda, 0xa2, 0x83, 0x62, 0x1b, 0x44, 0x4a, 0xc1, 0x41, 0xea, 0x46, 0x11,
+	0x92, 0xb6, 0x3e, 0x81, 0x96, 0x6c, 0x45, 0x42, 0x41, 0x70, 0x23, 0x69, 0x72, 0x88, 0x83, 0x66,
+	0x4e, 0x99, 0x99, 0x0e, 0xf6, 0x2d, 0xdc, 0xba, 0xf3, 0x71, 0x5c, 0x76, 0xe9, 0x52, 0x92, 0x17,
+	0x91, 0x4e, 0x26, 0x2a, 0x22, 0x6e, 0x04, 0x57, 0xc3, 0x7f, 0xce, 0x9c, 0x8f, 0x6f, 0xf1, 0xd3,
+	0xbd, 0x58, 0xc8, 0x85, 0x46, 0x11, 0x80, 0x4c, 0x46, 0x83, 0xc0, 0x0c, 0x03, 0x30, 0x20, 0xb4,
+	0xf2, 0x67, 0x12, 0x35, 0x7a, 0x6d, 0xb7, 0xf6, 0xed, 0xda, 0x37, 0xc3, 0xfe, 0x39, 0xed, 0x84,
+	0xab, 0x1f, 0x11, 0x64, 0x5c, 0x69, 0x90, 0x17, 0x31, 0x97, 0xde, 0x0e, 0x6d, 0xa6, 0x20, 0x30,
+	0xef, 0x92, 0x7d, 0x72, 0xb8, 0x11, 0x55, 0xc1, 0x3b, 0xa0, 0x5b, 0xf6, 0xec, 0x3a, 0x4e, 0x53,
+	0x09, 0x4a, 0x75, 0xff, 0xd9, 0xed, 0xa6, 0x1d, 0x9e, 0x56, 0xb3, 0xfe, 0x25, 0xed, 0x59, 0xde,
+	0x04, 0xb3, 0xec, 0x0e, 0x26, 0x78, 0x0b, 0x62, 0x8c, 0xc2, 0x80, 0x54, 0x1c, 0xc5, 0x6f, 0xc0,
+	0x8f, 0x84, 0xb6, 0x2d, 0xb9, 0xc2, 0xe9, 0x31, 0x72, 0xe1, 0xed, 0xd2, 0x96, 0x02, 0x91, 0x82,
+	0x74, 0x40, 0x97, 0xbc, 0x1e, 0x5d, 0x97, 0x90, 0x00, 0x37, 0x20, 0x1d, 0xec, 0x3d, 0xaf, 0x6e,
+	0xe2, 0x1c, 0xe7, 0x42, 0x77, 0xff, 0x57, 0x37, 0x55, 0xfa, 0x70, 0x5b, 0xfb, 0xd1, 0xad, 0xf9,
+	0x8d, 0xdb, 0x13, 0xa1, 0x9d, 0xcf, 0x6e, 0x61, 0x34, 0x1e, 0x0d, 0xfe, 0x40, 0xee, 0x88, 0xb6,
+	0x13, 0x14, 0x5a, 0xc6, 0x89, 0xfe, 0xe2, 0xb7, 0x5d, 0xcf, 0x9d, 0xe2, 0x59, 0xf8, 0x5c, 0x30,
+	0xb2, 0x2c, 0x18, 0x79, 0x2d, 0x18, 0x79, 0x28, 0x59, 0x63, 0x59, 0xb2, 0xc6, 0x4b, 0xc9, 0x1a,
+	0x57, 0xc7, 0x19, 0xd7, 0x37, 0xf3, 0xa9, 0x9f, 0x60, 0x1e, 0xd4, 0xed, 0xa9, 0x5f, 0x33, 0x0a,
+	0xee, 0x5d, 0x95, 0xf4, 0x62, 0x06, 0x6a, 0xda, 0xb2, 0x3d, 0x3a, 0x79, 0x1b, 0x00, 0x15, 0x0e,
+	0xdd, 0x36, 0x68, 0x02, 0x00, 0x00,
 }
 
 func (m *EventRegisterPair) Marshal() (dAtA []byte, err error) {
@@ -41,7 +41,7 @@ func (m *QueryTokenPairsRequest) Reset()         { *m = QueryTokenPairsRequest{}
 func (m *QueryTokenPairsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenPairsRequest) ProtoMessage()    {}
 func (*QueryTokenPairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05e2e8eee8846461, []int{0}
+	return fileDescriptor_78159bd6ad4405a4, []int{0}
 }
 func (m *QueryTokenPairsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -90,7 +90,7 @@ func (m *QueryTokenPairsResponse) Reset()         { *m = QueryTokenPairsResponse
 func (m *QueryTokenPairsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenPairsResponse) ProtoMessage()    {}
 func (*QueryTokenPairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05e2e8eee8846461, []int{1}
+	return fileDescriptor_78159bd6ad4405a4, []int{1}
 }
 func (m *QueryTokenPairsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -144,7 +144,7 @@ func (m *QueryTokenPairRequest) Reset()         { *m = QueryTokenPairRequest{} }
 func (m *QueryTokenPairRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenPairRequest) ProtoMessage()    {}
 func (*QueryTokenPairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05e2e8eee8846461, []int{2}
+	return fileDescriptor_78159bd6ad4405a4, []int{2}
 }
 func (m *QueryTokenPairRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -191,7 +191,7 @@ func (m *QueryTokenPairResponse) Reset()         { *m = QueryTokenPairResponse{}
 func (m *QueryTokenPairResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenPairResponse) ProtoMessage()    {}
 func (*QueryTokenPairResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05e2e8eee8846461, []int{3}
+	return fileDescriptor_78159bd6ad4405a4, []int{3}
 }
 func (m *QueryTokenPairResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +235,7 @@ func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
 func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsRequest) ProtoMessage()    {}
 func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05e2e8eee8846461, []int{4}
+	return fileDescriptor_78159bd6ad4405a4, []int{4}
 }
 func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -275,7 +275,7 @@ func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
 func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryParamsResponse) ProtoMessage()    {}
 func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_05e2e8eee8846461, []int{5}
+	return fileDescriptor_78159bd6ad4405a4, []int{5}
 }
 func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -320,42 +320,42 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "anryton.erc20.v1.QueryParamsResponse")
 }
 
-func init() { proto.RegisterFile("anryton/erc20/v1/query.proto", fileDescriptor_05e2e8eee8846461) }
-
-var fileDescriptor_05e2e8eee8846461 = []byte{
-	// 502 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x53, 0x4f, 0x8f, 0xd2, 0x40,
-	0x14, 0x67, 0x76, 0x5d, 0x12, 0x1e, 0x89, 0x87, 0x11, 0x11, 0xab, 0xd6, 0x4d, 0xc9, 0xc2, 0x46,
-	0xe3, 0x8c, 0x54, 0xcf, 0x46, 0xf7, 0xa0, 0x07, 0x2f, 0x48, 0x3c, 0x18, 0x2f, 0x3a, 0xe0, 0x64,
-	0xb6, 0x51, 0x3a, 0xa5, 0x33, 0x10, 0x37, 0xc6, 0xcb, 0x5e, 0x8c, 0x37, 0x13, 0xbf, 0x82, 0x1f,
-	0x66, 0x8f, 0x9b, 0x78, 0xf1, 0x64, 0x0c, 0xf8, 0x41, 0x4c, 0x67, 0x86, 0xb2, 0xad, 0x04, 0x2e,
-	0xa4, 0x7d, 0xef, 0xfd, 0xfe, 0xbd, 0x57, 0xc0, 0x53, 0xc7, 0xd1, 0x3b, 0x49, 0x79, 0x3a, 0x0a,
-	0xef, 0xd3, 0x59, 0x8f, 0x4e, 0xa6, 0x3c, 0x3d, 0x21, 0x49, 0x2a, 0xb5, 0xc4, 0x97, 0x4d, 0x8f,
-	0x98, 0x1e, 0x99, 0xf5, 0xbc, 0x3b, 0x23, 0xa9, 0xc6, 0x52, 0xd1, 0x21, 0x53, 0xdc, 0x0e, 0xd2,
-	0x59, 0x6f, 0xc8, 0x35, 0xeb, 0xd1, 0x84, 0x89, 0x28, 0x66, 0x3a, 0x92, 0xb1, 0xc5, 0x7a, 0x65,
-	0x5e, 0x4b, 0x62, 0x7b, 0x37, 0x4b, 0x3d, 0xc1, 0x63, 0xae, 0x22, 0xe5, 0xba, 0x0d, 0x21, 0x85,
-	0x34, 0x8f, 0x34, 0x7b, 0x5a, 0x62, 0x84, 0x94, 0xe2, 0x03, 0xa7, 0x2c, 0x89, 0x28, 0x8b, 0x63,
-	0xa9, 0x8d, 0x98, 0xc3, 0x04, 0x6f, 0xa1, 0xf9, 0x22, 0xf3, 0xf3, 0x52, 0xbe, 0xe7, 0x71, 0x9f,
-	0x45, 0xa9, 0x1a, 0xf0, 0xc9, 0x94, 0x2b, 0x8d, 0x9f, 0x02, 0xac, 0xbc, 0xb5, 0xd0, 0x3e, 0x3a,
-	0xac, 0x87, 0x1d, 0x62, 0x83, 0x90, 0x2c, 0x08, 0xb1, 0x89, 0x5d, 0x10, 0xd2, 0x67, 0x82, 0x3b,
-	0xec, 0xe0, 0x02, 0x32, 0xf8, 0x81, 0xe0, 0xda, 0x7f, 0x12, 0x2a, 0x91, 0xb1, 0xe2, 0xf8, 0x31,
-	0xd4, 0x75, 0x56, 0x7d, 0x93, 0x64, 0xe5, 0x16, 0xda, 0xdf, 0x3d, 0xac, 0x87, 0xd7, 0x49, 0x71,
-	0x7b, 0x24, 0x07, 0x1e, 0x5d, 0x3a, 0xfb, 0x7d, 0xbb, 0x32, 0x00, 0x9d, 0x33, 0xe1, 0x67, 0x05,
-	0x97, 0x3b, 0xc6, 0x65, 0x77, 0xab, 0x4b, 0x2b, 0x5f, 0xb0, 0x79, 0x0f, 0xae, 0x16, 0x5d, 0x2e,
-	0xf7, 0xd0, 0x80, 0x3d, 0xa3, 0x67, 0x56, 0x50, 0x1b, 0xd8, 0x97, 0xe0, 0x55, 0x79, 0x6f, 0x79,
-	0xa6, 0x47, 0x00, 0xab, 0x4c, 0x6e, 0x6f, 0x5b, 0x23, 0xd5, 0xf2, 0x48, 0x41, 0x03, 0xb0, 0x61,
-	0xee, 0xb3, 0x94, 0x8d, 0x97, 0xd7, 0x08, 0x9e, 0xc3, 0x95, 0x42, 0xd5, 0x89, 0x3d, 0x84, 0x6a,
-	0x62, 0x2a, 0x4e, 0xa8, 0x59, 0x16, 0xb2, 0xf3, 0x4e, 0xc5, 0xcd, 0x86, 0x5f, 0x77, 0x61, 0xcf,
-	0xb0, 0xe1, 0x53, 0x04, 0xb0, 0xba, 0x0b, 0xee, 0x94, 0xe1, 0xeb, 0xbf, 0x0d, 0xaf, 0xbb, 0x75,
-	0xce, 0xfa, 0x0b, 0xda, 0xa7, 0x3f, 0xff, 0x7e, 0xdf, 0xb9, 0x85, 0x6f, 0xd0, 0xd2, 0x97, 0x7b,
-	0xe1, 0xec, 0xf8, 0x0b, 0x82, 0x5a, 0x8e, 0xc5, 0x07, 0x9b, 0xb9, 0x97, 0x16, 0x3a, 0xdb, 0xc6,
-	0x9c, 0x83, 0xbb, 0xc6, 0xc1, 0x01, 0x6e, 0x6f, 0x70, 0x40, 0x3f, 0x99, 0x97, 0xcf, 0x78, 0x02,
-	0x55, 0xbb, 0x30, 0x1c, 0xac, 0xa5, 0x2f, 0xdc, 0xc4, 0x6b, 0x6f, 0x9c, 0x71, 0xfa, 0xbe, 0xd1,
-	0x6f, 0xe1, 0x66, 0x59, 0xdf, 0xde, 0xe2, 0xe8, 0xc9, 0xd9, 0xdc, 0x47, 0xe7, 0x73, 0x1f, 0xfd,
-	0x99, 0xfb, 0xe8, 0xdb, 0xc2, 0xaf, 0x9c, 0x2f, 0xfc, 0xca, 0xaf, 0x85, 0x5f, 0x79, 0xdd, 0x15,
-	0x91, 0x3e, 0x9e, 0x0e, 0xc9, 0x48, 0x8e, 0x1d, 0xd6, 0xfe, 0xce, 0x42, 0xfa, 0xd1, 0xd1, 0xe8,
-	0x93, 0x84, 0xab, 0x61, 0xd5, 0xfc, 0x95, 0x1f, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x21, 0xde,
-	0xdb, 0xe0, 0x92, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("anryton/erc20/v1/query.proto", fileDescriptor_78159bd6ad4405a4) }
+
+var fileDescriptor_78159bd6ad4405a4 = []byte{
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x8b, 0x13, 0x3f,
+	0x14, 0xc7, 0x9b, 0xdd, 0xdf, 0x16, 0xfa, 0x7a, 0xf9, 0x11, 0xab, 0x96, 0x51, 0x67, 0xcb, 0x60,
+	0x6d, 0x55, 0x36, 0xb1, 0x23, 0x78, 0x96, 0x82, 0x7a, 0x12, 0x6a, 0xf1, 0xb4, 0x17, 0x4d, 0x4b,
+	0x18, 0x07, 0x6d, 0x32, 0x3b, 0x49, 0xab, 0x45, 0xbc, 0x78, 0xf2, 0x22, 0x08, 0x1e, 0xbd, 0xfb,
+	0xb7, 0xec, 0x71, 0xc1, 0x8b, 0x27, 0x91, 0xd6, 0x3f, 0x44, 0x26, 0xc9, 0x4c, 0x77, 0x76, 0xd4,
+	0xee, 0xa9, 0x93, 0xbc, 0xf7, 0xbe, 0xef, 0xf3, 0x7d, 0x2f, 0x85, 0xab, 0x4c, 0xa4, 0x4b, 0x2d,
+	0x05, 0xe5, 0xe9, 0x34, 0xbc, 0x43, 0x17, 0x03, 0x7a, 0x34, 0xe7, 0xe9, 0x92, 0x24, 0xa9, 0xd4,
+	0x12, 0xff, 0xef, 0xa2, 0xc4, 0x44, 0xc9, 0x62, 0xe0, 0xdd, 0x9a, 0x4a, 0x35, 0x93, 0x8a, 0x4e,
+	0x98, 0xe2, 0x36, 0x95, 0x2e, 0x06, 0x13, 0xae, 0xd9, 0x80, 0x26, 0x2c, 0x8a, 0x05, 0xd3, 0xb1,
+	0x14, 0xb6, 0xda, 0xab, 0x6a, 0x5b, 0x19, 0x1b, 0xf5, 0x2b, 0xd1, 0x88, 0x0b, 0xae, 0x62, 0xe5,
+	0xe2, 0xad, 0x48, 0x46, 0xd2, 0x7c, 0xd2, 0xec, 0x2b, 0xd7, 0x8c, 0xa4, 0x8c, 0x5e, 0x71, 0xca,
+	0x92, 0x98, 0x32, 0x21, 0xa4, 0x36, 0x0d, 0x5d, 0x4d, 0xf0, 0x1c, 0x2e, 0x3d, 0xc9, 0x98, 0x9e,
+	0xca, 0x97, 0x5c, 0x8c, 0x58, 0x9c, 0xaa, 0x31, 0x3f, 0x9a, 0x73, 0xa5, 0xf1, 0x43, 0x80, 0x0d,
+	0x5f, 0x1b, 0x75, 0x50, 0xbf, 0x19, 0xde, 0x20, 0xd6, 0x0c, 0xc9, 0xcc, 0x10, 0xeb, 0xdb, 0x99,
+	0x21, 0x23, 0x16, 0x71, 0x57, 0x3b, 0x3e, 0x55, 0x19, 0x7c, 0x45, 0x70, 0xb9, 0xd2, 0x42, 0x25,
+	0x52, 0x28, 0x8e, 0x87, 0xd0, 0xd4, 0xd9, 0xed, 0xb3, 0x24, 0xbb, 0x6e, 0xa3, 0xce, 0x6e, 0xbf,
+	0x19, 0x5e, 0x21, 0x67, 0x67, 0x48, 0x8a, 0xd2, 0xe1, 0x7f, 0xc7, 0x3f, 0xf6, 0x6b, 0x63, 0xd0,
+	0x85, 0x16, 0x7e, 0x54, 0xe2, 0xdc, 0x31, 0x9c, 0xbd, 0xad, 0x9c, 0x16, 0xa0, 0x04, 0x7a, 0x00,
+	0x17, 0xcb, 0x9c, 0xf9, 0x24, 0x5a, 0xb0, 0x67, 0xfa, 0x99, 0x21, 0x34, 0xc6, 0xf6, 0x10, 0x1c,
+	0x9e, 0x9d, 0x5c, 0xe1, 0xea, 0x3e, 0xc0, 0xc6, 0x95, 0x9b, 0xdc, 0x39, 0x4c, 0x35, 0x0a, 0x53,
+	0x41, 0x0b, 0xb0, 0xd1, 0x1e, 0xb1, 0x94, 0xcd, 0xf2, 0x8d, 0x04, 0x8f, 0xe1, 0x42, 0xe9, 0xd6,
+	0xb5, 0xbb, 0x07, 0xf5, 0xc4, 0xdc, 0xb8, 0x56, 0xed, 0x6a, 0x2b, 0x5b, 0xe1, 0xfa, 0xb8, 0xec,
+	0xf0, 0xcb, 0x2e, 0xec, 0x19, 0x3d, 0xfc, 0x01, 0x01, 0x6c, 0xb6, 0x83, 0xfb, 0x55, 0x81, 0x3f,
+	0xbf, 0x11, 0xef, 0xe6, 0x39, 0x32, 0x2d, 0x65, 0xd0, 0x7d, 0xff, 0xed, 0xd7, 0xe7, 0x9d, 0x7d,
+	0x7c, 0x8d, 0x56, 0x5e, 0xf1, 0xa9, 0x27, 0x80, 0x3f, 0x22, 0x68, 0x14, 0xd5, 0xb8, 0xb7, 0x4d,
+	0x3f, 0x07, 0xe9, 0x6f, 0x4f, 0x74, 0x1c, 0x07, 0x86, 0xa3, 0x87, 0xbb, 0xff, 0xe4, 0xa0, 0x6f,
+	0xcd, 0xe1, 0x1d, 0x7e, 0x0d, 0x75, 0x3b, 0x3c, 0x7c, 0xfd, 0x2f, 0x2d, 0x4a, 0x3b, 0xf2, 0xba,
+	0x5b, 0xb2, 0x1c, 0x45, 0xc7, 0x50, 0x78, 0xb8, 0x5d, 0xa5, 0xb0, 0xdb, 0x19, 0x3e, 0x38, 0x5e,
+	0xf9, 0xe8, 0x64, 0xe5, 0xa3, 0x9f, 0x2b, 0x1f, 0x7d, 0x5a, 0xfb, 0xb5, 0x93, 0xb5, 0x5f, 0xfb,
+	0xbe, 0xf6, 0x6b, 0x87, 0xb7, 0xa3, 0x58, 0xbf, 0x98, 0x4f, 0xc8, 0x54, 0xce, 0x8a, 0xea, 0xfc,
+	0x77, 0x11, 0xd2, 0x37, 0x4e, 0x4a, 0x2f, 0x13, 0xae, 0x26, 0x75, 0xf3, 0x37, 0xbf, 0xfb, 0x7b,
+	0x00, 0x63, 0xa6, 0x60, 0x99, 0xb6, 0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -48,7 +48,7 @@ func (m *MsgConvertCoin) Reset()         { *m = MsgConvertCoin{} }
 func (m *MsgConvertCoin) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertCoin) ProtoMessage()    {}
 func (*MsgConvertCoin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c8b7e98404cd939, []int{0}
+	return fileDescriptor_ff92635d23e75988, []int{0}
 }
 func (m *MsgConvertCoin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -106,7 +106,7 @@ func (m *MsgConvertCoinResponse) Reset()         { *m = MsgConvertCoinResponse{}
 func (m *MsgConvertCoinResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertCoinResponse) ProtoMessage()    {}
 func (*MsgConvertCoinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c8b7e98404cd939, []int{1}
+	return fileDescriptor_ff92635d23e75988, []int{1}
 }
 func (m *MsgConvertCoinResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -152,7 +152,7 @@ func (m *MsgConvertERC20) Reset()         { *m = MsgConvertERC20{} }
 func (m *MsgConvertERC20) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertERC20) ProtoMessage()    {}
 func (*MsgConvertERC20) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c8b7e98404cd939, []int{2}
+	return fileDescriptor_ff92635d23e75988, []int{2}
 }
 func (m *MsgConvertERC20) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -210,7 +210,7 @@ func (m *MsgConvertERC20Response) Reset()         { *m = MsgConvertERC20Response
 func (m *MsgConvertERC20Response) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertERC20Response) ProtoMessage()    {}
 func (*MsgConvertERC20Response) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c8b7e98404cd939, []int{3}
+	return fileDescriptor_ff92635d23e75988, []int{3}
 }
 func (m *MsgConvertERC20Response) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -253,7 +253,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c8b7e98404cd939, []int{4}
+	return fileDescriptor_ff92635d23e75988, []int{4}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -306,7 +306,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3c8b7e98404cd939, []int{5}
+	return fileDescriptor_ff92635d23e75988, []int{5}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -344,47 +344,47 @@ func init() {
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "anryton.erc20.v1.MsgUpdateParamsResponse")
 }
 
-func init() { proto.RegisterFile("anryton/erc20/v1/tx.proto", fileDescriptor_3c8b7e98404cd939) }
-
-var fileDescriptor_3c8b7e98404cd939 = []byte{
-	// 577 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x4f, 0x6b, 0xdb, 0x3e,
-	0x1c, 0xc6, 0xe3, 0xb6, 0x84, 0x5f, 0x94, 0x92, 0xfe, 0x10, 0x25, 0xff, 0x18, 0x4e, 0x96, 0x43,
-	0x93, 0x0d, 0x6a, 0x35, 0xee, 0xd8, 0x61, 0xb7, 0x26, 0x6c, 0xb0, 0x43, 0x61, 0x78, 0x0c, 0xc6,
-	0x2e, 0x41, 0xb1, 0x85, 0x63, 0x36, 0x4b, 0xc6, 0x52, 0x4c, 0x73, 0xd9, 0x21, 0x6f, 0x60, 0x83,
-	0xbd, 0x91, 0x1d, 0x76, 0xd8, 0x4b, 0xe8, 0xb1, 0x6c, 0x97, 0xb1, 0x43, 0x19, 0x49, 0x61, 0x6f,
-	0x63, 0x58, 0x52, 0xfe, 0x38, 0x4b, 0xbb, 0x4b, 0x62, 0xe9, 0x79, 0x24, 0x7d, 0x9e, 0xaf, 0xbe,
-	0x02, 0x15, 0x3e, 0x0a, 0x3c, 0x86, 0x48, 0xec, 0xda, 0x27, 0x28, 0xe9, 0x22, 0x71, 0x61, 0x45,
-	0x31, 0x13, 0x0c, 0x96, 0xa4, 0x60, 0x49, 0xc1, 0x4a, 0xba, 0x75, 0xd3, 0x65, 0x3c, 0x64, 0x1c,
-	0x0d, 0x31, 0x27, 0x28, 0xe9, 0x0e, 0x89, 0xc0, 0x5d, 0xe4, 0xb2, 0x80, 0x2a, 0x7f, 0xbd, 0xa2,
-	0xf5, 0x90, 0xfb, 0xe9, 0x3e, 0x21, 0xf7, 0xb5, 0x50, 0x53, 0xc2, 0x40, 0x8e, 0x90, 0x1a, 0x68,
-	0xe9, 0xde, 0xc6, 0xe1, 0x3e, 0xa1, 0x84, 0x07, 0x0b, 0xf5, 0xd0, 0x67, 0x3e, 0x53, 0xab, 0xd2,
-	0xaf, 0xc5, 0x1a, 0x9f, 0x31, 0xff, 0x1d, 0x41, 0x38, 0x0a, 0x10, 0xa6, 0x94, 0x09, 0x2c, 0x02,
-	0x46, 0xf5, 0x9a, 0xd6, 0x04, 0x94, 0xce, 0xb9, 0xdf, 0x67, 0x34, 0x21, 0xb1, 0xe8, 0xb3, 0x80,
-	0xc2, 0x53, 0xb0, 0x97, 0x52, 0x56, 0x8d, 0xa6, 0xd1, 0x29, 0xda, 0x35, 0x4b, 0x03, 0xa4, 0x31,
-	0x2c, 0x1d, 0xc3, 0x4a, 0x8d, 0xbd, 0xbd, 0xcb, 0xeb, 0x46, 0xce, 0x91, 0x66, 0x58, 0x07, 0xff,
-	0xc5, 0xc4, 0x25, 0x41, 0x42, 0xe2, 0xea, 0x4e, 0xd3, 0xe8, 0x14, 0x9c, 0xe5, 0x18, 0x96, 0x41,
-	0x9e, 0x13, 0xea, 0x91, 0xb8, 0xba, 0x2b, 0x15, 0x3d, 0x6a, 0x55, 0x41, 0x39, 0x7b, 0xb4, 0x43,
-	0x78, 0xc4, 0x28, 0x27, 0xad, 0xaf, 0x06, 0x38, 0x58, 0x49, 0x4f, 0x9d, 0xbe, 0x7d, 0x02, 0x1f,
-	0x80, 0xff, 0x5d, 0x46, 0x45, 0x8c, 0x5d, 0x31, 0xc0, 0x9e, 0x17, 0x13, 0xce, 0x25, 0x62, 0xc1,
-	0x39, 0x58, 0xcc, 0x9f, 0xa9, 0x69, 0xf8, 0x0c, 0xe4, 0x71, 0xc8, 0xc6, 0x54, 0x28, 0x94, 0x9e,
-	0x95, 0x82, 0xfe, 0xbc, 0x6e, 0x1c, 0xf9, 0x81, 0x18, 0x8d, 0x87, 0x96, 0xcb, 0x42, 0x5d, 0x56,
-	0xfd, 0x77, 0xcc, 0xbd, 0xb7, 0x48, 0x4c, 0x22, 0xc2, 0xad, 0xe7, 0x54, 0x38, 0x7a, 0x75, 0x26,
-	0xd4, 0xee, 0xad, 0xa1, 0xf6, 0x32, 0xa1, 0x6a, 0xa0, 0xb2, 0x41, 0xbe, 0x4c, 0xf5, 0x41, 0xa5,
-	0x7a, 0x15, 0x79, 0x58, 0x90, 0x17, 0x38, 0xc6, 0x21, 0x87, 0x8f, 0x41, 0x01, 0x8f, 0xc5, 0x88,
-	0xc5, 0x81, 0x98, 0xa8, 0x38, 0xbd, 0xea, 0xb7, 0x2f, 0xc7, 0x87, 0xba, 0xe8, 0x3a, 0xd1, 0x4b,
-	0x11, 0x07, 0xd4, 0x77, 0x56, 0x56, 0xf8, 0x08, 0xe4, 0x23, 0xb9, 0x83, 0x8c, 0x58, 0xb4, 0xcb,
-	0x56, 0xb6, 0xfb, 0x2c, 0xb5, 0xbf, 0xbe, 0x23, 0xed, 0x7d, 0x52, 0x9a, 0xfe, 0xfe, 0xfc, 0x70,
-	0xb5, 0x8b, 0x86, 0x5d, 0x07, 0x5a, 0xc0, 0xda, 0x37, 0x3b, 0x60, 0xf7, 0x9c, 0xfb, 0xf0, 0x3d,
-	0x28, 0xae, 0x37, 0x87, 0xb9, 0x79, 0x4e, 0xf6, 0x06, 0xeb, 0x47, 0x77, 0xeb, 0xcb, 0x5a, 0xb4,
-	0xa7, 0xdf, 0x6f, 0x3e, 0xed, 0xdc, 0x87, 0x0d, 0xf4, 0xd7, 0x73, 0x42, 0xae, 0xf2, 0x0f, 0x64,
-	0x63, 0x4d, 0x0d, 0xb0, 0x9f, 0xe9, 0x83, 0xc6, 0xed, 0x27, 0x48, 0x43, 0xbd, 0xfd, 0x0f, 0xc3,
-	0x92, 0xa1, 0x23, 0x19, 0x5a, 0xb0, 0x79, 0x07, 0x83, 0x9c, 0x83, 0xaf, 0xc1, 0x7e, 0xe6, 0xd6,
-	0xb6, 0x31, 0xac, 0x1b, 0xb6, 0x32, 0x6c, 0x2b, 0x73, 0xef, 0xec, 0x72, 0x66, 0x1a, 0x57, 0x33,
-	0xd3, 0xf8, 0x35, 0x33, 0x8d, 0x8f, 0x73, 0x33, 0x77, 0x35, 0x37, 0x73, 0x3f, 0xe6, 0x66, 0xee,
-	0x4d, 0x7b, 0xad, 0x59, 0x15, 0x9f, 0xfa, 0x4d, 0x6c, 0x74, 0xa1, 0x51, 0x65, 0xc7, 0x0e, 0xf3,
-	0xf2, 0x21, 0x9f, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x54, 0x16, 0x91, 0x99, 0x04, 0x00,
-	0x00,
+func init() { proto.RegisterFile("anryton/erc20/v1/tx.proto", fileDescriptor_ff92635d23e75988) }
+
+var fileDescriptor_ff92635d23e75988 = []byte{
+	// 579 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0x41, 0x6b, 0xdb, 0x3c,
+	0x1c, 0xc6, 0xe3, 0xb6, 0x84, 0x37, 0x4a, 0x69, 0x8b, 0x28, 0xad, 0x63, 0x5e, 0xdc, 0x34, 0x1b,
+	0x23, 0xe9, 0xa8, 0xd5, 0xb8, 0xd0, 0xc3, 0x6e, 0x4b, 0xe8, 0x60, 0x87, 0xc2, 0xf0, 0xd8, 0x65,
+	0x0c, 0x82, 0xe2, 0x08, 0xd7, 0x6c, 0x96, 0x8c, 0xa4, 0x98, 0xe6, 0x5a, 0x76, 0xd8, 0x71, 0x63,
+	0x5f, 0x64, 0x87, 0x1d, 0xf6, 0x11, 0x7a, 0x2c, 0xdb, 0x65, 0xec, 0x50, 0x46, 0x32, 0xd8, 0x27,
+	0xd8, 0x7d, 0x58, 0x96, 0x93, 0xb8, 0xed, 0x9a, 0x93, 0x2d, 0x3d, 0xcf, 0x5f, 0xfa, 0x3d, 0xd2,
+	0x5f, 0xa0, 0x86, 0x29, 0x1f, 0x49, 0x46, 0x11, 0xe1, 0xbe, 0x7b, 0x80, 0x92, 0x36, 0x92, 0x67,
+	0x4e, 0xcc, 0x99, 0x64, 0x70, 0x43, 0x4b, 0x8e, 0x92, 0x9c, 0xa4, 0x6d, 0xd9, 0x3e, 0x13, 0x11,
+	0x13, 0xa8, 0x8f, 0x05, 0x41, 0x49, 0xbb, 0x4f, 0x24, 0x6e, 0x23, 0x9f, 0x85, 0x34, 0xab, 0xb0,
+	0xb6, 0xb5, 0x1e, 0x89, 0x20, 0x5d, 0x29, 0x12, 0x81, 0x16, 0x6a, 0x99, 0xd0, 0x53, 0x23, 0x94,
+	0x0d, 0xb4, 0x64, 0xdf, 0x00, 0x08, 0x08, 0x25, 0x22, 0xcc, 0xf5, 0xcd, 0x80, 0x05, 0x2c, 0xab,
+	0x4b, 0xff, 0xf4, 0xec, 0xff, 0x01, 0x63, 0xc1, 0x1b, 0x82, 0x70, 0x1c, 0x22, 0x4c, 0x29, 0x93,
+	0x58, 0x86, 0x8c, 0xea, 0x9a, 0xc6, 0x08, 0xac, 0x9d, 0x88, 0xa0, 0xcb, 0x68, 0x42, 0xb8, 0xec,
+	0xb2, 0x90, 0xc2, 0x43, 0xb0, 0x92, 0x72, 0x9a, 0x46, 0xdd, 0x68, 0x56, 0xdd, 0x9a, 0xa3, 0x11,
+	0xd2, 0x20, 0x8e, 0x0e, 0xe2, 0xa4, 0xc6, 0xce, 0xca, 0xc5, 0xd5, 0x4e, 0xc9, 0x53, 0x66, 0x68,
+	0x81, 0xff, 0x38, 0xf1, 0x49, 0x98, 0x10, 0x6e, 0x2e, 0xd5, 0x8d, 0x66, 0xc5, 0x9b, 0x8e, 0xe1,
+	0x16, 0x28, 0x0b, 0x42, 0x07, 0x84, 0x9b, 0xcb, 0x4a, 0xd1, 0xa3, 0x86, 0x09, 0xb6, 0x8a, 0x5b,
+	0x7b, 0x44, 0xc4, 0x8c, 0x0a, 0xd2, 0xf8, 0x62, 0x80, 0xf5, 0x99, 0x74, 0xec, 0x75, 0xdd, 0x03,
+	0xd8, 0x02, 0x1b, 0x3e, 0xa3, 0x92, 0x63, 0x5f, 0xf6, 0xf0, 0x60, 0xc0, 0x89, 0x10, 0x0a, 0xb1,
+	0xe2, 0xad, 0xe7, 0xf3, 0x8f, 0xb3, 0x69, 0xf8, 0x04, 0x94, 0x71, 0xc4, 0x86, 0x54, 0x66, 0x28,
+	0x1d, 0x27, 0x05, 0xfd, 0x71, 0xb5, 0xf3, 0x20, 0x08, 0xe5, 0xe9, 0xb0, 0xef, 0xf8, 0x2c, 0xd2,
+	0x07, 0xab, 0x3f, 0xfb, 0x62, 0xf0, 0x1a, 0xc9, 0x51, 0x4c, 0x84, 0xf3, 0x94, 0x4a, 0x4f, 0x57,
+	0x17, 0x42, 0x2d, 0xff, 0x33, 0xd4, 0x4a, 0x21, 0x54, 0x0d, 0x6c, 0x5f, 0x23, 0x9f, 0xa6, 0xfa,
+	0x90, 0xa5, 0x7a, 0x11, 0x0f, 0xb0, 0x24, 0xcf, 0x30, 0xc7, 0x91, 0x80, 0x47, 0xa0, 0x82, 0x87,
+	0xf2, 0x94, 0xf1, 0x50, 0x8e, 0xb2, 0x38, 0x1d, 0xf3, 0xeb, 0xe7, 0xfd, 0x4d, 0x7d, 0xe8, 0x3a,
+	0xd1, 0x73, 0xc9, 0x43, 0x1a, 0x78, 0x33, 0x2b, 0x3c, 0x02, 0xe5, 0x58, 0xad, 0xa0, 0x22, 0x56,
+	0x5d, 0xd3, 0xb9, 0xde, 0x81, 0x4e, 0xb6, 0x83, 0xbe, 0x25, 0xed, 0x7e, 0xb4, 0x76, 0xfe, 0xfb,
+	0xd3, 0xde, 0x6c, 0x1d, 0x8d, 0x3b, 0x8f, 0x94, 0xe3, 0xba, 0x7f, 0x96, 0xc0, 0xf2, 0x89, 0x08,
+	0xe0, 0x5b, 0x03, 0x54, 0xe7, 0xfb, 0xa3, 0x7e, 0x73, 0xab, 0xe2, 0x35, 0x5a, 0xcd, 0x45, 0x8e,
+	0xe9, 0x91, 0xb4, 0xce, 0xbf, 0xfd, 0xfa, 0xb8, 0x74, 0x0f, 0xee, 0xa2, 0x5b, 0xde, 0x16, 0xf2,
+	0xb3, 0x8a, 0x9e, 0xea, 0xb0, 0x77, 0x06, 0x58, 0x2d, 0x34, 0xc4, 0xee, 0x5d, 0xbb, 0x28, 0x8b,
+	0xd5, 0x5a, 0x68, 0x99, 0x92, 0xec, 0x29, 0x92, 0xfb, 0xb0, 0x71, 0x27, 0x89, 0x9a, 0x83, 0xaf,
+	0xc0, 0x6a, 0xe1, 0x12, 0x6f, 0x27, 0x99, 0xb7, 0x58, 0xad, 0x85, 0x96, 0x9c, 0xa4, 0x73, 0x7c,
+	0x31, 0xb6, 0x8d, 0xcb, 0xb1, 0x6d, 0xfc, 0x1c, 0xdb, 0xc6, 0xfb, 0x89, 0x5d, 0xba, 0x9c, 0xd8,
+	0xa5, 0xef, 0x13, 0xbb, 0xf4, 0xf2, 0xe1, 0x5c, 0xff, 0xe6, 0x94, 0xf9, 0x37, 0x71, 0xd1, 0x99,
+	0x46, 0x56, 0x8d, 0xdc, 0x2f, 0xab, 0xf7, 0x7d, 0xf8, 0x77, 0x00, 0x8b, 0x86, 0x18, 0x13, 0xb6,
+	0x04, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,348 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"os"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+)
+
+// loadABI reads the JSON contract ABI from the given file. It returns nil if
+// the path is empty.
+func loadABI(path string) (*abi.ABI, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	bz, err := os.ReadFile(path) // #nosec G304 -- path provided by the user
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read ABI file")
+	}
+
+	contractABI, err := abi.JSON(strings.NewReader(string(bz)))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse ABI file")
+	}
+
+	return &contractABI, nil
+}
+
+// loadBytecode reads the hex encoded contract bytecode from the given file.
+// Hardhat and Foundry JSON artifacts are also accepted.
+func loadBytecode(path string) ([]byte, error) {
+	bz, err := os.ReadFile(path) // #nosec G304 -- path provided by the user
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read bytecode file")
+	}
+
+	content := strings.TrimSpace(string(bz))
+	if strings.HasPrefix(content, "{") {
+		var artifact struct {
+			Bytecode json.RawMessage `json:"bytecode"`
+		}
+		if err := json.Unmarshal(bz, &artifact); err != nil {
+			return nil, errors.Wrap(err, "failed to parse contract artifact")
+		}
+
+		// Foundry artifacts nest the bytecode in an object
+		var nested struct {
+			Object string `json:"object"`
+		}
+		if err := json.Unmarshal(artifact.Bytecode, &nested); err == nil {
+			content = nested.Object
+		} else if err := json.Unmarshal(artifact.Bytecode, &content); err != nil {
+			return nil, errors.New("contract artifact without bytecode")
+		}
+	}
+
+	if !strings.HasPrefix(content, "0x") {
+		content = "0x" + content
+	}
+
+	bytecode, err := hexutil.Decode(content)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid hex bytecode")
+	}
+	if len(bytecode) == 0 {
+		return nil, errors.New("empty bytecode")
+	}
+
+	return bytecode, nil
+}
+
+// parseMethod returns the method identified by its signature, e.g.
+// "transfer(address,uint256)" or, to decode the returned values,
+// "balanceOf(address)(uint256)". If the contract ABI is provided, the method
+// can also be identified by its name.
+func parseMethod(contractABI *abi.ABI, method string) (abi.Method, error) {
+	method = strings.TrimSpace(method)
+
+	if contractABI != nil {
+		if m, ok := contractABI.Methods[method]; ok {
+			return m, nil
+		}
+	}
+
+	start := strings.Index(method, "(")
+	if start <= 0 {
+		if contractABI != nil {
+			return abi.Method{}, fmt.Errorf("method %s not found in ABI", method)
+		}
+		return abi.Method{}, fmt.Errorf("invalid method signature %s, expected name(types)", method)
+	}
+
+	name := method[:start]
+	inputTypes, rest, err := splitTypeList(method[start:])
+	if err != nil {
+		return abi.Method{}, errors.Wrapf(err, "invalid method signature %s", method)
+	}
+
+	var outputTypes []string
+	if rest != "" {
+		outputTypes, rest, err = splitTypeList(rest)
+		if err != nil || rest != "" {
+			return abi.Method{}, fmt.Errorf("invalid return types in method signature %s", method)
+		}
+	}
+
+	inputs, err := newArguments(inputTypes)
+	if err != nil {
+		return abi.Method{}, err
+	}
+
+	outputs, err := newArguments(outputTypes)
+	if err != nil {
+		return abi.Method{}, err
+	}
+
+	parsed := abi.NewMethod(name, name, abi.Function, "", false, true, inputs, outputs)
+
+	// use the ABI definition if the signature matches one of its methods
+	if contractABI != nil {
+		if m, err := contractABI.MethodById(parsed.ID); err == nil {
+			return *m, nil
+		}
+	}
+
+	return parsed, nil
+}
+
+// splitTypeList splits a parenthesized comma separated list of types, e.g.
+// "(address,uint256)", and returns the types and the remaining string.
+// Tuples are not supported.
+func splitTypeList(s string) ([]string, string, error) {
+	if !strings.HasPrefix(s, "(") {
+		return nil, "", errors.New("missing opening parenthesis")
+	}
+
+	end := strings.Index(s, ")")
+	if end < 0 {
+		return nil, "", errors.New("missing closing parenthesis")
+	}
+
+	list := strings.TrimSpace(s[1:end])
+	if strings.Contains(list, "(") {
+		return nil, "", errors.New("tuple types are not supported, use the --abi flag")
+	}
+
+	if list == "" {
+		return nil, s[end+1:], nil
+	}
+
+	types := strings.Split(list, ",")
+	for i := range types {
+		types[i] = strings.TrimSpace(types[i])
+	}
+
+	return types, s[end+1:], nil
+}
+
+// newArguments builds the ABI arguments of the given types.
+func newArguments(types []string) (abi.Arguments, error) {
+	args := make(abi.Arguments, len(types))
+	for i, t := range types {
+		typ, err := abi.NewType(t, "", nil)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid type %s", t)
+		}
+		args[i] = abi.Argument{Type: typ}
+	}
+	return args, nil
+}
+
+// packArguments converts the command line values to the argument types and
+// ABI encodes them.
+func packArguments(args abi.Arguments, values []string) ([]byte, error) {
+	if len(values) != len(args) {
+		return nil, fmt.Errorf("expected %d arguments, got %d", len(args), len(values))
+	}
+
+	converted := make([]interface{}, len(values))
+	for i, value := range values {
+		v, err := convertArgument(args[i].Type, value)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid argument %d", i)
+		}
+		converted[i] = v
+	}
+
+	return args.Pack(converted...)
+}
+
+// convertArgument converts the command line value into the Go type expected
+// by the ABI encoder for the given type. Array and slice values are JSON
+// arrays, e.g. ["0x01","0x02"] or [1,2].
+func convertArgument(typ abi.Type, value string) (interface{}, error) {
+	value = strings.TrimSpace(value)
+
+	switch typ.T {
+	case abi.AddressTy:
+		hexAddr, err := accountToHex(value)
+		if err != nil {
+			return nil, err
+		}
+		return common.HexToAddress(hexAddr), nil
+	case abi.IntTy, abi.UintTy:
+		n, ok := new(big.Int).SetString(value, 0)
+		if !ok {
+			return nil, fmt.Errorf("invalid integer %s", value)
+		}
+		if typ.T == abi.UintTy && n.Sign() < 0 {
+			return nil, fmt.Errorf("negative unsigned integer %s", value)
+		}
+		// non native integer sizes are encoded from *big.Int
+		if typ.GetType().Kind() == reflect.Ptr {
+			if n.BitLen() > typ.Size {
+				return nil, fmt.Errorf("integer %s overflows %s", value, typ.String())
+			}
+			return n, nil
+		}
+		if (typ.T == abi.UintTy && !n.IsUint64()) || (typ.T == abi.IntTy && !n.IsInt64()) {
+			return nil, fmt.Errorf("integer %s overflows %s", value, typ.String())
+		}
+		rv := reflect.New(typ.GetType()).Elem()
+		if typ.T == abi.UintTy {
+			if rv.OverflowUint(n.Uint64()) {
+				return nil, fmt.Errorf("integer %s overflows %s", value, typ.String())
+			}
+			rv.SetUint(n.Uint64())
+		} else {
+			if rv.OverflowInt(n.Int64()) {
+				return nil, fmt.Errorf("integer %s overflows %s", value, typ.String())
+			}
+			rv.SetInt(n.Int64())
+		}
+		return rv.Interface(), nil
+	case abi.BoolTy:
+		return strconv.ParseBool(value)
+	case abi.StringTy:
+		return value, nil
+	case abi.BytesTy:
+		return hexutil.Decode(value)
+	case abi.FixedBytesTy:
+		bz, err := hexutil.Decode(value)
+		if err != nil {
+			return nil, err
+		}
+		if len(bz) != typ.Size {
+			return nil, fmt.Errorf("expected %d bytes, got %d", typ.Size, len(bz))
+		}
+		rv := reflect.New(typ.GetType()).Elem()
+		reflect.Copy(rv, reflect.ValueOf(bz))
+		return rv.Interface(), nil
+	case abi.SliceTy, abi.ArrayTy:
+		var elems []json.RawMessage
+		if err := json.Unmarshal([]byte(value), &elems); err != nil {
+			return nil, errors.Wrap(err, "expected a JSON array")
+		}
+		if typ.T == abi.ArrayTy && len(elems) != typ.Size {
+			return nil, fmt.Errorf("expected %d elements, got %d", typ.Size, len(elems))
+		}
+
+		var rv reflect.Value
+		if typ.T == abi.SliceTy {
+			rv = reflect.MakeSlice(typ.GetType(), len(elems), len(elems))
+		} else {
+			rv = reflect.New(typ.GetType()).Elem()
+		}
+
+		for i, elem := range elems {
+			// accept both JSON strings and literals (numbers, booleans)
+			var s string
+			if err := json.Unmarshal(elem, &s); err != nil {
+				s = string(elem)
+			}
+			v, err := convertArgument(*typ.Elem, s)
+			if err != nil {
+				return nil, errors.Wrapf(err, "invalid element %d", i)
+			}
+			rv.Index(i).Set(reflect.ValueOf(v))
+		}
+		return rv.Interface(), nil
+	default:
+		return nil, fmt.Errorf("unsupported argument type %s", typ.String())
+	}
+}
+
+// formatValues converts the unpacked ABI values into JSON friendly values:
+// byte arrays are hex encoded and integers are decimal strings.
+func formatValues(values []interface{}) []interface{} {
+	formatted := make([]interface{}, len(values))
+	for i, v := range values {
+		formatted[i] = formatValue(reflect.ValueOf(v))
+	}
+	return formatted
+}
+
+func formatValue(v reflect.Value) interface{} {
+	if !v.IsValid() {
+		return nil
+	}
+
+	switch value := v.Interface().(type) {
+	case *big.Int:
+		return value.String()
+	case common.Address:
+		return value.Hex()
+	case common.Hash:
+		return value.Hex()
+	case []byte:
+		return hexutil.Encode(value)
+	}
+
+	switch v.Kind() {
+	case reflect.Array:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			bz := make([]byte, v.Len())
+			reflect.Copy(reflect.ValueOf(bz), v)
+			return hexutil.Encode(bz)
+		}
+		fallthrough
+	case reflect.Slice:
+		elems := make([]interface{}, v.Len())
+		for i := 0; i < v.Len(); i++ {
+			elems[i] = formatValue(v.Index(i))
+		}
+		return elems
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Struct:
+		fields := make(map[string]interface{}, v.NumField())
+		for i := 0; i < v.NumField(); i++ {
+			fields[v.Type().Field(i).Name] = formatValue(v.Field(i))
+		}
+		return fields
+	default:
+		return v.Interface()
+	}
+}
@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+const testABI = `[
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"balanceOf","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}
+]`
+
+func TestParseMethod(t *testing.T) {
+	contractABI, err := abi.JSON(strings.NewReader(testABI))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		abi        *abi.ABI
+		method     string
+		expSig     string
+		expOutputs int
+		expectErr  bool
+	}{
+		{"signature", nil, "transfer(address,uint256)", "transfer(address,uint256)", 0, false},
+		{"signature with outputs", nil, "balanceOf(address)(uint256)", "balanceOf(address)", 1, false},
+		{"signature without args", nil, "totalSupply()", "totalSupply()", 0, false},
+		{"name from abi", &contractABI, "balanceOf", "balanceOf(address)", 1, false},
+		{"signature from abi", &contractABI, "transfer(address,uint256)", "transfer(address,uint256)", 1, false},
+		{"name without abi", nil, "transfer", "", 0, true},
+		{"name not in abi", &contractABI, "approve", "", 0, true},
+		{"invalid type", nil, "transfer(address,uint)x", "", 0, true},
+		{"unknown type", nil, "transfer(foo)", "", 0, true},
+		{"tuple", nil, "transfer((address,uint256))", "", 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			method, err := parseMethod(tc.abi, tc.method)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expSig, method.Sig)
+			require.Len(t, method.Outputs, tc.expOutputs)
+		})
+	}
+}
+
+func TestPackArguments(t *testing.T) {
+	method, err := parseMethod(nil, "f(address,uint256,int8,bool,string,bytes,bytes4,uint24,address[],uint64[2])")
+	require.NoError(t, err)
+
+	values := []string{
+		"0x3B98c72760f7BBa69D62ED6f48278451251948e7",
+		"1000000000000000000000",
+		"-5",
+		"true",
+		"hello",
+		"0x0102",
+		"0xdeadbeef",
+		"0xffffff",
+		`["0x3B98c72760f7BBa69D62ED6f48278451251948e7"]`,
+		"[1,2]",
+	}
+
+	packed, err := packArguments(method.Inputs, values)
+	require.NoError(t, err)
+
+	unpacked, err := method.Inputs.Unpack(packed)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		"0x3B98c72760f7BBa69D62ED6f48278451251948e7",
+		"1000000000000000000000",
+		"-5",
+		true,
+		"hello",
+		"0x0102",
+		"0xdeadbeef",
+		"16777215",
+		[]interface{}{"0x3B98c72760f7BBa69D62ED6f48278451251948e7"},
+		[]interface{}{"1", "2"},
+	}, formatValues(unpacked))
+
+	_, err = packArguments(method.Inputs, values[:1])
+	require.Error(t, err)
+}
+
+func TestConvertArgument(t *testing.T) {
+	testCases := []struct {
+		name      string
+		typ       string
+		value     string
+		expValue  interface{}
+		expectErr bool
+	}{
+		{"hex address", "address", "0x3B98c72760f7BBa69D62ED6f48278451251948e7", common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7"), false},
+		{"invalid address", "address", "0x1234", nil, true},
+		{"uint256", "uint256", "0x10", big.NewInt(16), false},
+		{"uint8", "uint8", "255", uint8(255), false},
+		{"uint8 overflow", "uint8", "256", nil, true},
+		{"int16 negative", "int16", "-300", int16(-300), false},
+		{"int8 overflow", "int8", "-129", nil, true},
+		{"uint24 overflow", "uint24", "16777216", nil, true},
+		{"negative uint", "uint256", "-1", nil, true},
+		{"invalid integer", "uint256", "abc", nil, true},
+		{"bool", "bool", "false", false, false},
+		{"invalid bool", "bool", "yes", nil, true},
+		{"bytes", "bytes", "0xabcd", []byte{0xab, 0xcd}, false},
+		{"bytes without prefix", "bytes", "abcd", nil, true},
+		{"bytes2", "bytes2", "0xabcd", [2]byte{0xab, 0xcd}, false},
+		{"bytes2 wrong size", "bytes2", "0xab", nil, true},
+		{"uint8 slice", "uint8[]", `["1", 2]`, []uint8{1, 2}, false},
+		{"invalid slice", "uint8[]", "1,2", nil, true},
+		{"array wrong size", "bool[2]", "[true]", nil, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			typ, err := abi.NewType(tc.typ, "", nil)
+			require.NoError(t, err)
+
+			value, err := convertArgument(typ, tc.value)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expValue, value)
+		})
+	}
+}
+
+func TestLoadBytecode(t *testing.T) {
+	dir := t.TempDir()
+
+	testCases := []struct {
+		name      string
+		content   string
+		expectErr bool
+	}{
+		{"hex with prefix", "0x6080\n", false},
+		{"hex without prefix", "6080", false},
+		{"hardhat artifact", `{"bytecode":"0x6080"}`, false},
+		{"foundry artifact", `{"bytecode":{"object":"0x6080"}}`, false},
+		{"artifact without bytecode", `{"abi":[]}`, true},
+		{"empty", "", true},
+		{"invalid hex", "0x60zz", true},
+	}
+
+	for i, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(dir, string(rune('a'+i)))
+			require.NoError(t, os.WriteFile(path, []byte(tc.content), 0o600))
+
+			bytecode, err := loadBytecode(path)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []byte{0x60, 0x80}, bytecode)
+		})
+	}
+}
@@ -1,15 +1,33 @@
 package cli
 
 import (
+	"encoding/json"
+	"fmt"
+
 	rpctypes "github.com/anryton/anryton/v2/rpc/types"
 	"github.com/spf13/cobra"
 
+	tmrpcclient "github.com/cometbft/cometbft/rpc/client"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/pkg/errors"
 
+	"github.com/anryton/anryton/v2/server/config"
 	"github.com/anryton/anryton/v2/x/evm/types"
 )
 
+const (
+	// FlagTracer is the tracer used to trace a transaction
+	FlagTracer = "tracer"
+	// FlagTracerConfig is the JSON config of the tracer
+	FlagTracerConfig = "tracer-config"
+)
+
 // GetQueryCmd returns the parent command for all x/bank CLi query commands.
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -24,6 +42,10 @@ func GetQueryCmd() *cobra.Command {
 		GetStorageCmd(),
 		GetCodeCmd(),
 		GetParamsCmd(),
+		GetAvailablePrecompilesCmd(),
+		GetCallCmd(),
+		GetEstimateGasCmd(),
+		GetTraceTxCmd(),
 	)
 	return cmd
 }
@@ -132,3 +154,338 @@ func GetParamsCmd() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetAvailablePrecompilesCmd queries the available precompiled contracts
+func GetAvailablePrecompilesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "available-precompiles",
+		Short: "Get the available precompiled contracts",
+		Long:  "Get the precompiled contracts configurable through governance, whether they are active and their ABIs.",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.AvailablePrecompiles(cmd.Context(), &types.QueryAvailablePrecompilesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCallCmd executes a contract call without creating a transaction
+func GetCallCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call ADDRESS METHOD [ARGS...]",
+		Short: "Call a contract method without creating a transaction",
+		Long: `Call a contract method without creating a transaction. The method is identified by its signature,
+e.g. "balanceOf(address)(uint256)", or by its name if the contract ABI is provided with the --abi flag.
+The returned values are decoded when the return types are known, otherwise the raw hex data is printed.
+If the height is not provided, it will use the latest height from context.`,
+		Example: fmt.Sprintf(
+			`$ %s query %s call 0xdAC17F958D2ee523a2206206994597C13D831ec7 "balanceOf(address)(uint256)" 0x9C1e5bA6b2E0E1e8c1c6B64d2F8Ff8F5ba0A0C1e`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to, method, input, err := parseCallArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			callArgs, err := newCallArgs(cmd, to, input)
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.Marshal(&callArgs)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.EthCall(rpctypes.ContextWithHeight(clientCtx.Height), &types.EthCallRequest{
+				Args:   bz,
+				GasCap: config.DefaultGasCap,
+			})
+			if err != nil {
+				return err
+			}
+
+			if res.Failed() {
+				if res.VmError != vm.ErrExecutionReverted.Error() {
+					return errors.New(res.VmError)
+				}
+				return types.NewExecErrorWithReason(res.Ret)
+			}
+
+			if len(method.Outputs) == 0 {
+				return clientCtx.PrintString(fmt.Sprintf("%s\n", hexutil.Encode(res.Ret)))
+			}
+
+			values, err := method.Outputs.Unpack(res.Ret)
+			if err != nil {
+				return errors.Wrapf(err, "failed to decode %s return values", method.Sig)
+			}
+
+			out, err := json.Marshal(formatValues(values))
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addCallFlags(cmd)
+	return cmd
+}
+
+// GetEstimateGasCmd estimates the gas used by a contract call
+func GetEstimateGasCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "estimate-gas ADDRESS METHOD [ARGS...]",
+		Short: "Estimate the gas used by a contract call",
+		Long: `Estimate the gas used by a contract call. The method is identified by its signature,
+e.g. "transfer(address,uint256)", or by its name if the contract ABI is provided with the --abi flag.
+If the height is not provided, it will use the latest height from context.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to, _, input, err := parseCallArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			callArgs, err := newCallArgs(cmd, to, input)
+			if err != nil {
+				return err
+			}
+
+			queryClient := rpctypes.NewQueryClient(clientCtx)
+
+			gas, err := estimateGas(rpctypes.ContextWithHeight(clientCtx.Height), queryClient, callArgs)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintString(fmt.Sprintf("%d\n", gas))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	addCallFlags(cmd)
+	return cmd
+}
+
+// GetTraceTxCmd traces the execution of an ethereum transaction
+func GetTraceTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "trace TX_HASH",
+		Short: "Trace the execution of an ethereum transaction",
+		Long: `Trace the execution of an ethereum transaction in the state of its block. The default
+struct logger is used unless a tracer is provided, e.g. --tracer callTracer.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hash := common.HexToHash(args[0])
+
+			req, err := newTraceTxRequest(cmd, clientCtx, hash)
+			if err != nil {
+				return err
+			}
+
+			// minus one to get the context of block beginning
+			contextHeight := req.BlockNumber - 1
+			if contextHeight < 1 {
+				// 0 is a special value in `ContextWithHeight`
+				contextHeight = 1
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TraceTx(rpctypes.ContextWithHeight(contextHeight), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintBytes(res.Data)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	cmd.Flags().String(FlagTracer, "", "Tracer used to trace the transaction, e.g. callTracer")
+	cmd.Flags().String(FlagTracerConfig, "", "JSON config of the tracer")
+	return cmd
+}
+
+// addCallFlags adds the sender, value and ABI flags of the call queries.
+func addCallFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flags.FlagFrom, "", "Bech32 or hex address of the sender")
+	cmd.Flags().String(FlagValue, "", "Amount of wei sent with the call")
+	cmd.Flags().String(FlagABI, "", "Path to the JSON contract ABI")
+}
+
+// newCallArgs returns the call arguments from the command flags.
+func newCallArgs(cmd *cobra.Command, to common.Address, input []byte) (types.TransactionArgs, error) {
+	value, err := getWeiFlag(cmd, FlagValue)
+	if err != nil {
+		return types.TransactionArgs{}, err
+	}
+
+	data := hexutil.Bytes(input)
+	args := types.TransactionArgs{
+		To:    &to,
+		Value: value,
+		Input: &data,
+	}
+
+	if fromStr, _ := cmd.Flags().GetString(flags.FlagFrom); fromStr != "" {
+		hexAddr, err := accountToHex(fromStr)
+		if err != nil {
+			return types.TransactionArgs{}, err
+		}
+		from := common.HexToAddress(hexAddr)
+		args.From = &from
+	}
+
+	return args, nil
+}
+
+// newTraceTxRequest finds the ethereum transaction in the tendermint tx
+// indexer and returns the request to trace it with its predecessors.
+func newTraceTxRequest(cmd *cobra.Command, clientCtx client.Context, hash common.Hash) (*types.QueryTraceTxRequest, error) {
+	node, err := clientCtx.GetNode()
+	if err != nil {
+		return nil, err
+	}
+
+	query := fmt.Sprintf("%s.%s='%s'", types.TypeMsgEthereumTx, types.AttributeKeyEthereumTxHash, hash.Hex())
+	resTxs, err := node.TxSearch(cmd.Context(), query, false, nil, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(resTxs.Txs) == 0 {
+		return nil, fmt.Errorf("ethereum tx %s not found", hash.Hex())
+	}
+
+	resTx := resTxs.Txs[0]
+	if !rpctypes.TxSuccessOrExceedsBlockGasLimit(&resTx.TxResult) {
+		return nil, errors.New("invalid ethereum tx")
+	}
+
+	var tx sdk.Tx
+	if resTx.TxResult.Code != 0 {
+		// it's only needed when the tx exceeds block gas limit
+		if tx, err = clientCtx.TxConfig.TxDecoder()(resTx.Tx); err != nil {
+			return nil, errors.New("invalid ethereum tx")
+		}
+	}
+
+	txResult, err := rpctypes.ParseTxIndexerResult(resTx, tx, func(txs *rpctypes.ParsedTxs) *rpctypes.ParsedTx {
+		return txs.GetTxByHash(hash)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if txResult.Height == 0 {
+		return nil, errors.New("genesis is not traceable")
+	}
+
+	blk, err := node.Block(cmd.Context(), &txResult.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	if int(txResult.TxIndex) >= len(blk.Block.Txs) {
+		return nil, fmt.Errorf("transaction not included in block %d", blk.Block.Height)
+	}
+
+	var predecessors []*types.MsgEthereumTx
+	for _, txBz := range blk.Block.Txs[:txResult.TxIndex] {
+		blockTx, err := clientCtx.TxConfig.TxDecoder()(txBz)
+		if err != nil {
+			continue
+		}
+		for _, msg := range blockTx.GetMsgs() {
+			if ethMsg, ok := msg.(*types.MsgEthereumTx); ok {
+				predecessors = append(predecessors, ethMsg)
+			}
+		}
+	}
+
+	tx, err = clientCtx.TxConfig.TxDecoder()(blk.Block.Txs[txResult.TxIndex])
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := tx.GetMsgs()
+	if int(txResult.MsgIndex) >= len(msgs) {
+		return nil, fmt.Errorf("ethereum tx %s not found in msgs", hash.Hex())
+	}
+
+	// add predecessor messages in current cosmos tx
+	for _, msg := range msgs[:txResult.MsgIndex] {
+		if ethMsg, ok := msg.(*types.MsgEthereumTx); ok {
+			predecessors = append(predecessors, ethMsg)
+		}
+	}
+
+	ethMsg, ok := msgs[txResult.MsgIndex].(*types.MsgEthereumTx)
+	if !ok {
+		return nil, fmt.Errorf("invalid transaction type %T", msgs[txResult.MsgIndex])
+	}
+
+	nc, ok := node.(tmrpcclient.NetworkClient)
+	if !ok {
+		return nil, errors.New("invalid rpc client")
+	}
+
+	cp, err := nc.ConsensusParams(cmd.Context(), &blk.Block.Height)
+	if err != nil {
+		return nil, err
+	}
+
+	tracer, _ := cmd.Flags().GetString(FlagTracer)
+	tracerConfig, _ := cmd.Flags().GetString(FlagTracerConfig)
+
+	return &types.QueryTraceTxRequest{
+		Msg:             ethMsg,
+		Predecessors:    predecessors,
+		BlockNumber:     blk.Block.Height,
+		BlockTime:       blk.Block.Time,
+		BlockHash:       common.Bytes2Hex(blk.BlockID.Hash),
+		ProposerAddress: sdk.ConsAddress(blk.Block.ProposerAddress),
+		BlockMaxGas:     cp.ConsensusParams.Block.MaxGas,
+		TraceConfig: &types.TraceConfig{
+			Tracer:           tracer,
+			TracerJsonConfig: tracerConfig,
+		},
+	}, nil
+}
@@ -2,18 +2,47 @@ package cli
 
 import (
 	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"os"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/input"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 
+	"github.com/anryton/anryton/v2/crypto/ethsecp256k1"
 	rpctypes "github.com/anryton/anryton/v2/rpc/types"
+	"github.com/anryton/anryton/v2/server/config"
+	anrytontypes "github.com/anryton/anryton/v2/types"
 	"github.com/anryton/anryton/v2/x/evm/types"
+	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
+)
+
+const (
+	// FlagABI is the path to the JSON contract ABI
+	FlagABI = "abi"
+	// FlagValue is the amount of wei sent with the transaction or call
+	FlagValue = "value"
+	// FlagNonce is the nonce of the ethereum transaction
+	FlagNonce = "nonce"
+	// FlagGasPrice is the gas price in wei of a legacy ethereum transaction
+	FlagGasPrice = "gas-price"
+	// FlagMaxFeePerGas is the max fee per gas in wei of a dynamic fee ethereum transaction
+	FlagMaxFeePerGas = "max-fee-per-gas"
+	// FlagMaxPriorityFeePerGas is the max priority fee per gas in wei of a dynamic fee ethereum transaction
+	FlagMaxPriorityFeePerGas = "max-priority-fee-per-gas"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -25,7 +54,12 @@ func GetTxCmd() *cobra.Command {
 		SuggestionsMinimumDistance: 2,
 		RunE:                       client.ValidateCmd,
 	}
-	cmd.AddCommand(NewRawTxCmd())
+	cmd.AddCommand(
+		NewRawTxCmd(),
+		NewDeployCmd(),
+		NewCallTxCmd(),
+		NewSendCmd(),
+	)
 	return cmd
 }
 
@@ -46,66 +80,481 @@ func NewRawTxCmd() *cobra.Command {
 				return err
 			}
 
-			if err := msg.ValidateBasic(); err != nil {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
 				return err
 			}
 
+			return broadcastEthereumTx(cmd, clientCtx, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewDeployCmd command deploys a contract from its bytecode
+func NewDeployCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deploy BYTECODE_FILE [CONSTRUCTOR_ARGS...]",
+		Short: "Deploy a contract from its bytecode",
+		Long: `Deploy a contract from the hex encoded bytecode file or Hardhat/Foundry artifact.
+The constructor arguments are encoded with the contract ABI provided with the --abi flag.
+Array arguments are JSON arrays.`,
+		Example: fmt.Sprintf(
+			"$ %s tx %s deploy ./Token.bin 1000000 --abi ./Token.abi --from mykey",
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			rsp, err := rpctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+			bytecode, err := loadBytecode(args[0])
 			if err != nil {
 				return err
 			}
 
-			tx, err := msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+			abiPath, _ := cmd.Flags().GetString(FlagABI)
+			contractABI, err := loadABI(abiPath)
 			if err != nil {
 				return err
 			}
 
-			if clientCtx.GenerateOnly {
-				json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+			ctorArgs := args[1:]
+			if contractABI == nil && len(ctorArgs) > 0 {
+				return errors.New("the contract ABI is required to encode the constructor arguments, use the --abi flag")
+			}
+
+			if contractABI != nil {
+				packed, err := packArguments(contractABI.Constructor.Inputs, ctorArgs)
 				if err != nil {
-					return err
+					return errors.Wrap(err, "failed to encode constructor arguments")
 				}
+				bytecode = append(bytecode, packed...)
+			}
 
-				return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+			value, err := getWeiFlag(cmd, FlagValue)
+			if err != nil {
+				return err
 			}
 
-			if !clientCtx.SkipConfirm {
-				out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
-				if err != nil {
-					return err
-				}
+			txArgs, err := newTransactionArgs(cmd, clientCtx, nil, bytecode, value)
+			if err != nil {
+				return err
+			}
 
-				_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+			from := txArgs.GetFrom()
+			contractAddr := crypto.CreateAddress(from, uint64(*txArgs.Nonce))
+			_, _ = fmt.Fprintf(os.Stderr, "contract address: %s\n", contractAddr.Hex())
 
-				buf := bufio.NewReader(os.Stdin)
-				ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+			return signAndBroadcastEthereumTx(cmd, clientCtx, txArgs)
+		},
+	}
 
-				if err != nil || !ok {
-					_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
-					return err
-				}
+	addEthereumTxFlags(cmd)
+	cmd.Flags().String(FlagValue, "", "Amount of wei sent to the contract")
+	cmd.Flags().String(FlagABI, "", "Path to the JSON contract ABI, required to encode the constructor arguments")
+	return cmd
+}
+
+// NewCallTxCmd command sends a transaction calling a contract method
+func NewCallTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "call ADDRESS METHOD [ARGS...]",
+		Short: "Send a transaction calling a contract method",
+		Long: `Send a transaction calling a contract method. The method is identified by its signature,
+e.g. "transfer(address,uint256)", or by its name if the contract ABI is provided with the --abi flag.
+Addresses can be bech32 or hex encoded and array arguments are JSON arrays.`,
+		Example: fmt.Sprintf(
+			`$ %s tx %s call 0xdAC17F958D2ee523a2206206994597C13D831ec7 "transfer(address,uint256)" 0x9C1e5bA6b2E0E1e8c1c6B64d2F8Ff8F5ba0A0C1e 100 --from mykey`,
+			version.AppName, types.ModuleName,
+		),
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			to, _, input, err := parseCallArgs(cmd, args)
+			if err != nil {
+				return err
 			}
 
-			txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+			value, err := getWeiFlag(cmd, FlagValue)
 			if err != nil {
 				return err
 			}
 
-			// broadcast to a Tendermint node
-			res, err := clientCtx.BroadcastTx(txBytes)
+			txArgs, err := newTransactionArgs(cmd, clientCtx, &to, input, value)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
+			return signAndBroadcastEthereumTx(cmd, clientCtx, txArgs)
 		},
 	}
 
-	flags.AddTxFlagsToCmd(cmd)
+	addEthereumTxFlags(cmd)
+	cmd.Flags().String(FlagValue, "", "Amount of wei sent to the contract")
+	cmd.Flags().String(FlagABI, "", "Path to the JSON contract ABI")
 	return cmd
 }
+
+// NewSendCmd command sends wei to an address
+func NewSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send TO_ADDRESS VALUE",
+		Short: "Send an amount of wei to an address with an ethereum transaction",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			hexAddr, err := accountToHex(args[0])
+			if err != nil {
+				return err
+			}
+			to := common.HexToAddress(hexAddr)
+
+			value, err := parseWei(args[1])
+			if err != nil {
+				return err
+			}
+
+			txArgs, err := newTransactionArgs(cmd, clientCtx, &to, nil, (*hexutil.Big)(value))
+			if err != nil {
+				return err
+			}
+
+			return signAndBroadcastEthereumTx(cmd, clientCtx, txArgs)
+		},
+	}
+
+	addEthereumTxFlags(cmd)
+	return cmd
+}
+
+// addEthereumTxFlags adds the transaction flags and the ethereum transaction
+// fee and nonce flags to the command.
+func addEthereumTxFlags(cmd *cobra.Command) {
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(FlagNonce, "", "Nonce of the transaction, queried from the account if not set")
+	cmd.Flags().String(FlagGasPrice, "", "Gas price in wei of a legacy transaction")
+	cmd.Flags().String(FlagMaxFeePerGas, "", "Max fee per gas in wei of a dynamic fee transaction")
+	cmd.Flags().String(FlagMaxPriorityFeePerGas, "", "Max priority fee per gas in wei of a dynamic fee transaction")
+}
+
+// parseCallArgs parses the contract address, method and arguments of the call
+// and returns the contract address, the method and the ABI encoded input.
+func parseCallArgs(cmd *cobra.Command, args []string) (common.Address, abi.Method, []byte, error) {
+	hexAddr, err := accountToHex(args[0])
+	if err != nil {
+		return common.Address{}, abi.Method{}, nil, err
+	}
+
+	abiPath, _ := cmd.Flags().GetString(FlagABI)
+	contractABI, err := loadABI(abiPath)
+	if err != nil {
+		return common.Address{}, abi.Method{}, nil, err
+	}
+
+	method, err := parseMethod(contractABI, args[1])
+	if err != nil {
+		return common.Address{}, abi.Method{}, nil, err
+	}
+
+	packed, err := packArguments(method.Inputs, args[2:])
+	if err != nil {
+		return common.Address{}, abi.Method{}, nil, errors.Wrapf(err, "failed to encode %s arguments", method.Sig)
+	}
+
+	input := append(common.CopyBytes(method.ID), packed...)
+	return common.HexToAddress(hexAddr), method, input, nil
+}
+
+// newTransactionArgs returns the transaction arguments from the command flags
+// with the defaults set.
+func newTransactionArgs(
+	cmd *cobra.Command,
+	clientCtx client.Context,
+	to *common.Address,
+	input []byte,
+	value *hexutil.Big,
+) (types.TransactionArgs, error) {
+	from := common.BytesToAddress(clientCtx.GetFromAddress())
+	data := hexutil.Bytes(input)
+
+	args := types.TransactionArgs{
+		From:  &from,
+		To:    to,
+		Value: value,
+		Input: &data,
+	}
+
+	var err error
+	if args.GasPrice, err = getWeiFlag(cmd, FlagGasPrice); err != nil {
+		return args, err
+	}
+	if args.MaxFeePerGas, err = getWeiFlag(cmd, FlagMaxFeePerGas); err != nil {
+		return args, err
+	}
+	if args.MaxPriorityFeePerGas, err = getWeiFlag(cmd, FlagMaxPriorityFeePerGas); err != nil {
+		return args, err
+	}
+
+	if nonceStr, _ := cmd.Flags().GetString(FlagNonce); nonceStr != "" {
+		n, ok := new(big.Int).SetString(nonceStr, 0)
+		if !ok || n.Sign() < 0 || !n.IsUint64() {
+			return args, fmt.Errorf("invalid nonce %s", nonceStr)
+		}
+		nonce := hexutil.Uint64(n.Uint64())
+		args.Nonce = &nonce
+	}
+
+	// the gas is estimated unless a gas limit is provided
+	if gasStr, _ := cmd.Flags().GetString(flags.FlagGas); cmd.Flags().Changed(flags.FlagGas) {
+		gasSetting, err := flags.ParseGasSetting(gasStr)
+		if err != nil {
+			return args, err
+		}
+		if !gasSetting.Simulate {
+			gas := hexutil.Uint64(gasSetting.Gas)
+			args.Gas = &gas
+		}
+	}
+
+	return setTxDefaults(cmd.Context(), clientCtx, args)
+}
+
+// setTxDefaults populates the transaction fields which are not set by the user
+// with the values queried from the chain. The fee and gas defaults are shared
+// with the JSON-RPC eth_sendTransaction.
+func setTxDefaults(ctx context.Context, clientCtx client.Context, args types.TransactionArgs) (types.TransactionArgs, error) {
+	if err := rpctypes.ValidateFeeArgs(args); err != nil {
+		return args, err
+	}
+
+	queryClient := rpctypes.NewQueryClient(clientCtx)
+
+	var baseFee *big.Int
+	res, err := queryClient.BaseFee(ctx, &types.QueryBaseFeeRequest{})
+	if err != nil {
+		return args, errors.Wrap(err, "failed to query base fee")
+	}
+	if res.BaseFee != nil {
+		baseFee = res.BaseFee.BigInt()
+	}
+
+	suggestGasTipCap := func(baseFee *big.Int) (*big.Int, error) {
+		if baseFee == nil {
+			return big.NewInt(0), nil
+		}
+		res, err := queryClient.FeeMarket.Params(ctx, &feemarkettypes.QueryParamsRequest{})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query fee market params")
+		}
+		return rpctypes.MaxBaseFeeDelta(baseFee, res.Params), nil
+	}
+
+	if err := rpctypes.SetFeeDefaults(&args, baseFee, suggestGasTipCap); err != nil {
+		return args, err
+	}
+
+	if args.Value == nil {
+		args.Value = new(hexutil.Big)
+	}
+
+	if args.To == nil && len(args.GetData()) == 0 {
+		return args, errors.New("contract creation without any data provided")
+	}
+
+	if args.Nonce == nil {
+		res, err := queryClient.Account(ctx, &types.QueryAccountRequest{Address: args.GetFrom().Hex()})
+		if err != nil {
+			return args, errors.Wrap(err, "failed to query account nonce")
+		}
+		nonce := res.Nonce
+		args.Nonce = (*hexutil.Uint64)(&nonce)
+	}
+
+	if args.Gas == nil {
+		gas, err := estimateGas(ctx, queryClient, args)
+		if err != nil {
+			return args, err
+		}
+		args.Gas = &gas
+	}
+
+	if args.ChainID == nil {
+		chainID, err := anrytontypes.ParseChainID(clientCtx.ChainID)
+		if err != nil {
+			return args, err
+		}
+		args.ChainID = (*hexutil.Big)(chainID)
+	}
+
+	return args, nil
+}
+
+// estimateGas returns the gas used by the transaction in the latest state.
+func estimateGas(ctx context.Context, queryClient *rpctypes.QueryClient, args types.TransactionArgs) (hexutil.Uint64, error) {
+	bz, err := json.Marshal(&args)
+	if err != nil {
+		return 0, err
+	}
+
+	return rpctypes.EstimateGas(ctx, queryClient, &types.EthCallRequest{
+		Args:   bz,
+		GasCap: config.DefaultGasCap,
+	})
+}
+
+// signAndBroadcastEthereumTx signs the ethereum transaction with the key of the
+// sender and broadcasts it.
+func signAndBroadcastEthereumTx(cmd *cobra.Command, clientCtx client.Context, args types.TransactionArgs) error {
+	record, err := clientCtx.Keyring.KeyByAddress(clientCtx.GetFromAddress())
+	if err != nil {
+		return err
+	}
+
+	pubKey, err := record.GetPubKey()
+	if err != nil {
+		return err
+	}
+
+	if _, ok := pubKey.(*ethsecp256k1.PubKey); !ok {
+		return fmt.Errorf("key %s is not an %s key", record.Name, ethsecp256k1.KeyType)
+	}
+
+	msg := args.ToTransaction()
+	if msg == nil {
+		return errors.New("failed to build ethereum transaction")
+	}
+
+	signer := ethtypes.LatestSignerForChainID(args.ChainID.ToInt())
+	if err := msg.Sign(signer, clientCtx.Keyring); err != nil {
+		return err
+	}
+
+	_, _ = fmt.Fprintf(os.Stderr, "ethereum tx hash: %s\n", msg.Hash)
+
+	return broadcastEthereumTx(cmd, clientCtx, msg)
+}
+
+// signSponsoredOption names the fee granter of the ethereum transaction, signed
+// with the key of the sender so that it cannot be replaced by a relayer.
+func signSponsoredOption(clientCtx client.Context, msg *types.MsgEthereumTx) (*types.ExtensionOptionsEthereumTxSponsored, error) {
+	ethTx := msg.AsTransaction()
+	sender, err := msg.GetSender(ethTx.ChainId())
+	if err != nil {
+		return nil, err
+	}
+
+	sponsored := &types.ExtensionOptionsEthereumTxSponsored{FeeGranter: clientCtx.FeeGranter.String()}
+	sigHash, err := sponsored.SigHash(ethTx.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	sponsored.Signature, _, err = clientCtx.Keyring.SignByAddress(sdk.AccAddress(sender.Bytes()), sigHash)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to sign the fee granter with the key of the sender")
+	}
+	return sponsored, nil
+}
+
+// broadcastEthereumTx wraps the signed ethereum transaction into a cosmos
+// transaction and broadcasts it, or prints it in generate only mode.
+func broadcastEthereumTx(cmd *cobra.Command, clientCtx client.Context, msg *types.MsgEthereumTx) error {
+	if err := msg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	rsp, err := rpctypes.NewQueryClient(clientCtx).Params(cmd.Context(), &types.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	// the fees are paid by the fee granter when set with the --fee-granter flag
+	var tx signing.Tx
+	if clientCtx.FeeGranter != nil {
+		sponsored, err := signSponsoredOption(clientCtx, msg)
+		if err != nil {
+			return err
+		}
+		tx, err = msg.BuildSponsoredTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom, sponsored, nil)
+	} else {
+		tx, err = msg.BuildTx(clientCtx.TxConfig.NewTxBuilder(), rsp.Params.EvmDenom)
+	}
+	if err != nil {
+		return err
+	}
+
+	if clientCtx.GenerateOnly {
+		json, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		return clientCtx.PrintString(fmt.Sprintf("%s\n", json))
+	}
+
+	if !clientCtx.SkipConfirm {
+		out, err := clientCtx.TxConfig.TxJSONEncoder()(tx)
+		if err != nil {
+			return err
+		}
+
+		_, _ = fmt.Fprintf(os.Stderr, "%s\n\n", out)
+
+		buf := bufio.NewReader(os.Stdin)
+		ok, err := input.GetConfirmation("confirm transaction before signing and broadcasting", buf, os.Stderr)
+
+		if err != nil || !ok {
+			_, _ = fmt.Fprintf(os.Stderr, "%s\n", "canceled transaction")
+			return err
+		}
+	}
+
+	txBytes, err := clientCtx.TxConfig.TxEncoder()(tx)
+	if err != nil {
+		return err
+	}
+
+	// broadcast to a Tendermint node
+	res, err := clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return err
+	}
+
+	return clientCtx.PrintProto(res)
+}
+
+// getWeiFlag returns the wei amount of the flag or nil if it's not set.
+func getWeiFlag(cmd *cobra.Command, flag string) (*hexutil.Big, error) {
+	value, _ := cmd.Flags().GetString(flag)
+	if value == "" {
+		return nil, nil
+	}
+
+	amount, err := parseWei(value)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid --%s", flag)
+	}
+	return (*hexutil.Big)(amount), nil
+}
+
+// parseWei parses a decimal or 0x prefixed hex amount of wei.
+func parseWei(value string) (*big.Int, error) {
+	amount, ok := new(big.Int).SetString(value, 0)
+	if !ok || amount.Sign() < 0 {
+		return nil, fmt.Errorf("invalid wei amount %s", value)
+	}
+	return amount, nil
+}
@@ -14,6 +14,7 @@ import (
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 
 	"github.com/anryton/anryton/v2/x/evm/types"
+	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
 )
 
 // CheckSenderBalance validates that the tx cost value is positive and that the
@@ -40,12 +41,15 @@ func CheckSenderBalance(
 	return nil
 }
 
-// DeductTxCostsFromUserBalance deducts the fees from the user balance. Returns an
-// error if the specified sender address does not exist or the account balance is not sufficient.
+// DeductTxCostsFromUserBalance deducts the fees paid at the effective gas price from the user
+// balance. When the base fee is escrowed, the base fee share of the fees is sent to the fee market
+// module account and only the priority tip to the fee collector. Returns an error if the specified
+// sender address does not exist or the account balance is not sufficient.
 func (k *Keeper) DeductTxCostsFromUserBalance(
 	ctx sdk.Context,
 	fees sdk.Coins,
 	from common.Address,
+	gasPrice *big.Int,
 ) error {
 	// fetch sender account
 	signerAcc, err := authante.GetSignerAcc(ctx, k.accountKeeper, from.Bytes())
@@ -53,14 +57,52 @@ func (k *Keeper) DeductTxCostsFromUserBalance(
 		return errorsmod.Wrapf(err, "account not found for sender %s", from)
 	}
 
-	// deduct the full gas cost from the user balance
-	if err := authante.DeductFees(k.bankKeeper, ctx, signerAcc, fees); err != nil {
+	baseFees, tips := k.SplitFees(ctx, fees, gasPrice)
+
+	// escrow the base fee share until the end of the block
+	if !baseFees.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from.Bytes(), feemarkettypes.ModuleName, baseFees); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "failed to escrow base fee %s from the user %s balance: %s", baseFees, from, err)
+		}
+	}
+
+	// deduct the remaining gas cost from the user balance
+	if err := authante.DeductFees(k.bankKeeper, ctx, signerAcc, tips); err != nil {
 		return errorsmod.Wrapf(err, "failed to deduct full gas cost %s from the user %s balance", fees, from)
 	}
 
 	return nil
 }
 
+// GetEscrowedBaseFee returns the base fee escrowed by the fee market module account until the end
+// of the block, to be burned or sent to the community pool, or nil if the base fee goes to the fee
+// collector with the priority tips.
+func (k *Keeper) GetEscrowedBaseFee(ctx sdk.Context) *big.Int {
+	if !k.feeMarketKeeper.GetParams(ctx).IsBaseFeeEscrowed() {
+		return nil
+	}
+	ethCfg := k.GetParams(ctx).ChainConfig.EthereumConfig(k.eip155ChainID)
+	return k.GetBaseFee(ctx, ethCfg)
+}
+
+// SplitFees splits the fees paid at the effective gas price into the escrowed base fee share and
+// the priority tip. All the fees are tips when the base fee is not escrowed.
+func (k *Keeper) SplitFees(ctx sdk.Context, fees sdk.Coins, gasPrice *big.Int) (baseFees, tips sdk.Coins) {
+	baseFee := k.GetEscrowedBaseFee(ctx)
+	if baseFee == nil || baseFee.Sign() <= 0 || gasPrice == nil || gasPrice.Sign() <= 0 {
+		return sdk.Coins{}, fees
+	}
+	if baseFee.Cmp(gasPrice) > 0 {
+		baseFee = gasPrice
+	}
+
+	evmDenom := k.GetParams(ctx).EvmDenom
+	amount := fees.AmountOf(evmDenom).Mul(sdkmath.NewIntFromBigInt(baseFee)).Quo(sdkmath.NewIntFromBigInt(gasPrice))
+	baseFees = sdk.NewCoins(sdk.NewCoin(evmDenom, amount))
+
+	return baseFees, fees.Sub(baseFees...)
+}
+
 // VerifyFee is used to return the fee for the given transaction data in sdk.Coins. It checks that the
 // gas limit is not reached, the gas limit is higher than the intrinsic gas and that the
 // base fee is higher than the gas fee cap.
@@ -6,6 +6,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 	"github.com/anryton/anryton/v2/x/evm/keeper"
 	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
@@ -527,7 +528,7 @@ func (suite *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 				suite.Require().Nil(fees, "invalid test %d passed. fees value must be nil - '%s'", i, tc.name)
 			}
 
-			err = suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, common.HexToAddress(tx.From))
+			err = suite.app.EvmKeeper.DeductTxCostsFromUserBalance(suite.ctx, fees, common.HexToAddress(tx.From), txData.GetGasPrice())
 			if tc.expectPassDeduct {
 				suite.Require().NoError(err, "valid test %d failed - '%s'", i, tc.name)
 			} else {
@@ -537,3 +538,33 @@ func (suite *KeeperTestSuite) TestVerifyFeeAndDeductTxCostsFromUserBalance() {
 	}
 	suite.enableFeemarket = false // reset flag
 }
+
+func (suite *KeeperTestSuite) TestSplitFees() {
+	suite.enableFeemarket = true
+	defer func() { suite.enableFeemarket = false }()
+	suite.SetupTest()
+
+	baseFee := suite.app.FeeMarketKeeper.GetBaseFee(suite.ctx)
+	gasPrice := new(big.Int).Mul(baseFee, big.NewInt(3))
+	fees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntFromBigInt(gasPrice).MulRaw(100)))
+
+	// all the fees are tips when the base fee goes to the fee collector
+	suite.Require().Nil(suite.app.EvmKeeper.GetEscrowedBaseFee(suite.ctx))
+	baseFees, tips := suite.app.EvmKeeper.SplitFees(suite.ctx, fees, gasPrice)
+	suite.Require().True(baseFees.IsZero())
+	suite.Require().Equal(fees, tips)
+
+	params := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	params.BaseFeeDestination = feemarkettypes.BASE_FEE_DESTINATION_BURN
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, params))
+
+	expBaseFees := sdk.NewCoins(sdk.NewCoin(evmtypes.DefaultEVMDenom, sdkmath.NewIntFromBigInt(baseFee).MulRaw(100)))
+	baseFees, tips = suite.app.EvmKeeper.SplitFees(suite.ctx, fees, gasPrice)
+	suite.Require().Equal(expBaseFees, baseFees)
+	suite.Require().Equal(fees.Sub(expBaseFees...), tips)
+
+	// the base fee share is capped to the effective gas price
+	baseFees, tips = suite.app.EvmKeeper.SplitFees(suite.ctx, fees, new(big.Int).Quo(baseFee, big.NewInt(2)))
+	suite.Require().Equal(fees, baseFees)
+	suite.Require().True(tips.IsZero())
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/params"
 
@@ -13,6 +14,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	"github.com/anryton/anryton/v2/x/evm/types"
+	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
 )
 
 // GetEthIntrinsicGas returns the intrinsic gas cost for the transaction
@@ -24,10 +26,12 @@ func (k *Keeper) GetEthIntrinsicGas(ctx sdk.Context, msg core.Message, cfg *para
 	return core.IntrinsicGas(msg.Data(), msg.AccessList(), isContractCreation, homestead, istanbul)
 }
 
-// RefundGas transfers the leftover gas to the sender of the message, caped to half of the total gas
-// consumed in the transaction. Additionally, the function sets the total gas consumed to the value
-// returned by the EVM execution, thus ignoring the previous intrinsic gas consumed during in the
-// AnteHandler.
+// RefundGas transfers the leftover gas to the sender of the message, or to the fee granter of
+// sponsored txs, caped to half of the total gas consumed in the transaction. The escrowed base fee
+// share of the refund is returned from the fee market module account, and the refund is credited
+// back to the fee allowance of the granter, unless the allowance was removed once exhausted.
+// Additionally, the function sets the total gas consumed to the value returned by the EVM
+// execution, thus ignoring the previous intrinsic gas consumed during in the AnteHandler.
 func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64, denom string) error {
 	// Return EVM tokens for remaining gas, exchanged at the original rate.
 	remaining := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
@@ -40,12 +44,32 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 		// positive amount refund
 		refundedCoins := sdk.Coins{sdk.NewCoin(denom, sdkmath.NewIntFromBigInt(remaining))}
 
-		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees
+		// refund to sender from the fee collector module account, which is the escrow account in charge of collecting tx fees.
+		// The leftover gas of sponsored txs is refunded to the fee granter that paid the fees.
+		refundee := gasRefundee(ctx, msg)
+		feeGranter := types.FeeGranter(ctx, msg.From())
 
-		err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, msg.From().Bytes(), refundedCoins)
-		if err != nil {
-			err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
-			return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
+		baseFees, tips := k.SplitFees(ctx, refundedCoins, msg.GasPrice())
+		if !baseFees.IsZero() {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, feemarkettypes.ModuleName, refundee, baseFees)
+			if err != nil {
+				err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee market account failed to refund base fees: %s", err.Error())
+				return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
+			}
+		}
+
+		if !tips.IsZero() {
+			err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, refundee, tips)
+			if err != nil {
+				err = errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "fee collector account failed to refund fees: %s", err.Error())
+				return errorsmod.Wrapf(err, "failed to refund %d leftover gas (%s)", leftoverGas, refundedCoins.String())
+			}
+		}
+
+		if feeGranter != nil {
+			if err := k.restoreAllowance(ctx, feeGranter, msg.From().Bytes(), refundedCoins); err != nil {
+				return errorsmod.Wrapf(err, "failed to restore the fee allowance of %s", feeGranter)
+			}
 		}
 	default:
 		// no refund, consume gas and update the tx gas meter
@@ -54,6 +78,47 @@ func (k *Keeper) RefundGas(ctx sdk.Context, msg core.Message, leftoverGas uint64
 	return nil
 }
 
+// RefundSwappedFees swaps back into fee tokens the refund of the leftover gas of an Ethereum tx
+// whose fees were paid with fee tokens swapped by the AnteHandler, up to the swapped amount. The
+// reserve of the fee abstraction module thus only pays for the gas actually charged.
+func (k *Keeper) RefundSwappedFees(ctx sdk.Context, msg core.Message, txHash common.Hash, leftoverGas uint64) error {
+	swap, found := types.GetFeeTokenSwap(ctx, txHash)
+	if !found || k.feeAbsKeeper == nil {
+		return nil
+	}
+
+	refund := new(big.Int).Mul(new(big.Int).SetUint64(leftoverGas), msg.GasPrice())
+	if refund.Sign() <= 0 {
+		return nil
+	}
+	return k.feeAbsKeeper.RefundSwappedFeeTokens(ctx, gasRefundee(ctx, msg), swap.AmountIn, swap.AmountOut, sdkmath.NewIntFromBigInt(refund))
+}
+
+// gasRefundee returns the account refunded for the leftover gas of a message,
+// which is the fee granter of sponsored txs or else the sender.
+func gasRefundee(ctx sdk.Context, msg core.Message) sdk.AccAddress {
+	if feeGranter := types.FeeGranter(ctx, msg.From()); feeGranter != nil {
+		return feeGranter
+	}
+	return msg.From().Bytes()
+}
+
+// restoreAllowance credits back the refunded fees to the fee allowance of the
+// granter of a sponsored tx. Nothing is restored when the allowance was
+// removed, which happens when its spend limit is exhausted.
+func (k *Keeper) restoreAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, refund sdk.Coins) error {
+	allowance, err := k.feeGrantKeeper.GetAllowance(ctx, granter, grantee)
+	if err != nil || allowance == nil {
+		return nil
+	}
+
+	allowance, err = types.RestoreAllowance(allowance, refund)
+	if err != nil {
+		return err
+	}
+	return k.feeGrantKeeper.UpdateAllowance(ctx, granter, grantee, allowance)
+}
+
 // ResetGasMeterAndConsumeGas reset first the gas meter consumed value to zero and set it back to the new value
 // 'gasUsed'
 func (k *Keeper) ResetGasMeterAndConsumeGas(ctx sdk.Context, gasUsed uint64) {
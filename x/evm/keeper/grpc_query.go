@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
 	"time"
 
 	"github.com/ethereum/go-ethereum/eth/tracers"
@@ -25,6 +26,7 @@ import (
 	"github.com/ethereum/go-ethereum/core/vm"
 	ethparams "github.com/ethereum/go-ethereum/params"
 
+	cmn "github.com/anryton/anryton/v2/precompiles/common"
 	anrytontypes "github.com/anryton/anryton/v2/types"
 	"github.com/anryton/anryton/v2/x/evm/statedb"
 	"github.com/anryton/anryton/v2/x/evm/types"
@@ -366,7 +368,7 @@ func (k Keeper) EstimateGas(c context.Context, req *types.EthCallRequest) (*type
 		if failed {
 			if result != nil && result.VmError != vm.ErrOutOfGas.Error() {
 				if result.VmError == vm.ErrExecutionReverted.Error() {
-					return nil, types.NewExecErrorWithReason(result.Ret)
+					return nil, types.NewExecErrorWithRevertData(result.Ret)
 				}
 				return nil, errors.New(result.VmError)
 			}
@@ -657,6 +659,55 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// AvailablePrecompiles implements the Query/AvailablePrecompiles gRPC method. It
+// returns the precompiled contracts configurable through governance, sorted by
+// address, along with their ABIs and the base gas of their methods.
+func (k Keeper) AvailablePrecompiles(c context.Context, _ *types.QueryAvailablePrecompilesRequest) (*types.QueryAvailablePrecompilesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+
+	precompiles := make([]types.PrecompileInfo, 0, len(types.AvailableEVMExtensions))
+	for address := range k.precompiles {
+		precompile, err := k.availablePrecompile(address)
+		if err != nil {
+			// Ethereum precompile
+			continue
+		}
+
+		abiBz, err := cmn.MarshalABI(precompile.GetABI())
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		config := params.GetPrecompileConfig(address)
+		enabled := params.IsActivePrecompile(address)
+
+		schedule := config.GasSchedule(precompile.GetMethodGas())
+		gasSchedule := make([]types.MethodGas, 0, len(schedule))
+		for method, gas := range schedule {
+			gasSchedule = append(gasSchedule, types.MethodGas{Method: method, Gas: gas})
+		}
+		sort.Slice(gasSchedule, func(i, j int) bool {
+			return gasSchedule[i].Method < gasSchedule[j].Method
+		})
+
+		precompiles = append(precompiles, types.PrecompileInfo{
+			Address:     address.Hex(),
+			Enabled:     enabled,
+			Active:      enabled && config.IsActive(ctx.BlockHeight()),
+			Config:      config,
+			ABI:         string(abiBz),
+			GasSchedule: gasSchedule,
+		})
+	}
+
+	sort.Slice(precompiles, func(i, j int) bool {
+		return precompiles[i].Address < precompiles[j].Address
+	})
+
+	return &types.QueryAvailablePrecompilesResponse{Precompiles: precompiles}, nil
+}
+
 // getChainID parse chainID from current context if not provided
 func getChainID(ctx sdk.Context, chainID int64) (*big.Int, error) {
 	if chainID == 0 {
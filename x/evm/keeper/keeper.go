@@ -45,6 +45,8 @@ type Keeper struct {
 	stakingKeeper types.StakingKeeper
 	// fetch EIP1559 base fee and parameters
 	feeMarketKeeper types.FeeMarketKeeper
+	// restore the fee allowances of the refunded fee granters
+	feeGrantKeeper types.FeeGrantKeeper
 
 	// chain ID number obtained from the context's chain id
 	eip155ChainID *big.Int
@@ -54,6 +56,10 @@ type Keeper struct {
 
 	// EVM Hooks for tx post-processing
 	hooks types.EvmHooks
+	// credit back the unused gas covered by the gas allowance of the senders
+	gasQuotaKeeper types.GasQuotaKeeper
+	// swap back the refunds of the fees paid with swapped fee tokens
+	feeAbsKeeper types.FeeAbsKeeper
 	// Legacy subspace
 	ss paramstypes.Subspace
 
@@ -72,6 +78,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	sk types.StakingKeeper,
 	fmk types.FeeMarketKeeper,
+	fgk types.FeeGrantKeeper,
 	tracer string,
 	ss paramstypes.Subspace,
 ) *Keeper {
@@ -93,6 +100,7 @@ func NewKeeper(
 		bankKeeper:      bankKeeper,
 		stakingKeeper:   sk,
 		feeMarketKeeper: fmk,
+		feeGrantKeeper:  fgk,
 		storeKey:        storeKey,
 		transientKey:    transientKey,
 		tracer:          tracer,
@@ -242,6 +250,31 @@ func (k *Keeper) SetHooks(eh types.EvmHooks) *Keeper {
 	return k
 }
 
+// SetGasQuotaKeeper sets the gasquota keeper credited with the covered gas
+// left unused by the Ethereum txs. It should be called only once during
+// initialization, it panics if called more than once.
+func (k *Keeper) SetGasQuotaKeeper(gqk types.GasQuotaKeeper) *Keeper {
+	if k.gasQuotaKeeper != nil {
+		panic("cannot set gasquota keeper twice")
+	}
+
+	k.gasQuotaKeeper = gqk
+	return k
+}
+
+// SetFeeAbsKeeper sets the feeabs keeper swapping back into fee tokens the
+// leftover gas refunds of the Ethereum txs paid with swapped fee tokens. It
+// should be called only once during initialization, it panics if called more
+// than once.
+func (k *Keeper) SetFeeAbsKeeper(fak types.FeeAbsKeeper) *Keeper {
+	if k.feeAbsKeeper != nil {
+		panic("cannot set feeabs keeper twice")
+	}
+
+	k.feeAbsKeeper = fak
+	return k
+}
+
 // CleanHooks resets the hooks for the EVM module
 // NOTE: Should only be used for testing purposes
 func (k *Keeper) CleanHooks() *Keeper {
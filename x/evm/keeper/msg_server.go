@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
@@ -15,6 +16,8 @@ import (
 	"github.com/armon/go-metrics"
 	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/anryton/anryton/v2/x/evm/types"
 )
@@ -137,6 +140,10 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
 	}
 
+	if err := k.validatePrecompiles(req.Params); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	if err := k.SetParams(ctx, req.Params); err != nil {
 		return nil, err
@@ -144,3 +151,117 @@ func (k *Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams)
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// UpdateAccessControlList implements the gRPC MsgServer interface. When an
+// UpdateAccessControlList proposal passes, it adds and removes the addresses of
+// an access control list while keeping the other parameters. The update can
+// only be performed if the requested authority is the Cosmos SDK governance
+// module account.
+func (k *Keeper) UpdateAccessControlList(goCtx context.Context, req *types.MsgUpdateAccessControlList) (*types.MsgUpdateAccessControlListResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := params.AccessControl.UpdateList(req.List, req.Add, req.Remove); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateAccessControlList,
+			sdk.NewAttribute(types.AttributeKeyAccessControlList, req.List.String()),
+			sdk.NewAttribute(types.AttributeKeyAdded, strings.Join(req.Add, ",")),
+			sdk.NewAttribute(types.AttributeKeyRemoved, strings.Join(req.Remove, ",")),
+		),
+	)
+
+	return &types.MsgUpdateAccessControlListResponse{}, nil
+}
+
+// TogglePrecompile implements the gRPC MsgServer interface. When a
+// TogglePrecompile proposal passes, it adds the available precompiled contract
+// to the active precompiles or removes it from them. The update can only be
+// performed if the requested authority is the Cosmos SDK governance module
+// account.
+func (k *Keeper) TogglePrecompile(goCtx context.Context, req *types.MsgTogglePrecompile) (*types.MsgTogglePrecompileResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	address := common.HexToAddress(req.Address)
+	if _, err := k.availablePrecompile(address); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if err := params.TogglePrecompile(address, req.Enabled); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTogglePrecompile,
+			sdk.NewAttribute(types.AttributeKeyPrecompile, address.Hex()),
+			sdk.NewAttribute(types.AttributeKeyEnabled, strconv.FormatBool(req.Enabled)),
+		),
+	)
+
+	return &types.MsgTogglePrecompileResponse{}, nil
+}
+
+// UpdatePrecompileConfig implements the gRPC MsgServer interface. When an
+// UpdatePrecompileConfig proposal passes, it replaces the activation height, the
+// disabled methods and the method gas of the available precompiled contract. The
+// update can only be performed if the requested authority is the Cosmos SDK
+// governance module account.
+func (k *Keeper) UpdatePrecompileConfig(goCtx context.Context, req *types.MsgUpdatePrecompileConfig) (*types.MsgUpdatePrecompileConfigResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority, expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	params.SetPrecompileConfig(req.Config)
+
+	if err := k.validatePrecompiles(params); err != nil {
+		return nil, errorsmod.Wrap(errortypes.ErrInvalidRequest, err.Error())
+	}
+	if err := params.Validate(); err != nil {
+		return nil, err
+	}
+	if err := k.SetParams(ctx, params); err != nil {
+		return nil, err
+	}
+
+	// the method gas is emitted as comma separated method:gas pairs
+	methodGas := make([]string, 0, len(req.Config.MethodGas))
+	for _, mg := range req.Config.MethodGas {
+		methodGas = append(methodGas, fmt.Sprintf("%s:%d", mg.Method, mg.Gas))
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdatePrecompileConfig,
+			sdk.NewAttribute(types.AttributeKeyPrecompile, common.HexToAddress(req.Config.Address).Hex()),
+			sdk.NewAttribute(types.AttributeKeyActivationHeight, strconv.FormatInt(req.Config.ActivationHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyDisabledMethods, strings.Join(req.Config.DisabledMethods, ",")),
+			sdk.NewAttribute(types.AttributeKeyMethodGas, strings.Join(methodGas, ",")),
+		),
+	)
+
+	return &types.MsgUpdatePrecompileConfigResponse{}, nil
+}
@@ -3,11 +3,15 @@ package keeper_test
 import (
 	"math/big"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
+	utiltx "github.com/anryton/anryton/v2/testutil/tx"
 	"github.com/anryton/anryton/v2/x/evm/statedb"
 	"github.com/anryton/anryton/v2/x/evm/types"
+	"github.com/ethereum/go-ethereum/common"
 	ethtypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/params"
 )
@@ -109,3 +113,199 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateAccessControlList() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	addr := utiltx.GenerateAddress().Hex()
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdateAccessControlList
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdateAccessControlList{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "fail - removed address not on the list",
+			request: &types.MsgUpdateAccessControlList{
+				Authority: authority,
+				List:      types.AccessControlListDenylist,
+				Remove:    []string{addr},
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - add address to the denylist",
+			request: &types.MsgUpdateAccessControlList{
+				Authority: authority,
+				List:      types.AccessControlListDenylist,
+				Add:       []string{addr},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.app.EvmKeeper.UpdateAccessControlList(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				suite.Require().True(params.AccessControl.IsDenied(common.HexToAddress(addr)))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestTogglePrecompile() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	address := types.AvailableEVMExtensions[0]
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgTogglePrecompile
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgTogglePrecompile{Authority: "foobar", Address: address},
+			expectErr: true,
+		},
+		{
+			name:      "fail - unavailable precompile",
+			request:   &types.MsgTogglePrecompile{Authority: authority, Address: utiltx.GenerateAddress().Hex()},
+			expectErr: true,
+		},
+		{
+			name:      "fail - precompile already active",
+			request:   &types.MsgTogglePrecompile{Authority: authority, Address: address, Enabled: true},
+			expectErr: true,
+		},
+		{
+			name:      "pass - disable precompile",
+			request:   &types.MsgTogglePrecompile{Authority: authority, Address: address},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.app.EvmKeeper.TogglePrecompile(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+			} else {
+				suite.Require().NoError(err)
+				params := suite.app.EvmKeeper.GetParams(suite.ctx)
+				suite.Require().False(params.IsActivePrecompile(common.HexToAddress(address)))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdatePrecompileConfig() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	address := types.AvailableEVMExtensions[0]
+
+	testCases := []struct {
+		name      string
+		request   *types.MsgUpdatePrecompileConfig
+		expectErr bool
+	}{
+		{
+			name:      "fail - invalid authority",
+			request:   &types.MsgUpdatePrecompileConfig{Authority: "foobar"},
+			expectErr: true,
+		},
+		{
+			name: "fail - unknown method",
+			request: &types.MsgUpdatePrecompileConfig{
+				Authority: authority,
+				Config:    types.PrecompileConfig{Address: address, DisabledMethods: []string{"0x00000000"}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "fail - gas of unknown method",
+			request: &types.MsgUpdatePrecompileConfig{
+				Authority: authority,
+				Config:    types.PrecompileConfig{Address: address, MethodGas: []types.MethodGas{{Method: "foo", Gas: 1000}}},
+			},
+			expectErr: true,
+		},
+		{
+			name: "pass - schedule activation",
+			request: &types.MsgUpdatePrecompileConfig{
+				Authority: authority,
+				Config:    types.PrecompileConfig{Address: address, ActivationHeight: suite.ctx.BlockHeight() + 10},
+			},
+			expectErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			_, err := suite.app.EvmKeeper.UpdatePrecompileConfig(suite.ctx, tc.request)
+			if tc.expectErr {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+			res, err := suite.app.EvmKeeper.AvailablePrecompiles(suite.ctx, &types.QueryAvailablePrecompilesRequest{})
+			suite.Require().NoError(err)
+			for _, precompile := range res.Precompiles {
+				if precompile.Address == address {
+					suite.Require().True(precompile.Enabled)
+					suite.Require().False(precompile.Active)
+					suite.Require().NotEmpty(precompile.ABI)
+				}
+			}
+
+			_, activePrecompiles := suite.app.EvmKeeper.Precompiles(suite.ctx, suite.app.EvmKeeper.GetParams(suite.ctx))
+			suite.Require().NotContains(activePrecompiles, common.HexToAddress(address))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdatePrecompileMethodGas() {
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	address := types.AvailableEVMExtensions[0]
+
+	ctx := suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err := suite.app.EvmKeeper.UpdatePrecompileConfig(ctx, &types.MsgUpdatePrecompileConfig{
+		Authority: authority,
+		Config:    types.PrecompileConfig{Address: address, MethodGas: []types.MethodGas{{Method: "delegate", Gas: 12345}}},
+	})
+	suite.Require().NoError(err)
+
+	events := ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeUpdatePrecompileConfig, events[0].Type)
+	suite.Require().Contains(events[0].Attributes, abci.EventAttribute{Key: types.AttributeKeyMethodGas, Value: "delegate:12345"})
+
+	res, err := suite.app.EvmKeeper.AvailablePrecompiles(suite.ctx, &types.QueryAvailablePrecompilesRequest{})
+	suite.Require().NoError(err)
+	for _, precompile := range res.Precompiles {
+		if precompile.Address != address {
+			continue
+		}
+		suite.Require().True(precompile.Active)
+		suite.Require().Contains(precompile.GasSchedule, types.MethodGas{Method: "delegate", Gas: 12345})
+		suite.Require().Contains(precompile.GasSchedule, types.MethodGas{Method: "delegation", Gas: 1000})
+	}
+
+	precompiles, _ := suite.app.EvmKeeper.Precompiles(suite.ctx, suite.app.EvmKeeper.GetParams(suite.ctx))
+	precompile, ok := precompiles[common.HexToAddress(address)].(interface {
+		GetMethodGas() map[string]uint64
+	})
+	suite.Require().True(ok)
+	suite.Require().Equal(uint64(12345), precompile.GetMethodGas()["delegate"])
+}
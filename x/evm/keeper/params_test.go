@@ -3,6 +3,8 @@ package keeper_test
 import (
 	"reflect"
 
+	"github.com/ethereum/go-ethereum/common"
+
 	"github.com/anryton/anryton/v2/x/evm/types"
 )
 
@@ -104,3 +106,58 @@ func (suite *KeeperTestSuite) TestParams() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestEnablePrecompiles() {
+	icaAddress := common.HexToAddress("0x0000000000000000000000000000000000000804")
+	tokenFactoryAddress := common.HexToAddress("0x0000000000000000000000000000000000000805")
+
+	testCases := []struct {
+		name      string
+		addresses []common.Address
+		// expActive is the number of active precompiles once enabled
+		expActive int
+		expPass   bool
+	}{
+		{
+			"fail - precompile not available",
+			[]common.Address{common.HexToAddress("0x0000000000000000000000000000000000000999")},
+			1,
+			false,
+		},
+		{
+			"pass - precompiles appended",
+			[]common.Address{icaAddress, tokenFactoryAddress},
+			3,
+			true,
+		},
+		{
+			"pass - active precompile skipped",
+			[]common.Address{icaAddress, icaAddress},
+			2,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.ActivePrecompiles = []string{"0x0000000000000000000000000000000000000800"}
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			err := suite.app.EvmKeeper.EnablePrecompiles(suite.ctx, tc.addresses...)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				suite.Require().Len(suite.app.EvmKeeper.GetParams(suite.ctx).ActivePrecompiles, tc.expActive)
+				return
+			}
+			suite.Require().NoError(err)
+
+			active := suite.app.EvmKeeper.GetParams(suite.ctx).GetActivePrecompilesAddrs()
+			for _, address := range tc.addresses {
+				suite.Require().Contains(active, address)
+			}
+			suite.Require().Len(active, tc.expActive)
+		})
+	}
+}
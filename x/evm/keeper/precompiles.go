@@ -4,19 +4,31 @@ import (
 	"fmt"
 
 	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/core/vm"
 
 	distprecompile "github.com/anryton/anryton/v2/precompiles/distribution"
+	icaprecompile "github.com/anryton/anryton/v2/precompiles/ica"
 	ics20precompile "github.com/anryton/anryton/v2/precompiles/ics20"
 	stakingprecompile "github.com/anryton/anryton/v2/precompiles/staking"
+	tokenfactoryprecompile "github.com/anryton/anryton/v2/precompiles/tokenfactory"
 	vestingprecompile "github.com/anryton/anryton/v2/precompiles/vesting"
+	"github.com/anryton/anryton/v2/x/evm/types"
+	ibchookskeeper "github.com/anryton/anryton/v2/x/ibc/hooks/keeper"
 	transferkeeper "github.com/anryton/anryton/v2/x/ibc/transfer/keeper"
+	tokenfactorykeeper "github.com/anryton/anryton/v2/x/tokenfactory/keeper"
 	vestingkeeper "github.com/anryton/anryton/v2/x/vesting/keeper"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	distributionkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	icacontrollerkeeper "github.com/cosmos/ibc-go/v7/modules/apps/27-interchain-accounts/controller/keeper"
 	channelkeeper "github.com/cosmos/ibc-go/v7/modules/core/04-channel/keeper"
 )
 
@@ -29,6 +41,11 @@ func AvailablePrecompiles(
 	authzKeeper authzkeeper.Keeper,
 	transferKeeper transferkeeper.Keeper,
 	channelKeeper channelkeeper.Keeper,
+	ibcHooksKeeper ibchookskeeper.Keeper,
+	icaControllerKeeper icacontrollerkeeper.Keeper,
+	tokenFactoryKeeper tokenfactorykeeper.Keeper,
+	bankKeeper bankkeeper.Keeper,
+	evmKeeper *Keeper,
 ) map[common.Address]vm.PrecompiledContract {
 	// Clone the mapping from the latest EVM fork.
 	precompiles := maps.Clone(vm.PrecompiledContractsBerlin)
@@ -38,12 +55,12 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to load staking precompile: %w", err))
 	}
 
-	distributionPrecompile, err := distprecompile.NewPrecompile(distributionKeeper, authzKeeper)
+	distributionPrecompile, err := distprecompile.NewPrecompile(distributionKeeper, stakingKeeper, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to load distribution precompile: %w", err))
 	}
 
-	ibcTransferPrecompile, err := ics20precompile.NewPrecompile(transferKeeper, channelKeeper, authzKeeper)
+	ibcTransferPrecompile, err := ics20precompile.NewPrecompile(transferKeeper, channelKeeper, ibcHooksKeeper, authzKeeper)
 	if err != nil {
 		panic(fmt.Errorf("failed to load ICS20 precompile: %w", err))
 	}
@@ -53,10 +70,22 @@ func AvailablePrecompiles(
 		panic(fmt.Errorf("failed to load vesting precompile: %w", err))
 	}
 
+	icaPrecompile, err := icaprecompile.NewPrecompile(icaControllerKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load ICA precompile: %w", err))
+	}
+
+	tokenFactoryPrecompile, err := tokenfactoryprecompile.NewPrecompile(tokenFactoryKeeper, bankKeeper, evmKeeper, authzKeeper)
+	if err != nil {
+		panic(fmt.Errorf("failed to load token factory precompile: %w", err))
+	}
+
 	precompiles[stakingPrecompile.Address()] = stakingPrecompile
 	precompiles[distributionPrecompile.Address()] = distributionPrecompile
 	precompiles[vestingPrecompile.Address()] = vestingPrecompile
 	precompiles[ibcTransferPrecompile.Address()] = ibcTransferPrecompile
+	precompiles[icaPrecompile.Address()] = icaPrecompile
+	precompiles[tokenFactoryPrecompile.Address()] = tokenFactoryPrecompile
 	return precompiles
 }
 
@@ -74,21 +103,153 @@ func (k *Keeper) WithPrecompiles(precompiles map[common.Address]vm.PrecompiledCo
 	return k
 }
 
-// Precompiles returns the subset of the available precompiled contracts that
-// are active given the current parameters.
+// Precompiles returns the precompiled contracts that are active given the
+// current parameters and block height, along with their addresses. The
+// precompiles of the parameters are active from their activation height, charge
+// the configured base gas for their methods and the calls to their disabled
+// methods fail.
 func (k Keeper) Precompiles(
-	activePrecompiles ...common.Address,
-) map[common.Address]vm.PrecompiledContract {
+	ctx sdk.Context,
+	params types.Params,
+) (map[common.Address]vm.PrecompiledContract, []common.Address) {
 	activePrecompileMap := make(map[common.Address]vm.PrecompiledContract)
+	activePrecompiles := make([]common.Address, 0, len(vm.PrecompiledAddressesBerlin)+len(params.ActivePrecompiles))
+
+	for _, address := range vm.PrecompiledAddressesBerlin {
+		activePrecompileMap[address] = k.precompiles[address]
+		activePrecompiles = append(activePrecompiles, address)
+	}
 
-	for _, address := range activePrecompiles {
+	for _, address := range params.GetActivePrecompilesAddrs() {
 		precompile, ok := k.precompiles[address]
 		if !ok {
-			panic(fmt.Sprintf("precompiled contract not initialized: %s", address))
+			k.Logger(ctx).Error("precompiled contract not initialized", "address", address)
+			continue
+		}
+
+		config := params.GetPrecompileConfig(address)
+		if !config.IsActive(ctx.BlockHeight()) {
+			continue
+		}
+
+		if scheduled, ok := precompile.(gasSchedulePrecompile); ok && len(config.MethodGas) > 0 {
+			precompile = scheduled.WithMethodGas(config.GasSchedule(scheduled.GetMethodGas()))
+		}
+
+		if len(config.DisabledMethods) > 0 {
+			precompile = &restrictedPrecompile{
+				PrecompiledContract: precompile,
+				disabledMethods:     config.DisabledSelectors(),
+			}
 		}
 
 		activePrecompileMap[address] = precompile
+		activePrecompiles = append(activePrecompiles, address)
+	}
+
+	return activePrecompileMap, activePrecompiles
+}
+
+// abiPrecompile is a precompiled contract exposing its ABI and the base gas of
+// its methods.
+type abiPrecompile interface {
+	vm.PrecompiledContract
+	GetABI() abi.ABI
+	GetMethodGas() map[string]uint64
+}
+
+// gasSchedulePrecompile is a precompiled contract whose base gas of the methods
+// can be configured through governance.
+type gasSchedulePrecompile interface {
+	abiPrecompile
+	WithMethodGas(methodGas map[string]uint64) vm.PrecompiledContract
+}
+
+// availablePrecompile returns the available precompiled contract that can be
+// configured through governance, i.e. that is not an Ethereum precompile.
+func (k Keeper) availablePrecompile(address common.Address) (abiPrecompile, error) {
+	precompile, ok := k.precompiles[address].(abiPrecompile)
+	if !ok {
+		return nil, fmt.Errorf("precompiled contract %s is not available", address)
 	}
+	return precompile, nil
+}
 
-	return activePrecompileMap
+// EnablePrecompiles appends the given precompiled contracts to the active
+// precompiles of the parameters, skipping the ones already active. It fails if
+// a precompiled contract is not available.
+func (k Keeper) EnablePrecompiles(ctx sdk.Context, addresses ...common.Address) error {
+	params := k.GetParams(ctx)
+	active := params.GetActivePrecompilesAddrs()
+	for _, address := range addresses {
+		if slices.Contains(active, address) {
+			continue
+		}
+		params.ActivePrecompiles = append(params.ActivePrecompiles, address.Hex())
+		active = append(active, address)
+	}
+
+	if err := k.validatePrecompiles(params); err != nil {
+		return err
+	}
+	return k.SetParams(ctx, params)
+}
+
+// validatePrecompiles checks that the active and configured precompiles of the
+// parameters are available and that their disabled and gas scheduled methods
+// exist.
+func (k Keeper) validatePrecompiles(params types.Params) error {
+	for _, address := range params.GetActivePrecompilesAddrs() {
+		if _, err := k.availablePrecompile(address); err != nil {
+			return err
+		}
+	}
+
+	for _, config := range params.PrecompileConfigs {
+		precompile, err := k.availablePrecompile(common.HexToAddress(config.Address))
+		if err != nil {
+			return err
+		}
+
+		precompileABI := precompile.GetABI()
+		for _, method := range config.DisabledMethods {
+			selector, err := hexutil.Decode(method)
+			if err != nil {
+				return err
+			}
+			if _, err := precompileABI.MethodById(selector); err != nil {
+				return fmt.Errorf("precompiled contract %s has no method %s", config.Address, method)
+			}
+		}
+
+		if len(config.MethodGas) == 0 {
+			continue
+		}
+		if _, ok := precompile.(gasSchedulePrecompile); !ok {
+			return fmt.Errorf("precompiled contract %s has no configurable gas schedule", config.Address)
+		}
+		for _, methodGas := range config.MethodGas {
+			if _, ok := precompileABI.Methods[methodGas.Method]; !ok {
+				return fmt.Errorf("precompiled contract %s has no method %s", config.Address, methodGas.Method)
+			}
+		}
+	}
+
+	return nil
+}
+
+// restrictedPrecompile wraps a precompiled contract to reject the calls to its
+// methods disabled through governance.
+type restrictedPrecompile struct {
+	vm.PrecompiledContract
+	// disabledMethods are the lowercase hex selectors of the disabled methods
+	disabledMethods map[string]bool
+}
+
+// Run implements vm.PrecompiledContract interface
+func (p *restrictedPrecompile) Run(evm *vm.EVM, contract *vm.Contract, readonly bool) ([]byte, error) {
+	if len(contract.Input) >= 4 && p.disabledMethods[hexutil.Encode(contract.Input[:4])] {
+		return nil, errorsmod.Wrapf(types.ErrPrecompileMethodDisabled, "method %s of precompile %s", hexutil.Encode(contract.Input[:4]), p.Address())
+	}
+	return p.PrecompiledContract.Run(evm, contract, readonly)
 }
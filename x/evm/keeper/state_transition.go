@@ -225,9 +225,28 @@ func (k *Keeper) ApplyTransaction(ctx sdk.Context, tx *ethtypes.Transaction) (*t
 	}
 
 	// refund gas in order to match the Ethereum gas consumption instead of the default SDK one.
-	if err = k.RefundGas(ctx, msg, msg.Gas()-res.GasUsed, cfg.Params.EvmDenom); err != nil {
+	// The gas covered by the gas quota of the sender was not paid, so only the
+	// leftover of the paid gas is refunded and the rest of the leftover gas is
+	// credited back to the gas quota.
+	leftoverGas := msg.Gas() - res.GasUsed
+	if subsidizedGas := types.SubsidizedGas(ctx, txConfig.TxHash); subsidizedGas > 0 {
+		paidGas := uint64(0)
+		if msg.Gas() > subsidizedGas {
+			paidGas = msg.Gas() - subsidizedGas
+		}
+		if leftoverGas > paidGas {
+			if k.gasQuotaKeeper != nil {
+				k.gasQuotaKeeper.RefundGasQuota(ctx, msg.From().Bytes(), leftoverGas-paidGas)
+			}
+			leftoverGas = paidGas
+		}
+	}
+	if err = k.RefundGas(ctx, msg, leftoverGas, cfg.Params.EvmDenom); err != nil {
 		return nil, errorsmod.Wrapf(err, "failed to refund gas leftover gas to sender %s", msg.From())
 	}
+	if err = k.RefundSwappedFees(ctx, msg, txConfig.TxHash, leftoverGas); err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to swap back the refund of sender %s", msg.From())
+	}
 
 	if len(receipt.Logs) > 0 {
 		// Update transient block bloom filter
@@ -315,16 +334,18 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		return nil, errorsmod.Wrap(types.ErrCallDisabled, "failed to call contract")
 	}
 
+	// return error if the sender or recipient are not permitted by the access control
+	if err := cfg.Params.AccessControl.CheckMessage(msg.From(), msg.To()); err != nil {
+		return nil, err
+	}
+
 	stateDB := statedb.New(ctx, k, txConfig)
 	evm := k.NewEVM(ctx, msg, cfg, tracer, stateDB)
 
 	// set the custom precompiles to the EVM (if any)
 	if cfg.Params.HasCustomPrecompiles() {
-		customPrecompiles := cfg.Params.GetActivePrecompilesAddrs()
-
-		activePrecompiles := make([]common.Address, len(vm.PrecompiledAddressesBerlin)+len(customPrecompiles))
-		copy(activePrecompiles[:len(vm.PrecompiledAddressesBerlin)], vm.PrecompiledAddressesBerlin)
-		copy(activePrecompiles[len(vm.PrecompiledAddressesBerlin):], customPrecompiles)
+		// NOTE: the precompiles not reaching their activation height are inactive
+		precompileMap, activePrecompiles := k.Precompiles(ctx, cfg.Params)
 
 		// Check if the transaction is sent to an inactive precompile
 		//
@@ -341,10 +362,15 @@ func (k *Keeper) ApplyMessageWithConfig(ctx sdk.Context,
 		// NOTE: this only adds active precompiles to the EVM.
 		// This means that evm.Precompile(addr) will return false for inactive precompiles
 		// even though this is actually a reserved address.
-		precompileMap := k.Precompiles(activePrecompiles...)
 		evm.WithPrecompiles(precompileMap, activePrecompiles)
 	}
 
+	// check the calls and contract creations made by the contracts against the
+	// access control, once the precompiles are set
+	if cfg.Params.AccessControl.RestrictsNestedCalls() {
+		types.WithAccessControl(evm, cfg.Params.AccessControl)
+	}
+
 	leftoverGas := msg.Gas()
 
 	// Allow the tracer captures the tx level events, mainly the gas consumption.
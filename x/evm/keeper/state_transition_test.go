@@ -5,10 +5,12 @@ import (
 	"math"
 	"math/big"
 
+	"github.com/anryton/anryton/v2/testutil"
 	utiltx "github.com/anryton/anryton/v2/testutil/tx"
 	"github.com/anryton/anryton/v2/x/evm/keeper"
 	"github.com/anryton/anryton/v2/x/evm/statedb"
 	"github.com/anryton/anryton/v2/x/evm/types"
+	feeabstypes "github.com/anryton/anryton/v2/x/feeabs/types"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	tmtypes "github.com/cometbft/cometbft/types"
@@ -457,6 +459,54 @@ func (suite *KeeperTestSuite) TestRefundGas() {
 	suite.mintFeeCollector = false
 }
 
+func (suite *KeeperTestSuite) TestRefundSwappedFees() {
+	txHash := common.BytesToHash([]byte("tx"))
+
+	// 500uatom were swapped for 1000 EVM denom to pay the fees at a gas price of 1
+	swap := types.FeeTokenSwap{
+		AmountIn:  sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)),
+		AmountOut: sdk.NewInt(1000),
+	}
+
+	testCases := []struct {
+		name           string
+		swaps          map[common.Hash]types.FeeTokenSwap
+		leftoverGas    uint64
+		expSwappedBack int64
+		expFeeTokens   int64
+	}{
+		{"no fee tokens swapped - refund kept", nil, 400, 0, 0},
+		{"refund swapped back", map[common.Hash]types.FeeTokenSwap{txHash: swap}, 400, 400, 200},
+		{"refund above the swapped amount - swapped amount swapped back", map[common.Hash]types.FeeTokenSwap{txHash: swap}, 1500, 1000, 500},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			denom := suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+			sender := sdk.AccAddress(suite.address.Bytes())
+
+			// the sender got the refund of the leftover gas and the reserve keeps
+			// the swapped fee tokens
+			refund := sdk.NewCoins(sdk.NewCoin(denom, sdk.NewIntFromUint64(tc.leftoverGas)))
+			suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, sender, refund))
+			suite.Require().NoError(testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, feeabstypes.ModuleName, swap.AmountIn))
+			balance := suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom)
+
+			ctx := suite.ctx
+			if tc.swaps != nil {
+				ctx = types.WithFeeTokenSwaps(ctx, tc.swaps)
+			}
+			msg := ethtypes.NewMessage(suite.address, nil, 0, big.NewInt(0), 2000, big.NewInt(1), big.NewInt(1), big.NewInt(1), nil, nil, false)
+
+			err := suite.app.EvmKeeper.RefundSwappedFees(ctx, msg, txHash, tc.leftoverGas)
+			suite.Require().NoError(err)
+			suite.Require().Equal(balance.Amount.Int64()-tc.expSwappedBack, suite.app.BankKeeper.GetBalance(suite.ctx, sender, denom).Amount.Int64())
+			suite.Require().Equal(tc.expFeeTokens, suite.app.BankKeeper.GetBalance(suite.ctx, sender, "uatom").Amount.Int64())
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestResetGasMeterAndConsumeGas() {
 	testCases := []struct {
 		name        string
@@ -743,3 +793,89 @@ func (suite *KeeperTestSuite) TestGetProposerAddress() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestApplyMessageAccessControl() {
+	contract := utiltx.GenerateAddress()
+	denied := utiltx.GenerateAddress()
+	slot := common.Hash{}
+
+	// call the denied address, then store the success flag of the call at slot 0
+	callCode := append(common.FromHex("0x600060006000600060007f"), common.LeftPadBytes(denied.Bytes(), 32)...)
+	callCode = append(callCode, common.FromHex("0x5af160005500")...)
+	// create an empty contract, then store its address at slot 0
+	createCode := common.FromHex("0x600060006000f060005500")
+
+	testCases := []struct {
+		name          string
+		code          []byte
+		accessControl types.AccessControl
+		expDenied     bool
+	}{
+		{
+			"call to a denied address",
+			callCode,
+			types.AccessControl{
+				Create:   types.AccessControlType{AccessType: types.AccessTypePermissionless},
+				Call:     types.AccessControlType{AccessType: types.AccessTypePermissionless},
+				Denylist: []string{denied.Hex()},
+			},
+			true,
+		},
+		{
+			"call allowed",
+			callCode,
+			types.DefaultAccessControl(),
+			false,
+		},
+		{
+			"create by a contract not on the allowlist",
+			createCode,
+			types.AccessControl{
+				Create: types.AccessControlType{AccessType: types.AccessTypeAllowlist, Allowlist: []string{suite.address.Hex()}},
+				Call:   types.AccessControlType{AccessType: types.AccessTypePermissionless},
+			},
+			true,
+		},
+		{
+			"create by an allowlisted contract",
+			createCode,
+			types.AccessControl{
+				Create: types.AccessControlType{AccessType: types.AccessTypeAllowlist, Allowlist: []string{contract.Hex()}},
+				Call:   types.AccessControlType{AccessType: types.AccessTypePermissionless},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			vmdb := suite.StateDB()
+			vmdb.SetCode(contract, tc.code)
+			suite.Require().NoError(vmdb.Commit())
+
+			params := suite.app.EvmKeeper.GetParams(suite.ctx)
+			params.AccessControl = tc.accessControl
+			suite.Require().NoError(suite.app.EvmKeeper.SetParams(suite.ctx, params))
+
+			msg := ethtypes.NewMessage(
+				suite.address, &contract, suite.app.EvmKeeper.GetNonce(suite.ctx, suite.address),
+				big.NewInt(0), 2000000, big.NewInt(0), big.NewInt(0), big.NewInt(0), nil, nil, true,
+			)
+			res, err := suite.app.EvmKeeper.ApplyMessage(suite.ctx, msg, nil, true)
+			suite.Require().NoError(err)
+			// the denied frame consumes its gas, leaving 1/64 of it to the contract
+			suite.Require().False(res.Failed(), res.VmError)
+
+			// the denied call or creation fails its own frame, so the contract
+			// only sees it failing and doesn't get any result from it
+			value := suite.app.EvmKeeper.GetState(suite.ctx, contract, slot)
+			if tc.expDenied {
+				suite.Require().Equal(common.Hash{}, value)
+				return
+			}
+			suite.Require().NotEqual(common.Hash{}, value)
+		})
+	}
+}
@@ -0,0 +1,285 @@
+package types
+
+import (
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/anryton/anryton/v2/types"
+)
+
+// DefaultAccessControl returns the permissionless access control, allowing any
+// address to deploy and call contracts.
+func DefaultAccessControl() AccessControl {
+	return AccessControl{
+		Create: AccessControlType{AccessType: AccessTypePermissionless},
+		Call:   AccessControlType{AccessType: AccessTypePermissionless},
+	}
+}
+
+// Validate performs a basic validation of the access control policies and lists.
+func (ac AccessControl) Validate() error {
+	if err := ac.Create.Validate(); err != nil {
+		return fmt.Errorf("invalid create access control: %w", err)
+	}
+	if err := ac.Call.Validate(); err != nil {
+		return fmt.Errorf("invalid call access control: %w", err)
+	}
+	if err := validateAddressList(ac.Denylist); err != nil {
+		return fmt.Errorf("invalid denylist: %w", err)
+	}
+	return nil
+}
+
+// Validate performs a basic validation of the access type and allowlist.
+func (act AccessControlType) Validate() error {
+	if _, ok := AccessType_name[int32(act.AccessType)]; !ok {
+		return fmt.Errorf("invalid access type %d", act.AccessType)
+	}
+	return validateAddressList(act.Allowlist)
+}
+
+// IsAllowed returns true if the policy allows the address.
+func (act AccessControlType) IsAllowed(addr common.Address) bool {
+	switch act.AccessType {
+	case AccessTypePermissionless:
+		return true
+	case AccessTypeAllowlist:
+		return containsAddress(act.Allowlist, addr)
+	default:
+		return false
+	}
+}
+
+// IsDenied returns true if the address is on the denylist.
+func (ac AccessControl) IsDenied(addr common.Address) bool {
+	return containsAddress(ac.Denylist, addr)
+}
+
+// RestrictsNestedCalls returns true if the access control applies to the calls
+// and contract creations made by the contracts, i.e. if the denylist is not
+// empty or the contract deployments are not permissionless.
+func (ac AccessControl) RestrictsNestedCalls() bool {
+	return len(ac.Denylist) > 0 || ac.Create.AccessType != AccessTypePermissionless
+}
+
+// CheckMessage returns an error if the sender of a message is not permitted to
+// send it. The recipient is nil for contract creations. The call policy only
+// applies to the sender of the message.
+func (ac AccessControl) CheckMessage(from common.Address, to *common.Address) error {
+	if ac.IsDenied(from) {
+		return errorsmod.Wrapf(ErrAccessDenied, "sender %s is denied", from)
+	}
+
+	if to == nil {
+		if !ac.Create.IsAllowed(from) {
+			return errorsmod.Wrapf(ErrAccessDenied, "sender %s is not allowed to deploy contracts", from)
+		}
+		return nil
+	}
+
+	if ac.IsDenied(*to) {
+		return errorsmod.Wrapf(ErrAccessDenied, "recipient %s is denied", to)
+	}
+	if !ac.Call.IsAllowed(from) {
+		return errorsmod.Wrapf(ErrAccessDenied, "sender %s is not allowed to call", from)
+	}
+	return nil
+}
+
+// CheckNested returns an error if a contract is not permitted to make the call
+// or contract creation of the given type. The contract is the deployer of the
+// contracts it creates, so governance may allowlist the factory contracts.
+func (ac AccessControl) CheckNested(typ vm.OpCode, from, to common.Address) error {
+	if ac.IsDenied(from) {
+		return errorsmod.Wrapf(ErrAccessDenied, "caller %s is denied", from)
+	}
+
+	if (typ == vm.CREATE || typ == vm.CREATE2) && !ac.Create.IsAllowed(from) {
+		return errorsmod.Wrapf(ErrAccessDenied, "contract %s is not allowed to deploy contracts", from)
+	}
+
+	if ac.IsDenied(to) {
+		return errorsmod.Wrapf(ErrAccessDenied, "%s target %s is denied", typ, to)
+	}
+	return nil
+}
+
+// GetList returns the addresses of the given access control list.
+func (ac AccessControl) GetList(list AccessControlList) ([]string, error) {
+	switch list {
+	case AccessControlListCreateAllowlist:
+		return ac.Create.Allowlist, nil
+	case AccessControlListCallAllowlist:
+		return ac.Call.Allowlist, nil
+	case AccessControlListDenylist:
+		return ac.Denylist, nil
+	default:
+		return nil, fmt.Errorf("invalid access control list %s", list)
+	}
+}
+
+// SetList replaces the addresses of the given access control list.
+func (ac *AccessControl) SetList(list AccessControlList, addrs []string) error {
+	switch list {
+	case AccessControlListCreateAllowlist:
+		ac.Create.Allowlist = addrs
+	case AccessControlListCallAllowlist:
+		ac.Call.Allowlist = addrs
+	case AccessControlListDenylist:
+		ac.Denylist = addrs
+	default:
+		return fmt.Errorf("invalid access control list %s", list)
+	}
+	return nil
+}
+
+// UpdateList adds and removes the addresses of the given access control list.
+// It fails if an added address is already on the list or a removed address is
+// not on it.
+func (ac *AccessControl) UpdateList(list AccessControlList, add, remove []string) error {
+	addrs, err := ac.GetList(list)
+	if err != nil {
+		return err
+	}
+
+	updated := make([]string, 0, len(addrs)+len(add))
+	removed := make(map[common.Address]bool, len(remove))
+	for _, addr := range remove {
+		removed[common.HexToAddress(addr)] = true
+	}
+	for _, addr := range addrs {
+		if removed[common.HexToAddress(addr)] {
+			delete(removed, common.HexToAddress(addr))
+			continue
+		}
+		updated = append(updated, addr)
+	}
+	for _, addr := range remove {
+		if removed[common.HexToAddress(addr)] {
+			return fmt.Errorf("address %s is not on the %s", addr, list)
+		}
+	}
+
+	for _, addr := range add {
+		if containsAddress(updated, common.HexToAddress(addr)) {
+			return fmt.Errorf("address %s is already on the %s", addr, list)
+		}
+		updated = append(updated, common.HexToAddress(addr).Hex())
+	}
+
+	return ac.SetList(list, updated)
+}
+
+func validateAccessControl(i interface{}) error {
+	ac, ok := i.(AccessControl)
+	if !ok {
+		return fmt.Errorf("invalid access control type: %T", i)
+	}
+
+	return ac.Validate()
+}
+
+// validateAddressList checks that the list holds unique hex addresses.
+func validateAddressList(addrs []string) error {
+	seen := make(map[common.Address]bool, len(addrs))
+	for _, addr := range addrs {
+		if err := types.ValidateAddress(addr); err != nil {
+			return fmt.Errorf("invalid address %s", addr)
+		}
+
+		if seen[common.HexToAddress(addr)] {
+			return fmt.Errorf("duplicate address %s", addr)
+		}
+		seen[common.HexToAddress(addr)] = true
+	}
+
+	return nil
+}
+
+// containsAddress returns true if the hex addresses hold the address.
+func containsAddress(addrs []string, addr common.Address) bool {
+	for _, a := range addrs {
+		if common.HexToAddress(a) == addr {
+			return true
+		}
+	}
+	return false
+}
+
+// WithAccessControl hooks the access control into the EVM, so that the calls
+// and contract creations made by the contracts are checked when entering their
+// frame. The denied addresses run a precompiled contract failing the call,
+// whether they hold code or not, and the interpreter checks the contract
+// creations before running their init code. As any other execution error, the
+// denial reverts the frame and consumes its gas, and the calling contract only
+// sees a failed call or creation.
+func WithAccessControl(evm *vm.EVM, accessControl AccessControl) {
+	rules := evm.ChainConfig().Rules(evm.Context.BlockNumber, evm.Context.Random != nil)
+	activePrecompiles := evm.ActivePrecompiles(rules)
+
+	precompiles := make(map[common.Address]vm.PrecompiledContract, len(activePrecompiles)+len(accessControl.Denylist))
+	for _, addr := range activePrecompiles {
+		precompiles[addr], _ = evm.Precompile(addr)
+	}
+	for _, addr := range accessControl.Denylist {
+		denied := common.HexToAddress(addr)
+		precompiles[denied] = deniedContract{address: denied}
+	}
+
+	// NOTE: the denied addresses are not active precompiles, so they are not
+	// added to the access list of the transactions.
+	evm.WithPrecompiles(precompiles, activePrecompiles)
+	evm.WithInterpreter(accessControlInterpreter{
+		Interpreter:   evm.Interpreter(),
+		evm:           evm,
+		accessControl: accessControl,
+	})
+}
+
+var _ vm.PrecompiledContract = deniedContract{}
+
+// deniedContract is the precompiled contract installed at a denied address,
+// failing the calls made to it.
+type deniedContract struct {
+	address common.Address
+}
+
+// Address implements vm.ContractRef interface
+func (dc deniedContract) Address() common.Address {
+	return dc.address
+}
+
+// RequiredGas implements vm.PrecompiledContract interface
+func (deniedContract) RequiredGas(_ []byte) uint64 {
+	return 0
+}
+
+// Run implements vm.PrecompiledContract interface
+func (dc deniedContract) Run(_ *vm.EVM, contract *vm.Contract, _ bool) ([]byte, error) {
+	return nil, errorsmod.Wrapf(ErrAccessDenied, "call from %s to %s is denied", contract.CallerAddress, dc.address)
+}
+
+var _ vm.Interpreter = accessControlInterpreter{}
+
+// accessControlInterpreter wraps the interpreter of the EVM to check the
+// contract creations against the access control before running the init code.
+type accessControlInterpreter struct {
+	vm.Interpreter
+	evm           *vm.EVM
+	accessControl AccessControl
+}
+
+// Run implements vm.Interpreter interface. The code of the called contracts is
+// loaded from the state, so the contracts without code in the state are being
+// created.
+func (i accessControlInterpreter) Run(contract *vm.Contract, input []byte, readOnly bool) ([]byte, error) {
+	if i.evm.StateDB.GetCodeSize(*contract.CodeAddr) == 0 {
+		if err := i.accessControl.CheckNested(vm.CREATE, contract.CallerAddress, contract.Address()); err != nil {
+			return nil, err
+		}
+	}
+	return i.Interpreter.Run(contract, input, readOnly)
+}
@@ -0,0 +1,268 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAccessControlValidate(t *testing.T) {
+	addr := common.BytesToAddress([]byte{1}).Hex()
+
+	testCases := []struct {
+		name          string
+		accessControl AccessControl
+		expPass       bool
+	}{
+		{
+			"default",
+			DefaultAccessControl(),
+			true,
+		},
+		{
+			"valid allowlist and denylist",
+			AccessControl{
+				Create:   AccessControlType{AccessType: AccessTypeAllowlist, Allowlist: []string{addr}},
+				Call:     AccessControlType{AccessType: AccessTypeNobody},
+				Denylist: []string{common.BytesToAddress([]byte{2}).Hex()},
+			},
+			true,
+		},
+		{
+			"invalid access type",
+			AccessControl{
+				Call: AccessControlType{AccessType: AccessType(3)},
+			},
+			false,
+		},
+		{
+			"invalid allowlist address",
+			AccessControl{
+				Create: AccessControlType{AccessType: AccessTypeAllowlist, Allowlist: []string{"foo"}},
+			},
+			false,
+		},
+		{
+			"duplicate denylist address",
+			AccessControl{
+				Denylist: []string{addr, common.HexToAddress(addr).Hex()},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.accessControl.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestAccessControlCheckMessage(t *testing.T) {
+	allowed := common.BytesToAddress([]byte{1})
+	other := common.BytesToAddress([]byte{2})
+	denied := common.BytesToAddress([]byte{3})
+
+	testCases := []struct {
+		name          string
+		accessControl AccessControl
+		from          common.Address
+		to            *common.Address
+		expPass       bool
+	}{
+		{
+			"permissionless create",
+			DefaultAccessControl(),
+			other,
+			nil,
+			true,
+		},
+		{
+			"permissionless call",
+			DefaultAccessControl(),
+			other,
+			&allowed,
+			true,
+		},
+		{
+			"allowlisted deployer",
+			AccessControl{Create: AccessControlType{AccessType: AccessTypeAllowlist, Allowlist: []string{allowed.Hex()}}},
+			allowed,
+			nil,
+			true,
+		},
+		{
+			"deployer not on the allowlist",
+			AccessControl{Create: AccessControlType{AccessType: AccessTypeAllowlist, Allowlist: []string{allowed.Hex()}}},
+			other,
+			nil,
+			false,
+		},
+		{
+			"create allowlist doesn't restrict calls",
+			AccessControl{Create: AccessControlType{AccessType: AccessTypeAllowlist, Allowlist: []string{allowed.Hex()}}},
+			other,
+			&allowed,
+			true,
+		},
+		{
+			"nobody may call",
+			AccessControl{Call: AccessControlType{AccessType: AccessTypeNobody}},
+			allowed,
+			&other,
+			false,
+		},
+		{
+			"denied sender",
+			AccessControl{Denylist: []string{denied.Hex()}},
+			denied,
+			&other,
+			false,
+		},
+		{
+			"denied recipient",
+			AccessControl{Denylist: []string{denied.Hex()}},
+			other,
+			&denied,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.accessControl.CheckMessage(tc.from, tc.to)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrAccessDenied, tc.name)
+		}
+	}
+}
+
+func TestAccessControlCheckNested(t *testing.T) {
+	factory := common.BytesToAddress([]byte{1})
+	other := common.BytesToAddress([]byte{2})
+	denied := common.BytesToAddress([]byte{3})
+
+	accessControl := AccessControl{
+		Create:   AccessControlType{AccessType: AccessTypeAllowlist, Allowlist: []string{factory.Hex()}},
+		Call:     AccessControlType{AccessType: AccessTypeNobody},
+		Denylist: []string{denied.Hex()},
+	}
+
+	testCases := []struct {
+		name    string
+		typ     vm.OpCode
+		from    common.Address
+		to      common.Address
+		expPass bool
+	}{
+		{"call", vm.CALL, other, factory, true},
+		{"create by allowlisted factory", vm.CREATE2, factory, other, true},
+		{"create by other contract", vm.CREATE, other, factory, false},
+		{"call to denied address", vm.STATICCALL, other, denied, false},
+		{"call by denied contract", vm.DELEGATECALL, denied, other, false},
+		{"selfdestruct to denied beneficiary", vm.SELFDESTRUCT, other, denied, false},
+	}
+
+	for _, tc := range testCases {
+		err := accessControl.CheckNested(tc.typ, tc.from, tc.to)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrAccessDenied, tc.name)
+		}
+	}
+}
+
+func TestAccessControlUpdateList(t *testing.T) {
+	addr1 := common.BytesToAddress([]byte{1}).Hex()
+	addr2 := common.BytesToAddress([]byte{2}).Hex()
+
+	testCases := []struct {
+		name    string
+		list    AccessControlList
+		add     []string
+		remove  []string
+		expList []string
+		expPass bool
+	}{
+		{"add", AccessControlListDenylist, []string{addr2}, nil, []string{addr1, addr2}, true},
+		{"remove", AccessControlListDenylist, nil, []string{addr1}, []string{}, true},
+		{"replace", AccessControlListDenylist, []string{addr2}, []string{addr1}, []string{addr2}, true},
+		{"add to the create allowlist", AccessControlListCreateAllowlist, []string{addr1}, nil, []string{addr1}, true},
+		{"add existing address", AccessControlListDenylist, []string{addr1}, nil, nil, false},
+		{"remove missing address", AccessControlListCallAllowlist, nil, []string{addr1}, nil, false},
+		{"unspecified list", AccessControlListUnspecified, []string{addr1}, nil, nil, false},
+	}
+
+	for _, tc := range testCases {
+		accessControl := AccessControl{Denylist: []string{addr1}}
+		err := accessControl.UpdateList(tc.list, tc.add, tc.remove)
+		if !tc.expPass {
+			require.Error(t, err, tc.name)
+			continue
+		}
+
+		require.NoError(t, err, tc.name)
+		list, err := accessControl.GetList(tc.list)
+		require.NoError(t, err, tc.name)
+		require.Equal(t, tc.expList, list, tc.name)
+	}
+}
+
+func TestMsgUpdateAccessControlListValidateBasic(t *testing.T) {
+	authority := sdk.AccAddress(common.BytesToAddress([]byte{9}).Bytes()).String()
+	addr := common.BytesToAddress([]byte{1}).Hex()
+
+	testCases := []struct {
+		name    string
+		msg     MsgUpdateAccessControlList
+		expPass bool
+	}{
+		{
+			"valid",
+			MsgUpdateAccessControlList{Authority: authority, List: AccessControlListDenylist, Add: []string{addr}},
+			true,
+		},
+		{
+			"invalid authority",
+			MsgUpdateAccessControlList{Authority: "foo", List: AccessControlListDenylist, Add: []string{addr}},
+			false,
+		},
+		{
+			"unspecified list",
+			MsgUpdateAccessControlList{Authority: authority, Add: []string{addr}},
+			false,
+		},
+		{
+			"no address",
+			MsgUpdateAccessControlList{Authority: authority, List: AccessControlListDenylist},
+			false,
+		},
+		{
+			"invalid address",
+			MsgUpdateAccessControlList{Authority: authority, List: AccessControlListDenylist, Remove: []string{"foo"}},
+			false,
+		},
+		{
+			"address added and removed",
+			MsgUpdateAccessControlList{Authority: authority, List: AccessControlListDenylist, Add: []string{addr}, Remove: []string{addr}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.msg.ValidateBasic()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
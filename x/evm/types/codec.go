@@ -8,6 +8,7 @@ import (
 	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	"github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	proto "github.com/cosmos/gogoproto/proto"
 )
 
@@ -23,7 +24,10 @@ var (
 
 const (
 	// Amino names
-	updateParamsName = "ethermint/MsgUpdateParams"
+	updateParamsName            = "ethermint/MsgUpdateParams"
+	updateAccessControlListName = "ethermint/MsgUpdateAccessControlList"
+	togglePrecompileName        = "ethermint/MsgTogglePrecompile"
+	updatePrecompileConfigName  = "ethermint/MsgUpdatePrecompileConfig"
 )
 
 // NOTE: This is required for the GetSignBytes function
@@ -37,11 +41,20 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*tx.TxExtensionOptionI)(nil),
 		&ExtensionOptionsEthereumTx{},
+		&ExtensionOptionsEthereumTxConditional{},
+		&ExtensionOptionsEthereumTxSponsored{},
+	)
+	registry.RegisterImplementations(
+		(*feegrant.FeeAllowanceI)(nil),
+		&AllowedContractAllowance{},
 	)
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgEthereumTx{},
 		&MsgUpdateParams{},
+		&MsgUpdateAccessControlList{},
+		&MsgTogglePrecompile{},
+		&MsgUpdatePrecompileConfig{},
 	)
 	registry.RegisterInterface(
 		"ethermint.evm.v1.TxData",
@@ -89,4 +102,7 @@ func UnpackTxData(any *codectypes.Any) (TxData, error) {
 // RegisterLegacyAminoCodec required for EIP-712
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgUpdateParams{}, updateParamsName, nil)
+	cdc.RegisterConcrete(&MsgUpdateAccessControlList{}, updateAccessControlListName, nil)
+	cdc.RegisterConcrete(&MsgTogglePrecompile{}, togglePrecompileName, nil)
+	cdc.RegisterConcrete(&MsgUpdatePrecompileConfig{}, updatePrecompileConfigName, nil)
 }
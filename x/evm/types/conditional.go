@@ -0,0 +1,87 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/anryton/anryton/v2/types"
+)
+
+// StateReader defines the storage getter required to check the known accounts
+// of a conditional transaction.
+type StateReader interface {
+	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+}
+
+// Validate performs a stateless validation of the transaction conditions.
+func (c ExtensionOptionsEthereumTxConditional) Validate() error {
+	if c.BlockNumberMax != 0 && c.BlockNumberMin > c.BlockNumberMax {
+		return errorsmod.Wrapf(
+			ErrConditionalTxRejected,
+			"block number min %d greater than max %d", c.BlockNumberMin, c.BlockNumberMax,
+		)
+	}
+
+	if c.TimestampMax != 0 && c.TimestampMin > c.TimestampMax {
+		return errorsmod.Wrapf(
+			ErrConditionalTxRejected,
+			"timestamp min %d greater than max %d", c.TimestampMin, c.TimestampMax,
+		)
+	}
+
+	seenAccounts := make(map[common.Address]bool)
+	for _, account := range c.KnownAccounts {
+		if err := types.ValidateAddress(account.Address); err != nil {
+			return errorsmod.Wrap(err, "invalid known account address")
+		}
+
+		address := common.HexToAddress(account.Address)
+		if seenAccounts[address] {
+			return errorsmod.Wrapf(ErrConditionalTxRejected, "duplicate known account %s", account.Address)
+		}
+		seenAccounts[address] = true
+
+		if err := Storage(account.Storage).Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid storage of known account %s", account.Address)
+		}
+	}
+
+	return nil
+}
+
+// CheckConditions returns an error if the transaction conditions are not met
+// by the current block or by the committed state of the known accounts.
+func (c ExtensionOptionsEthereumTxConditional) CheckConditions(ctx sdk.Context, reader StateReader) error {
+	height := uint64(ctx.BlockHeight()) //#nosec G701 -- block height is never negative
+	if c.BlockNumberMin != 0 && height < c.BlockNumberMin {
+		return errorsmod.Wrapf(ErrConditionalTxRejected, "block number %d lower than min %d", height, c.BlockNumberMin)
+	}
+	if c.BlockNumberMax != 0 && height > c.BlockNumberMax {
+		return errorsmod.Wrapf(ErrConditionalTxRejected, "block number %d greater than max %d", height, c.BlockNumberMax)
+	}
+
+	timestamp := uint64(ctx.BlockTime().Unix()) //#nosec G701 -- block time is never before the unix epoch
+	if c.TimestampMin != 0 && timestamp < c.TimestampMin {
+		return errorsmod.Wrapf(ErrConditionalTxRejected, "timestamp %d lower than min %d", timestamp, c.TimestampMin)
+	}
+	if c.TimestampMax != 0 && timestamp > c.TimestampMax {
+		return errorsmod.Wrapf(ErrConditionalTxRejected, "timestamp %d greater than max %d", timestamp, c.TimestampMax)
+	}
+
+	for _, account := range c.KnownAccounts {
+		address := common.HexToAddress(account.Address)
+		for _, state := range account.Storage {
+			expected := common.HexToHash(state.Value)
+			actual := reader.GetState(ctx, address, common.HexToHash(state.Key))
+			if actual != expected {
+				return errorsmod.Wrapf(
+					ErrConditionalTxRejected,
+					"storage slot %s of %s is %s, expected %s", state.Key, account.Address, actual.Hex(), expected.Hex(),
+				)
+			}
+		}
+	}
+
+	return nil
+}
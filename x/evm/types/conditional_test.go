@@ -0,0 +1,165 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+type mockStateReader map[common.Address]map[common.Hash]common.Hash
+
+func (m mockStateReader) GetState(_ sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	return m[addr][key]
+}
+
+func TestConditionalValidate(t *testing.T) {
+	addr := common.BytesToAddress([]byte{1})
+
+	testCases := []struct {
+		name        string
+		conditional ExtensionOptionsEthereumTxConditional
+		expPass     bool
+	}{
+		{
+			"empty conditions",
+			ExtensionOptionsEthereumTxConditional{},
+			true,
+		},
+		{
+			"valid conditions",
+			ExtensionOptionsEthereumTxConditional{
+				BlockNumberMin: 1,
+				BlockNumberMax: 10,
+				TimestampMin:   100,
+				KnownAccounts: []KnownAccount{
+					{Address: addr.Hex(), Storage: Storage{NewState(common.Hash{1}, common.Hash{2})}},
+				},
+			},
+			true,
+		},
+		{
+			"block number min greater than max",
+			ExtensionOptionsEthereumTxConditional{BlockNumberMin: 10, BlockNumberMax: 1},
+			false,
+		},
+		{
+			"timestamp min greater than max",
+			ExtensionOptionsEthereumTxConditional{TimestampMin: 10, TimestampMax: 1},
+			false,
+		},
+		{
+			"invalid known account address",
+			ExtensionOptionsEthereumTxConditional{
+				KnownAccounts: []KnownAccount{{Address: "invalid"}},
+			},
+			false,
+		},
+		{
+			"duplicate known account",
+			ExtensionOptionsEthereumTxConditional{
+				KnownAccounts: []KnownAccount{{Address: addr.Hex()}, {Address: addr.Hex()}},
+			},
+			false,
+		},
+		{
+			"blank storage key",
+			ExtensionOptionsEthereumTxConditional{
+				KnownAccounts: []KnownAccount{{Address: addr.Hex(), Storage: Storage{{Key: ""}}}},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.conditional.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestConditionalCheckConditions(t *testing.T) {
+	addr := common.BytesToAddress([]byte{1})
+	reader := mockStateReader{
+		addr: {common.Hash{1}: common.Hash{2}},
+	}
+	ctx := sdk.Context{}.
+		WithBlockHeader(tmproto.Header{Height: 10, Time: time.Unix(1000, 0)})
+
+	testCases := []struct {
+		name        string
+		conditional ExtensionOptionsEthereumTxConditional
+		expPass     bool
+	}{
+		{
+			"no conditions",
+			ExtensionOptionsEthereumTxConditional{},
+			true,
+		},
+		{
+			"block number and timestamp in range",
+			ExtensionOptionsEthereumTxConditional{
+				BlockNumberMin: 10,
+				BlockNumberMax: 10,
+				TimestampMin:   1000,
+				TimestampMax:   1000,
+			},
+			true,
+		},
+		{
+			"block number lower than min",
+			ExtensionOptionsEthereumTxConditional{BlockNumberMin: 11},
+			false,
+		},
+		{
+			"block number greater than max",
+			ExtensionOptionsEthereumTxConditional{BlockNumberMax: 9},
+			false,
+		},
+		{
+			"timestamp lower than min",
+			ExtensionOptionsEthereumTxConditional{TimestampMin: 1001},
+			false,
+		},
+		{
+			"timestamp greater than max",
+			ExtensionOptionsEthereumTxConditional{TimestampMax: 999},
+			false,
+		},
+		{
+			"known account storage matches",
+			ExtensionOptionsEthereumTxConditional{
+				KnownAccounts: []KnownAccount{
+					{Address: addr.Hex(), Storage: Storage{NewState(common.Hash{1}, common.Hash{2})}},
+				},
+			},
+			true,
+		},
+		{
+			"known account storage mismatch",
+			ExtensionOptionsEthereumTxConditional{
+				KnownAccounts: []KnownAccount{
+					{Address: addr.Hex(), Storage: Storage{NewState(common.Hash{1}, common.Hash{3})}},
+				},
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.conditional.CheckConditions(ctx, reader)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, ErrConditionalTxRejected, tc.name)
+		}
+	}
+}
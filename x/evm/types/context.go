@@ -0,0 +1,71 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// private type creates an interface key for Context that cannot be accessed by any other package
+type contextKey int
+
+const (
+	// gas of the Ethereum txs covered without fees, indexed by tx hash
+	contextKeySubsidizedGas contextKey = iota
+	// fee granters paying the fees of the Ethereum txs, indexed by sender
+	contextKeyFeeGranters
+	// fee tokens swapped to pay the fees of the Ethereum txs, indexed by tx hash
+	contextKeyFeeTokenSwaps
+)
+
+// FeeTokenSwap is the swap of fee tokens into the EVM denom paying the fees of
+// an Ethereum tx
+type FeeTokenSwap struct {
+	// swapped fee tokens
+	AmountIn sdk.Coins
+	// EVM denom amount received for the fee tokens
+	AmountOut sdkmath.Int
+}
+
+// WithSubsidizedGas stores in the context the gas of the Ethereum txs that is
+// covered without charging fees to the sender, indexed by tx hash
+func WithSubsidizedGas(ctx sdk.Context, subsidizedGas map[common.Hash]uint64) sdk.Context {
+	return ctx.WithValue(contextKeySubsidizedGas, subsidizedGas)
+}
+
+// SubsidizedGas returns the gas of an Ethereum tx covered without charging
+// fees to the sender. The leftover gas of the subsidized txs is not refunded
+// and it does not generate fee revenue.
+func SubsidizedGas(ctx sdk.Context, txHash common.Hash) uint64 {
+	subsidizedGas, _ := ctx.Value(contextKeySubsidizedGas).(map[common.Hash]uint64)
+	return subsidizedGas[txHash]
+}
+
+// WithFeeGranters stores in the context the fee granters paying the fees of
+// the Ethereum txs, indexed by sender address
+func WithFeeGranters(ctx sdk.Context, feeGranters map[common.Address]sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(contextKeyFeeGranters, feeGranters)
+}
+
+// FeeGranter returns the fee granter paying the fees of the Ethereum txs of
+// the sender, or nil if the sender pays them. The leftover gas of the
+// sponsored txs is refunded to the fee granter.
+func FeeGranter(ctx sdk.Context, sender common.Address) sdk.AccAddress {
+	feeGranters, _ := ctx.Value(contextKeyFeeGranters).(map[common.Address]sdk.AccAddress)
+	return feeGranters[sender]
+}
+
+// WithFeeTokenSwaps stores in the context the swaps of fee tokens paying the
+// fees of the Ethereum txs, indexed by tx hash
+func WithFeeTokenSwaps(ctx sdk.Context, swaps map[common.Hash]FeeTokenSwap) sdk.Context {
+	return ctx.WithValue(contextKeyFeeTokenSwaps, swaps)
+}
+
+// GetFeeTokenSwap returns the swap of fee tokens paying the fees of an Ethereum
+// tx, if any. The leftover gas refund of the tx is swapped back into the fee
+// tokens up to the swapped amount.
+func GetFeeTokenSwap(ctx sdk.Context, txHash common.Hash) (FeeTokenSwap, bool) {
+	swaps, _ := ctx.Value(contextKeyFeeTokenSwaps).(map[common.Hash]FeeTokenSwap)
+	swap, found := swaps[txHash]
+	return swap, found
+}
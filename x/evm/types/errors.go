@@ -3,11 +3,10 @@ package types
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	errorsmod "cosmossdk.io/errors"
 	"github.com/ethereum/go-ethereum/common"
-
-	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 )
 
@@ -28,6 +27,9 @@ const (
 	codeErrInvalidAccount
 	codeErrInvalidGasLimit
 	codeErrInactivePrecompile
+	codeErrConditionalTxRejected
+	codeErrAccessDenied
+	codeErrPrecompileMethodDisabled
 )
 
 var ErrPostTxProcessing = errors.New("failed to execute post processing")
@@ -80,15 +82,23 @@ var (
 
 	// ErrInactivePrecompile returns an error if a call is made to an inactive precompile
 	ErrInactivePrecompile = errorsmod.Register(ModuleName, codeErrInactivePrecompile, "precompile not enabled")
+
+	// ErrConditionalTxRejected returns an error if the conditions of a conditional transaction are not met
+	ErrConditionalTxRejected = errorsmod.Register(ModuleName, codeErrConditionalTxRejected, "transaction conditions not met")
+
+	// ErrAccessDenied returns an error if an address is not permitted by the AccessControl parameters
+	ErrAccessDenied = errorsmod.Register(ModuleName, codeErrAccessDenied, "EVM access denied")
+
+	// ErrPrecompileMethodDisabled returns an error if a call is made to a method of a precompile disabled by governance
+	ErrPrecompileMethodDisabled = errorsmod.Register(ModuleName, codeErrPrecompileMethodDisabled, "precompile method disabled")
 )
 
 // NewExecErrorWithReason unpacks the revert return bytes and returns a wrapped error
 // with the return reason.
 func NewExecErrorWithReason(revertReason []byte) *RevertError {
 	result := common.CopyBytes(revertReason)
-	reason, errUnpack := abi.UnpackRevert(result)
 	err := errors.New("execution reverted")
-	if errUnpack == nil {
+	if reason, ok := DecodeRevertReason(result); ok {
 		err = fmt.Errorf("execution reverted: %v", reason)
 	}
 	return &RevertError{
@@ -97,6 +107,46 @@ func NewExecErrorWithReason(revertReason []byte) *RevertError {
 	}
 }
 
+// revertDataKey precedes the hex encoded revert data in the message of the
+// errors created by NewExecErrorWithRevertData.
+const revertDataKey = "revert data: "
+
+// NewExecErrorWithRevertData creates a RevertError as NewExecErrorWithReason,
+// with the hex encoded revert data appended to the error message. Since the
+// error data is lost when an error is returned through gRPC, this allows the
+// caller to rebuild the revert error with RevertErrorFromMessage.
+func NewExecErrorWithRevertData(revertReason []byte) *RevertError {
+	revertErr := NewExecErrorWithReason(revertReason)
+	revertErr.error = fmt.Errorf("%w; %s%s", revertErr.error, revertDataKey, revertErr.reason)
+	return revertErr
+}
+
+// RevertErrorFromMessage rebuilds the RevertError from the message of an error
+// created by NewExecErrorWithRevertData. It returns false if the message does
+// not contain any revert data.
+func RevertErrorFromMessage(msg string) (*RevertError, bool) {
+	i := strings.LastIndex(msg, revertDataKey)
+	if i < 0 {
+		return nil, false
+	}
+
+	// the revert data ends at the first non hex character, since the gRPC
+	// status might wrap the message
+	data := msg[i+len(revertDataKey):]
+	if j := strings.IndexFunc(data, func(r rune) bool {
+		return !strings.ContainsRune("0123456789abcdefxABCDEF", r)
+	}); j >= 0 {
+		data = data[:j]
+	}
+
+	revertReason, err := hexutil.Decode(data)
+	if err != nil {
+		return nil, false
+	}
+
+	return NewExecErrorWithReason(revertReason), true
+}
+
 // RevertError is an API error that encompass an EVM revert with JSON error
 // code and a binary data blob.
 type RevertError struct {
@@ -6,6 +6,10 @@ const (
 	EventTypeBlockBloom = "block_bloom"
 	EventTypeTxLog      = "tx_log"
 
+	EventTypeUpdateAccessControlList = "update_access_control_list"
+	EventTypeTogglePrecompile        = "toggle_precompile"
+	EventTypeUpdatePrecompileConfig  = "update_precompile_config"
+
 	AttributeKeyContractAddress = "contract"
 	AttributeKeyRecipient       = "recipient"
 	AttributeKeyTxHash          = "txHash"
@@ -15,9 +19,17 @@ const (
 	AttributeKeyTxType          = "txType"
 	AttributeKeyTxLog           = "txLog"
 	// tx failed in eth vm execution
-	AttributeKeyEthereumTxFailed = "ethereumTxFailed"
-	AttributeValueCategory       = ModuleName
-	AttributeKeyEthereumBloom    = "bloom"
+	AttributeKeyEthereumTxFailed  = "ethereumTxFailed"
+	AttributeValueCategory        = ModuleName
+	AttributeKeyEthereumBloom     = "bloom"
+	AttributeKeyAccessControlList = "list"
+	AttributeKeyAdded             = "added"
+	AttributeKeyRemoved           = "removed"
+	AttributeKeyPrecompile        = "precompile"
+	AttributeKeyEnabled           = "enabled"
+	AttributeKeyActivationHeight  = "activation_height"
+	AttributeKeyDisabledMethods   = "disabled_methods"
+	AttributeKeyMethodGas         = "method_gas"
 
 	MetricKeyTransitionDB = "transition_db"
 	MetricKeyStaticCall   = "static_call"
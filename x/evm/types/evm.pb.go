@@ -24,6 +24,75 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// AccessType defines the types of permission policies
+type AccessType int32
+
+const (
+	// ACCESS_TYPE_PERMISSIONLESS allows any address
+	AccessTypePermissionless AccessType = 0
+	// ACCESS_TYPE_ALLOWLIST allows only the addresses of the allowlist
+	AccessTypeAllowlist AccessType = 1
+	// ACCESS_TYPE_NOBODY allows no address
+	AccessTypeNobody AccessType = 2
+)
+
+var AccessType_name = map[int32]string{
+	0: "ACCESS_TYPE_PERMISSIONLESS",
+	1: "ACCESS_TYPE_ALLOWLIST",
+	2: "ACCESS_TYPE_NOBODY",
+}
+
+var AccessType_value = map[string]int32{
+	"ACCESS_TYPE_PERMISSIONLESS": 0,
+	"ACCESS_TYPE_ALLOWLIST":      1,
+	"ACCESS_TYPE_NOBODY":         2,
+}
+
+func (x AccessType) String() string {
+	return proto.EnumName(AccessType_name, int32(x))
+}
+
+func (AccessType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{0}
+}
+
+// AccessControlList defines the lists of the access control updated by
+// MsgUpdateAccessControlList
+type AccessControlList int32
+
+const (
+	// ACCESS_CONTROL_LIST_UNSPECIFIED defines an invalid list
+	AccessControlListUnspecified AccessControlList = 0
+	// ACCESS_CONTROL_LIST_CREATE_ALLOWLIST is the allowlist of the deployers
+	AccessControlListCreateAllowlist AccessControlList = 1
+	// ACCESS_CONTROL_LIST_CALL_ALLOWLIST is the allowlist of the callers
+	AccessControlListCallAllowlist AccessControlList = 2
+	// ACCESS_CONTROL_LIST_DENYLIST is the denylist
+	AccessControlListDenylist AccessControlList = 3
+)
+
+var AccessControlList_name = map[int32]string{
+	0: "ACCESS_CONTROL_LIST_UNSPECIFIED",
+	1: "ACCESS_CONTROL_LIST_CREATE_ALLOWLIST",
+	2: "ACCESS_CONTROL_LIST_CALL_ALLOWLIST",
+	3: "ACCESS_CONTROL_LIST_DENYLIST",
+}
+
+var AccessControlList_value = map[string]int32{
+	"ACCESS_CONTROL_LIST_UNSPECIFIED":      0,
+	"ACCESS_CONTROL_LIST_CREATE_ALLOWLIST": 1,
+	"ACCESS_CONTROL_LIST_CALL_ALLOWLIST":   2,
+	"ACCESS_CONTROL_LIST_DENYLIST":         3,
+}
+
+func (x AccessControlList) String() string {
+	return proto.EnumName(AccessControlList_name, int32(x))
+}
+
+func (AccessControlList) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// evm_denom represents the token denomination used to run the EVM state
@@ -43,6 +112,12 @@ type Params struct {
 	// active_precompiles defines the slice of hex addresses of the precompiled
 	// contracts that are active
 	ActivePrecompiles []string `protobuf:"bytes,7,rep,name=active_precompiles,json=activePrecompiles,proto3" json:"active_precompiles,omitempty"`
+	// access_control defines the permission policies of the contract deployments
+	// and calls, and the addresses denied from the EVM
+	AccessControl AccessControl `protobuf:"bytes,8,opt,name=access_control,json=accessControl,proto3" json:"access_control"`
+	// precompile_configs defines the activation height and the disabled methods
+	// of the precompiled contracts
+	PrecompileConfigs []PrecompileConfig `protobuf:"bytes,9,rep,name=precompile_configs,json=precompileConfigs,proto3" json:"precompile_configs"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -127,6 +202,275 @@ func (m *Params) GetActivePrecompiles() []string {
 	return nil
 }
 
+func (m *Params) GetAccessControl() AccessControl {
+	if m != nil {
+		return m.AccessControl
+	}
+	return AccessControl{}
+}
+
+func (m *Params) GetPrecompileConfigs() []PrecompileConfig {
+	if m != nil {
+		return m.PrecompileConfigs
+	}
+	return nil
+}
+
+// PrecompileConfig defines the governance configuration of a precompiled
+// contract
+type PrecompileConfig struct {
+	// address is the hex address of the precompiled contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// activation_height is the block height from which the precompiled contract
+	// is active, if it is part of the active precompiles
+	ActivationHeight int64 `protobuf:"varint,2,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// disabled_methods defines the hex encoded 4 bytes selectors of the methods
+	// of the precompiled contract that are disabled
+	DisabledMethods []string `protobuf:"bytes,3,rep,name=disabled_methods,json=disabledMethods,proto3" json:"disabled_methods,omitempty"`
+	// method_gas overrides the default base gas charged for the methods of the
+	// precompiled contract
+	MethodGas []MethodGas `protobuf:"bytes,4,rep,name=method_gas,json=methodGas,proto3" json:"method_gas"`
+}
+
+func (m *PrecompileConfig) Reset()         { *m = PrecompileConfig{} }
+func (m *PrecompileConfig) String() string { return proto.CompactTextString(m) }
+func (*PrecompileConfig) ProtoMessage()    {}
+func (*PrecompileConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+}
+func (m *PrecompileConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileConfig.Merge(m, src)
+}
+func (m *PrecompileConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileConfig proto.InternalMessageInfo
+
+func (m *PrecompileConfig) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileConfig) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *PrecompileConfig) GetDisabledMethods() []string {
+	if m != nil {
+		return m.DisabledMethods
+	}
+	return nil
+}
+
+func (m *PrecompileConfig) GetMethodGas() []MethodGas {
+	if m != nil {
+		return m.MethodGas
+	}
+	return nil
+}
+
+// MethodGas defines the base gas charged for a method of a precompiled contract,
+// on top of the cost of its arguments and of its state accesses
+type MethodGas struct {
+	// method is the name of the method
+	Method string `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	// gas is the base gas charged for the method
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *MethodGas) Reset()         { *m = MethodGas{} }
+func (m *MethodGas) String() string { return proto.CompactTextString(m) }
+func (*MethodGas) ProtoMessage()    {}
+func (*MethodGas) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+}
+func (m *MethodGas) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MethodGas) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MethodGas.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MethodGas) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MethodGas.Merge(m, src)
+}
+func (m *MethodGas) XXX_Size() int {
+	return m.Size()
+}
+func (m *MethodGas) XXX_DiscardUnknown() {
+	xxx_messageInfo_MethodGas.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MethodGas proto.InternalMessageInfo
+
+func (m *MethodGas) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *MethodGas) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+// AccessControlType defines the permission policy of an EVM operation
+type AccessControlType struct {
+	// access_type defines which addresses may perform the operation
+	AccessType AccessType `protobuf:"varint,1,opt,name=access_type,json=accessType,proto3,enum=ethermint.evm.v1.AccessType" json:"access_type,omitempty" yaml:"access_type"`
+	// allowlist defines the hex addresses allowed to perform the operation when
+	// the access type is the allowlist
+	Allowlist []string `protobuf:"bytes,2,rep,name=allowlist,proto3" json:"allowlist,omitempty"`
+}
+
+func (m *AccessControlType) Reset()         { *m = AccessControlType{} }
+func (m *AccessControlType) String() string { return proto.CompactTextString(m) }
+func (*AccessControlType) ProtoMessage()    {}
+func (*AccessControlType) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+}
+func (m *AccessControlType) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessControlType) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessControlType.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessControlType) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControlType.Merge(m, src)
+}
+func (m *AccessControlType) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessControlType) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControlType.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControlType proto.InternalMessageInfo
+
+func (m *AccessControlType) GetAccessType() AccessType {
+	if m != nil {
+		return m.AccessType
+	}
+	return AccessTypePermissionless
+}
+
+func (m *AccessControlType) GetAllowlist() []string {
+	if m != nil {
+		return m.Allowlist
+	}
+	return nil
+}
+
+// AccessControl defines the permission policies of the contract deployments and
+// calls, and the addresses that can neither send EVM txs nor be called
+type AccessControl struct {
+	// create defines the policy of the addresses deploying contracts
+	Create AccessControlType `protobuf:"bytes,1,opt,name=create,proto3" json:"create"`
+	// call defines the policy of the addresses sending call txs
+	Call AccessControlType `protobuf:"bytes,2,opt,name=call,proto3" json:"call"`
+	// denylist defines the hex addresses that can neither send EVM txs nor be
+	// called, created or used as the deployer of a contract
+	Denylist []string `protobuf:"bytes,3,rep,name=denylist,proto3" json:"denylist,omitempty"`
+}
+
+func (m *AccessControl) Reset()         { *m = AccessControl{} }
+func (m *AccessControl) String() string { return proto.CompactTextString(m) }
+func (*AccessControl) ProtoMessage()    {}
+func (*AccessControl) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+}
+func (m *AccessControl) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccessControl) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccessControl.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccessControl) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccessControl.Merge(m, src)
+}
+func (m *AccessControl) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccessControl) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccessControl.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccessControl proto.InternalMessageInfo
+
+func (m *AccessControl) GetCreate() AccessControlType {
+	if m != nil {
+		return m.Create
+	}
+	return AccessControlType{}
+}
+
+func (m *AccessControl) GetCall() AccessControlType {
+	if m != nil {
+		return m.Call
+	}
+	return AccessControlType{}
+}
+
+func (m *AccessControl) GetDenylist() []string {
+	if m != nil {
+		return m.Denylist
+	}
+	return nil
+}
+
 // ChainConfig defines the Ethereum ChainConfig parameters using *sdk.Int values
 // instead of *big.Int.
 type ChainConfig struct {
@@ -175,7 +519,7 @@ func (m *ChainConfig) Reset()         { *m = ChainConfig{} }
 func (m *ChainConfig) String() string { return proto.CompactTextString(m) }
 func (*ChainConfig) ProtoMessage()    {}
 func (*ChainConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{1}
+	return fileDescriptor_d21ecc92c8c8583e, []int{5}
 }
 func (m *ChainConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -230,7 +574,7 @@ func (m *State) Reset()         { *m = State{} }
 func (m *State) String() string { return proto.CompactTextString(m) }
 func (*State) ProtoMessage()    {}
 func (*State) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{2}
+	return fileDescriptor_d21ecc92c8c8583e, []int{6}
 }
 func (m *State) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -287,7 +631,7 @@ func (m *TransactionLogs) Reset()         { *m = TransactionLogs{} }
 func (m *TransactionLogs) String() string { return proto.CompactTextString(m) }
 func (*TransactionLogs) ProtoMessage()    {}
 func (*TransactionLogs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{3}
+	return fileDescriptor_d21ecc92c8c8583e, []int{7}
 }
 func (m *TransactionLogs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,7 +707,7 @@ func (m *Log) Reset()         { *m = Log{} }
 func (m *Log) String() string { return proto.CompactTextString(m) }
 func (*Log) ProtoMessage()    {}
 func (*Log) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{4}
+	return fileDescriptor_d21ecc92c8c8583e, []int{8}
 }
 func (m *Log) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -478,7 +822,7 @@ func (m *TxResult) Reset()         { *m = TxResult{} }
 func (m *TxResult) String() string { return proto.CompactTextString(m) }
 func (*TxResult) ProtoMessage()    {}
 func (*TxResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{5}
+	return fileDescriptor_d21ecc92c8c8583e, []int{9}
 }
 func (m *TxResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -519,7 +863,7 @@ func (m *AccessTuple) Reset()         { *m = AccessTuple{} }
 func (m *AccessTuple) String() string { return proto.CompactTextString(m) }
 func (*AccessTuple) ProtoMessage()    {}
 func (*AccessTuple) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{6}
+	return fileDescriptor_d21ecc92c8c8583e, []int{10}
 }
 func (m *AccessTuple) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -579,7 +923,7 @@ func (m *TraceConfig) Reset()         { *m = TraceConfig{} }
 func (m *TraceConfig) String() string { return proto.CompactTextString(m) }
 func (*TraceConfig) ProtoMessage()    {}
 func (*TraceConfig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d21ecc92c8c8583e, []int{7}
+	return fileDescriptor_d21ecc92c8c8583e, []int{11}
 }
 func (m *TraceConfig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -686,7 +1030,13 @@ func (m *TraceConfig) GetTracerJsonConfig() string {
 }
 
 func init() {
+	proto.RegisterEnum("ethermint.evm.v1.AccessType", AccessType_name, AccessType_value)
+	proto.RegisterEnum("ethermint.evm.v1.AccessControlList", AccessControlList_name, AccessControlList_value)
 	proto.RegisterType((*Params)(nil), "ethermint.evm.v1.Params")
+	proto.RegisterType((*PrecompileConfig)(nil), "ethermint.evm.v1.PrecompileConfig")
+	proto.RegisterType((*MethodGas)(nil), "ethermint.evm.v1.MethodGas")
+	proto.RegisterType((*AccessControlType)(nil), "ethermint.evm.v1.AccessControlType")
+	proto.RegisterType((*AccessControl)(nil), "ethermint.evm.v1.AccessControl")
 	proto.RegisterType((*ChainConfig)(nil), "ethermint.evm.v1.ChainConfig")
 	proto.RegisterType((*State)(nil), "ethermint.evm.v1.State")
 	proto.RegisterType((*TransactionLogs)(nil), "ethermint.evm.v1.TransactionLogs")
@@ -699,110 +1049,141 @@ func init() {
 func init() { proto.RegisterFile("ethermint/evm/v1/evm.proto", fileDescriptor_d21ecc92c8c8583e) }
 
 var fileDescriptor_d21ecc92c8c8583e = []byte{
-	// 1641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0xdd, 0x4e, 0x23, 0xc9,
-	0x15, 0x06, 0xdc, 0x40, 0xbb, 0x6c, 0xec, 0xa6, 0xf0, 0xb0, 0xde, 0x19, 0x85, 0x26, 0x2d, 0x25,
-	0x22, 0xd2, 0x0e, 0x2c, 0xac, 0x50, 0x46, 0xbb, 0x4a, 0x22, 0x3c, 0xc3, 0xee, 0x42, 0x26, 0x1b,
-	0x54, 0xc3, 0x2a, 0x52, 0xa4, 0xa8, 0x55, 0xee, 0xae, 0x6d, 0xf7, 0xd2, 0xdd, 0x65, 0x55, 0x55,
-	0x7b, 0xec, 0x24, 0x0f, 0x10, 0x29, 0x37, 0x79, 0x82, 0x68, 0x1f, 0x23, 0x8f, 0xb0, 0xca, 0xd5,
-	0x5c, 0x46, 0xb9, 0x68, 0x45, 0xcc, 0x1d, 0x97, 0x7e, 0x82, 0xa8, 0x7e, 0xdc, 0xfe, 0x01, 0x25,
-	0x8b, 0x6f, 0xa0, 0xbe, 0x73, 0x4e, 0x7d, 0x5f, 0xd5, 0xa9, 0x53, 0xd4, 0x69, 0xc0, 0x53, 0x22,
-	0x7a, 0x84, 0xa5, 0x71, 0x26, 0x8e, 0xc8, 0x20, 0x3d, 0x1a, 0x1c, 0xcb, 0x5f, 0x87, 0x7d, 0x46,
-	0x05, 0x85, 0x4e, 0xe9, 0x3b, 0x94, 0xc6, 0xc1, 0xf1, 0xd3, 0x56, 0x44, 0x23, 0xaa, 0x9c, 0x47,
-	0x72, 0xa4, 0xe3, 0xbc, 0x7f, 0x54, 0xc0, 0xc6, 0x15, 0x66, 0x38, 0xe5, 0xf0, 0x18, 0x54, 0xc9,
-	0x20, 0xf5, 0x43, 0x92, 0xd1, 0xb4, 0xbd, 0xba, 0xbf, 0x7a, 0x50, 0xed, 0xb4, 0xc6, 0x85, 0xeb,
-	0x8c, 0x70, 0x9a, 0x7c, 0xea, 0x95, 0x2e, 0x0f, 0xd9, 0x64, 0x90, 0xbe, 0x92, 0x43, 0xf8, 0x0b,
-	0xb0, 0x45, 0x32, 0xdc, 0x4d, 0x88, 0x1f, 0x30, 0x82, 0x05, 0x69, 0xaf, 0xed, 0xaf, 0x1e, 0xd8,
-	0x9d, 0xf6, 0xb8, 0x70, 0x5b, 0x66, 0xda, 0xac, 0xdb, 0x43, 0x75, 0x8d, 0x5f, 0x2a, 0x08, 0x7f,
-	0x0e, 0x6a, 0x13, 0x3f, 0x4e, 0x92, 0x76, 0x45, 0x4d, 0xde, 0x1d, 0x17, 0x2e, 0x9c, 0x9f, 0x8c,
-	0x93, 0xc4, 0x43, 0xc0, 0x4c, 0xc5, 0x49, 0x02, 0xcf, 0x00, 0x20, 0x43, 0xc1, 0xb0, 0x4f, 0xe2,
-	0x3e, 0x6f, 0x5b, 0xfb, 0x95, 0x83, 0x4a, 0xc7, 0xbb, 0x2d, 0xdc, 0xea, 0xb9, 0xb4, 0x9e, 0x5f,
-	0x5c, 0xf1, 0x71, 0xe1, 0x6e, 0x1b, 0x92, 0x32, 0xd0, 0x43, 0x55, 0x05, 0xce, 0xe3, 0x3e, 0x87,
-	0x7f, 0x00, 0xf5, 0xa0, 0x87, 0xe3, 0xcc, 0x0f, 0x68, 0xf6, 0x4d, 0x1c, 0xb5, 0xd7, 0xf7, 0x57,
-	0x0f, 0x6a, 0x27, 0x3f, 0x3a, 0x5c, 0xcc, 0xdb, 0xe1, 0x4b, 0x19, 0xf5, 0x52, 0x05, 0x75, 0x9e,
-	0x7d, 0x5f, 0xb8, 0x2b, 0xe3, 0xc2, 0xdd, 0xd1, 0xd4, 0xb3, 0x04, 0x1e, 0xaa, 0x05, 0xd3, 0x48,
-	0x78, 0x02, 0x9e, 0xe0, 0x24, 0xa1, 0x6f, 0xfd, 0x3c, 0x93, 0x89, 0x26, 0x81, 0x20, 0xa1, 0x2f,
-	0x86, 0xbc, 0xbd, 0x21, 0x37, 0x89, 0x76, 0x94, 0xf3, 0xeb, 0xa9, 0xef, 0x7a, 0xc8, 0xe1, 0x73,
-	0x00, 0x71, 0x20, 0xe2, 0x01, 0xf1, 0xfb, 0x8c, 0x04, 0x34, 0xed, 0xc7, 0x09, 0xe1, 0xed, 0xcd,
-	0xfd, 0xca, 0x41, 0x15, 0x6d, 0x6b, 0xcf, 0xd5, 0xd4, 0xe1, 0xfd, 0x7d, 0x1b, 0xd4, 0x66, 0x16,
-	0x07, 0x53, 0xd0, 0xec, 0xd1, 0x94, 0x70, 0x41, 0x70, 0xe8, 0x77, 0x13, 0x1a, 0xdc, 0x98, 0x53,
-	0x7c, 0xf5, 0xef, 0xc2, 0xfd, 0x69, 0x14, 0x8b, 0x5e, 0xde, 0x3d, 0x0c, 0x68, 0x7a, 0x14, 0x50,
-	0x9e, 0x52, 0x6e, 0x7e, 0x3d, 0xe7, 0xe1, 0xcd, 0x91, 0x18, 0xf5, 0x09, 0x3f, 0xbc, 0xc8, 0xc4,
-	0xb8, 0x70, 0x77, 0xf5, 0xde, 0x16, 0xa8, 0x3c, 0xd4, 0x28, 0x2d, 0x1d, 0x69, 0x80, 0x23, 0xd0,
-	0x08, 0x31, 0xf5, 0xbf, 0xa1, 0xec, 0xc6, 0xa8, 0xad, 0x29, 0xb5, 0x37, 0x3f, 0x5c, 0xed, 0xb6,
-	0x70, 0xeb, 0xaf, 0xce, 0x7e, 0xfb, 0x39, 0x65, 0x37, 0x8a, 0x73, 0x5c, 0xb8, 0x4f, 0xb4, 0xfa,
-	0x3c, 0xb3, 0x87, 0xea, 0x21, 0xa6, 0x65, 0x18, 0xfc, 0x1d, 0x70, 0xca, 0x00, 0x9e, 0xf7, 0xfb,
-	0x94, 0x09, 0x53, 0x3c, 0xcf, 0x6f, 0x0b, 0xb7, 0x61, 0x28, 0xdf, 0x68, 0xcf, 0xb8, 0x70, 0x3f,
-	0x58, 0x20, 0x35, 0x73, 0x3c, 0xd4, 0x30, 0xb4, 0x26, 0x14, 0x72, 0x50, 0x27, 0x71, 0xff, 0xf8,
-	0xf4, 0x63, 0xb3, 0x23, 0x4b, 0xed, 0xe8, 0xea, 0x51, 0x3b, 0xaa, 0x9d, 0x5f, 0x5c, 0x1d, 0x9f,
-	0x7e, 0x3c, 0xd9, 0x90, 0x29, 0x95, 0x59, 0x5a, 0x0f, 0xd5, 0x34, 0xd4, 0xbb, 0xb9, 0x00, 0x06,
-	0xfa, 0x3d, 0xcc, 0x7b, 0xaa, 0x10, 0xab, 0x9d, 0x83, 0xdb, 0xc2, 0x05, 0x9a, 0xe9, 0x4b, 0xcc,
-	0x7b, 0xd3, 0x73, 0xe9, 0x8e, 0xfe, 0x88, 0x33, 0x11, 0xe7, 0xe9, 0x84, 0x0b, 0xe8, 0xc9, 0x32,
-	0xaa, 0x5c, 0xff, 0xa9, 0x59, 0xff, 0xc6, 0xd2, 0xeb, 0x3f, 0x7d, 0x68, 0xfd, 0xa7, 0xf3, 0xeb,
-	0xd7, 0x31, 0xa5, 0xe8, 0x0b, 0x23, 0xba, 0xb9, 0xb4, 0xe8, 0x8b, 0x87, 0x44, 0x5f, 0xcc, 0x8b,
-	0xea, 0x18, 0x59, 0xec, 0x0b, 0x99, 0x68, 0xdb, 0xcb, 0x17, 0xfb, 0xbd, 0xa4, 0x36, 0x4a, 0x8b,
-	0x96, 0xfb, 0x33, 0x68, 0x05, 0x34, 0xe3, 0x42, 0xda, 0x32, 0xda, 0x4f, 0x88, 0xd1, 0xac, 0x2a,
-	0xcd, 0x8b, 0x47, 0x69, 0x3e, 0x33, 0x7f, 0x3c, 0x1e, 0xe0, 0xf3, 0xd0, 0xce, 0xbc, 0x59, 0xab,
-	0xf7, 0x81, 0xd3, 0x27, 0x82, 0x30, 0xde, 0xcd, 0x59, 0x64, 0x94, 0x81, 0x52, 0x3e, 0x7f, 0x94,
-	0xb2, 0xb9, 0x07, 0x8b, 0x5c, 0x1e, 0x6a, 0x4e, 0x4d, 0x5a, 0xf1, 0x5b, 0xd0, 0x88, 0xe5, 0x32,
-	0xba, 0x79, 0x62, 0xf4, 0x6a, 0x4a, 0xef, 0xe5, 0xa3, 0xf4, 0xcc, 0x65, 0x9e, 0x67, 0xf2, 0xd0,
-	0xd6, 0xc4, 0xa0, 0xb5, 0x72, 0x00, 0xd3, 0x3c, 0x66, 0x7e, 0x94, 0xe0, 0x20, 0x26, 0xcc, 0xe8,
-	0xd5, 0x95, 0xde, 0x17, 0x8f, 0xd2, 0xfb, 0x50, 0xeb, 0xdd, 0x67, 0xf3, 0x90, 0x23, 0x8d, 0x5f,
-	0x68, 0x9b, 0x96, 0x0d, 0x41, 0xbd, 0x4b, 0x58, 0x12, 0x67, 0x46, 0x70, 0x4b, 0x09, 0x9e, 0x3d,
-	0x4a, 0xd0, 0xd4, 0xe9, 0x2c, 0x8f, 0x87, 0x6a, 0x1a, 0x96, 0x2a, 0x09, 0xcd, 0x42, 0x3a, 0x51,
-	0xd9, 0x5e, 0x5e, 0x65, 0x96, 0xc7, 0x43, 0x35, 0x0d, 0xb5, 0xca, 0x10, 0xec, 0x60, 0xc6, 0xe8,
-	0xdb, 0x85, 0x1c, 0x42, 0x25, 0xf6, 0xe5, 0xa3, 0xc4, 0x9e, 0x6a, 0xb1, 0x07, 0xe8, 0x3c, 0xb4,
-	0xad, 0xac, 0x73, 0x59, 0xcc, 0x01, 0x8c, 0x18, 0x1e, 0x2d, 0x08, 0xb7, 0x96, 0x3f, 0xbc, 0xfb,
-	0x6c, 0x1e, 0x72, 0xa4, 0x71, 0x4e, 0xf6, 0x4f, 0xa0, 0x95, 0x12, 0x16, 0x11, 0x3f, 0x23, 0x82,
-	0xf7, 0x93, 0x58, 0x18, 0xe1, 0x27, 0xcb, 0xdf, 0xc7, 0x87, 0xf8, 0x3c, 0x04, 0x95, 0xf9, 0x2b,
-	0x63, 0x2d, 0x2f, 0x07, 0xef, 0xe1, 0x2c, 0xea, 0xe1, 0xd8, 0xc8, 0xee, 0x2e, 0x7f, 0x39, 0xe6,
-	0x99, 0x3c, 0xb4, 0x35, 0x31, 0x94, 0xf5, 0x13, 0xe0, 0x2c, 0xc8, 0x27, 0xf5, 0xf3, 0xc1, 0xf2,
-	0xf5, 0x33, 0xcb, 0x23, 0xbb, 0x15, 0x05, 0x95, 0xca, 0xa5, 0x65, 0x37, 0x9c, 0xe6, 0xa5, 0x65,
-	0x37, 0x1d, 0xe7, 0xd2, 0xb2, 0x1d, 0x67, 0xfb, 0xd2, 0xb2, 0x77, 0x9c, 0x16, 0xda, 0x1a, 0xd1,
-	0x84, 0xfa, 0x83, 0x4f, 0xf4, 0x24, 0x54, 0x23, 0x6f, 0x31, 0x37, 0x7f, 0x23, 0x51, 0x23, 0xc0,
-	0x02, 0x27, 0x23, 0x6e, 0x52, 0x85, 0x1c, 0x9d, 0xc0, 0x99, 0x57, 0xfb, 0x08, 0xac, 0xbf, 0x11,
-	0xb2, 0xcf, 0x73, 0x40, 0xe5, 0x86, 0x8c, 0x74, 0x37, 0x82, 0xe4, 0x10, 0xb6, 0xc0, 0xfa, 0x00,
-	0x27, 0xb9, 0x6e, 0x18, 0xab, 0x48, 0x03, 0xef, 0x0a, 0x34, 0xaf, 0x19, 0xce, 0xb8, 0xec, 0x75,
-	0x68, 0xf6, 0x9a, 0x46, 0x1c, 0x42, 0x60, 0xa9, 0x57, 0x51, 0xcf, 0x55, 0x63, 0xf8, 0x33, 0x60,
-	0x25, 0x34, 0xe2, 0xed, 0xb5, 0xfd, 0xca, 0x41, 0xed, 0xe4, 0xc9, 0xfd, 0x96, 0xed, 0x35, 0x8d,
-	0x90, 0x0a, 0xf1, 0xfe, 0xb9, 0x06, 0x2a, 0xaf, 0x69, 0x04, 0xdb, 0x60, 0x13, 0x87, 0x21, 0x23,
-	0x9c, 0x1b, 0xa6, 0x09, 0x84, 0xbb, 0x60, 0x43, 0xd0, 0x7e, 0x1c, 0x68, 0xba, 0x2a, 0x32, 0x48,
-	0x0a, 0x87, 0x58, 0x60, 0xd5, 0x57, 0xd4, 0x91, 0x1a, 0xc3, 0x13, 0x50, 0x57, 0x3b, 0xf3, 0xb3,
-	0x3c, 0xed, 0x12, 0xa6, 0xda, 0x03, 0xab, 0xd3, 0xbc, 0x2b, 0xdc, 0x9a, 0xb2, 0x7f, 0xa5, 0xcc,
-	0x68, 0x16, 0xc0, 0x8f, 0xc0, 0xa6, 0x18, 0xce, 0xbe, 0xec, 0x3b, 0x77, 0x85, 0xdb, 0x14, 0xd3,
-	0x6d, 0xca, 0x87, 0x1b, 0x6d, 0x88, 0xa1, 0x7a, 0xc0, 0x8f, 0x80, 0x2d, 0x86, 0x7e, 0x9c, 0x85,
-	0x64, 0xa8, 0x1e, 0x6f, 0xab, 0xd3, 0xba, 0x2b, 0x5c, 0x67, 0x26, 0xfc, 0x42, 0xfa, 0xd0, 0xa6,
-	0x18, 0xaa, 0x01, 0xfc, 0x08, 0x00, 0xbd, 0x24, 0xa5, 0xa0, 0x9f, 0xde, 0xad, 0xbb, 0xc2, 0xad,
-	0x2a, 0xab, 0xe2, 0x9e, 0x0e, 0xa1, 0x07, 0xd6, 0x35, 0xb7, 0xad, 0xb8, 0xeb, 0x77, 0x85, 0x6b,
-	0x27, 0x34, 0xd2, 0x9c, 0xda, 0x25, 0x53, 0xc5, 0x48, 0x4a, 0x07, 0x24, 0x54, 0xaf, 0x9b, 0x8d,
-	0x26, 0xd0, 0xfb, 0xeb, 0x1a, 0xb0, 0xaf, 0x87, 0x88, 0xf0, 0x3c, 0x11, 0xf0, 0x73, 0xe0, 0x04,
-	0x34, 0x13, 0x0c, 0x07, 0xc2, 0x9f, 0x4b, 0x6d, 0xe7, 0xd9, 0xf4, 0xa5, 0x59, 0x8c, 0xf0, 0x50,
-	0x73, 0x62, 0x3a, 0x33, 0xf9, 0x6f, 0x81, 0xf5, 0x6e, 0x42, 0x69, 0xaa, 0x2a, 0xa1, 0x8e, 0x34,
-	0x80, 0x48, 0x65, 0x4d, 0x9d, 0x72, 0x45, 0x35, 0xe6, 0x3f, 0xbe, 0x7f, 0xca, 0x0b, 0xa5, 0xd2,
-	0xd9, 0x35, 0xcd, 0x79, 0x43, 0x6b, 0x9b, 0xf9, 0x9e, 0xcc, 0xad, 0x2a, 0x25, 0x07, 0x54, 0x18,
-	0x11, 0xea, 0xd0, 0xea, 0x48, 0x0e, 0xe1, 0x53, 0x60, 0x33, 0x32, 0x20, 0x4c, 0x90, 0x50, 0x1d,
-	0x8e, 0x8d, 0x4a, 0x0c, 0x3f, 0x04, 0x76, 0x84, 0xb9, 0x9f, 0x73, 0x12, 0xea, 0x93, 0x40, 0x9b,
-	0x11, 0xe6, 0x5f, 0x73, 0x12, 0x7e, 0x6a, 0xfd, 0xe5, 0x3b, 0x77, 0xc5, 0xc3, 0xa0, 0x76, 0x16,
-	0x04, 0x84, 0xf3, 0xeb, 0xbc, 0x9f, 0x90, 0xff, 0x51, 0x61, 0x27, 0xa0, 0xce, 0x05, 0x65, 0x38,
-	0x22, 0xfe, 0x0d, 0x19, 0x99, 0x3a, 0xd3, 0x55, 0x63, 0xec, 0xbf, 0x26, 0x23, 0x8e, 0x66, 0x81,
-	0x91, 0xf8, 0xce, 0x02, 0xb5, 0x6b, 0x86, 0x03, 0x62, 0x3a, 0x7c, 0x59, 0xab, 0x12, 0x32, 0x23,
-	0x61, 0x90, 0xd4, 0x16, 0x71, 0x4a, 0x68, 0x2e, 0xcc, 0x7d, 0x9a, 0x40, 0x39, 0x83, 0x11, 0x32,
-	0x24, 0x81, 0x4a, 0xa3, 0x85, 0x0c, 0x82, 0xa7, 0x60, 0x2b, 0x8c, 0xb9, 0xfa, 0xba, 0xe2, 0x02,
-	0x07, 0x37, 0x7a, 0xfb, 0x1d, 0xe7, 0xae, 0x70, 0xeb, 0xc6, 0xf1, 0x46, 0xda, 0xd1, 0x1c, 0x82,
-	0x9f, 0x81, 0xe6, 0x74, 0x9a, 0x5a, 0xad, 0xfe, 0x9e, 0xe9, 0xc0, 0xbb, 0xc2, 0x6d, 0x94, 0xa1,
-	0xca, 0x83, 0x16, 0xb0, 0x3c, 0xe9, 0x90, 0x74, 0xf3, 0x48, 0x15, 0x9f, 0x8d, 0x34, 0x90, 0xd6,
-	0x24, 0x4e, 0x63, 0xa1, 0x8a, 0x6d, 0x1d, 0x69, 0x00, 0x3f, 0x03, 0x55, 0x3a, 0x20, 0x8c, 0xc5,
-	0x21, 0xe1, 0xaa, 0xd5, 0xf9, 0x7f, 0x9f, 0x66, 0x68, 0x1a, 0x2f, 0x37, 0x67, 0xbe, 0x1c, 0x53,
-	0x92, 0x52, 0x36, 0x52, 0xbd, 0x8b, 0xd9, 0x9c, 0x76, 0xfc, 0x46, 0xd9, 0xd1, 0x1c, 0x82, 0x1d,
-	0x00, 0xcd, 0x34, 0x46, 0x44, 0xce, 0x32, 0x5f, 0xdd, 0xff, 0xba, 0x9a, 0xab, 0x6e, 0xa1, 0xf6,
-	0x22, 0xe5, 0x7c, 0x85, 0x05, 0x46, 0xf7, 0x2c, 0xf0, 0x97, 0x00, 0xea, 0x33, 0xf1, 0xbf, 0xe5,
-	0xb4, 0xfc, 0xb6, 0xd4, 0xad, 0x85, 0xd2, 0xd7, 0x5e, 0xb3, 0x66, 0x47, 0xa3, 0x4b, 0x4e, 0xcd,
-	0x2e, 0x2e, 0x2d, 0xdb, 0x72, 0xd6, 0x2f, 0x2d, 0x7b, 0xd3, 0xb1, 0xcb, 0xfc, 0x99, 0x5d, 0xa0,
-	0x9d, 0x09, 0x9e, 0x59, 0x5e, 0xe7, 0x57, 0xdf, 0xdf, 0xee, 0xad, 0xbe, 0xbb, 0xdd, 0x5b, 0xfd,
-	0xcf, 0xed, 0xde, 0xea, 0xdf, 0xde, 0xef, 0xad, 0xbc, 0x7b, 0xbf, 0xb7, 0xf2, 0xaf, 0xf7, 0x7b,
-	0x2b, 0xbf, 0xff, 0xc9, 0xcc, 0xfb, 0xc0, 0x7b, 0x71, 0x48, 0xcd, 0xcf, 0xc1, 0xc9, 0xd1, 0x50,
-	0xfd, 0xbf, 0x40, 0x3d, 0x11, 0xdd, 0x0d, 0xf5, 0x7f, 0x80, 0x4f, 0xfe, 0x1b, 0x00, 0x00, 0xff,
-	0xff, 0x02, 0x42, 0x88, 0xc7, 0x4d, 0x10, 0x00, 0x00,
+	// 2133 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x58, 0x4d, 0x6f, 0x1b, 0xb9,
+	0x19, 0xb6, 0x2c, 0xd9, 0x96, 0x28, 0x59, 0x1e, 0xd3, 0x8e, 0xa3, 0x28, 0x59, 0x8f, 0x3a, 0x5d,
+	0x14, 0xce, 0x36, 0xb1, 0x37, 0x5e, 0x18, 0x0d, 0x76, 0xbb, 0x6d, 0x2d, 0x5b, 0x49, 0xe4, 0x2a,
+	0xb6, 0x41, 0x39, 0x08, 0x52, 0xa0, 0x18, 0x50, 0x33, 0x8c, 0x34, 0xeb, 0x99, 0xa1, 0x30, 0xa4,
+	0x14, 0xa9, 0xed, 0x0f, 0x08, 0xdc, 0x4b, 0xff, 0x80, 0x8b, 0x05, 0x7a, 0xee, 0xa5, 0xf7, 0x5e,
+	0x8b, 0x45, 0x4f, 0x7b, 0x2c, 0x7a, 0x50, 0x0b, 0xe7, 0xe6, 0xa3, 0x7f, 0x41, 0xc1, 0x0f, 0x7d,
+	0xbb, 0xbb, 0x6b, 0x9f, 0xc4, 0xf7, 0xeb, 0x79, 0xf8, 0xbe, 0x7c, 0x29, 0x92, 0x03, 0xf2, 0x84,
+	0x37, 0x48, 0x14, 0x78, 0x21, 0xdf, 0x22, 0xed, 0x60, 0xab, 0xfd, 0x44, 0xfc, 0x6c, 0x36, 0x23,
+	0xca, 0x29, 0x34, 0x06, 0xb6, 0x4d, 0xa1, 0x6c, 0x3f, 0xc9, 0xaf, 0xd6, 0x69, 0x9d, 0x4a, 0xe3,
+	0x96, 0x18, 0x29, 0x3f, 0xeb, 0x3f, 0x09, 0x30, 0x7f, 0x8c, 0x23, 0x1c, 0x30, 0xf8, 0x04, 0xa4,
+	0x48, 0x3b, 0xb0, 0x5d, 0x12, 0xd2, 0x20, 0x17, 0x2b, 0xc4, 0x36, 0x52, 0xc5, 0xd5, 0xab, 0x9e,
+	0x69, 0x74, 0x71, 0xe0, 0x7f, 0x6e, 0x0d, 0x4c, 0x16, 0x4a, 0x92, 0x76, 0xb0, 0x2f, 0x86, 0xf0,
+	0x4b, 0xb0, 0x48, 0x42, 0x5c, 0xf3, 0x89, 0xed, 0x44, 0x04, 0x73, 0x92, 0x9b, 0x2d, 0xc4, 0x36,
+	0x92, 0xc5, 0xdc, 0x55, 0xcf, 0x5c, 0xd5, 0x61, 0xa3, 0x66, 0x0b, 0x65, 0x94, 0xbc, 0x27, 0x45,
+	0xf8, 0x33, 0x90, 0xee, 0xdb, 0xb1, 0xef, 0xe7, 0xe2, 0x32, 0x78, 0xed, 0xaa, 0x67, 0xc2, 0xf1,
+	0x60, 0xec, 0xfb, 0x16, 0x02, 0x3a, 0x14, 0xfb, 0x3e, 0xdc, 0x05, 0x80, 0x74, 0x78, 0x84, 0x6d,
+	0xe2, 0x35, 0x59, 0x2e, 0x51, 0x88, 0x6f, 0xc4, 0x8b, 0xd6, 0x45, 0xcf, 0x4c, 0x95, 0x84, 0xb6,
+	0x54, 0x3e, 0x66, 0x57, 0x3d, 0x73, 0x59, 0x83, 0x0c, 0x1c, 0x2d, 0x94, 0x92, 0x42, 0xc9, 0x6b,
+	0x32, 0xf8, 0x5b, 0x90, 0x71, 0x1a, 0xd8, 0x0b, 0x6d, 0x87, 0x86, 0x6f, 0xbd, 0x7a, 0x6e, 0xae,
+	0x10, 0xdb, 0x48, 0x6f, 0x7f, 0xb4, 0x39, 0x59, 0xb7, 0xcd, 0x3d, 0xe1, 0xb5, 0x27, 0x9d, 0x8a,
+	0xf7, 0xbf, 0xe9, 0x99, 0x33, 0x57, 0x3d, 0x73, 0x45, 0x41, 0x8f, 0x02, 0x58, 0x28, 0xed, 0x0c,
+	0x3d, 0xe1, 0x36, 0xb8, 0x83, 0x7d, 0x9f, 0xbe, 0xb3, 0x5b, 0xa1, 0x28, 0x34, 0x71, 0x38, 0x71,
+	0x6d, 0xde, 0x61, 0xb9, 0x79, 0x91, 0x24, 0x5a, 0x91, 0xc6, 0x57, 0x43, 0xdb, 0x49, 0x87, 0xc1,
+	0xc7, 0x00, 0x62, 0x87, 0x7b, 0x6d, 0x62, 0x37, 0x23, 0xe2, 0xd0, 0xa0, 0xe9, 0xf9, 0x84, 0xe5,
+	0x16, 0x0a, 0xf1, 0x8d, 0x14, 0x5a, 0x56, 0x96, 0xe3, 0xa1, 0x01, 0x56, 0x40, 0x16, 0x3b, 0x0e,
+	0x61, 0x4c, 0xcc, 0x80, 0x47, 0xd4, 0xcf, 0x25, 0x65, 0x0e, 0xe6, 0x74, 0x0e, 0xbb, 0xd2, 0x6f,
+	0x4f, 0xb9, 0x15, 0x13, 0x22, 0x0b, 0xb4, 0x88, 0x47, 0x95, 0xf0, 0x35, 0x80, 0x43, 0x56, 0x9d,
+	0x13, 0xcb, 0xa5, 0x0a, 0xf1, 0x8d, 0xf4, 0xb6, 0x35, 0x8d, 0x38, 0x9c, 0x88, 0x2e, 0x8d, 0x02,
+	0x5d, 0x6e, 0x4e, 0xe8, 0x99, 0xf5, 0x8f, 0x18, 0x30, 0x26, 0xbd, 0x61, 0x0e, 0x2c, 0x60, 0xd7,
+	0x8d, 0x08, 0x63, 0xaa, 0xd3, 0x50, 0x5f, 0x84, 0x3f, 0x05, 0x2a, 0x55, 0xcc, 0x3d, 0x1a, 0xda,
+	0x0d, 0xe2, 0xd5, 0x1b, 0x5c, 0xb6, 0x55, 0x1c, 0x19, 0x43, 0xc3, 0x0b, 0xa9, 0x87, 0x0f, 0x81,
+	0xe1, 0x7a, 0x4c, 0xb4, 0x85, 0x6b, 0x07, 0x84, 0x37, 0xa8, 0xcb, 0x72, 0x71, 0x59, 0xaf, 0xa5,
+	0xbe, 0xfe, 0xa5, 0x52, 0xc3, 0x5f, 0x01, 0xa0, 0x3c, 0xec, 0x3a, 0x56, 0x2d, 0x93, 0xde, 0xbe,
+	0x3f, 0x9d, 0x97, 0x72, 0x7f, 0x8e, 0x99, 0x4e, 0x28, 0x15, 0xf4, 0x15, 0xd6, 0x0e, 0x48, 0x0d,
+	0xac, 0x70, 0x0d, 0xcc, 0x2b, 0x8b, 0x9e, 0xbf, 0x96, 0xa0, 0x01, 0xe2, 0x02, 0x5f, 0x4c, 0x38,
+	0x81, 0xc4, 0xd0, 0x7a, 0x1f, 0x03, 0xcb, 0x63, 0xf5, 0x3f, 0xe9, 0x36, 0x09, 0x7c, 0x05, 0xd2,
+	0x7a, 0xf1, 0x78, 0xb7, 0x49, 0x24, 0x48, 0x76, 0xfb, 0xc1, 0xff, 0x5b, 0x39, 0x11, 0x32, 0xba,
+	0x31, 0x46, 0x42, 0x2d, 0x04, 0xf0, 0xc0, 0x07, 0x3e, 0x00, 0x29, 0xd9, 0x59, 0xbe, 0xc7, 0x44,
+	0xd5, 0x44, 0x25, 0x86, 0x0a, 0xeb, 0xaf, 0x31, 0xb0, 0x38, 0x36, 0x15, 0xb8, 0x0b, 0xe6, 0xf5,
+	0xce, 0x8d, 0xc9, 0xde, 0xf9, 0xf1, 0xf7, 0xf4, 0x8e, 0x9c, 0x88, 0xaa, 0x8c, 0x0e, 0x84, 0x5f,
+	0x82, 0x84, 0xdc, 0xbd, 0xb3, 0x37, 0x05, 0x90, 0x61, 0x30, 0x0f, 0x92, 0x2e, 0x09, 0xbb, 0x72,
+	0xc2, 0x6a, 0xe9, 0x06, 0xb2, 0xf5, 0xe7, 0x65, 0x90, 0x1e, 0xd9, 0x7e, 0x30, 0x00, 0x4b, 0x0d,
+	0x1a, 0x10, 0xc6, 0x09, 0x76, 0xed, 0x9a, 0x4f, 0x9d, 0x53, 0xfd, 0x3f, 0xb5, 0xff, 0xef, 0x9e,
+	0xf9, 0x93, 0xba, 0xc7, 0x1b, 0xad, 0xda, 0xa6, 0x43, 0x83, 0x2d, 0x87, 0xb2, 0x80, 0x32, 0xfd,
+	0xf3, 0x98, 0xb9, 0xa7, 0x5b, 0xa2, 0x54, 0x6c, 0xb3, 0x1c, 0xf2, 0xab, 0x9e, 0xb9, 0xa6, 0x8a,
+	0x38, 0x01, 0x65, 0xa1, 0xec, 0x40, 0x53, 0x14, 0x0a, 0xd8, 0x05, 0x59, 0x17, 0x53, 0xfb, 0x2d,
+	0x8d, 0x4e, 0x35, 0xdb, 0xac, 0x64, 0xab, 0xfe, 0x70, 0xb6, 0x8b, 0x9e, 0x99, 0xd9, 0xdf, 0x3d,
+	0x7a, 0x46, 0xa3, 0x53, 0x89, 0x79, 0xd5, 0x33, 0xef, 0x28, 0xf6, 0x71, 0x64, 0x0b, 0x65, 0x5c,
+	0x4c, 0x07, 0x6e, 0xf0, 0x35, 0x30, 0x06, 0x0e, 0xac, 0xd5, 0x6c, 0xd2, 0x88, 0xeb, 0xbf, 0xc7,
+	0xc7, 0x17, 0x3d, 0x33, 0xab, 0x21, 0xab, 0xca, 0x72, 0xd5, 0x33, 0xef, 0x4e, 0x80, 0xea, 0x18,
+	0x0b, 0x65, 0x35, 0xac, 0x76, 0x85, 0x0c, 0x64, 0x88, 0xd7, 0x7c, 0xb2, 0xf3, 0xa9, 0xce, 0x28,
+	0x21, 0x33, 0x3a, 0xbe, 0x51, 0x46, 0xe9, 0x52, 0xf9, 0xf8, 0xc9, 0xce, 0xa7, 0xfd, 0x84, 0xf4,
+	0x9f, 0xe1, 0x28, 0xac, 0x85, 0xd2, 0x4a, 0x54, 0xd9, 0x94, 0x81, 0x16, 0xed, 0x06, 0x66, 0x0d,
+	0xf9, 0x57, 0x9b, 0x2a, 0x6e, 0x5c, 0xf4, 0x4c, 0xa0, 0x90, 0x5e, 0x60, 0xd6, 0x18, 0xae, 0x4b,
+	0xad, 0xfb, 0x3b, 0x1c, 0x72, 0xaf, 0x15, 0xf4, 0xb1, 0x80, 0x0a, 0x16, 0x5e, 0x83, 0xf9, 0xef,
+	0xe8, 0xf9, 0xcf, 0xdf, 0x7a, 0xfe, 0x3b, 0xd7, 0xcd, 0x7f, 0x67, 0x7c, 0xfe, 0xca, 0x67, 0x40,
+	0xfa, 0x54, 0x93, 0x2e, 0xdc, 0x9a, 0xf4, 0xe9, 0x75, 0xa4, 0x4f, 0xc7, 0x49, 0x95, 0x8f, 0x68,
+	0xf6, 0x89, 0x4a, 0xe4, 0x92, 0xb7, 0x6f, 0xf6, 0xa9, 0xa2, 0x66, 0x07, 0x1a, 0x45, 0xf7, 0x07,
+	0xb0, 0xea, 0xd0, 0x90, 0x71, 0xa1, 0x0b, 0x69, 0xd3, 0x27, 0x9a, 0x33, 0x25, 0x39, 0xcb, 0x37,
+	0xe2, 0xbc, 0xaf, 0x8f, 0xc7, 0x6b, 0xf0, 0x2c, 0xb4, 0x32, 0xae, 0x56, 0xec, 0x4d, 0x60, 0x34,
+	0x09, 0x27, 0x11, 0xab, 0xb5, 0xa2, 0xba, 0x66, 0x06, 0x92, 0xb9, 0x74, 0x23, 0x66, 0xbd, 0x0f,
+	0x26, 0xb1, 0x2c, 0xb4, 0x34, 0x54, 0x29, 0xc6, 0xaf, 0x40, 0xd6, 0x13, 0xd3, 0xa8, 0xb5, 0x7c,
+	0xcd, 0x97, 0x96, 0x7c, 0x7b, 0x37, 0xe2, 0xd3, 0x9b, 0x79, 0x1c, 0xc9, 0x42, 0x8b, 0x7d, 0x85,
+	0xe2, 0x6a, 0x01, 0x18, 0xb4, 0xbc, 0xc8, 0xae, 0xfb, 0xd8, 0xf1, 0x48, 0xa4, 0xf9, 0x32, 0x92,
+	0xef, 0xf9, 0x8d, 0xf8, 0xee, 0x29, 0xbe, 0x69, 0x34, 0x0b, 0x19, 0x42, 0xf9, 0x5c, 0xe9, 0x14,
+	0xad, 0x0b, 0x32, 0x35, 0x12, 0xf9, 0x5e, 0xa8, 0x09, 0x17, 0x25, 0xe1, 0xee, 0x8d, 0x08, 0x75,
+	0x9f, 0x8e, 0xe2, 0x58, 0x28, 0xad, 0xc4, 0x01, 0x8b, 0x4f, 0x43, 0x97, 0xf6, 0x59, 0x96, 0x6f,
+	0xcf, 0x32, 0x8a, 0x63, 0xa1, 0xb4, 0x12, 0x15, 0x4b, 0x07, 0xac, 0xe0, 0x28, 0xa2, 0xef, 0x26,
+	0x6a, 0x08, 0x25, 0xd9, 0x8b, 0x1b, 0x91, 0xe5, 0xf5, 0x19, 0x3a, 0x0d, 0x67, 0xa1, 0x65, 0xa9,
+	0x1d, 0xab, 0x62, 0x0b, 0xc0, 0x7a, 0x84, 0xbb, 0x13, 0xc4, 0xab, 0xb7, 0x5f, 0xbc, 0x69, 0x34,
+	0x0b, 0x19, 0x42, 0x39, 0x46, 0xfb, 0x7b, 0xb0, 0x1a, 0x90, 0xa8, 0x4e, 0xec, 0x90, 0x70, 0xd6,
+	0xf4, 0x3d, 0xae, 0x89, 0xef, 0xdc, 0x7e, 0x3f, 0x5e, 0x87, 0x67, 0x21, 0x28, 0xd5, 0x87, 0x5a,
+	0x3b, 0xd8, 0x1c, 0xac, 0x81, 0xc3, 0x7a, 0x03, 0x7b, 0x9a, 0x76, 0xed, 0xf6, 0x9b, 0x63, 0x1c,
+	0xc9, 0x42, 0x8b, 0x7d, 0xc5, 0xa0, 0x7f, 0x1c, 0x1c, 0x3a, 0xad, 0x7e, 0xff, 0xdc, 0xbd, 0x7d,
+	0xff, 0x8c, 0xe2, 0x88, 0xfb, 0xb8, 0x14, 0x25, 0xcb, 0x41, 0x22, 0x99, 0x35, 0x96, 0x0e, 0x12,
+	0xc9, 0x25, 0xc3, 0x38, 0x48, 0x24, 0x0d, 0x63, 0xf9, 0x20, 0x91, 0x5c, 0x31, 0x56, 0xd1, 0x62,
+	0x97, 0xfa, 0xd4, 0x6e, 0x7f, 0xa6, 0x82, 0x50, 0x9a, 0xbc, 0xc3, 0x4c, 0xff, 0x47, 0xa2, 0xac,
+	0x83, 0x39, 0xf6, 0xbb, 0x4c, 0x97, 0x0a, 0x19, 0xaa, 0x80, 0x23, 0xa7, 0xf6, 0x16, 0x98, 0xab,
+	0x72, 0x71, 0x09, 0x32, 0x40, 0xfc, 0x94, 0x74, 0xf5, 0x5d, 0x50, 0x0c, 0xe1, 0x2a, 0x98, 0x6b,
+	0x63, 0xbf, 0xa5, 0x9e, 0x44, 0x29, 0xa4, 0x04, 0xeb, 0x18, 0x2c, 0x9d, 0x44, 0x38, 0x64, 0xe2,
+	0x26, 0x4b, 0xc3, 0x0a, 0xad, 0x33, 0x08, 0x41, 0x42, 0x9e, 0x8a, 0x2a, 0x56, 0x8e, 0xe1, 0x43,
+	0x90, 0xf0, 0x69, 0x9d, 0xc9, 0x1b, 0x5c, 0x7a, 0xfb, 0xce, 0xf4, 0x9d, 0xaa, 0x42, 0xeb, 0x48,
+	0xba, 0x58, 0xff, 0x9c, 0x05, 0xf1, 0x0a, 0xfd, 0xae, 0x1b, 0xf5, 0x1a, 0x98, 0xe7, 0xb4, 0xe9,
+	0x39, 0x4c, 0x5f, 0x08, 0xb5, 0x24, 0x88, 0x5d, 0xcc, 0xb1, 0xbc, 0x57, 0x64, 0x90, 0x1c, 0xc3,
+	0x6d, 0x90, 0x91, 0x99, 0xd9, 0x61, 0x2b, 0xa8, 0x91, 0x48, 0x5e, 0x0f, 0x12, 0xc5, 0xa5, 0xcb,
+	0x9e, 0x99, 0x96, 0xfa, 0x43, 0xa9, 0x46, 0xa3, 0x02, 0x7c, 0x04, 0x16, 0x78, 0x67, 0xf4, 0x64,
+	0x5f, 0xb9, 0xec, 0x99, 0x4b, 0x7c, 0x98, 0xa6, 0x38, 0xb8, 0xd1, 0x3c, 0xef, 0x88, 0x5f, 0xb8,
+	0x05, 0x92, 0xbc, 0x63, 0x7b, 0xa1, 0x4b, 0x3a, 0xf2, 0xf0, 0x4e, 0x14, 0x57, 0x2f, 0x7b, 0xa6,
+	0x31, 0xe2, 0x5e, 0x16, 0x36, 0xb4, 0xc0, 0x3b, 0x72, 0x00, 0x1f, 0x01, 0xa0, 0xa6, 0x24, 0x19,
+	0xd4, 0xd1, 0xbb, 0x78, 0xd9, 0x33, 0x53, 0x52, 0x2b, 0xb1, 0x87, 0x43, 0x68, 0x81, 0x39, 0x85,
+	0x9d, 0x94, 0xd8, 0x99, 0xcb, 0x9e, 0x99, 0xf4, 0x69, 0x5d, 0x61, 0x2a, 0x93, 0x28, 0x55, 0x44,
+	0x02, 0xda, 0x26, 0xae, 0x3c, 0xdd, 0x92, 0xa8, 0x2f, 0x5a, 0x7f, 0x9c, 0x05, 0xc9, 0x93, 0x0e,
+	0x22, 0xac, 0xe5, 0x73, 0xf8, 0x0c, 0x18, 0xf2, 0x61, 0x85, 0x1d, 0x6e, 0x8f, 0x95, 0xb6, 0x78,
+	0x7f, 0x78, 0xd2, 0x4c, 0x7a, 0x58, 0x68, 0xa9, 0xaf, 0xda, 0xd5, 0xf5, 0x5f, 0x05, 0x73, 0x35,
+	0x9f, 0xd2, 0x40, 0x76, 0x42, 0x06, 0x29, 0x01, 0x22, 0x59, 0x35, 0xb9, 0xca, 0x71, 0x79, 0x73,
+	0xfe, 0xd1, 0xf4, 0x2a, 0x4f, 0xb4, 0x4a, 0x71, 0x4d, 0x3f, 0x3f, 0xb3, 0x8a, 0x5b, 0xc7, 0x5b,
+	0xa2, 0xb6, 0xb2, 0x95, 0x0c, 0x10, 0x8f, 0x08, 0x97, 0x8b, 0x96, 0x41, 0x62, 0x28, 0x6e, 0xd7,
+	0x11, 0x69, 0x93, 0x88, 0x13, 0x57, 0x2e, 0x4e, 0x12, 0x0d, 0x64, 0x78, 0x0f, 0x24, 0xeb, 0x98,
+	0xd9, 0x2d, 0x46, 0x5c, 0xb5, 0x12, 0x68, 0xa1, 0x8e, 0xd9, 0x2b, 0x46, 0xdc, 0xcf, 0x13, 0xef,
+	0xbf, 0x36, 0x67, 0x2c, 0x0c, 0xd2, 0xfa, 0xf9, 0xd1, 0x6a, 0xfa, 0xe4, 0x3b, 0x3a, 0x6c, 0x1b,
+	0x64, 0x18, 0xa7, 0x11, 0xae, 0x13, 0xfb, 0x94, 0x74, 0x75, 0x9f, 0xa9, 0xae, 0xd1, 0xfa, 0x5f,
+	0x93, 0x2e, 0x43, 0xa3, 0x82, 0xa6, 0xf8, 0x3a, 0x01, 0xd2, 0x27, 0x11, 0x76, 0xfa, 0xef, 0x42,
+	0xd1, 0xab, 0x42, 0x8c, 0xfa, 0xcf, 0x2a, 0x25, 0x09, 0x6e, 0xee, 0x05, 0x84, 0xb6, 0xb8, 0xde,
+	0x4f, 0x7d, 0x51, 0x44, 0x44, 0x84, 0x74, 0x88, 0x23, 0xcb, 0x98, 0x40, 0x5a, 0x82, 0x3b, 0x60,
+	0x51, 0x3f, 0x01, 0x6d, 0xc6, 0xb1, 0x73, 0xaa, 0xd2, 0x2f, 0x1a, 0x97, 0x3d, 0x33, 0xa3, 0x0d,
+	0x55, 0xa1, 0x47, 0x63, 0x12, 0xfc, 0x02, 0x2c, 0x0d, 0xc3, 0xe4, 0x6c, 0xd5, 0x8b, 0xbd, 0x08,
+	0x2f, 0x7b, 0x66, 0x76, 0xe0, 0x2a, 0x2d, 0x68, 0x42, 0x16, 0x2b, 0xed, 0x92, 0x5a, 0xab, 0x2e,
+	0x9b, 0x2f, 0x89, 0x94, 0x20, 0xb4, 0xbe, 0x17, 0x78, 0x5c, 0x36, 0xdb, 0x1c, 0x52, 0x02, 0xfc,
+	0x02, 0xa4, 0x68, 0x9b, 0x44, 0x91, 0xe7, 0x12, 0x96, 0x03, 0x3f, 0xe0, 0xe3, 0x03, 0x1a, 0xfa,
+	0x8b, 0xe4, 0xf4, 0xb7, 0x91, 0x80, 0x04, 0x34, 0xea, 0xe6, 0xd2, 0xc3, 0xe4, 0x94, 0xe1, 0xa5,
+	0xd4, 0xa3, 0x31, 0x09, 0x16, 0x01, 0xd4, 0x61, 0x11, 0xe1, 0xad, 0x28, 0xb4, 0xe5, 0xfe, 0xcf,
+	0xc8, 0x58, 0xb9, 0x0b, 0x95, 0x15, 0x49, 0xe3, 0x3e, 0xe6, 0x18, 0x4d, 0x69, 0xe0, 0x2f, 0x00,
+	0x54, 0x6b, 0x62, 0x7f, 0xc5, 0xe8, 0xe0, 0xeb, 0x89, 0xba, 0x5a, 0x48, 0x7e, 0x65, 0xd5, 0x73,
+	0x36, 0x94, 0x74, 0xc0, 0xa8, 0xce, 0xe2, 0x20, 0x91, 0x4c, 0x18, 0x73, 0x07, 0x89, 0xe4, 0x82,
+	0x91, 0x1c, 0xd4, 0x4f, 0x67, 0x81, 0x56, 0xfa, 0xf2, 0xc8, 0xf4, 0x3e, 0xf9, 0x5b, 0x0c, 0x80,
+	0xe1, 0x2b, 0x18, 0xfe, 0x1c, 0xe4, 0x77, 0xf7, 0xf6, 0x4a, 0xd5, 0xaa, 0x7d, 0xf2, 0xe6, 0xb8,
+	0x64, 0x1f, 0x97, 0xd0, 0xcb, 0x72, 0xb5, 0x5a, 0x3e, 0x3a, 0xac, 0x94, 0xaa, 0x55, 0x63, 0x26,
+	0xff, 0xe0, 0xec, 0xbc, 0x90, 0x1b, 0xfa, 0x1f, 0x8b, 0x7a, 0x32, 0xe6, 0xd1, 0xd0, 0x57, 0x9d,
+	0x7a, 0x67, 0x34, 0x7a, 0xb7, 0x52, 0x39, 0x7a, 0x5d, 0x29, 0x57, 0x4f, 0x8c, 0x58, 0xfe, 0xee,
+	0xd9, 0x79, 0x61, 0x65, 0x18, 0xb8, 0xdb, 0x7f, 0x35, 0xc3, 0x47, 0x00, 0x8e, 0xc6, 0x1c, 0x1e,
+	0x15, 0x8f, 0xf6, 0xdf, 0x18, 0xb3, 0xf9, 0xd5, 0xb3, 0xf3, 0x82, 0x31, 0x0c, 0x38, 0xa4, 0x35,
+	0xea, 0x76, 0xf3, 0x89, 0xf7, 0x7f, 0x59, 0x9f, 0xf9, 0xe4, 0xef, 0xb3, 0x13, 0x8f, 0xfe, 0x8a,
+	0x40, 0x2a, 0x01, 0x53, 0x23, 0xed, 0x1d, 0x1d, 0x9e, 0xa0, 0xa3, 0x8a, 0x2d, 0xb8, 0xed, 0x57,
+	0x87, 0xd5, 0xe3, 0xd2, 0x5e, 0xf9, 0x59, 0xb9, 0xb4, 0x6f, 0xcc, 0xe4, 0x0b, 0x67, 0xe7, 0x85,
+	0x07, 0x53, 0xb1, 0xaf, 0x42, 0xd6, 0x24, 0x8e, 0xf7, 0xd6, 0x23, 0x2e, 0x3c, 0x04, 0x1f, 0x5f,
+	0x07, 0xb3, 0x87, 0x4a, 0xbb, 0x27, 0xe3, 0x39, 0x7d, 0x7c, 0x76, 0x5e, 0x28, 0x4c, 0x61, 0xa9,
+	0xaf, 0x6f, 0xc3, 0x04, 0x0f, 0x80, 0x75, 0x2d, 0xde, 0x6e, 0xa5, 0x32, 0x82, 0x36, 0x9b, 0xb7,
+	0xce, 0xce, 0x0b, 0xeb, 0xd3, 0x68, 0xd8, 0xf7, 0x87, 0x58, 0xbf, 0x04, 0x0f, 0xae, 0xc3, 0xda,
+	0x2f, 0x1d, 0xbe, 0x91, 0x28, 0xf1, 0xfc, 0x47, 0x67, 0xe7, 0x85, 0x7b, 0x53, 0x28, 0xfb, 0xfa,
+	0xcd, 0xaf, 0xea, 0x57, 0xdc, 0xfb, 0xe6, 0x62, 0x3d, 0xf6, 0xed, 0xc5, 0x7a, 0xec, 0xbf, 0x17,
+	0xeb, 0xb1, 0x3f, 0x7d, 0x58, 0x9f, 0xf9, 0xf6, 0xc3, 0xfa, 0xcc, 0xbf, 0x3e, 0xac, 0xcf, 0xfc,
+	0xe6, 0xe1, 0xc8, 0xa5, 0x00, 0x87, 0x51, 0x97, 0xd3, 0x70, 0xf0, 0xdb, 0xde, 0xde, 0xea, 0xc8,
+	0x4f, 0xa1, 0xf2, 0x6e, 0x50, 0x9b, 0x97, 0x9f, 0x38, 0x3f, 0xfb, 0xdf, 0x00, 0x39, 0x7b, 0x9f,
+	0x01, 0x28, 0x15, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -825,6 +1206,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PrecompileConfigs) > 0 {
+		for iNdEx := len(m.PrecompileConfigs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PrecompileConfigs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size, err := m.AccessControl.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.ActivePrecompiles) > 0 {
 		for iNdEx := len(m.ActivePrecompiles) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ActivePrecompiles[iNdEx])
@@ -855,21 +1260,21 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i--
 	dAtA[i] = 0x2a
 	if len(m.ExtraEIPs) > 0 {
-		dAtA3 := make([]byte, len(m.ExtraEIPs)*10)
-		var j2 int
+		dAtA4 := make([]byte, len(m.ExtraEIPs)*10)
+		var j3 int
 		for _, num1 := range m.ExtraEIPs {
 			num := uint64(num1)
 			for num >= 1<<7 {
-				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j2++
+				j3++
 			}
-			dAtA3[j2] = uint8(num)
-			j2++
+			dAtA4[j3] = uint8(num)
+			j3++
 		}
-		i -= j2
-		copy(dAtA[i:], dAtA3[:j2])
-		i = encodeVarintEvm(dAtA, i, uint64(j2))
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintEvm(dAtA, i, uint64(j3))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -903,7 +1308,7 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
+func (m *PrecompileConfig) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -913,59 +1318,241 @@ func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
+func (m *PrecompileConfig) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PrecompileConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.CancunBlock != nil {
-		{
-			size := m.CancunBlock.Size()
-			i -= size
-			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
+	if len(m.MethodGas) > 0 {
+		for iNdEx := len(m.MethodGas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MethodGas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintEvm(dAtA, i, uint64(size))
 			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x22
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xba
 	}
-	if m.ShanghaiBlock != nil {
-		{
-			size := m.ShanghaiBlock.Size()
-			i -= size
-			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
+	if len(m.DisabledMethods) > 0 {
+		for iNdEx := len(m.DisabledMethods) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DisabledMethods[iNdEx])
+			copy(dAtA[i:], m.DisabledMethods[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.DisabledMethods[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb2
 	}
-	if m.MergeNetsplitBlock != nil {
-		{
-			size := m.MergeNetsplitBlock.Size()
-			i -= size
-			if _, err := m.MergeNetsplitBlock.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
-			i = encodeVarintEvm(dAtA, i, uint64(size))
-		}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.ActivationHeight))
 		i--
-		dAtA[i] = 0x1
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Address)))
 		i--
-		dAtA[i] = 0xaa
+		dAtA[i] = 0xa
 	}
-	if m.GrayGlacierBlock != nil {
+	return len(dAtA) - i, nil
+}
+
+func (m *MethodGas) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MethodGas) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MethodGas) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Gas != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarintEvm(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessControlType) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessControlType) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessControlType) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allowlist) > 0 {
+		for iNdEx := len(m.Allowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Allowlist[iNdEx])
+			copy(dAtA[i:], m.Allowlist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Allowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.AccessType != 0 {
+		i = encodeVarintEvm(dAtA, i, uint64(m.AccessType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccessControl) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccessControl) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccessControl) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denylist) > 0 {
+		for iNdEx := len(m.Denylist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denylist[iNdEx])
+			copy(dAtA[i:], m.Denylist[iNdEx])
+			i = encodeVarintEvm(dAtA, i, uint64(len(m.Denylist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Call.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Create.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvm(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ChainConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChainConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChainConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CancunBlock != nil {
+		{
+			size := m.CancunBlock.Size()
+			i -= size
+			if _, err := m.CancunBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xba
+	}
+	if m.ShanghaiBlock != nil {
+		{
+			size := m.ShanghaiBlock.Size()
+			i -= size
+			if _, err := m.ShanghaiBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb2
+	}
+	if m.MergeNetsplitBlock != nil {
+		{
+			size := m.MergeNetsplitBlock.Size()
+			i -= size
+			if _, err := m.MergeNetsplitBlock.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintEvm(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xaa
+	}
+	if m.GrayGlacierBlock != nil {
 		{
 			size := m.GrayGlacierBlock.Size()
 			i -= size
@@ -1594,6 +2181,95 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovEvm(uint64(l))
 		}
 	}
+	l = m.AccessControl.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.PrecompileConfigs) > 0 {
+		for _, e := range m.PrecompileConfigs {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PrecompileConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovEvm(uint64(m.ActivationHeight))
+	}
+	if len(m.DisabledMethods) > 0 {
+		for _, s := range m.DisabledMethods {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	if len(m.MethodGas) > 0 {
+		for _, e := range m.MethodGas {
+			l = e.Size()
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MethodGas) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sovEvm(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovEvm(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *AccessControlType) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AccessType != 0 {
+		n += 1 + sovEvm(uint64(m.AccessType))
+	}
+	if len(m.Allowlist) > 0 {
+		for _, s := range m.Allowlist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccessControl) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Create.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	l = m.Call.Size()
+	n += 1 + l + sovEvm(uint64(l))
+	if len(m.Denylist) > 0 {
+		for _, s := range m.Denylist {
+			l = len(s)
+			n += 1 + l + sovEvm(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2122,6 +2798,590 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.ActivePrecompiles = append(m.ActivePrecompiles, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessControl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AccessControl.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PrecompileConfigs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PrecompileConfigs = append(m.PrecompileConfigs, PrecompileConfig{})
+			if err := m.PrecompileConfigs[len(m.PrecompileConfigs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisabledMethods", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisabledMethods = append(m.DisabledMethods, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MethodGas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MethodGas = append(m.MethodGas, MethodGas{})
+			if err := m.MethodGas[len(m.MethodGas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MethodGas) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MethodGas: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MethodGas: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessControlType) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControlType: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControlType: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccessType", wireType)
+			}
+			m.AccessType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccessType |= AccessType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allowlist = append(m.Allowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvm(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessControl) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvm
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessControl: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessControl: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Create", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Create.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Call", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Call.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denylist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvm
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvm
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvm
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denylist = append(m.Denylist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvm(dAtA[iNdEx:])
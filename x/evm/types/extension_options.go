@@ -0,0 +1,86 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SigHash returns the hash signed by the sender of the ethereum tx with the
+// given hash to bind the conditions to it. It is the EIP-191 personal message
+// hash of the keccak256 hash of the tx hash and the encoded conditions.
+func (c ExtensionOptionsEthereumTxConditional) SigHash(txHash common.Hash) ([]byte, error) {
+	c.Signature = nil
+	bz, err := c.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return extensionOptionSigHash(txHash, bz), nil
+}
+
+// VerifySignature returns an error if the conditions are not signed by the
+// sender of the ethereum tx with the given hash.
+func (c ExtensionOptionsEthereumTxConditional) VerifySignature(txHash common.Hash, sender common.Address) error {
+	sigHash, err := c.SigHash(txHash)
+	if err != nil {
+		return err
+	}
+	return verifyExtensionOptionSignature(sigHash, c.Signature, sender)
+}
+
+// SigHash returns the hash signed by the sender of the ethereum tx with the
+// given hash to bind the fee granter to it. It is the EIP-191 personal message
+// hash of the keccak256 hash of the tx hash and the encoded fee granter.
+func (s ExtensionOptionsEthereumTxSponsored) SigHash(txHash common.Hash) ([]byte, error) {
+	s.Signature = nil
+	bz, err := s.Marshal()
+	if err != nil {
+		return nil, err
+	}
+	return extensionOptionSigHash(txHash, bz), nil
+}
+
+// VerifySignature returns an error if the fee granter is not signed by the
+// sender of the ethereum tx with the given hash.
+func (s ExtensionOptionsEthereumTxSponsored) VerifySignature(txHash common.Hash, sender common.Address) error {
+	sigHash, err := s.SigHash(txHash)
+	if err != nil {
+		return err
+	}
+	return verifyExtensionOptionSignature(sigHash, s.Signature, sender)
+}
+
+// extensionOptionSigHash returns the EIP-191 hash of the tx hash and option,
+// so that the option can be signed with personal_sign by the wallets.
+func extensionOptionSigHash(txHash common.Hash, option []byte) []byte {
+	return accounts.TextHash(crypto.Keccak256(txHash.Bytes(), option))
+}
+
+// verifyExtensionOptionSignature checks that the signature of the hash is made
+// by the sender. The V value may be 0/1 or the legacy 27/28.
+func verifyExtensionOptionSignature(sigHash, signature []byte, sender common.Address) error {
+	if len(signature) == 0 {
+		return errorsmod.Wrap(errortypes.ErrNoSignatures, "extension option is not signed by the sender")
+	}
+	if len(signature) != crypto.SignatureLength {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "signature must be %d bytes long", crypto.SignatureLength)
+	}
+
+	sig := make([]byte, len(signature))
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27 // Transform yellow paper V from 27/28 to 0/1
+	}
+
+	pubKey, err := crypto.SigToPub(sigHash, sig)
+	if err != nil {
+		return errorsmod.Wrap(errortypes.ErrUnauthorized, err.Error())
+	}
+
+	if signer := crypto.PubkeyToAddress(*pubKey); signer != sender {
+		return errorsmod.Wrapf(errortypes.ErrUnauthorized, "extension option signed by %s, expected sender %s", signer, sender)
+	}
+	return nil
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+// signedOption is an extension option signed by the sender of the ethereum tx
+type signedOption interface {
+	VerifySignature(txHash common.Hash, sender common.Address) error
+}
+
+func TestExtensionOptionsVerifySignature(t *testing.T) {
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+
+	otherKey, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	txHash := common.BytesToHash([]byte("tx"))
+
+	signConditional := func(c *ExtensionOptionsEthereumTxConditional, hash common.Hash) {
+		sigHash, err := c.SigHash(hash)
+		require.NoError(t, err)
+		c.Signature, err = crypto.Sign(sigHash, key)
+		require.NoError(t, err)
+	}
+
+	signSponsored := func(s *ExtensionOptionsEthereumTxSponsored, hash common.Hash) {
+		sigHash, err := s.SigHash(hash)
+		require.NoError(t, err)
+		s.Signature, err = crypto.Sign(sigHash, key)
+		require.NoError(t, err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func() signedOption
+		expPass  bool
+	}{
+		{
+			"conditions signed by the sender",
+			func() signedOption {
+				c := &ExtensionOptionsEthereumTxConditional{BlockNumberMax: 10}
+				signConditional(c, txHash)
+				return c
+			},
+			true,
+		},
+		{
+			"conditions signed with a legacy V value",
+			func() signedOption {
+				c := &ExtensionOptionsEthereumTxConditional{BlockNumberMax: 10}
+				signConditional(c, txHash)
+				c.Signature[crypto.RecoveryIDOffset] += 27
+				return c
+			},
+			true,
+		},
+		{
+			"unsigned conditions",
+			func() signedOption {
+				return &ExtensionOptionsEthereumTxConditional{BlockNumberMax: 10}
+			},
+			false,
+		},
+		{
+			"conditions altered after signing",
+			func() signedOption {
+				c := &ExtensionOptionsEthereumTxConditional{BlockNumberMax: 10}
+				signConditional(c, txHash)
+				c.BlockNumberMax = 20
+				return c
+			},
+			false,
+		},
+		{
+			"conditions signed for another tx",
+			func() signedOption {
+				c := &ExtensionOptionsEthereumTxConditional{BlockNumberMax: 10}
+				signConditional(c, common.BytesToHash([]byte("other tx")))
+				return c
+			},
+			false,
+		},
+		{
+			"fee granter signed by the sender",
+			func() signedOption {
+				s := &ExtensionOptionsEthereumTxSponsored{FeeGranter: "granter"}
+				signSponsored(s, txHash)
+				return s
+			},
+			true,
+		},
+		{
+			"fee granter signed by another account",
+			func() signedOption {
+				s := &ExtensionOptionsEthereumTxSponsored{FeeGranter: "granter"}
+				sigHash, err := s.SigHash(txHash)
+				require.NoError(t, err)
+				s.Signature, err = crypto.Sign(sigHash, otherKey)
+				require.NoError(t, err)
+				return s
+			},
+			false,
+		},
+		{
+			"fee granter replaced after signing",
+			func() signedOption {
+				s := &ExtensionOptionsEthereumTxSponsored{FeeGranter: "granter"}
+				signSponsored(s, txHash)
+				s.FeeGranter = "other granter"
+				return s
+			},
+			false,
+		},
+		{
+			"invalid signature length",
+			func() signedOption {
+				return &ExtensionOptionsEthereumTxSponsored{FeeGranter: "granter", Signature: []byte{1}}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		err := tc.malleate().VerifySignature(txHash, sender)
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
@@ -0,0 +1,234 @@
+package types
+
+import (
+	"bytes"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/anryton/anryton/v2/types"
+)
+
+// SelectorLength is the length of the method selectors in bytes
+const SelectorLength = 4
+
+var (
+	_ feegrant.FeeAllowanceI             = (*AllowedContractAllowance)(nil)
+	_ codectypes.UnpackInterfacesMessage = (*AllowedContractAllowance)(nil)
+)
+
+// NewAllowedContractAllowance creates a new fee allowance restricted to the
+// ethereum transactions calling the given contracts and methods.
+func NewAllowedContractAllowance(
+	allowance feegrant.FeeAllowanceI,
+	allowedContracts, allowedSelectors []string,
+) (*AllowedContractAllowance, error) {
+	a := &AllowedContractAllowance{
+		AllowedContracts: allowedContracts,
+		AllowedSelectors: allowedSelectors,
+	}
+	if err := a.SetAllowance(allowance); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (a *AllowedContractAllowance) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var allowance feegrant.FeeAllowanceI
+	return unpacker.UnpackAny(a.Allowance, &allowance)
+}
+
+// GetAllowance returns the wrapped fee allowance.
+func (a *AllowedContractAllowance) GetAllowance() (feegrant.FeeAllowanceI, error) {
+	allowance, ok := a.Allowance.GetCachedValue().(feegrant.FeeAllowanceI)
+	if !ok {
+		return nil, errorsmod.Wrap(feegrant.ErrNoAllowance, "failed to get allowance")
+	}
+	return allowance, nil
+}
+
+// SetAllowance sets the wrapped fee allowance.
+func (a *AllowedContractAllowance) SetAllowance(allowance feegrant.FeeAllowanceI) error {
+	msg, ok := allowance.(proto.Message)
+	if !ok {
+		return errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+
+	var err error
+	a.Allowance, err = codectypes.NewAnyWithValue(msg)
+	if err != nil {
+		return errorsmod.Wrapf(errortypes.ErrPackAny, "cannot proto marshal %T", allowance)
+	}
+	return nil
+}
+
+// Accept checks that all the messages are ethereum transactions calling the
+// allowed contracts and methods, and delegates the fee check to the wrapped
+// allowance.
+func (a *AllowedContractAllowance) Accept(ctx sdk.Context, fee sdk.Coins, msgs []sdk.Msg) (bool, error) {
+	for _, msg := range msgs {
+		if !a.AllowsMsg(msg) {
+			return false, errorsmod.Wrap(feegrant.ErrMessageNotAllowed, "message is not an ethereum tx calling the allowed contracts")
+		}
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return false, err
+	}
+
+	remove, err := allowance.Accept(ctx, fee, msgs)
+	if err == nil && !remove {
+		if err = a.SetAllowance(allowance); err != nil {
+			return false, err
+		}
+	}
+	return remove, err
+}
+
+// AllowsMsg returns true if the message is an ethereum transaction calling one
+// of the allowed contracts and methods.
+func (a AllowedContractAllowance) AllowsMsg(msg sdk.Msg) bool {
+	msgEthTx, ok := msg.(*MsgEthereumTx)
+	if !ok {
+		return false
+	}
+
+	txData, err := UnpackTxData(msgEthTx.Data)
+	if err != nil {
+		return false
+	}
+
+	if len(a.AllowedContracts) > 0 {
+		to := txData.GetTo()
+		if to == nil || !a.allowsContract(*to) {
+			return false
+		}
+	}
+
+	if len(a.AllowedSelectors) > 0 {
+		data := txData.GetData()
+		if len(data) < SelectorLength || !a.allowsSelector(data[:SelectorLength]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (a AllowedContractAllowance) allowsContract(contract common.Address) bool {
+	for _, allowed := range a.AllowedContracts {
+		if common.HexToAddress(allowed) == contract {
+			return true
+		}
+	}
+	return false
+}
+
+func (a AllowedContractAllowance) allowsSelector(selector []byte) bool {
+	for _, allowed := range a.AllowedSelectors {
+		if bytes.Equal(common.FromHex(allowed), selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateBasic implements FeeAllowance and enforces basic sanity checks
+func (a *AllowedContractAllowance) ValidateBasic() error {
+	if a.Allowance == nil {
+		return errorsmod.Wrap(feegrant.ErrNoAllowance, "allowance should not be empty")
+	}
+
+	seenContracts := make(map[common.Address]bool)
+	for _, contract := range a.AllowedContracts {
+		if err := types.ValidateAddress(contract); err != nil {
+			return errorsmod.Wrap(err, "invalid allowed contract")
+		}
+
+		address := common.HexToAddress(contract)
+		if seenContracts[address] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate allowed contract %s", contract)
+		}
+		seenContracts[address] = true
+	}
+
+	seenSelectors := make(map[string]bool)
+	for _, selector := range a.AllowedSelectors {
+		bz, err := hexutil.Decode(selector)
+		if err != nil || len(bz) != SelectorLength {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid allowed selector %s, expected %d hex encoded bytes", selector, SelectorLength)
+		}
+
+		if seenSelectors[string(bz)] {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "duplicate allowed selector %s", selector)
+		}
+		seenSelectors[string(bz)] = true
+	}
+
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return err
+	}
+	return allowance.ValidateBasic()
+}
+
+// ExpiresAt returns the expiry time of the wrapped allowance.
+func (a *AllowedContractAllowance) ExpiresAt() (*time.Time, error) {
+	allowance, err := a.GetAllowance()
+	if err != nil {
+		return nil, err
+	}
+	return allowance.ExpiresAt()
+}
+
+// RestoreAllowance credits back the refunded fees to the spend limits of a fee
+// allowance, unwrapping the allowances restricted to messages or contracts.
+// The unlimited spend limits are left unchanged and the periodic allowances
+// can't spend more than their period spend limit.
+func RestoreAllowance(allowance feegrant.FeeAllowanceI, refund sdk.Coins) (feegrant.FeeAllowanceI, error) {
+	switch a := allowance.(type) {
+	case *feegrant.BasicAllowance:
+		if a.SpendLimit != nil {
+			a.SpendLimit = a.SpendLimit.Add(refund...)
+		}
+	case *feegrant.PeriodicAllowance:
+		if a.Basic.SpendLimit != nil {
+			a.Basic.SpendLimit = a.Basic.SpendLimit.Add(refund...)
+		}
+		a.PeriodCanSpend = a.PeriodCanSpend.Add(refund...).Min(a.PeriodSpendLimit)
+	case *feegrant.AllowedMsgAllowance:
+		wrapped, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		if wrapped, err = RestoreAllowance(wrapped, refund); err != nil {
+			return nil, err
+		}
+		if err := a.SetAllowance(wrapped); err != nil {
+			return nil, err
+		}
+	case *AllowedContractAllowance:
+		wrapped, err := a.GetAllowance()
+		if err != nil {
+			return nil, err
+		}
+		if wrapped, err = RestoreAllowance(wrapped, refund); err != nil {
+			return nil, err
+		}
+		if err := a.SetAllowance(wrapped); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, errorsmod.Wrapf(feegrant.ErrNoAllowance, "cannot restore allowance of type %T", allowance)
+	}
+	return allowance, nil
+}
@@ -0,0 +1,435 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ethermint/evm/v1/feegrant.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/codec/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AllowedContractAllowance is a x/feegrant allowance that only pays the fees
+// of ethereum transactions calling the given contracts and methods. The
+// allowances of this type are also used to sponsor the ethereum transactions
+// of the grantee that don't name a fee granter.
+type AllowedContractAllowance struct {
+	// allowance can be any of basic and periodic fee allowance.
+	Allowance *types.Any `protobuf:"bytes,1,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	// allowed_contracts are the hex addresses of the contracts that can be called.
+	// All the contracts are allowed if empty, contract creations are only allowed
+	// if empty.
+	AllowedContracts []string `protobuf:"bytes,2,rep,name=allowed_contracts,json=allowedContracts,proto3" json:"allowed_contracts,omitempty"`
+	// allowed_selectors are the hex encoded 4 byte selectors of the methods that
+	// can be called. All the methods are allowed if empty.
+	AllowedSelectors []string `protobuf:"bytes,3,rep,name=allowed_selectors,json=allowedSelectors,proto3" json:"allowed_selectors,omitempty"`
+}
+
+func (m *AllowedContractAllowance) Reset()         { *m = AllowedContractAllowance{} }
+func (m *AllowedContractAllowance) String() string { return proto.CompactTextString(m) }
+func (*AllowedContractAllowance) ProtoMessage()    {}
+func (*AllowedContractAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6c023f2958185f28, []int{0}
+}
+func (m *AllowedContractAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AllowedContractAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AllowedContractAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AllowedContractAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AllowedContractAllowance.Merge(m, src)
+}
+func (m *AllowedContractAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *AllowedContractAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_AllowedContractAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AllowedContractAllowance proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*AllowedContractAllowance)(nil), "ethermint.evm.v1.AllowedContractAllowance")
+}
+
+func init() { proto.RegisterFile("ethermint/evm/v1/feegrant.proto", fileDescriptor_6c023f2958185f28) }
+
+var fileDescriptor_6c023f2958185f28 = []byte{
+	// 307 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0x41, 0x4b, 0x02, 0x41,
+	0x18, 0x86, 0x77, 0x15, 0x02, 0xb7, 0x8b, 0x89, 0x87, 0xd5, 0xc3, 0x2a, 0x41, 0xa0, 0x84, 0x33,
+	0xac, 0xdd, 0xba, 0xa9, 0x10, 0x74, 0xb5, 0x5b, 0x10, 0x32, 0xbb, 0x7d, 0x8e, 0xc2, 0xee, 0x7c,
+	0x32, 0x33, 0x4e, 0xed, 0xb5, 0x53, 0xc7, 0x7e, 0x42, 0x3f, 0xa2, 0x1f, 0x11, 0x9d, 0x3c, 0x76,
+	0x0c, 0xfd, 0x23, 0xd1, 0x8e, 0xbb, 0xe5, 0xad, 0xd3, 0xcc, 0xfb, 0x7e, 0x2f, 0xef, 0xf7, 0x30,
+	0xe3, 0x75, 0x40, 0x2f, 0x40, 0xa6, 0x4b, 0xa1, 0x29, 0x98, 0x94, 0x9a, 0x90, 0xce, 0x01, 0xb8,
+	0x64, 0x42, 0x93, 0x95, 0x44, 0x8d, 0x8d, 0x7a, 0x19, 0x20, 0x60, 0x52, 0x62, 0xc2, 0x76, 0x2b,
+	0x46, 0x95, 0xa2, 0x9a, 0xe5, 0x73, 0x6a, 0x85, 0x0d, 0xb7, 0x9b, 0x1c, 0x39, 0x5a, 0xff, 0xe7,
+	0xb6, 0x77, 0x5b, 0x1c, 0x91, 0x27, 0x40, 0x73, 0x15, 0xad, 0xe7, 0x94, 0x89, 0xcc, 0x8e, 0x4e,
+	0x9f, 0x2a, 0x9e, 0x3f, 0x4a, 0x12, 0x7c, 0x80, 0xfb, 0x09, 0x0a, 0x2d, 0x59, 0xac, 0x73, 0xc9,
+	0x44, 0x0c, 0x8d, 0x3b, 0xaf, 0xc6, 0x0a, 0xe1, 0xbb, 0x5d, 0xb7, 0x77, 0x3c, 0x6c, 0x12, 0xdb,
+	0x45, 0x8a, 0x2e, 0x32, 0x12, 0xd9, 0xb8, 0xff, 0xf1, 0x36, 0x38, 0xdb, 0x83, 0x94, 0xf8, 0x26,
+	0x8c, 0x40, 0xb3, 0x90, 0x5c, 0x01, 0x94, 0x95, 0xd7, 0xd3, 0xdf, 0xc6, 0xc6, 0xb9, 0x77, 0xc2,
+	0xec, 0xea, 0x59, 0xbc, 0xdf, 0xad, 0xfc, 0x4a, 0xb7, 0xda, 0xab, 0x4d, 0xeb, 0xec, 0x90, 0x49,
+	0xfd, 0x0d, 0x2b, 0x48, 0x20, 0xd6, 0x28, 0x95, 0x5f, 0x3d, 0x08, 0xdf, 0x14, 0xfe, 0xe5, 0xe0,
+	0xf9, 0xb5, 0xe3, 0xfc, 0x9b, 0x69, 0x3c, 0x79, 0xdf, 0x06, 0xee, 0x66, 0x1b, 0xb8, 0x5f, 0xdb,
+	0xc0, 0x7d, 0xd9, 0x05, 0xce, 0x66, 0x17, 0x38, 0x9f, 0xbb, 0xc0, 0xb9, 0xed, 0xf3, 0xa5, 0x5e,
+	0xac, 0x23, 0x12, 0x63, 0x4a, 0x99, 0x90, 0x99, 0x46, 0x51, 0x9e, 0x66, 0x48, 0x1f, 0xf3, 0x3f,
+	0xd3, 0xd9, 0x0a, 0x54, 0x74, 0x94, 0xbf, 0xc8, 0xc5, 0xf7, 0x00, 0xcb, 0x66, 0x6a, 0xca, 0xd1,
+	0x01, 0x00, 0x00,
+}
+
+func (m *AllowedContractAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AllowedContractAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AllowedContractAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSelectors) > 0 {
+		for iNdEx := len(m.AllowedSelectors) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSelectors[iNdEx])
+			copy(dAtA[i:], m.AllowedSelectors[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedSelectors[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.AllowedContracts) > 0 {
+		for iNdEx := len(m.AllowedContracts) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedContracts[iNdEx])
+			copy(dAtA[i:], m.AllowedContracts[iNdEx])
+			i = encodeVarintFeegrant(dAtA, i, uint64(len(m.AllowedContracts[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Allowance != nil {
+		{
+			size, err := m.Allowance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintFeegrant(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeegrant(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeegrant(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AllowedContractAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Allowance != nil {
+		l = m.Allowance.Size()
+		n += 1 + l + sovFeegrant(uint64(l))
+	}
+	if len(m.AllowedContracts) > 0 {
+		for _, s := range m.AllowedContracts {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	if len(m.AllowedSelectors) > 0 {
+		for _, s := range m.AllowedSelectors {
+			l = len(s)
+			n += 1 + l + sovFeegrant(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovFeegrant(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeegrant(x uint64) (n int) {
+	return sovFeegrant(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AllowedContractAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AllowedContractAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AllowedContractAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Allowance == nil {
+				m.Allowance = &types.Any{}
+			}
+			if err := m.Allowance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedContracts", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedContracts = append(m.AllowedContracts, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSelectors", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSelectors = append(m.AllowedSelectors, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeegrant(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeegrant
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeegrant(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeegrant
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeegrant
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeegrant
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeegrant
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeegrant
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeegrant        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeegrant          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeegrant = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,170 @@
+package types
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	allowedContract = common.BytesToAddress([]byte("allowed_contract"))
+	otherContract   = common.BytesToAddress([]byte("other_contract"))
+)
+
+func newAllowedContractAllowance(t *testing.T, spendLimit int64, expiration *time.Time, contracts, selectors []string) *AllowedContractAllowance {
+	allowance, err := NewAllowedContractAllowance(
+		&feegrant.BasicAllowance{SpendLimit: sdk.NewCoins(sdk.NewInt64Coin(DefaultEVMDenom, spendLimit)), Expiration: expiration},
+		contracts, selectors,
+	)
+	require.NoError(t, err)
+	return allowance
+}
+
+func newCallTx(to *common.Address, input []byte) *MsgEthereumTx {
+	return NewTx(&EvmTxArgs{ChainID: big.NewInt(9000), GasLimit: 21000, GasPrice: big.NewInt(1), To: to, Input: input})
+}
+
+func TestAllowedContractAllowanceValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		contracts []string
+		selectors []string
+		expPass   bool
+	}{
+		{"no restrictions", nil, nil, true},
+		{"valid restrictions", []string{allowedContract.Hex()}, []string{"0xa9059cbb", "0x095ea7b3"}, true},
+		{"invalid contract", []string{"contract"}, nil, false},
+		{"duplicate contract", []string{allowedContract.Hex(), allowedContract.Hex()}, nil, false},
+		{"invalid selector", nil, []string{"0xa9059c"}, false},
+		{"non hex selector", nil, []string{"transfer"}, false},
+		{"duplicate selector", nil, []string{"0xa9059cbb", "0xA9059CBB"}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := newAllowedContractAllowance(t, 100, nil, tc.contracts, tc.selectors).ValidateBasic()
+			if tc.expPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+
+	require.Error(t, (&AllowedContractAllowance{}).ValidateBasic())
+}
+
+func TestAllowedContractAllowanceAllowsMsg(t *testing.T) {
+	transfer := common.FromHex("0xa9059cbb0000000000000000000000000000000000000000000000000000000000000001")
+
+	testCases := []struct {
+		name      string
+		contracts []string
+		selectors []string
+		msg       sdk.Msg
+		expAllow  bool
+	}{
+		{"no restrictions", nil, nil, newCallTx(&otherContract, nil), true},
+		{"no restrictions, contract creation", nil, nil, newCallTx(nil, transfer), true},
+		{"allowed contract", []string{allowedContract.Hex()}, nil, newCallTx(&allowedContract, nil), true},
+		{"other contract", []string{allowedContract.Hex()}, nil, newCallTx(&otherContract, nil), false},
+		{"contract creation", []string{allowedContract.Hex()}, nil, newCallTx(nil, nil), false},
+		{"allowed selector", nil, []string{"0xa9059cbb"}, newCallTx(&otherContract, transfer), true},
+		{"other selector", nil, []string{"0x095ea7b3"}, newCallTx(&otherContract, transfer), false},
+		{"short input", nil, []string{"0xa9059cbb"}, newCallTx(&otherContract, transfer[:3]), false},
+		{"allowed contract and selector", []string{allowedContract.Hex()}, []string{"0xa9059cbb"}, newCallTx(&allowedContract, transfer), true},
+		{"cosmos msg", nil, nil, &banktypes.MsgSend{}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			allowance := newAllowedContractAllowance(t, 100, nil, tc.contracts, tc.selectors)
+			require.Equal(t, tc.expAllow, allowance.AllowsMsg(tc.msg))
+		})
+	}
+}
+
+func TestAllowedContractAllowanceAccept(t *testing.T) {
+	now := time.Now().UTC()
+	ctx := sdk.Context{}.WithBlockHeader(tmproto.Header{Time: now}).WithGasMeter(sdk.NewInfiniteGasMeter())
+	expiration := now.Add(time.Hour)
+	fee := sdk.NewCoins(sdk.NewInt64Coin(DefaultEVMDenom, 60))
+
+	allowance := newAllowedContractAllowance(t, 100, &expiration, []string{allowedContract.Hex()}, nil)
+
+	// the msgs calling other contracts are rejected
+	_, err := allowance.Accept(ctx, fee, []sdk.Msg{newCallTx(&otherContract, nil)})
+	require.ErrorIs(t, err, feegrant.ErrMessageNotAllowed)
+
+	// the spend limit of the wrapped allowance is updated
+	remove, err := allowance.Accept(ctx, fee, []sdk.Msg{newCallTx(&allowedContract, nil)})
+	require.NoError(t, err)
+	require.False(t, remove)
+
+	basic, err := allowance.GetAllowance()
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(DefaultEVMDenom, 40)), basic.(*feegrant.BasicAllowance).SpendLimit)
+
+	_, err = allowance.Accept(ctx, fee, []sdk.Msg{newCallTx(&allowedContract, nil)})
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExceeded)
+
+	exp, err := allowance.ExpiresAt()
+	require.NoError(t, err)
+	require.Equal(t, expiration, *exp)
+
+	// the expired allowances are removed
+	remove, err = allowance.Accept(ctx.WithBlockTime(expiration.Add(time.Second)), fee, []sdk.Msg{newCallTx(&allowedContract, nil)})
+	require.ErrorIs(t, err, feegrant.ErrFeeLimitExpired)
+	require.True(t, remove)
+}
+
+func TestRestoreAllowance(t *testing.T) {
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(DefaultEVMDenom, amount))
+	}
+	refund := coins(30)
+
+	// the spend limit of the basic allowances is increased
+	allowance, err := RestoreAllowance(&feegrant.BasicAllowance{SpendLimit: coins(40)}, refund)
+	require.NoError(t, err)
+	require.Equal(t, coins(70), allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the unlimited allowances stay unlimited
+	allowance, err = RestoreAllowance(&feegrant.BasicAllowance{}, refund)
+	require.NoError(t, err)
+	require.Nil(t, allowance.(*feegrant.BasicAllowance).SpendLimit)
+
+	// the period allowance is capped to the period spend limit
+	allowance, err = RestoreAllowance(&feegrant.PeriodicAllowance{
+		Basic:            feegrant.BasicAllowance{SpendLimit: coins(40)},
+		Period:           time.Hour,
+		PeriodSpendLimit: coins(50),
+		PeriodCanSpend:   coins(35),
+	}, refund)
+	require.NoError(t, err)
+	periodic := allowance.(*feegrant.PeriodicAllowance)
+	require.Equal(t, coins(70), periodic.Basic.SpendLimit)
+	require.Equal(t, coins(50), periodic.PeriodCanSpend)
+
+	// the wrapped allowances are restored
+	allowance, err = RestoreAllowance(newAllowedContractAllowance(t, 40, nil, []string{allowedContract.Hex()}, nil), refund)
+	require.NoError(t, err)
+	basic, err := allowance.(*AllowedContractAllowance).GetAllowance()
+	require.NoError(t, err)
+	require.Equal(t, coins(70), basic.(*feegrant.BasicAllowance).SpendLimit)
+
+	msgAllowance, err := feegrant.NewAllowedMsgAllowance(&feegrant.BasicAllowance{SpendLimit: coins(40)}, []string{sdk.MsgTypeURL(&MsgEthereumTx{})})
+	require.NoError(t, err)
+	allowance, err = RestoreAllowance(msgAllowance, refund)
+	require.NoError(t, err)
+	basic, err = allowance.(*feegrant.AllowedMsgAllowance).GetAllowance()
+	require.NoError(t, err)
+	require.Equal(t, coins(70), basic.(*feegrant.BasicAllowance).SpendLimit)
+}
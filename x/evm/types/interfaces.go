@@ -3,10 +3,12 @@ package types
 import (
 	"math/big"
 
+	sdkmath "cosmossdk.io/math"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
@@ -50,6 +52,25 @@ type FeeMarketKeeper interface {
 	CalculateBaseFee(ctx sdk.Context) *big.Int
 }
 
+// FeeGrantKeeper restores the fee allowances of the granters refunded with the
+// leftover gas of the sponsored Ethereum txs
+type FeeGrantKeeper interface {
+	GetAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	UpdateAllowance(ctx sdk.Context, granter, grantee sdk.AccAddress, feeAllowance feegrant.FeeAllowanceI) error
+}
+
+// GasQuotaKeeper credits back the covered gas left unused by the Ethereum txs
+// to the gas allowance of their senders
+type GasQuotaKeeper interface {
+	RefundGasQuota(ctx sdk.Context, addr sdk.AccAddress, gas uint64)
+}
+
+// FeeAbsKeeper swaps back into fee tokens the leftover gas refunds of the
+// Ethereum txs paid with swapped fee tokens
+type FeeAbsKeeper interface {
+	RefundSwappedFeeTokens(ctx sdk.Context, payer sdk.AccAddress, swappedIn sdk.Coins, swappedOut, refund sdkmath.Int) error
+}
+
 // Event Hooks
 // These can be utilized to customize evm transaction processing.
 
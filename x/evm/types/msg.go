@@ -16,6 +16,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	proto "github.com/cosmos/gogoproto/proto"
 
 	"github.com/anryton/anryton/v2/types"
 
@@ -29,6 +30,9 @@ var (
 	_ sdk.Tx     = &MsgEthereumTx{}
 	_ ante.GasTx = &MsgEthereumTx{}
 	_ sdk.Msg    = &MsgUpdateParams{}
+	_ sdk.Msg    = &MsgUpdateAccessControlList{}
+	_ sdk.Msg    = &MsgTogglePrecompile{}
+	_ sdk.Msg    = &MsgUpdatePrecompileConfig{}
 
 	_ codectypes.UnpackInterfacesMessage = MsgEthereumTx{}
 )
@@ -332,14 +336,47 @@ func (msg *MsgEthereumTx) UnmarshalBinary(b []byte) error {
 
 // BuildTx builds the canonical cosmos tx from ethereum msg
 func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.Tx, error) {
+	return msg.buildTx(b, evmDenom, &ExtensionOptionsEthereumTx{})
+}
+
+// BuildConditionalTx builds the canonical cosmos tx from ethereum msg, carrying
+// the given inclusion conditions in place of the ethereum tx extension option.
+func (msg *MsgEthereumTx) BuildConditionalTx(
+	b client.TxBuilder,
+	evmDenom string,
+	conditional *ExtensionOptionsEthereumTxConditional,
+) (signing.Tx, error) {
+	return msg.buildTx(b, evmDenom, conditional)
+}
+
+// BuildSponsoredTx builds the canonical cosmos tx from ethereum msg, whose
+// fees are paid by the fee granter of the sponsored option. The inclusion
+// conditions are carried along with it if not nil.
+func (msg *MsgEthereumTx) BuildSponsoredTx(
+	b client.TxBuilder,
+	evmDenom string,
+	sponsored *ExtensionOptionsEthereumTxSponsored,
+	conditional *ExtensionOptionsEthereumTxConditional,
+) (signing.Tx, error) {
+	if conditional != nil {
+		return msg.buildTx(b, evmDenom, sponsored, conditional)
+	}
+	return msg.buildTx(b, evmDenom, sponsored)
+}
+
+func (msg *MsgEthereumTx) buildTx(b client.TxBuilder, evmDenom string, extOptions ...proto.Message) (signing.Tx, error) {
 	builder, ok := b.(authtx.ExtensionOptionsTxBuilder)
 	if !ok {
 		return nil, errors.New("unsupported builder")
 	}
 
-	option, err := codectypes.NewAnyWithValue(&ExtensionOptionsEthereumTx{})
-	if err != nil {
-		return nil, err
+	options := make([]*codectypes.Any, 0, len(extOptions))
+	for _, extOption := range extOptions {
+		option, err := codectypes.NewAnyWithValue(extOption)
+		if err != nil {
+			return nil, err
+		}
+		options = append(options, option)
 	}
 
 	txData, err := UnpackTxData(msg.Data)
@@ -352,7 +389,7 @@ func (msg *MsgEthereumTx) BuildTx(b client.TxBuilder, evmDenom string) (signing.
 		fees = append(fees, sdk.NewCoin(evmDenom, feeAmt))
 	}
 
-	builder.SetExtensionOptions(option)
+	builder.SetExtensionOptions(options...)
 
 	// A valid msg should have empty `From`
 	msg.From = ""
@@ -387,3 +424,82 @@ func (m *MsgUpdateParams) ValidateBasic() error {
 func (m MsgUpdateParams) GetSignBytes() []byte {
 	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
 }
+
+// GetSigners returns the expected signers for a MsgUpdateAccessControlList message.
+func (m MsgUpdateAccessControlList) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateAccessControlList) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if _, ok := AccessControlList_name[int32(m.List)]; !ok || m.List == AccessControlListUnspecified {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid access control list %d", m.List)
+	}
+
+	if len(m.Add) == 0 && len(m.Remove) == 0 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "no address to add or remove")
+	}
+
+	if err := validateAddressList(append(append([]string{}, m.Add...), m.Remove...)); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateAccessControlList) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgTogglePrecompile message.
+func (m MsgTogglePrecompile) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgTogglePrecompile) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if err := types.ValidateAddress(m.Address); err != nil {
+		return errorsmod.Wrap(err, "invalid precompile address")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgTogglePrecompile) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners returns the expected signers for a MsgUpdatePrecompileConfig message.
+func (m MsgUpdatePrecompileConfig) GetSigners() []sdk.AccAddress {
+	//#nosec G703 -- gosec raises a warning about a non-handled error which we deliberately ignore here
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdatePrecompileConfig) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	return m.Config.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdatePrecompileConfig) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
@@ -13,6 +13,10 @@ import (
 	"github.com/anryton/anryton/v2/utils"
 )
 
+// ICS20PrecompileAddress is the address of the ICS20 transfer precompile. It is
+// also the sender of the IBC callbacks of the transfers sent by the precompile.
+const ICS20PrecompileAddress = "0x0000000000000000000000000000000000000802"
+
 var (
 	// DefaultEVMDenom defines the default EVM denomination on Anryton
 	DefaultEVMDenom = utils.BaseDenom
@@ -26,8 +30,10 @@ var (
 	AvailableEVMExtensions = []string{
 		"0x0000000000000000000000000000000000000800", // Staking precompile
 		"0x0000000000000000000000000000000000000801", // Distribution precompile
-		"0x0000000000000000000000000000000000000802", // ICS20 transfer precompile
+		ICS20PrecompileAddress,                       // ICS20 transfer precompile
 		"0x0000000000000000000000000000000000000803", // Vesting precompile
+		"0x0000000000000000000000000000000000000804", // ICA precompile
+		"0x0000000000000000000000000000000000000805", // Token factory precompile
 	}
 )
 
@@ -72,6 +78,7 @@ func DefaultParams() Params {
 		ExtraEIPs:           nil,
 		AllowUnprotectedTxs: DefaultAllowUnprotectedTxs,
 		ActivePrecompiles:   AvailableEVMExtensions,
+		AccessControl:       DefaultAccessControl(),
 	}
 }
 
@@ -101,7 +108,15 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validatePrecompiles(p.ActivePrecompiles)
+	if err := validatePrecompiles(p.ActivePrecompiles); err != nil {
+		return err
+	}
+
+	if err := validateAccessControl(p.AccessControl); err != nil {
+		return err
+	}
+
+	return validatePrecompileConfigs(p.PrecompileConfigs)
 }
 
 // EIPs returns the ExtraEIPS as a int slice
@@ -41,6 +41,15 @@ func TestParamsValidate(t *testing.T) {
 			},
 			true,
 		},
+		{
+			"invalid access control",
+			Params{
+				EvmDenom:      DefaultEVMDenom,
+				ChainConfig:   DefaultChainConfig(),
+				AccessControl: AccessControl{Denylist: []string{"foo"}},
+			},
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,182 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/anryton/anryton/v2/types"
+)
+
+// Validate performs a basic validation of the precompile configuration.
+func (pc PrecompileConfig) Validate() error {
+	if err := types.ValidateAddress(pc.Address); err != nil {
+		return fmt.Errorf("invalid precompile %s", pc.Address)
+	}
+
+	if pc.ActivationHeight < 0 {
+		return fmt.Errorf("negative activation height %d for precompile %s", pc.ActivationHeight, pc.Address)
+	}
+
+	seenMethods := make(map[string]bool, len(pc.DisabledMethods))
+	for _, method := range pc.DisabledMethods {
+		selector, err := ParseMethodSelector(method)
+		if err != nil {
+			return err
+		}
+
+		if seenMethods[selector] {
+			return fmt.Errorf("duplicate disabled method %s for precompile %s", method, pc.Address)
+		}
+		seenMethods[selector] = true
+	}
+
+	seenGas := make(map[string]bool, len(pc.MethodGas))
+	for _, methodGas := range pc.MethodGas {
+		if methodGas.Method == "" {
+			return fmt.Errorf("empty method name in the gas of precompile %s", pc.Address)
+		}
+
+		if seenGas[methodGas.Method] {
+			return fmt.Errorf("duplicate method gas %s for precompile %s", methodGas.Method, pc.Address)
+		}
+		seenGas[methodGas.Method] = true
+	}
+
+	return nil
+}
+
+// IsEmpty returns true if the configuration neither schedules an activation
+// height, disables methods nor overrides the gas of methods.
+func (pc PrecompileConfig) IsEmpty() bool {
+	return pc.ActivationHeight == 0 && len(pc.DisabledMethods) == 0 && len(pc.MethodGas) == 0
+}
+
+// GasSchedule returns the base gas of the methods of the precompile, i.e. the
+// given defaults overridden by the method gas of the configuration.
+func (pc PrecompileConfig) GasSchedule(defaults map[string]uint64) map[string]uint64 {
+	schedule := make(map[string]uint64, len(defaults)+len(pc.MethodGas))
+	for method, gas := range defaults {
+		schedule[method] = gas
+	}
+	for _, methodGas := range pc.MethodGas {
+		schedule[methodGas.Method] = methodGas.Gas
+	}
+	return schedule
+}
+
+// IsActive returns true if the activation height of the precompile is reached.
+func (pc PrecompileConfig) IsActive(height int64) bool {
+	return height >= pc.ActivationHeight
+}
+
+// DisabledSelectors returns the set of the lowercase hex selectors of the
+// disabled methods.
+func (pc PrecompileConfig) DisabledSelectors() map[string]bool {
+	selectors := make(map[string]bool, len(pc.DisabledMethods))
+	for _, method := range pc.DisabledMethods {
+		if selector, err := ParseMethodSelector(method); err == nil {
+			selectors[selector] = true
+		}
+	}
+	return selectors
+}
+
+// ParseMethodSelector checks that the method is a hex encoded 4 bytes selector
+// and returns it in lowercase.
+func ParseMethodSelector(method string) (string, error) {
+	bz, err := hexutil.Decode(method)
+	if err != nil || len(bz) != 4 {
+		return "", fmt.Errorf("invalid method selector %s, expected 4 hex encoded bytes", method)
+	}
+	return hexutil.Encode(bz), nil
+}
+
+// GetPrecompileConfig returns the configuration of the precompile, or an empty
+// configuration if there is none.
+func (p Params) GetPrecompileConfig(address common.Address) PrecompileConfig {
+	for _, config := range p.PrecompileConfigs {
+		if common.HexToAddress(config.Address) == address {
+			return config
+		}
+	}
+	return PrecompileConfig{Address: address.Hex()}
+}
+
+// SetPrecompileConfig replaces the configuration of the precompile. An empty
+// configuration is removed.
+func (p *Params) SetPrecompileConfig(config PrecompileConfig) {
+	address := common.HexToAddress(config.Address)
+	config.Address = address.Hex()
+
+	configs := make([]PrecompileConfig, 0, len(p.PrecompileConfigs)+1)
+	for _, c := range p.PrecompileConfigs {
+		if common.HexToAddress(c.Address) != address {
+			configs = append(configs, c)
+		}
+	}
+	if !config.IsEmpty() {
+		configs = append(configs, config)
+	}
+
+	p.PrecompileConfigs = configs
+}
+
+// IsActivePrecompile returns true if the precompile is part of the active
+// precompiles.
+func (p Params) IsActivePrecompile(address common.Address) bool {
+	for _, precompile := range p.ActivePrecompiles {
+		if common.HexToAddress(precompile) == address {
+			return true
+		}
+	}
+	return false
+}
+
+// TogglePrecompile adds the precompile to the active precompiles or removes it
+// from them. It fails if the precompile is already in the requested state.
+func (p *Params) TogglePrecompile(address common.Address, enabled bool) error {
+	if p.IsActivePrecompile(address) == enabled {
+		if enabled {
+			return fmt.Errorf("precompile %s is already active", address)
+		}
+		return fmt.Errorf("precompile %s is not active", address)
+	}
+
+	// copy the active precompiles, as they may share the default slice
+	precompiles := make([]string, 0, len(p.ActivePrecompiles)+1)
+	for _, precompile := range p.ActivePrecompiles {
+		if common.HexToAddress(precompile) != address {
+			precompiles = append(precompiles, precompile)
+		}
+	}
+	if enabled {
+		precompiles = append(precompiles, address.Hex())
+	}
+
+	p.ActivePrecompiles = precompiles
+	return nil
+}
+
+func validatePrecompileConfigs(i interface{}) error {
+	configs, ok := i.([]PrecompileConfig)
+	if !ok {
+		return fmt.Errorf("invalid precompile config slice type: %T", i)
+	}
+
+	seenPrecompiles := make(map[common.Address]bool, len(configs))
+	for _, config := range configs {
+		if err := config.Validate(); err != nil {
+			return err
+		}
+
+		address := common.HexToAddress(config.Address)
+		if seenPrecompiles[address] {
+			return fmt.Errorf("duplicate precompile config %s", config.Address)
+		}
+		seenPrecompiles[address] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,140 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPrecompileConfigValidate(t *testing.T) {
+	address := AvailableEVMExtensions[0]
+
+	testCases := []struct {
+		name    string
+		config  PrecompileConfig
+		expPass bool
+	}{
+		{
+			"valid",
+			PrecompileConfig{Address: address, ActivationHeight: 100, DisabledMethods: []string{"0x12345678", "0xABCDEF01"}},
+			true,
+		},
+		{
+			"invalid address",
+			PrecompileConfig{Address: "foo"},
+			false,
+		},
+		{
+			"negative activation height",
+			PrecompileConfig{Address: address, ActivationHeight: -1},
+			false,
+		},
+		{
+			"invalid selector length",
+			PrecompileConfig{Address: address, DisabledMethods: []string{"0x1234"}},
+			false,
+		},
+		{
+			"selector without prefix",
+			PrecompileConfig{Address: address, DisabledMethods: []string{"12345678"}},
+			false,
+		},
+		{
+			"duplicate selector",
+			PrecompileConfig{Address: address, DisabledMethods: []string{"0xabcdef01", "0xABCDEF01"}},
+			false,
+		},
+		{
+			"valid method gas",
+			PrecompileConfig{Address: address, MethodGas: []MethodGas{{Method: "delegate", Gas: 5000}, {Method: "redelegate", Gas: 0}}},
+			true,
+		},
+		{
+			"empty method gas name",
+			PrecompileConfig{Address: address, MethodGas: []MethodGas{{Gas: 5000}}},
+			false,
+		},
+		{
+			"duplicate method gas",
+			PrecompileConfig{Address: address, MethodGas: []MethodGas{{Method: "delegate", Gas: 5000}, {Method: "delegate", Gas: 6000}}},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		err := tc.config.Validate()
+		if tc.expPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestPrecompileConfigGasSchedule(t *testing.T) {
+	defaults := map[string]uint64{"delegate": 4000, "delegation": 1000}
+	config := PrecompileConfig{
+		Address:   AvailableEVMExtensions[0],
+		MethodGas: []MethodGas{{Method: "delegate", Gas: 6000}},
+	}
+	require.False(t, config.IsEmpty())
+
+	schedule := config.GasSchedule(defaults)
+	require.Equal(t, map[string]uint64{"delegate": 6000, "delegation": 1000}, schedule)
+	// the defaults are left untouched
+	require.Equal(t, uint64(4000), defaults["delegate"])
+}
+
+func TestParamsValidatePrecompileConfigs(t *testing.T) {
+	params := DefaultParams()
+	params.PrecompileConfigs = []PrecompileConfig{
+		{Address: AvailableEVMExtensions[0], ActivationHeight: 10},
+		{Address: AvailableEVMExtensions[1], DisabledMethods: []string{"0x12345678"}},
+	}
+	require.NoError(t, params.Validate())
+
+	params.PrecompileConfigs = append(params.PrecompileConfigs, PrecompileConfig{Address: AvailableEVMExtensions[0]})
+	require.Error(t, params.Validate())
+}
+
+func TestParamsSetPrecompileConfig(t *testing.T) {
+	params := DefaultParams()
+	address := common.HexToAddress(AvailableEVMExtensions[0])
+
+	config := params.GetPrecompileConfig(address)
+	require.True(t, config.IsEmpty())
+	require.True(t, config.IsActive(0))
+
+	params.SetPrecompileConfig(PrecompileConfig{Address: address.Hex(), ActivationHeight: 10})
+	config = params.GetPrecompileConfig(address)
+	require.False(t, config.IsActive(9))
+	require.True(t, config.IsActive(10))
+
+	params.SetPrecompileConfig(PrecompileConfig{Address: address.Hex(), DisabledMethods: []string{"0xABCDEF01"}})
+	require.Len(t, params.PrecompileConfigs, 1)
+	config = params.GetPrecompileConfig(address)
+	require.True(t, config.IsActive(0))
+	require.Equal(t, map[string]bool{"0xabcdef01": true}, config.DisabledSelectors())
+
+	params.SetPrecompileConfig(PrecompileConfig{Address: address.Hex()})
+	require.Empty(t, params.PrecompileConfigs)
+}
+
+func TestParamsTogglePrecompile(t *testing.T) {
+	params := DefaultParams()
+	address := common.HexToAddress(AvailableEVMExtensions[0])
+
+	require.Error(t, params.TogglePrecompile(address, true))
+
+	require.NoError(t, params.TogglePrecompile(address, false))
+	require.False(t, params.IsActivePrecompile(address))
+	require.Len(t, params.ActivePrecompiles, len(AvailableEVMExtensions)-1)
+	// the default precompiles are left untouched
+	require.Equal(t, AvailableEVMExtensions[0], DefaultParams().ActivePrecompiles[0])
+
+	require.Error(t, params.TogglePrecompile(address, false))
+
+	require.NoError(t, params.TogglePrecompile(address, true))
+	require.True(t, params.IsActivePrecompile(address))
+}
@@ -1302,6 +1302,184 @@ func (m *QueryBaseFeeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBaseFeeResponse proto.InternalMessageInfo
 
+// QueryAvailablePrecompilesRequest defines the request type for querying the
+// available precompiled contracts.
+type QueryAvailablePrecompilesRequest struct {
+}
+
+func (m *QueryAvailablePrecompilesRequest) Reset()         { *m = QueryAvailablePrecompilesRequest{} }
+func (m *QueryAvailablePrecompilesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAvailablePrecompilesRequest) ProtoMessage()    {}
+func (*QueryAvailablePrecompilesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{24}
+}
+func (m *QueryAvailablePrecompilesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAvailablePrecompilesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAvailablePrecompilesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAvailablePrecompilesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAvailablePrecompilesRequest.Merge(m, src)
+}
+func (m *QueryAvailablePrecompilesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAvailablePrecompilesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAvailablePrecompilesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAvailablePrecompilesRequest proto.InternalMessageInfo
+
+// QueryAvailablePrecompilesResponse returns the available precompiled contracts.
+type QueryAvailablePrecompilesResponse struct {
+	// precompiles are the available precompiled contracts
+	Precompiles []PrecompileInfo `protobuf:"bytes,1,rep,name=precompiles,proto3" json:"precompiles"`
+}
+
+func (m *QueryAvailablePrecompilesResponse) Reset()         { *m = QueryAvailablePrecompilesResponse{} }
+func (m *QueryAvailablePrecompilesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAvailablePrecompilesResponse) ProtoMessage()    {}
+func (*QueryAvailablePrecompilesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{25}
+}
+func (m *QueryAvailablePrecompilesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAvailablePrecompilesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAvailablePrecompilesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAvailablePrecompilesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAvailablePrecompilesResponse.Merge(m, src)
+}
+func (m *QueryAvailablePrecompilesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAvailablePrecompilesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAvailablePrecompilesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAvailablePrecompilesResponse proto.InternalMessageInfo
+
+func (m *QueryAvailablePrecompilesResponse) GetPrecompiles() []PrecompileInfo {
+	if m != nil {
+		return m.Precompiles
+	}
+	return nil
+}
+
+// PrecompileInfo defines the state of an available precompiled contract.
+type PrecompileInfo struct {
+	// address is the hex address of the precompiled contract
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// enabled defines if the precompiled contract is part of the active
+	// precompiles of the parameters
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// active defines if the precompiled contract can be called at the current
+	// height, i.e. it is enabled and its activation height is reached
+	Active bool `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+	// config is the governance configuration of the precompiled contract
+	Config PrecompileConfig `protobuf:"bytes,4,opt,name=config,proto3" json:"config"`
+	// abi is the JSON encoded ABI of the precompiled contract
+	ABI string `protobuf:"bytes,5,opt,name=abi,proto3" json:"abi,omitempty"`
+	// gas_schedule is the base gas charged for each method of the precompiled
+	// contract, i.e. the defaults merged with the governance overrides
+	GasSchedule []MethodGas `protobuf:"bytes,6,rep,name=gas_schedule,json=gasSchedule,proto3" json:"gas_schedule"`
+}
+
+func (m *PrecompileInfo) Reset()         { *m = PrecompileInfo{} }
+func (m *PrecompileInfo) String() string { return proto.CompactTextString(m) }
+func (*PrecompileInfo) ProtoMessage()    {}
+func (*PrecompileInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e15a877459347994, []int{26}
+}
+func (m *PrecompileInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PrecompileInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PrecompileInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PrecompileInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrecompileInfo.Merge(m, src)
+}
+func (m *PrecompileInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *PrecompileInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrecompileInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrecompileInfo proto.InternalMessageInfo
+
+func (m *PrecompileInfo) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PrecompileInfo) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *PrecompileInfo) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *PrecompileInfo) GetConfig() PrecompileConfig {
+	if m != nil {
+		return m.Config
+	}
+	return PrecompileConfig{}
+}
+
+func (m *PrecompileInfo) GetABI() string {
+	if m != nil {
+		return m.ABI
+	}
+	return ""
+}
+
+func (m *PrecompileInfo) GetGasSchedule() []MethodGas {
+	if m != nil {
+		return m.GasSchedule
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAccountRequest)(nil), "ethermint.evm.v1.QueryAccountRequest")
 	proto.RegisterType((*QueryAccountResponse)(nil), "ethermint.evm.v1.QueryAccountResponse")
@@ -1327,104 +1505,118 @@ func init() {
 	proto.RegisterType((*QueryTraceBlockResponse)(nil), "ethermint.evm.v1.QueryTraceBlockResponse")
 	proto.RegisterType((*QueryBaseFeeRequest)(nil), "ethermint.evm.v1.QueryBaseFeeRequest")
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.evm.v1.QueryBaseFeeResponse")
+	proto.RegisterType((*QueryAvailablePrecompilesRequest)(nil), "ethermint.evm.v1.QueryAvailablePrecompilesRequest")
+	proto.RegisterType((*QueryAvailablePrecompilesResponse)(nil), "ethermint.evm.v1.QueryAvailablePrecompilesResponse")
+	proto.RegisterType((*PrecompileInfo)(nil), "ethermint.evm.v1.PrecompileInfo")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/query.proto", fileDescriptor_e15a877459347994) }
 
 var fileDescriptor_e15a877459347994 = []byte{
-	// 1459 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcd, 0x6f, 0x13, 0xd7,
-	0x16, 0xcf, 0xc4, 0x4e, 0xec, 0x1c, 0x27, 0x90, 0x77, 0x09, 0xe0, 0x0c, 0x49, 0x1c, 0xe6, 0xbd,
-	0xc4, 0x86, 0x07, 0x33, 0xc4, 0x4f, 0x42, 0x7a, 0xdd, 0x50, 0x6c, 0x05, 0x4a, 0x81, 0x8a, 0xba,
-	0x51, 0x17, 0x95, 0x90, 0x75, 0x3d, 0xbe, 0x8c, 0xad, 0xd8, 0x33, 0x66, 0xee, 0xb5, 0xe5, 0x80,
-	0x58, 0x14, 0xa1, 0x7e, 0xa8, 0x1b, 0xa4, 0xee, 0xba, 0x62, 0xdf, 0x5d, 0x37, 0x5d, 0x76, 0xcb,
-	0x12, 0xa9, 0x9b, 0xaa, 0x0b, 0x5a, 0x41, 0x17, 0xfd, 0x1b, 0xba, 0xaa, 0xee, 0xc7, 0xd8, 0x33,
-	0xb6, 0x27, 0x0e, 0x15, 0xdd, 0x75, 0x63, 0xcf, 0xbd, 0xf7, 0xdc, 0xf3, 0xfb, 0x9d, 0x8f, 0x7b,
-	0xce, 0x81, 0x35, 0xc2, 0x1a, 0xc4, 0x6f, 0x37, 0x5d, 0x66, 0x91, 0x5e, 0xdb, 0xea, 0xed, 0x58,
-	0xf7, 0xbb, 0xc4, 0x3f, 0x30, 0x3b, 0xbe, 0xc7, 0x3c, 0xb4, 0x3c, 0x38, 0x35, 0x49, 0xaf, 0x6d,
-	0xf6, 0x76, 0xf4, 0xf3, 0xb6, 0x47, 0xdb, 0x1e, 0xb5, 0x6a, 0x98, 0x12, 0x29, 0x6a, 0xf5, 0x76,
-	0x6a, 0x84, 0xe1, 0x1d, 0xab, 0x83, 0x9d, 0xa6, 0x8b, 0x59, 0xd3, 0x73, 0xe5, 0x6d, 0x5d, 0x1f,
-	0xd3, 0xcd, 0x95, 0xc8, 0xb3, 0xd5, 0xb1, 0x33, 0xd6, 0x57, 0x47, 0x2b, 0x8e, 0xe7, 0x78, 0xe2,
-	0xd3, 0xe2, 0x5f, 0x6a, 0x77, 0xcd, 0xf1, 0x3c, 0xa7, 0x45, 0x2c, 0xdc, 0x69, 0x5a, 0xd8, 0x75,
-	0x3d, 0x26, 0x90, 0xa8, 0x3a, 0xcd, 0xa9, 0x53, 0xb1, 0xaa, 0x75, 0xef, 0x59, 0xac, 0xd9, 0x26,
-	0x94, 0xe1, 0x76, 0x47, 0x0a, 0x18, 0xff, 0x87, 0x13, 0x1f, 0x72, 0xb6, 0x57, 0x6d, 0xdb, 0xeb,
-	0xba, 0xac, 0x42, 0xee, 0x77, 0x09, 0x65, 0x28, 0x0b, 0x29, 0x5c, 0xaf, 0xfb, 0x84, 0xd2, 0xac,
-	0xb6, 0xa9, 0x15, 0x16, 0x2a, 0xc1, 0xf2, 0x9d, 0xf4, 0x17, 0xcf, 0x72, 0x33, 0xbf, 0x3f, 0xcb,
-	0xcd, 0x18, 0x36, 0xac, 0x44, 0xaf, 0xd2, 0x8e, 0xe7, 0x52, 0xc2, 0xef, 0xd6, 0x70, 0x0b, 0xbb,
-	0x36, 0x09, 0xee, 0xaa, 0x25, 0x3a, 0x03, 0x0b, 0xb6, 0x57, 0x27, 0xd5, 0x06, 0xa6, 0x8d, 0xec,
-	0xac, 0x38, 0x4b, 0xf3, 0x8d, 0xf7, 0x30, 0x6d, 0xa0, 0x15, 0x98, 0x73, 0x3d, 0x7e, 0x29, 0xb1,
-	0xa9, 0x15, 0x92, 0x15, 0xb9, 0x30, 0xae, 0xc0, 0xaa, 0x00, 0x29, 0x0b, 0xf7, 0xfe, 0x05, 0x96,
-	0x9f, 0x69, 0xa0, 0x4f, 0xd2, 0xa0, 0xc8, 0x6e, 0xc1, 0x31, 0x19, 0xb9, 0x6a, 0x54, 0xd3, 0x92,
-	0xdc, 0xbd, 0x2a, 0x37, 0x91, 0x0e, 0x69, 0xca, 0x41, 0x39, 0xbf, 0x59, 0xc1, 0x6f, 0xb0, 0xe6,
-	0x2a, 0xb0, 0xd4, 0x5a, 0x75, 0xbb, 0xed, 0x1a, 0xf1, 0x95, 0x05, 0x4b, 0x6a, 0xf7, 0x03, 0xb1,
-	0x69, 0xdc, 0x84, 0x35, 0xc1, 0xe3, 0x63, 0xdc, 0x6a, 0xd6, 0x31, 0xf3, 0xfc, 0x11, 0x63, 0xce,
-	0xc2, 0xa2, 0xed, 0xb9, 0xa3, 0x3c, 0x32, 0x7c, 0xef, 0xea, 0x98, 0x55, 0x5f, 0x69, 0xb0, 0x1e,
-	0xa3, 0x4d, 0x19, 0x96, 0x87, 0xe3, 0x01, 0xab, 0xa8, 0xc6, 0x80, 0xec, 0x5b, 0x34, 0x2d, 0x48,
-	0xa2, 0x92, 0x8c, 0xf3, 0x9b, 0x84, 0xe7, 0x92, 0x4a, 0xa2, 0xc1, 0xd5, 0x69, 0x49, 0x64, 0xdc,
-	0x54, 0x60, 0x1f, 0x31, 0xcf, 0xc7, 0xce, 0x74, 0x30, 0xb4, 0x0c, 0x89, 0x7d, 0x72, 0xa0, 0xf2,
-	0x8d, 0x7f, 0x86, 0xe0, 0x2f, 0x28, 0xf8, 0x81, 0x32, 0x05, 0xbf, 0x02, 0x73, 0x3d, 0xdc, 0xea,
-	0x06, 0xe0, 0x72, 0x61, 0x5c, 0x86, 0x65, 0x95, 0x4a, 0xf5, 0x37, 0x32, 0x32, 0x0f, 0xff, 0x0a,
-	0xdd, 0x53, 0x10, 0x08, 0x92, 0x3c, 0xf7, 0xc5, 0xad, 0xc5, 0x8a, 0xf8, 0x36, 0x1e, 0x00, 0x12,
-	0x82, 0x7b, 0xfd, 0x5b, 0x9e, 0x43, 0x03, 0x08, 0x04, 0x49, 0xf1, 0x62, 0xa4, 0x7e, 0xf1, 0x8d,
-	0xae, 0x01, 0x0c, 0xeb, 0x8a, 0xb0, 0x2d, 0x53, 0xdc, 0x36, 0x65, 0xd2, 0x9a, 0xbc, 0x08, 0x99,
-	0xb2, 0x5e, 0xa9, 0x22, 0x64, 0xde, 0x19, 0xba, 0xaa, 0x12, 0xba, 0x19, 0x22, 0xf9, 0xa5, 0xa6,
-	0x1c, 0x1b, 0x80, 0x2b, 0x9e, 0xe7, 0x20, 0xd9, 0xf2, 0x1c, 0x6e, 0x5d, 0xa2, 0x90, 0x29, 0x9e,
-	0x34, 0x47, 0x4b, 0x9f, 0x79, 0xcb, 0x73, 0x2a, 0x42, 0x04, 0x5d, 0x9f, 0x40, 0x2a, 0x3f, 0x95,
-	0x94, 0xc4, 0x09, 0xb3, 0x32, 0x56, 0x94, 0x1f, 0xee, 0x60, 0x1f, 0xb7, 0x03, 0x3f, 0x18, 0xb7,
-	0x15, 0xc1, 0x60, 0x57, 0x11, 0xbc, 0x0c, 0xf3, 0x1d, 0xb1, 0x23, 0x1c, 0x94, 0x29, 0x66, 0xc7,
-	0x29, 0xca, 0x1b, 0xa5, 0xe4, 0xf3, 0x97, 0xb9, 0x99, 0x8a, 0x92, 0x36, 0xbe, 0xd7, 0xe0, 0xd8,
-	0x2e, 0x6b, 0x94, 0x71, 0xab, 0x15, 0xf2, 0x34, 0xf6, 0x1d, 0x1a, 0xc4, 0x84, 0x7f, 0xa3, 0xd3,
-	0x90, 0x72, 0x30, 0xad, 0xda, 0xb8, 0xa3, 0x9e, 0xc7, 0xbc, 0x83, 0x69, 0x19, 0x77, 0xd0, 0x5d,
-	0x58, 0xee, 0xf8, 0x5e, 0xc7, 0xa3, 0xc4, 0x1f, 0x3c, 0x31, 0xfe, 0x3c, 0x16, 0x4b, 0xc5, 0x3f,
-	0x5e, 0xe6, 0x4c, 0xa7, 0xc9, 0x1a, 0xdd, 0x9a, 0x69, 0x7b, 0x6d, 0x4b, 0xf5, 0x06, 0xf9, 0x77,
-	0x91, 0xd6, 0xf7, 0x2d, 0x76, 0xd0, 0x21, 0xd4, 0x2c, 0x0f, 0xdf, 0x76, 0xe5, 0x78, 0xa0, 0x2b,
-	0x78, 0x97, 0xab, 0x90, 0xb6, 0x1b, 0xb8, 0xe9, 0x56, 0x9b, 0xf5, 0x6c, 0x72, 0x53, 0x2b, 0x24,
-	0x2a, 0x29, 0xb1, 0xbe, 0x51, 0x37, 0xf2, 0x70, 0x62, 0x97, 0xb2, 0x66, 0x1b, 0x33, 0x72, 0x1d,
-	0x0f, 0x1d, 0xb1, 0x0c, 0x09, 0x07, 0x4b, 0xf2, 0xc9, 0x0a, 0xff, 0x34, 0x9e, 0x24, 0x83, 0x98,
-	0xfa, 0xd8, 0x26, 0x7b, 0xfd, 0xc0, 0xce, 0x1d, 0x48, 0xb4, 0xa9, 0xa3, 0xfc, 0x95, 0x1b, 0xf7,
-	0xd7, 0x6d, 0xea, 0xec, 0xf2, 0x3d, 0xd2, 0x6d, 0xef, 0xf5, 0x2b, 0x5c, 0x16, 0xbd, 0x0b, 0x8b,
-	0x8c, 0x2b, 0xa9, 0xda, 0x9e, 0x7b, 0xaf, 0xe9, 0x08, 0x4b, 0x33, 0xc5, 0xf5, 0xf1, 0xbb, 0x02,
-	0xaa, 0x2c, 0x84, 0x2a, 0x19, 0x36, 0x5c, 0xa0, 0x32, 0x2c, 0x76, 0x7c, 0x52, 0x27, 0x36, 0xa1,
-	0xd4, 0xf3, 0x69, 0x36, 0x29, 0x12, 0x6a, 0x2a, 0x7a, 0xe4, 0x12, 0xaf, 0x92, 0xb5, 0x96, 0x67,
-	0xef, 0x07, 0xf5, 0x68, 0x4e, 0x78, 0x26, 0x23, 0xf6, 0x64, 0x35, 0x42, 0xeb, 0x00, 0x52, 0x44,
-	0x3c, 0x9a, 0x79, 0xf1, 0x68, 0x16, 0xc4, 0x8e, 0xe8, 0x33, 0xe5, 0xe0, 0x98, 0xb7, 0xc2, 0x6c,
-	0x4a, 0x98, 0xa1, 0x9b, 0xb2, 0x4f, 0x9a, 0x41, 0x9f, 0x34, 0xf7, 0x82, 0x3e, 0x59, 0x4a, 0xf3,
-	0xa4, 0x79, 0xfa, 0x4b, 0x4e, 0x53, 0x4a, 0xf8, 0xc9, 0xc4, 0xd8, 0xa7, 0xff, 0x9e, 0xd8, 0x2f,
-	0x44, 0x62, 0x8f, 0x0c, 0x58, 0x92, 0xf4, 0xdb, 0xb8, 0x5f, 0xe5, 0xe1, 0x86, 0x90, 0x07, 0x6e,
-	0xe3, 0xfe, 0x75, 0x4c, 0xdf, 0x4f, 0xa6, 0x67, 0x97, 0x13, 0x95, 0x34, 0xeb, 0x57, 0x9b, 0x6e,
-	0x9d, 0xf4, 0x8d, 0xf3, 0xaa, 0xca, 0x0d, 0xb2, 0x60, 0x58, 0x82, 0xea, 0x98, 0xe1, 0x20, 0xdd,
-	0xf9, 0xb7, 0xf1, 0x5d, 0x02, 0x4e, 0x0d, 0x85, 0x4b, 0x5c, 0x6b, 0x28, 0x6b, 0x58, 0x3f, 0x28,
-	0x04, 0xd3, 0xb3, 0x86, 0xf5, 0xe9, 0x5b, 0xc8, 0x9a, 0x7f, 0x02, 0x3e, 0x3d, 0xe0, 0xc6, 0x45,
-	0x38, 0x3d, 0x16, 0xb3, 0x43, 0x62, 0x7c, 0x72, 0xd0, 0xaf, 0x29, 0xb9, 0x46, 0x82, 0xbe, 0x60,
-	0xdc, 0x1d, 0xf4, 0x62, 0xb5, 0xad, 0x54, 0xec, 0x42, 0x9a, 0x17, 0xef, 0xea, 0x3d, 0xa2, 0xfa,
-	0x61, 0xe9, 0xfc, 0xcf, 0x2f, 0x73, 0xdb, 0x47, 0xb0, 0xf9, 0x86, 0xcb, 0x78, 0xe3, 0x16, 0xea,
-	0x8a, 0x3f, 0x2c, 0xc2, 0x9c, 0xd0, 0x8f, 0x3e, 0xd5, 0x20, 0xa5, 0xe6, 0x15, 0xb4, 0x35, 0x9e,
-	0x0b, 0x13, 0x06, 0x52, 0x7d, 0x7b, 0x9a, 0x98, 0xe4, 0x6a, 0xe4, 0x1f, 0xff, 0xf8, 0xdb, 0xd7,
-	0xb3, 0x67, 0x51, 0xce, 0xa2, 0x8d, 0x66, 0xdd, 0x0b, 0x86, 0x68, 0x35, 0xaf, 0x58, 0x0f, 0x55,
-	0xec, 0x1e, 0xa1, 0x6f, 0x34, 0x58, 0x8a, 0x8c, 0x84, 0xe8, 0xbf, 0x31, 0x10, 0x93, 0x46, 0x4f,
-	0xfd, 0xc2, 0xd1, 0x84, 0x15, 0x2b, 0x53, 0xb0, 0x2a, 0xa0, 0xed, 0x28, 0xab, 0x60, 0xf2, 0x1c,
-	0x23, 0xf7, 0xad, 0x06, 0xcb, 0xa3, 0x93, 0x1d, 0x32, 0x63, 0x20, 0x63, 0x06, 0x4a, 0xdd, 0x3a,
-	0xb2, 0xbc, 0x62, 0x79, 0x59, 0xb0, 0xbc, 0x84, 0xcc, 0x28, 0xcb, 0x5e, 0x20, 0x3f, 0x24, 0x1a,
-	0x1e, 0x54, 0x1f, 0xa1, 0xc7, 0x1a, 0xa4, 0xd4, 0xfc, 0x16, 0x1b, 0xce, 0xe8, 0x68, 0x18, 0x1b,
-	0xce, 0x91, 0x31, 0xd0, 0x28, 0x08, 0x4a, 0x06, 0xda, 0x8c, 0x52, 0x52, 0xb3, 0x20, 0x0d, 0xb9,
-	0xec, 0x73, 0x0d, 0x52, 0x6a, 0x8a, 0x8b, 0x25, 0x11, 0x1d, 0x19, 0x63, 0x49, 0x8c, 0x0c, 0x83,
-	0xc6, 0x45, 0x41, 0x22, 0x8f, 0xb6, 0xa2, 0x24, 0xa8, 0x14, 0x1b, 0x72, 0xb0, 0x1e, 0xee, 0x93,
-	0x83, 0x47, 0xa8, 0x07, 0x49, 0x3e, 0xe8, 0x21, 0x23, 0x36, 0x45, 0x06, 0xd3, 0xa3, 0xfe, 0xef,
-	0x43, 0x65, 0x14, 0xfe, 0x96, 0xc0, 0xcf, 0xa1, 0xf5, 0xd1, 0xec, 0xa9, 0x47, 0x3c, 0x40, 0x61,
-	0x5e, 0xce, 0x39, 0xe8, 0x3f, 0x31, 0x5a, 0x23, 0xe3, 0x94, 0xbe, 0x35, 0x45, 0x4a, 0xa1, 0xaf,
-	0x09, 0xf4, 0x53, 0x68, 0x25, 0x8a, 0x2e, 0x87, 0x28, 0xc4, 0x20, 0xa5, 0x66, 0x28, 0xb4, 0x39,
-	0xae, 0x2f, 0x3a, 0x5e, 0xe9, 0xf9, 0x69, 0x3d, 0x23, 0xc0, 0xdc, 0x10, 0x98, 0x59, 0x74, 0x2a,
-	0x8a, 0x49, 0x58, 0xa3, 0x6a, 0x73, 0xa8, 0x07, 0x90, 0x09, 0x0d, 0x40, 0x47, 0x40, 0x9e, 0x60,
-	0xeb, 0x84, 0x09, 0xca, 0x30, 0x04, 0xee, 0x1a, 0xd2, 0x47, 0x70, 0x95, 0x28, 0x2f, 0xbf, 0xa8,
-	0x0f, 0x29, 0xd5, 0x47, 0x63, 0xf3, 0x2c, 0x3a, 0x6d, 0xc5, 0xe6, 0xd9, 0x48, 0x3b, 0x8e, 0xb3,
-	0x5a, 0x36, 0x50, 0xd6, 0x47, 0x4f, 0x34, 0x80, 0x61, 0x85, 0x47, 0x85, 0xc3, 0xd4, 0x86, 0x1b,
-	0xb7, 0x7e, 0xee, 0x08, 0x92, 0x8a, 0xc3, 0x59, 0xc1, 0xe1, 0x0c, 0x5a, 0x9d, 0xc4, 0x41, 0xb4,
-	0x1c, 0xee, 0x00, 0xd5, 0x21, 0x0e, 0x79, 0xed, 0xe1, 0xc6, 0x72, 0xc8, 0x6b, 0x8f, 0x34, 0x9a,
-	0x38, 0x07, 0x04, 0xcd, 0xa7, 0x74, 0xe5, 0xf9, 0xab, 0x0d, 0xed, 0xc5, 0xab, 0x0d, 0xed, 0xd7,
-	0x57, 0x1b, 0xda, 0xd3, 0xd7, 0x1b, 0x33, 0x2f, 0x5e, 0x6f, 0xcc, 0xfc, 0xf4, 0x7a, 0x63, 0xe6,
-	0x93, 0xad, 0x50, 0x33, 0x92, 0x77, 0xe5, 0x6f, 0xaf, 0x68, 0xf5, 0x85, 0x1a, 0xd1, 0x8f, 0x6a,
-	0xf3, 0xa2, 0xdd, 0xff, 0xef, 0xcf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0b, 0xc7, 0xfb, 0x68, 0xde,
-	0x11, 0x00, 0x00,
+	// 1638 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x57, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x37, 0x2d, 0x59, 0x92, 0x9f, 0xec, 0xc4, 0x1d, 0x2b, 0x89, 0xcc, 0xd8, 0x92, 0xcd, 0x24,
+	0xfe, 0x6a, 0x42, 0xc6, 0x0a, 0x10, 0xb4, 0xbd, 0x34, 0x96, 0xea, 0x38, 0x6e, 0x92, 0x22, 0x65,
+	0x8c, 0x1e, 0x0a, 0x04, 0xc2, 0x88, 0x1a, 0x53, 0x84, 0x25, 0x52, 0xe1, 0x50, 0x82, 0x9c, 0x34,
+	0x97, 0x36, 0x08, 0x1a, 0xf4, 0x12, 0xa0, 0xd7, 0x1e, 0x72, 0xe8, 0xb5, 0x97, 0x5e, 0xf6, 0x5f,
+	0xc8, 0x31, 0xc0, 0x5e, 0x16, 0x7b, 0xf0, 0x2e, 0x9c, 0x3d, 0xec, 0xdf, 0xb0, 0x87, 0xdd, 0xc5,
+	0x0c, 0x87, 0x12, 0xa9, 0x0f, 0xcb, 0x59, 0x64, 0x6f, 0x7b, 0x12, 0x67, 0xe6, 0x7d, 0xfc, 0xe6,
+	0xbd, 0x37, 0xef, 0xfd, 0x04, 0x8b, 0xc4, 0xab, 0x11, 0xb7, 0x61, 0xd9, 0x9e, 0x46, 0xda, 0x0d,
+	0xad, 0xbd, 0xa5, 0x3d, 0x6d, 0x11, 0xf7, 0x48, 0x6d, 0xba, 0x8e, 0xe7, 0xa0, 0xb9, 0xee, 0xa9,
+	0x4a, 0xda, 0x0d, 0xb5, 0xbd, 0x25, 0x6f, 0x1a, 0x0e, 0x6d, 0x38, 0x54, 0xab, 0x60, 0x4a, 0x7c,
+	0x51, 0xad, 0xbd, 0x55, 0x21, 0x1e, 0xde, 0xd2, 0x9a, 0xd8, 0xb4, 0x6c, 0xec, 0x59, 0x8e, 0xed,
+	0x6b, 0xcb, 0xf2, 0x80, 0x6d, 0x66, 0xc4, 0x3f, 0x5b, 0x18, 0x38, 0xf3, 0x3a, 0xe2, 0x28, 0x63,
+	0x3a, 0xa6, 0xc3, 0x3f, 0x35, 0xf6, 0x25, 0x76, 0x17, 0x4d, 0xc7, 0x31, 0xeb, 0x44, 0xc3, 0x4d,
+	0x4b, 0xc3, 0xb6, 0xed, 0x78, 0xdc, 0x13, 0x15, 0xa7, 0x79, 0x71, 0xca, 0x57, 0x95, 0xd6, 0x81,
+	0xe6, 0x59, 0x0d, 0x42, 0x3d, 0xdc, 0x68, 0xfa, 0x02, 0xca, 0x6f, 0x61, 0xfe, 0xcf, 0x0c, 0xed,
+	0xb6, 0x61, 0x38, 0x2d, 0xdb, 0xd3, 0xc9, 0xd3, 0x16, 0xa1, 0x1e, 0xca, 0x42, 0x12, 0x57, 0xab,
+	0x2e, 0xa1, 0x34, 0x2b, 0x2d, 0x4b, 0xeb, 0xd3, 0x7a, 0xb0, 0xfc, 0x5d, 0xea, 0x9f, 0x6f, 0xf3,
+	0x13, 0xdf, 0xbe, 0xcd, 0x4f, 0x28, 0x06, 0x64, 0xa2, 0xaa, 0xb4, 0xe9, 0xd8, 0x94, 0x30, 0xdd,
+	0x0a, 0xae, 0x63, 0xdb, 0x20, 0x81, 0xae, 0x58, 0xa2, 0xcb, 0x30, 0x6d, 0x38, 0x55, 0x52, 0xae,
+	0x61, 0x5a, 0xcb, 0x4e, 0xf2, 0xb3, 0x14, 0xdb, 0xb8, 0x87, 0x69, 0x0d, 0x65, 0x60, 0xca, 0x76,
+	0x98, 0x52, 0x6c, 0x59, 0x5a, 0x8f, 0xeb, 0xfe, 0x42, 0xf9, 0x3d, 0x2c, 0x70, 0x27, 0x25, 0x1e,
+	0xde, 0x9f, 0x80, 0xf2, 0x95, 0x04, 0xf2, 0x30, 0x0b, 0x02, 0xec, 0x35, 0x38, 0xe7, 0x67, 0xae,
+	0x1c, 0xb5, 0x34, 0xeb, 0xef, 0x6e, 0xfb, 0x9b, 0x48, 0x86, 0x14, 0x65, 0x4e, 0x19, 0xbe, 0x49,
+	0x8e, 0xaf, 0xbb, 0x66, 0x26, 0xb0, 0x6f, 0xb5, 0x6c, 0xb7, 0x1a, 0x15, 0xe2, 0x8a, 0x1b, 0xcc,
+	0x8a, 0xdd, 0x3f, 0xf1, 0x4d, 0xe5, 0x3e, 0x2c, 0x72, 0x1c, 0x7f, 0xc1, 0x75, 0xab, 0x8a, 0x3d,
+	0xc7, 0xed, 0xbb, 0xcc, 0x0a, 0xcc, 0x18, 0x8e, 0xdd, 0x8f, 0x23, 0xcd, 0xf6, 0xb6, 0x07, 0x6e,
+	0xf5, 0x2f, 0x09, 0x96, 0x46, 0x58, 0x13, 0x17, 0x5b, 0x83, 0xf3, 0x01, 0xaa, 0xa8, 0xc5, 0x00,
+	0xec, 0x27, 0xbc, 0x5a, 0x50, 0x44, 0x45, 0x3f, 0xcf, 0x1f, 0x93, 0x9e, 0x9b, 0x90, 0x89, 0xaa,
+	0x8e, 0x2b, 0x22, 0xe5, 0xbe, 0x70, 0xf6, 0xd8, 0x73, 0x5c, 0x6c, 0x8e, 0x77, 0x86, 0xe6, 0x20,
+	0x76, 0x48, 0x8e, 0x44, 0xbd, 0xb1, 0xcf, 0x90, 0xfb, 0xeb, 0x90, 0x89, 0x1a, 0x13, 0xee, 0x33,
+	0x30, 0xd5, 0xc6, 0xf5, 0x56, 0xe0, 0xdc, 0x5f, 0x28, 0xb7, 0x61, 0x4e, 0x94, 0x52, 0xf5, 0xa3,
+	0x2e, 0xb9, 0x06, 0xbf, 0x0a, 0xe9, 0x09, 0x17, 0x08, 0xe2, 0xac, 0xf6, 0xb9, 0xd6, 0x8c, 0xce,
+	0xbf, 0x95, 0x67, 0x80, 0xb8, 0xe0, 0x7e, 0xe7, 0x81, 0x63, 0xd2, 0xc0, 0x05, 0x82, 0x38, 0x7f,
+	0x31, 0xbe, 0x7d, 0xfe, 0x8d, 0xee, 0x02, 0xf4, 0xfa, 0x0a, 0xbf, 0x5b, 0xba, 0xb0, 0xaa, 0xfa,
+	0x45, 0xab, 0xb2, 0x26, 0xa4, 0xfa, 0xfd, 0x4a, 0x34, 0x21, 0xf5, 0x51, 0x2f, 0x54, 0x7a, 0x48,
+	0x33, 0x04, 0xf2, 0xb5, 0x04, 0xf3, 0x11, 0xe7, 0x02, 0xe7, 0x06, 0xc4, 0xeb, 0x8e, 0xc9, 0x6e,
+	0x17, 0x5b, 0x4f, 0x17, 0x2e, 0xa8, 0xfd, 0xad, 0x4f, 0x7d, 0xe0, 0x98, 0x3a, 0x17, 0x41, 0xbb,
+	0x43, 0x40, 0xad, 0x8d, 0x05, 0xe5, 0xfb, 0x09, 0xa3, 0x52, 0x32, 0x22, 0x0e, 0x8f, 0xb0, 0x8b,
+	0x1b, 0x41, 0x1c, 0x94, 0x87, 0x30, 0x1f, 0xd9, 0x15, 0x00, 0x6f, 0x43, 0xa2, 0xc9, 0x77, 0x78,
+	0x80, 0xd2, 0x85, 0xec, 0x20, 0x44, 0x5f, 0xa3, 0x18, 0x7f, 0x77, 0x9c, 0x9f, 0xd0, 0x85, 0xb4,
+	0xf2, 0x99, 0x04, 0xe7, 0x76, 0xbc, 0x5a, 0x09, 0xd7, 0xeb, 0xa1, 0x48, 0x63, 0xd7, 0xa4, 0x41,
+	0x4e, 0xd8, 0x37, 0xba, 0x04, 0x49, 0x13, 0xd3, 0xb2, 0x81, 0x9b, 0xe2, 0x79, 0x24, 0x4c, 0x4c,
+	0x4b, 0xb8, 0x89, 0x9e, 0xc0, 0x5c, 0xd3, 0x75, 0x9a, 0x0e, 0x25, 0x6e, 0xf7, 0x89, 0xb1, 0xe7,
+	0x31, 0x53, 0x2c, 0x7c, 0x77, 0x9c, 0x57, 0x4d, 0xcb, 0xab, 0xb5, 0x2a, 0xaa, 0xe1, 0x34, 0x34,
+	0x31, 0x1b, 0xfc, 0x9f, 0x1b, 0xb4, 0x7a, 0xa8, 0x79, 0x47, 0x4d, 0x42, 0xd5, 0x52, 0xef, 0x6d,
+	0xeb, 0xe7, 0x03, 0x5b, 0xc1, 0xbb, 0x5c, 0x80, 0x94, 0x51, 0xc3, 0x96, 0x5d, 0xb6, 0xaa, 0xd9,
+	0xf8, 0xb2, 0xb4, 0x1e, 0xd3, 0x93, 0x7c, 0xbd, 0x57, 0x55, 0xd6, 0x60, 0x7e, 0x87, 0x7a, 0x56,
+	0x03, 0x7b, 0x64, 0x17, 0xf7, 0x02, 0x31, 0x07, 0x31, 0x13, 0xfb, 0xe0, 0xe3, 0x3a, 0xfb, 0x54,
+	0x5e, 0xc6, 0x83, 0x9c, 0xba, 0xd8, 0x20, 0xfb, 0x9d, 0xe0, 0x9e, 0x5b, 0x10, 0x6b, 0x50, 0x53,
+	0xc4, 0x2b, 0x3f, 0x18, 0xaf, 0x87, 0xd4, 0xdc, 0x61, 0x7b, 0xa4, 0xd5, 0xd8, 0xef, 0xe8, 0x4c,
+	0x16, 0xdd, 0x81, 0x19, 0x8f, 0x19, 0x29, 0x1b, 0x8e, 0x7d, 0x60, 0x99, 0xfc, 0xa6, 0xe9, 0xc2,
+	0xd2, 0xa0, 0x2e, 0x77, 0x55, 0xe2, 0x42, 0x7a, 0xda, 0xeb, 0x2d, 0x50, 0x09, 0x66, 0x9a, 0x2e,
+	0xa9, 0x12, 0x83, 0x50, 0xea, 0xb8, 0x34, 0x1b, 0x5f, 0x8e, 0x9d, 0xc5, 0x7b, 0x44, 0x89, 0x75,
+	0xc9, 0x4a, 0xdd, 0x31, 0x0e, 0x83, 0x7e, 0x34, 0xc5, 0x23, 0x93, 0xe6, 0x7b, 0x7e, 0x37, 0x42,
+	0x4b, 0x00, 0xbe, 0x08, 0x7f, 0x34, 0x09, 0xfe, 0x68, 0xa6, 0xf9, 0x0e, 0x9f, 0x33, 0xa5, 0xe0,
+	0x98, 0x8d, 0xc2, 0x6c, 0x92, 0x5f, 0x43, 0x56, 0xfd, 0x39, 0xa9, 0x06, 0x73, 0x52, 0xdd, 0x0f,
+	0xe6, 0x64, 0x31, 0xc5, 0x8a, 0xe6, 0xcd, 0x57, 0x79, 0x49, 0x18, 0x61, 0x27, 0x43, 0x73, 0x9f,
+	0xfa, 0x79, 0x72, 0x3f, 0x1d, 0xc9, 0x3d, 0x52, 0x60, 0xd6, 0x87, 0xdf, 0xc0, 0x9d, 0x32, 0x4b,
+	0x37, 0x84, 0x22, 0xf0, 0x10, 0x77, 0x76, 0x31, 0xfd, 0x63, 0x3c, 0x35, 0x39, 0x17, 0xd3, 0x53,
+	0x5e, 0xa7, 0x6c, 0xd9, 0x55, 0xd2, 0x51, 0x36, 0x45, 0x97, 0xeb, 0x56, 0x41, 0xaf, 0x05, 0x55,
+	0xb1, 0x87, 0x83, 0x72, 0x67, 0xdf, 0xca, 0xff, 0x63, 0x70, 0xb1, 0x27, 0x5c, 0x64, 0x56, 0x43,
+	0x55, 0xe3, 0x75, 0x82, 0x46, 0x30, 0xbe, 0x6a, 0xbc, 0x0e, 0xfd, 0x04, 0x55, 0xf3, 0x4b, 0xc2,
+	0xc7, 0x27, 0x5c, 0xb9, 0x01, 0x97, 0x06, 0x72, 0x76, 0x4a, 0x8e, 0x2f, 0x74, 0xe7, 0x35, 0x25,
+	0x77, 0x49, 0x30, 0x17, 0x94, 0x27, 0x90, 0x89, 0x6e, 0x0b, 0x13, 0x3b, 0x90, 0x62, 0xcd, 0xbb,
+	0x7c, 0x40, 0xc4, 0x3c, 0x2c, 0x6e, 0x7e, 0x79, 0x9c, 0x5f, 0x3d, 0xc3, 0x9d, 0xf7, 0x6c, 0x8f,
+	0x0d, 0x6e, 0x6e, 0x4e, 0x51, 0x60, 0xd9, 0xe7, 0x8b, 0x6d, 0x6c, 0xd5, 0x71, 0xa5, 0x4e, 0x1e,
+	0xb9, 0xc4, 0x70, 0x1a, 0x4d, 0xab, 0x4e, 0xba, 0x2d, 0xbe, 0x01, 0x2b, 0xa7, 0xc8, 0x08, 0x3c,
+	0xf7, 0x20, 0xdd, 0xec, 0x6d, 0x8b, 0x7a, 0x5c, 0x1e, 0xd2, 0xf5, 0xbb, 0x42, 0x7b, 0xf6, 0x81,
+	0x23, 0xba, 0x7f, 0x58, 0x55, 0xf9, 0x41, 0x82, 0x73, 0x51, 0xa9, 0x53, 0x78, 0x44, 0x16, 0x92,
+	0xc4, 0x66, 0x98, 0xaa, 0x7c, 0x10, 0xa4, 0xf4, 0x60, 0x89, 0x2e, 0x42, 0x02, 0x1b, 0x9e, 0xd5,
+	0xf6, 0xb9, 0x6b, 0x4a, 0x17, 0x2b, 0x74, 0x07, 0x12, 0xa2, 0xee, 0xe3, 0xbc, 0xea, 0x94, 0xd3,
+	0x30, 0xfa, 0xf5, 0x1e, 0xcc, 0x28, 0x5f, 0x0f, 0x2d, 0x40, 0x0c, 0x57, 0x2c, 0x5e, 0xf4, 0xd3,
+	0xc5, 0xe4, 0xc9, 0x71, 0x3e, 0xb6, 0x5d, 0xdc, 0xd3, 0xd9, 0x1e, 0xfa, 0x03, 0xcc, 0xb0, 0xb9,
+	0x44, 0x8d, 0x1a, 0xa9, 0xb6, 0xea, 0x24, 0x9b, 0xe0, 0x61, 0xb8, 0x3c, 0xe4, 0x59, 0x12, 0xaf,
+	0xe6, 0x54, 0x77, 0x71, 0x30, 0xff, 0xd2, 0x26, 0xa6, 0x8f, 0x85, 0x56, 0xe1, 0xfb, 0x59, 0x98,
+	0xe2, 0x11, 0x47, 0xff, 0x90, 0x20, 0x29, 0x48, 0x24, 0xba, 0x36, 0x68, 0x65, 0xc8, 0xbf, 0x04,
+	0x79, 0x75, 0x9c, 0x98, 0x9f, 0x30, 0x65, 0xe3, 0xef, 0x9f, 0x7f, 0xf3, 0xef, 0xc9, 0x2b, 0x68,
+	0x45, 0xc3, 0xb6, 0x7b, 0xe4, 0x39, 0x76, 0xf0, 0xdf, 0x46, 0xd0, 0x48, 0xed, 0xb9, 0x88, 0xf1,
+	0x0b, 0xf4, 0x1f, 0x09, 0x66, 0x23, 0x4c, 0x1d, 0xfd, 0x7a, 0x84, 0x93, 0x61, 0xff, 0x08, 0xe4,
+	0xeb, 0x67, 0x13, 0x16, 0xb8, 0x6e, 0x72, 0x5c, 0x9b, 0x68, 0xbd, 0x1f, 0x57, 0xf0, 0x97, 0x60,
+	0x00, 0xde, 0xff, 0x24, 0x98, 0xeb, 0xa7, 0xdc, 0x48, 0x1d, 0xe1, 0x74, 0x04, 0xd3, 0x97, 0xb5,
+	0x33, 0xcb, 0x0b, 0x9c, 0xbf, 0xe1, 0x38, 0x0b, 0xe8, 0x66, 0x3f, 0xce, 0x76, 0xa0, 0xd1, 0x83,
+	0x1a, 0xfe, 0x0f, 0xf1, 0x02, 0xbd, 0x94, 0x20, 0x29, 0xa8, 0xf5, 0xc8, 0xa4, 0x46, 0x59, 0xbb,
+	0xbc, 0x3a, 0x4e, 0x4c, 0x80, 0xda, 0xe4, 0xa0, 0xae, 0x22, 0xa5, 0x1f, 0x94, 0x20, 0xea, 0x34,
+	0x14, 0xb6, 0xd7, 0x12, 0x24, 0x05, 0xc5, 0x1e, 0x09, 0x23, 0xca, 0xe7, 0xe5, 0xd5, 0x71, 0x62,
+	0x02, 0x86, 0xc6, 0x61, 0x6c, 0xa0, 0xb5, 0x7e, 0x18, 0xd4, 0x17, 0xec, 0xa1, 0xd0, 0x9e, 0x1f,
+	0x92, 0xa3, 0x17, 0xa8, 0x03, 0x71, 0xc6, 0xc3, 0x91, 0x32, 0xb2, 0x54, 0xba, 0xe4, 0x5e, 0xbe,
+	0x72, 0xaa, 0x8c, 0x40, 0xb0, 0xc6, 0x11, 0xac, 0xa0, 0xfc, 0x60, 0x15, 0x55, 0x23, 0x51, 0x68,
+	0x41, 0xc2, 0x27, 0xa2, 0xe8, 0xea, 0x08, 0xbb, 0x11, 0xbe, 0x2b, 0x5f, 0x1b, 0x23, 0x25, 0xfc,
+	0xe7, 0xb8, 0xff, 0x2c, 0xba, 0xd8, 0xef, 0xdf, 0xe7, 0xb9, 0xa8, 0x0d, 0x49, 0x41, 0x73, 0xd1,
+	0x90, 0x26, 0x19, 0x65, 0xc0, 0xf2, 0xda, 0xb8, 0xb1, 0x1e, 0x78, 0x5d, 0xe6, 0x5e, 0x65, 0x94,
+	0xed, 0xf7, 0x4a, 0xbc, 0x5a, 0xd9, 0x60, 0xce, 0xfe, 0x06, 0xe9, 0x10, 0x4b, 0x3d, 0x83, 0xef,
+	0x21, 0xf7, 0x1d, 0x42, 0x73, 0x95, 0xab, 0xdc, 0x73, 0x0e, 0x2d, 0x0e, 0x78, 0x16, 0xc2, 0x6c,
+	0x4a, 0xa2, 0x67, 0x90, 0x14, 0x74, 0x67, 0x64, 0xc5, 0x45, 0x49, 0xb1, 0xbc, 0x3a, 0x4e, 0x6c,
+	0xdc, 0xcd, 0x7d, 0xa6, 0xe3, 0x75, 0xd0, 0x2b, 0x09, 0xa0, 0x37, 0x8a, 0xd1, 0xfa, 0x69, 0x86,
+	0xc3, 0x0c, 0x4b, 0xde, 0x38, 0x83, 0xa4, 0x40, 0x71, 0x85, 0xa3, 0x58, 0x42, 0x97, 0x87, 0xa3,
+	0xe0, 0xec, 0x80, 0x05, 0x41, 0x0c, 0xf3, 0x53, 0x5e, 0x7f, 0x98, 0x03, 0xc8, 0xab, 0xe3, 0xc4,
+	0xc6, 0x05, 0x21, 0x60, 0x0a, 0xe8, 0xbf, 0x12, 0x64, 0x86, 0x8d, 0x71, 0x54, 0x18, 0x35, 0x35,
+	0x46, 0xf3, 0x02, 0xf9, 0xd6, 0x47, 0xe9, 0x8c, 0x0b, 0x51, 0x88, 0x02, 0x14, 0x4b, 0xef, 0x4e,
+	0x72, 0xd2, 0xfb, 0x93, 0x9c, 0xf4, 0xf5, 0x49, 0x4e, 0x7a, 0xf3, 0x21, 0x37, 0xf1, 0xfe, 0x43,
+	0x6e, 0xe2, 0x8b, 0x0f, 0xb9, 0x89, 0xbf, 0x6e, 0x84, 0x08, 0x4e, 0x60, 0x20, 0xf8, 0x6d, 0x17,
+	0xb4, 0x0e, 0xb7, 0xc6, 0x79, 0x4e, 0x25, 0xc1, 0x69, 0xe4, 0xad, 0x1f, 0x07, 0x00, 0xae, 0x94,
+	0x61, 0x85, 0x36, 0x14, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1466,6 +1658,9 @@ type QueryClient interface {
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
+	// AvailablePrecompiles queries the available precompiled contracts, whether
+	// they are active and their ABIs.
+	AvailablePrecompiles(ctx context.Context, in *QueryAvailablePrecompilesRequest, opts ...grpc.CallOption) (*QueryAvailablePrecompilesResponse, error)
 }
 
 type queryClient struct {
@@ -1584,6 +1779,15 @@ func (c *queryClient) BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) AvailablePrecompiles(ctx context.Context, in *QueryAvailablePrecompilesRequest, opts ...grpc.CallOption) (*QueryAvailablePrecompilesResponse, error) {
+	out := new(QueryAvailablePrecompilesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Query/AvailablePrecompiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Account queries an Ethereum account.
@@ -1613,6 +1817,9 @@ type QueryServer interface {
 	// BaseFee queries the base fee of the parent block of the current block,
 	// it's similar to feemarket module's method, but also checks london hardfork status.
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
+	// AvailablePrecompiles queries the available precompiled contracts, whether
+	// they are active and their ABIs.
+	AvailablePrecompiles(context.Context, *QueryAvailablePrecompilesRequest) (*QueryAvailablePrecompilesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1655,6 +1862,9 @@ func (*UnimplementedQueryServer) TraceBlock(ctx context.Context, req *QueryTrace
 func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BaseFee not implemented")
 }
+func (*UnimplementedQueryServer) AvailablePrecompiles(ctx context.Context, req *QueryAvailablePrecompilesRequest) (*QueryAvailablePrecompilesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AvailablePrecompiles not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1876,6 +2086,24 @@ func _Query_BaseFee_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AvailablePrecompiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAvailablePrecompilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AvailablePrecompiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Query/AvailablePrecompiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AvailablePrecompiles(ctx, req.(*QueryAvailablePrecompilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1928,6 +2156,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BaseFee",
 			Handler:    _Query_BaseFee_Handler,
 		},
+		{
+			MethodName: "AvailablePrecompiles",
+			Handler:    _Query_AvailablePrecompiles_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/query.proto",
@@ -2856,6 +3088,147 @@ func (m *QueryBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryAvailablePrecompilesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvailablePrecompilesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvailablePrecompilesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAvailablePrecompilesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAvailablePrecompilesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAvailablePrecompilesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Precompiles) > 0 {
+		for iNdEx := len(m.Precompiles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Precompiles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PrecompileInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PrecompileInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PrecompileInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GasSchedule) > 0 {
+		for iNdEx := len(m.GasSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GasSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ABI) > 0 {
+		i -= len(m.ABI)
+		copy(dAtA[i:], m.ABI)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ABI)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -3265,24 +3638,79 @@ func (m *QueryBaseFeeResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryAvailablePrecompilesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *QueryAvailablePrecompilesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Precompiles) > 0 {
+		for _, e := range m.Precompiles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
 }
-func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+func (m *PrecompileInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	if m.Active {
+		n += 2
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.ABI)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.GasSchedule) > 0 {
+		for _, e := range m.GasSchedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -5904,6 +6332,361 @@ func (m *QueryBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryAvailablePrecompilesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvailablePrecompilesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvailablePrecompilesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAvailablePrecompilesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAvailablePrecompilesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAvailablePrecompilesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precompiles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Precompiles = append(m.Precompiles, PrecompileInfo{})
+			if err := m.Precompiles[len(m.Precompiles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PrecompileInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PrecompileInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PrecompileInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Active = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ABI", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ABI = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GasSchedule = append(m.GasSchedule, MethodGas{})
+			if err := m.GasSchedule[len(m.GasSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// panicSelector is the selector of the Solidity Panic(uint256) error.
+var panicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+
+// panicReasons are the descriptions of the Solidity panic codes.
+// See: https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic panic",
+	0x01: "assert(false)",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "enum overflow",
+	0x22: "invalid encoded storage byte array accessed",
+	0x31: "out-of-bounds array access; popping on an empty array",
+	0x32: "out-of-bounds access of an array or bytesN",
+	0x41: "out of memory",
+	0x51: "uninitialized function",
+}
+
+// erc20ErrorsABI is the ABI of the standard ERC20 custom errors (ERC-6093),
+// which are registered by default.
+const erc20ErrorsABI = `[
+	{"type":"error","name":"ERC20InsufficientBalance","inputs":[{"name":"sender","type":"address"},{"name":"balance","type":"uint256"},{"name":"needed","type":"uint256"}]},
+	{"type":"error","name":"ERC20InvalidSender","inputs":[{"name":"sender","type":"address"}]},
+	{"type":"error","name":"ERC20InvalidReceiver","inputs":[{"name":"receiver","type":"address"}]},
+	{"type":"error","name":"ERC20InsufficientAllowance","inputs":[{"name":"spender","type":"address"},{"name":"allowance","type":"uint256"},{"name":"needed","type":"uint256"}]},
+	{"type":"error","name":"ERC20InvalidApprover","inputs":[{"name":"approver","type":"address"}]},
+	{"type":"error","name":"ERC20InvalidSpender","inputs":[{"name":"spender","type":"address"}]}
+]`
+
+var (
+	customErrorsMu sync.RWMutex
+	// customErrors are the known custom errors indexed by their 4 bytes selector
+	customErrors = make(map[[4]byte]abi.Error)
+)
+
+func init() {
+	erc20Errors, err := abi.JSON(strings.NewReader(erc20ErrorsABI))
+	if err != nil {
+		panic(err)
+	}
+	RegisterCustomErrors(erc20Errors)
+}
+
+// RegisterCustomErrors registers the custom errors of the given contract ABI so
+// that they can be decoded from the revert data of failed executions.
+func RegisterCustomErrors(contractABI abi.ABI) {
+	customErrorsMu.Lock()
+	defer customErrorsMu.Unlock()
+
+	for _, customErr := range contractABI.Errors {
+		var selector [4]byte
+		copy(selector[:], customErr.ID[:4])
+		customErrors[selector] = customErr
+	}
+}
+
+// DecodeRevertReason decodes the data supplied with the revert opcode into a
+// human readable reason. It supports the Error(string) and Panic(uint256)
+// errors emitted by Solidity and the registered custom errors. It returns false
+// if the data can't be decoded.
+func DecodeRevertReason(data []byte) (string, bool) {
+	if len(data) < 4 {
+		return "", false
+	}
+
+	if reason, err := abi.UnpackRevert(data); err == nil {
+		return reason, true
+	}
+
+	if bytes.Equal(data[:4], panicSelector) {
+		return decodePanic(data[4:])
+	}
+
+	return decodeCustomError(data)
+}
+
+// decodePanic decodes the code argument of a Panic(uint256) error.
+func decodePanic(data []byte) (string, bool) {
+	if len(data) != 32 {
+		return "", false
+	}
+
+	code := new(big.Int).SetBytes(data)
+	if !code.IsUint64() {
+		return fmt.Sprintf("panic: unknown panic code %s", hexutil.EncodeBig(code)), true
+	}
+
+	reason, ok := panicReasons[code.Uint64()]
+	if !ok {
+		reason = "unknown panic code"
+	}
+	return fmt.Sprintf("panic: %s (%s)", reason, hexutil.EncodeBig(code)), true
+}
+
+// decodeCustomError decodes a registered custom error into its name and
+// arguments, e.g. ERC20InvalidSender(0x0000000000000000000000000000000000000000).
+func decodeCustomError(data []byte) (string, bool) {
+	var selector [4]byte
+	copy(selector[:], data[:4])
+
+	customErrorsMu.RLock()
+	customErr, ok := customErrors[selector]
+	customErrorsMu.RUnlock()
+	if !ok {
+		return "", false
+	}
+
+	unpacked, err := customErr.Unpack(data)
+	if err != nil {
+		return "", false
+	}
+
+	values, _ := unpacked.([]interface{})
+	args := make([]string, len(values))
+	for i, value := range values {
+		switch v := value.(type) {
+		case []byte:
+			args[i] = hexutil.Encode(v)
+		default:
+			args[i] = fmt.Sprintf("%v", v)
+		}
+	}
+
+	return fmt.Sprintf("%s(%s)", customErr.Name, strings.Join(args, ", ")), true
+}
@@ -0,0 +1,138 @@
+package types
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeRevertReason(t *testing.T) {
+	uint256Type, _ := abi.NewType("uint256", "", nil)
+	stringType, _ := abi.NewType("string", "", nil)
+	addressType, _ := abi.NewType("address", "", nil)
+
+	pack := func(sig string, args abi.Arguments, values ...interface{}) []byte {
+		bz, err := args.Pack(values...)
+		require.NoError(t, err)
+		return append(crypto.Keccak256([]byte(sig))[:4], bz...)
+	}
+
+	customABI, err := abi.JSON(strings.NewReader(`[{"type":"error","name":"Unauthorized","inputs":[{"name":"caller","type":"address"},{"name":"data","type":"bytes"}]}]`))
+	require.NoError(t, err)
+	RegisterCustomErrors(customABI)
+	bytesType, _ := abi.NewType("bytes", "", nil)
+
+	addr := common.BytesToAddress([]byte{1})
+
+	testCases := []struct {
+		name      string
+		data      []byte
+		expReason string
+		expPass   bool
+	}{
+		{
+			"empty data",
+			nil,
+			"",
+			false,
+		},
+		{
+			"Error(string)",
+			pack("Error(string)", abi.Arguments{{Type: stringType}}, "insufficient funds"),
+			"insufficient funds",
+			true,
+		},
+		{
+			"Panic(uint256) with known code",
+			pack("Panic(uint256)", abi.Arguments{{Type: uint256Type}}, big.NewInt(0x11)),
+			"panic: arithmetic underflow or overflow (0x11)",
+			true,
+		},
+		{
+			"Panic(uint256) with unknown code",
+			pack("Panic(uint256)", abi.Arguments{{Type: uint256Type}}, big.NewInt(0x99)),
+			"panic: unknown panic code (0x99)",
+			true,
+		},
+		{
+			"default ERC20 custom error",
+			pack(
+				"ERC20InsufficientBalance(address,uint256,uint256)",
+				abi.Arguments{{Type: addressType}, {Type: uint256Type}, {Type: uint256Type}},
+				addr, big.NewInt(1), big.NewInt(2),
+			),
+			"ERC20InsufficientBalance(" + addr.Hex() + ", 1, 2)",
+			true,
+		},
+		{
+			"registered custom error",
+			pack("Unauthorized(address,bytes)", abi.Arguments{{Type: addressType}, {Type: bytesType}}, addr, []byte{0xab}),
+			"Unauthorized(" + addr.Hex() + ", 0xab)",
+			true,
+		},
+		{
+			"unknown custom error",
+			pack("Unknown(uint256)", abi.Arguments{{Type: uint256Type}}, big.NewInt(1)),
+			"",
+			false,
+		},
+		{
+			"malformed custom error",
+			hexutil.MustDecode("0xe450d38c"),
+			"",
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		reason, ok := DecodeRevertReason(tc.data)
+		require.Equal(t, tc.expPass, ok, tc.name)
+		require.Equal(t, tc.expReason, reason, tc.name)
+	}
+}
+
+func TestNewExecErrorWithReason(t *testing.T) {
+	stringType, _ := abi.NewType("string", "", nil)
+	bz, err := abi.Arguments{{Type: stringType}}.Pack("not owner")
+	require.NoError(t, err)
+	data := append(crypto.Keccak256([]byte("Error(string)"))[:4], bz...)
+
+	revertErr := NewExecErrorWithReason(data)
+	require.Equal(t, "execution reverted: not owner", revertErr.Error())
+	require.Equal(t, 3, revertErr.ErrorCode())
+	require.Equal(t, hexutil.Encode(data), revertErr.ErrorData())
+
+	revertErr = NewExecErrorWithReason([]byte{1, 2, 3, 4})
+	require.Equal(t, "execution reverted", revertErr.Error())
+	require.Equal(t, "0x01020304", revertErr.ErrorData())
+}
+
+func TestRevertErrorFromMessage(t *testing.T) {
+	stringType, _ := abi.NewType("string", "", nil)
+	bz, err := abi.Arguments{{Type: stringType}}.Pack("not allowed")
+	require.NoError(t, err)
+	data := append(crypto.Keccak256([]byte("Error(string)"))[:4], bz...)
+
+	revertErr := NewExecErrorWithRevertData(data)
+	require.Equal(t, hexutil.Encode(data), revertErr.ErrorData())
+
+	// the gRPC status wraps the error message
+	msg := "rpc error: code = Unknown desc = " + revertErr.Error() + ": unknown request"
+	rebuilt, ok := RevertErrorFromMessage(msg)
+	require.True(t, ok)
+	require.Equal(t, "execution reverted: not allowed", rebuilt.Error())
+	require.Equal(t, hexutil.Encode(data), rebuilt.ErrorData())
+
+	rebuilt, ok = RevertErrorFromMessage(NewExecErrorWithRevertData(nil).Error())
+	require.True(t, ok)
+	require.Equal(t, "execution reverted", rebuilt.Error())
+
+	_, ok = RevertErrorFromMessage("out of gas")
+	require.False(t, ok)
+}
@@ -295,6 +295,161 @@ func (m *ExtensionOptionsEthereumTx) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExtensionOptionsEthereumTx proto.InternalMessageInfo
 
+// ExtensionOptionsEthereumTxConditional is an extension option for ethereum
+// transactions that must only be included while the given conditions hold. It
+// replaces ExtensionOptionsEthereumTx on conditional transactions, and it may be
+// combined with ExtensionOptionsEthereumTxSponsored.
+type ExtensionOptionsEthereumTxConditional struct {
+	// block_number_min is the minimum block height for inclusion (0 = no minimum)
+	BlockNumberMin uint64 `protobuf:"varint,1,opt,name=block_number_min,json=blockNumberMin,proto3" json:"block_number_min,omitempty"`
+	// block_number_max is the maximum block height for inclusion (0 = no maximum)
+	BlockNumberMax uint64 `protobuf:"varint,2,opt,name=block_number_max,json=blockNumberMax,proto3" json:"block_number_max,omitempty"`
+	// timestamp_min is the minimum block unix timestamp for inclusion (0 = no minimum)
+	TimestampMin uint64 `protobuf:"varint,3,opt,name=timestamp_min,json=timestampMin,proto3" json:"timestamp_min,omitempty"`
+	// timestamp_max is the maximum block unix timestamp for inclusion (0 = no maximum)
+	TimestampMax uint64 `protobuf:"varint,4,opt,name=timestamp_max,json=timestampMax,proto3" json:"timestamp_max,omitempty"`
+	// known_accounts are the expected storage values of the given accounts
+	KnownAccounts []KnownAccount `protobuf:"bytes,5,rep,name=known_accounts,json=knownAccounts,proto3" json:"known_accounts"`
+	// signature is the signature by the sender of the ethereum transaction of the
+	// transaction hash and conditions, so that they cannot be altered by a relayer
+	Signature []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ExtensionOptionsEthereumTxConditional) Reset()         { *m = ExtensionOptionsEthereumTxConditional{} }
+func (m *ExtensionOptionsEthereumTxConditional) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionsEthereumTxConditional) ProtoMessage()    {}
+func (*ExtensionOptionsEthereumTxConditional) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{5}
+}
+func (m *ExtensionOptionsEthereumTxConditional) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionsEthereumTxConditional) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionsEthereumTxConditional.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionsEthereumTxConditional) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionsEthereumTxConditional.Merge(m, src)
+}
+func (m *ExtensionOptionsEthereumTxConditional) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionsEthereumTxConditional) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionsEthereumTxConditional.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionsEthereumTxConditional proto.InternalMessageInfo
+
+// ExtensionOptionsEthereumTxSponsored is an extension option for ethereum
+// transactions whose fees are paid by a fee granter, through a x/feegrant
+// allowance granted to the sender. It replaces ExtensionOptionsEthereumTx on
+// sponsored transactions, and it may be combined with
+// ExtensionOptionsEthereumTxConditional.
+type ExtensionOptionsEthereumTxSponsored struct {
+	// fee_granter is the bech32 address of the account paying the fees
+	FeeGranter string `protobuf:"bytes,1,opt,name=fee_granter,json=feeGranter,proto3" json:"fee_granter,omitempty"`
+	// signature is the signature by the sender of the ethereum transaction of the
+	// transaction hash and fee granter, so that they cannot be altered by a relayer
+	Signature []byte `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *ExtensionOptionsEthereumTxSponsored) Reset()         { *m = ExtensionOptionsEthereumTxSponsored{} }
+func (m *ExtensionOptionsEthereumTxSponsored) String() string { return proto.CompactTextString(m) }
+func (*ExtensionOptionsEthereumTxSponsored) ProtoMessage()    {}
+func (*ExtensionOptionsEthereumTxSponsored) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{6}
+}
+func (m *ExtensionOptionsEthereumTxSponsored) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtensionOptionsEthereumTxSponsored) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtensionOptionsEthereumTxSponsored.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtensionOptionsEthereumTxSponsored) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtensionOptionsEthereumTxSponsored.Merge(m, src)
+}
+func (m *ExtensionOptionsEthereumTxSponsored) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtensionOptionsEthereumTxSponsored) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtensionOptionsEthereumTxSponsored.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtensionOptionsEthereumTxSponsored proto.InternalMessageInfo
+
+// KnownAccount defines the expected storage slot values of an account for a
+// conditional ethereum transaction.
+type KnownAccount struct {
+	// address is the hex formatted address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// storage are the expected values of the account storage slots
+	Storage []State `protobuf:"bytes,2,rep,name=storage,proto3" json:"storage"`
+}
+
+func (m *KnownAccount) Reset()         { *m = KnownAccount{} }
+func (m *KnownAccount) String() string { return proto.CompactTextString(m) }
+func (*KnownAccount) ProtoMessage()    {}
+func (*KnownAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{7}
+}
+func (m *KnownAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KnownAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KnownAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KnownAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KnownAccount.Merge(m, src)
+}
+func (m *KnownAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *KnownAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_KnownAccount.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KnownAccount proto.InternalMessageInfo
+
+func (m *KnownAccount) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *KnownAccount) GetStorage() []State {
+	if m != nil {
+		return m.Storage
+	}
+	return nil
+}
+
 // MsgEthereumTxResponse defines the Msg/EthereumTx response type.
 type MsgEthereumTxResponse struct {
 	// hash of the ethereum transaction in hex format. This hash differs from the
@@ -317,7 +472,7 @@ func (m *MsgEthereumTxResponse) Reset()         { *m = MsgEthereumTxResponse{} }
 func (m *MsgEthereumTxResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEthereumTxResponse) ProtoMessage()    {}
 func (*MsgEthereumTxResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{5}
+	return fileDescriptor_f75ac0a12d075f21, []int{8}
 }
 func (m *MsgEthereumTxResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +514,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{6}
+	return fileDescriptor_f75ac0a12d075f21, []int{9}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -411,7 +566,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f75ac0a12d075f21, []int{7}
+	return fileDescriptor_f75ac0a12d075f21, []int{10}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -440,83 +595,429 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgUpdateAccessControlList defines a Msg for adding and removing addresses of
+// an access control list of the x/evm parameters.
+type MsgUpdateAccessControlList struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// list defines the access control list to update
+	List AccessControlList `protobuf:"varint,2,opt,name=list,proto3,enum=ethermint.evm.v1.AccessControlList" json:"list,omitempty"`
+	// add defines the hex addresses added to the list
+	Add []string `protobuf:"bytes,3,rep,name=add,proto3" json:"add,omitempty"`
+	// remove defines the hex addresses removed from the list
+	Remove []string `protobuf:"bytes,4,rep,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgUpdateAccessControlList) Reset()         { *m = MsgUpdateAccessControlList{} }
+func (m *MsgUpdateAccessControlList) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccessControlList) ProtoMessage()    {}
+func (*MsgUpdateAccessControlList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{11}
+}
+func (m *MsgUpdateAccessControlList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccessControlList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccessControlList.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAccessControlList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccessControlList.Merge(m, src)
+}
+func (m *MsgUpdateAccessControlList) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccessControlList) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccessControlList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAccessControlList proto.InternalMessageInfo
+
+func (m *MsgUpdateAccessControlList) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateAccessControlList) GetList() AccessControlList {
+	if m != nil {
+		return m.List
+	}
+	return AccessControlListUnspecified
+}
+
+func (m *MsgUpdateAccessControlList) GetAdd() []string {
+	if m != nil {
+		return m.Add
+	}
+	return nil
+}
+
+func (m *MsgUpdateAccessControlList) GetRemove() []string {
+	if m != nil {
+		return m.Remove
+	}
+	return nil
+}
+
+// MsgUpdateAccessControlListResponse defines the response structure for
+// executing a MsgUpdateAccessControlList message.
+type MsgUpdateAccessControlListResponse struct {
+}
+
+func (m *MsgUpdateAccessControlListResponse) Reset()         { *m = MsgUpdateAccessControlListResponse{} }
+func (m *MsgUpdateAccessControlListResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateAccessControlListResponse) ProtoMessage()    {}
+func (*MsgUpdateAccessControlListResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{12}
+}
+func (m *MsgUpdateAccessControlListResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateAccessControlListResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateAccessControlListResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateAccessControlListResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateAccessControlListResponse.Merge(m, src)
+}
+func (m *MsgUpdateAccessControlListResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateAccessControlListResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateAccessControlListResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateAccessControlListResponse proto.InternalMessageInfo
+
+// MsgTogglePrecompile defines a Msg for adding an available precompiled contract
+// to the active precompiles or removing it from them.
+type MsgTogglePrecompile struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// address is the hex address of the precompiled contract
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// enabled defines if the precompiled contract is added to the active
+	// precompiles or removed from them
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (m *MsgTogglePrecompile) Reset()         { *m = MsgTogglePrecompile{} }
+func (m *MsgTogglePrecompile) String() string { return proto.CompactTextString(m) }
+func (*MsgTogglePrecompile) ProtoMessage()    {}
+func (*MsgTogglePrecompile) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{13}
+}
+func (m *MsgTogglePrecompile) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTogglePrecompile) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTogglePrecompile.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTogglePrecompile) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTogglePrecompile.Merge(m, src)
+}
+func (m *MsgTogglePrecompile) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTogglePrecompile) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTogglePrecompile.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTogglePrecompile proto.InternalMessageInfo
+
+func (m *MsgTogglePrecompile) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgTogglePrecompile) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgTogglePrecompile) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+// MsgTogglePrecompileResponse defines the response structure for executing a
+// MsgTogglePrecompile message.
+type MsgTogglePrecompileResponse struct {
+}
+
+func (m *MsgTogglePrecompileResponse) Reset()         { *m = MsgTogglePrecompileResponse{} }
+func (m *MsgTogglePrecompileResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTogglePrecompileResponse) ProtoMessage()    {}
+func (*MsgTogglePrecompileResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{14}
+}
+func (m *MsgTogglePrecompileResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTogglePrecompileResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTogglePrecompileResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTogglePrecompileResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTogglePrecompileResponse.Merge(m, src)
+}
+func (m *MsgTogglePrecompileResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTogglePrecompileResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTogglePrecompileResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTogglePrecompileResponse proto.InternalMessageInfo
+
+// MsgUpdatePrecompileConfig defines a Msg for replacing the configuration of a
+// precompiled contract.
+type MsgUpdatePrecompileConfig struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// config defines the new configuration of the precompiled contract. A config
+	// without activation height nor disabled methods removes the configuration.
+	Config PrecompileConfig `protobuf:"bytes,2,opt,name=config,proto3" json:"config"`
+}
+
+func (m *MsgUpdatePrecompileConfig) Reset()         { *m = MsgUpdatePrecompileConfig{} }
+func (m *MsgUpdatePrecompileConfig) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrecompileConfig) ProtoMessage()    {}
+func (*MsgUpdatePrecompileConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{15}
+}
+func (m *MsgUpdatePrecompileConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrecompileConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrecompileConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrecompileConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrecompileConfig.Merge(m, src)
+}
+func (m *MsgUpdatePrecompileConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrecompileConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrecompileConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePrecompileConfig proto.InternalMessageInfo
+
+func (m *MsgUpdatePrecompileConfig) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdatePrecompileConfig) GetConfig() PrecompileConfig {
+	if m != nil {
+		return m.Config
+	}
+	return PrecompileConfig{}
+}
+
+// MsgUpdatePrecompileConfigResponse defines the response structure for
+// executing a MsgUpdatePrecompileConfig message.
+type MsgUpdatePrecompileConfigResponse struct {
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) Reset()         { *m = MsgUpdatePrecompileConfigResponse{} }
+func (m *MsgUpdatePrecompileConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePrecompileConfigResponse) ProtoMessage()    {}
+func (*MsgUpdatePrecompileConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f75ac0a12d075f21, []int{16}
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePrecompileConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePrecompileConfigResponse.Merge(m, src)
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePrecompileConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePrecompileConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePrecompileConfigResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgEthereumTx)(nil), "ethermint.evm.v1.MsgEthereumTx")
 	proto.RegisterType((*LegacyTx)(nil), "ethermint.evm.v1.LegacyTx")
 	proto.RegisterType((*AccessListTx)(nil), "ethermint.evm.v1.AccessListTx")
 	proto.RegisterType((*DynamicFeeTx)(nil), "ethermint.evm.v1.DynamicFeeTx")
 	proto.RegisterType((*ExtensionOptionsEthereumTx)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTx")
+	proto.RegisterType((*ExtensionOptionsEthereumTxConditional)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTxConditional")
+	proto.RegisterType((*ExtensionOptionsEthereumTxSponsored)(nil), "ethermint.evm.v1.ExtensionOptionsEthereumTxSponsored")
+	proto.RegisterType((*KnownAccount)(nil), "ethermint.evm.v1.KnownAccount")
 	proto.RegisterType((*MsgEthereumTxResponse)(nil), "ethermint.evm.v1.MsgEthereumTxResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "ethermint.evm.v1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "ethermint.evm.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdateAccessControlList)(nil), "ethermint.evm.v1.MsgUpdateAccessControlList")
+	proto.RegisterType((*MsgUpdateAccessControlListResponse)(nil), "ethermint.evm.v1.MsgUpdateAccessControlListResponse")
+	proto.RegisterType((*MsgTogglePrecompile)(nil), "ethermint.evm.v1.MsgTogglePrecompile")
+	proto.RegisterType((*MsgTogglePrecompileResponse)(nil), "ethermint.evm.v1.MsgTogglePrecompileResponse")
+	proto.RegisterType((*MsgUpdatePrecompileConfig)(nil), "ethermint.evm.v1.MsgUpdatePrecompileConfig")
+	proto.RegisterType((*MsgUpdatePrecompileConfigResponse)(nil), "ethermint.evm.v1.MsgUpdatePrecompileConfigResponse")
 }
 
 func init() { proto.RegisterFile("ethermint/evm/v1/tx.proto", fileDescriptor_f75ac0a12d075f21) }
 
 var fileDescriptor_f75ac0a12d075f21 = []byte{
-	// 978 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0x4f, 0x8f, 0x1b, 0x35,
-	0x14, 0xcf, 0x24, 0x93, 0x7f, 0x4e, 0x58, 0xaa, 0xd1, 0x56, 0x9d, 0x04, 0xc8, 0xa4, 0x91, 0x80,
-	0xb4, 0x52, 0x66, 0xd4, 0x80, 0x7a, 0xd8, 0x0b, 0x6c, 0xba, 0xdb, 0xaa, 0xd5, 0xae, 0xa8, 0x86,
-	0xf4, 0x42, 0x91, 0x22, 0xef, 0x8c, 0xd7, 0xb1, 0xc8, 0x8c, 0x47, 0x63, 0x67, 0x94, 0x70, 0xec,
-	0x89, 0x1b, 0x20, 0xbe, 0x00, 0x07, 0x4e, 0x9c, 0x90, 0xe8, 0x07, 0xe0, 0x58, 0x71, 0xaa, 0xe0,
-	0x82, 0x38, 0x04, 0x94, 0x45, 0x42, 0xda, 0x1b, 0x7c, 0x02, 0x64, 0x7b, 0xb2, 0xd9, 0x34, 0x6c,
-	0x0b, 0x65, 0x51, 0x2f, 0x89, 0x9f, 0xdf, 0xf3, 0x7b, 0xcf, 0xbf, 0xdf, 0xcf, 0xf6, 0x80, 0x1a,
-	0xe2, 0x43, 0x14, 0x07, 0x24, 0xe4, 0x0e, 0x4a, 0x02, 0x27, 0xb9, 0xe6, 0xf0, 0x89, 0x1d, 0xc5,
-	0x94, 0x53, 0xe3, 0xc2, 0x89, 0xcb, 0x46, 0x49, 0x60, 0x27, 0xd7, 0xea, 0x97, 0x3c, 0xca, 0x02,
-	0xca, 0x9c, 0x80, 0x61, 0x11, 0x19, 0x30, 0xac, 0x42, 0xeb, 0x35, 0xe5, 0x18, 0x48, 0xcb, 0x51,
-	0x46, 0xea, 0xaa, 0xaf, 0x15, 0x10, 0xc9, 0x94, 0x6f, 0x13, 0x53, 0x4c, 0xd5, 0x1a, 0x31, 0x4a,
-	0x67, 0x5f, 0xc5, 0x94, 0xe2, 0x11, 0x72, 0x60, 0x44, 0x1c, 0x18, 0x86, 0x94, 0x43, 0x4e, 0x68,
-	0xb8, 0xc8, 0x57, 0x4b, 0xbd, 0xd2, 0x3a, 0x18, 0x1f, 0x3a, 0x30, 0x9c, 0x2a, 0x57, 0xeb, 0x53,
-	0x0d, 0xbc, 0xb4, 0xcf, 0xf0, 0xae, 0x28, 0x88, 0xc6, 0x41, 0x7f, 0x62, 0xb4, 0x81, 0xee, 0x43,
-	0x0e, 0x4d, 0xad, 0xa9, 0xb5, 0x2b, 0xdd, 0x4d, 0x5b, 0xad, 0xb5, 0x17, 0x6b, 0xed, 0xed, 0x70,
-	0xea, 0xca, 0x08, 0xa3, 0x06, 0x74, 0x46, 0x3e, 0x46, 0x66, 0xb6, 0xa9, 0xb5, 0xb5, 0x5e, 0xfe,
-	0x78, 0x66, 0x69, 0x1d, 0x57, 0x4e, 0x19, 0x16, 0xd0, 0x87, 0x90, 0x0d, 0xcd, 0x5c, 0x53, 0x6b,
-	0x97, 0x7b, 0x95, 0x3f, 0x67, 0x56, 0x31, 0x1e, 0x45, 0x5b, 0xad, 0x4e, 0xcb, 0x95, 0x0e, 0xc3,
-	0x00, 0xfa, 0x61, 0x4c, 0x03, 0x53, 0x17, 0x01, 0xae, 0x1c, 0x6f, 0xe9, 0x9f, 0x7c, 0x69, 0x65,
-	0x5a, 0xdf, 0x66, 0x41, 0x69, 0x0f, 0x61, 0xe8, 0x4d, 0xfb, 0x13, 0x63, 0x13, 0xe4, 0x43, 0x1a,
-	0x7a, 0x48, 0x76, 0xa3, 0xbb, 0xca, 0x30, 0x6e, 0x81, 0x32, 0x86, 0x02, 0x39, 0xe2, 0xa9, 0xea,
-	0xe5, 0xde, 0xd5, 0x9f, 0x67, 0xd6, 0x1b, 0x98, 0xf0, 0xe1, 0xf8, 0xc0, 0xf6, 0x68, 0x90, 0xe2,
-	0x99, 0xfe, 0x75, 0x98, 0xff, 0x91, 0xc3, 0xa7, 0x11, 0x62, 0xf6, 0xed, 0x90, 0xbb, 0x25, 0x0c,
-	0xd9, 0x5d, 0xb1, 0xd6, 0x68, 0x80, 0x1c, 0x86, 0x4c, 0x76, 0xa9, 0xf7, 0xaa, 0xf3, 0x99, 0x55,
-	0xba, 0x05, 0xd9, 0x1e, 0x09, 0x08, 0x77, 0x85, 0xc3, 0xd8, 0x00, 0x59, 0x4e, 0xd3, 0x1e, 0xb3,
-	0x9c, 0x1a, 0x77, 0x40, 0x3e, 0x81, 0xa3, 0x31, 0x32, 0xf3, 0xb2, 0xe8, 0xdb, 0xff, 0xbc, 0xe8,
-	0x7c, 0x66, 0x15, 0xb6, 0x03, 0x3a, 0x0e, 0xb9, 0xab, 0x52, 0x08, 0x04, 0x24, 0xce, 0x85, 0xa6,
-	0xd6, 0xae, 0xa6, 0x88, 0x56, 0x81, 0x96, 0x98, 0x45, 0x39, 0xa1, 0x25, 0xc2, 0x8a, 0xcd, 0x92,
-	0xb2, 0x62, 0x61, 0x31, 0xb3, 0xac, 0x2c, 0xb6, 0xb5, 0x21, 0xb0, 0xfa, 0xfe, 0x61, 0xa7, 0xd0,
-	0x9f, 0xec, 0x40, 0x0e, 0x5b, 0x7f, 0xe4, 0x40, 0x75, 0xdb, 0xf3, 0x10, 0x63, 0x7b, 0x84, 0xf1,
-	0xfe, 0xc4, 0xb8, 0x0f, 0x4a, 0xde, 0x10, 0x92, 0x70, 0x40, 0x7c, 0x09, 0x5e, 0xb9, 0xf7, 0xee,
-	0xbf, 0xea, 0xb6, 0x78, 0x43, 0xac, 0xbe, 0xbd, 0x73, 0x3c, 0xb3, 0x8a, 0x9e, 0x1a, 0xba, 0xe9,
-	0xc0, 0x5f, 0xd2, 0x92, 0x3d, 0x93, 0x96, 0xdc, 0x7f, 0xa7, 0x45, 0x7f, 0x3a, 0x2d, 0xf9, 0x75,
-	0x5a, 0x0a, 0xe7, 0x47, 0x4b, 0xf1, 0x14, 0x2d, 0xf7, 0x41, 0x09, 0x4a, 0x6c, 0x11, 0x33, 0x4b,
-	0xcd, 0x5c, 0xbb, 0xd2, 0x7d, 0xcd, 0x7e, 0xf2, 0xa0, 0xdb, 0x0a, 0xfd, 0xfe, 0x38, 0x1a, 0xa1,
-	0x5e, 0xf3, 0xd1, 0xcc, 0xca, 0x1c, 0xcf, 0x2c, 0x00, 0x4f, 0x28, 0xf9, 0xfa, 0x17, 0x0b, 0x2c,
-	0x09, 0x72, 0x4f, 0x12, 0x2a, 0xce, 0xcb, 0x2b, 0x9c, 0x83, 0x15, 0xce, 0x2b, 0x67, 0x71, 0xfe,
-	0x9d, 0x0e, 0xaa, 0x3b, 0xd3, 0x10, 0x06, 0xc4, 0xbb, 0x89, 0xd0, 0x8b, 0xe1, 0xfc, 0x0e, 0xa8,
-	0x08, 0xce, 0x39, 0x89, 0x06, 0x1e, 0x8c, 0x9e, 0x83, 0x75, 0x21, 0x99, 0x3e, 0x89, 0x6e, 0xc0,
-	0x68, 0x91, 0xeb, 0x10, 0x21, 0x99, 0x4b, 0x7f, 0xae, 0x5c, 0x37, 0x11, 0x12, 0xb9, 0x52, 0x09,
-	0xe5, 0x9f, 0x2e, 0xa1, 0xc2, 0xba, 0x84, 0x8a, 0xe7, 0x27, 0xa1, 0xd2, 0x19, 0x12, 0x2a, 0xff,
-	0x2f, 0x12, 0x02, 0x2b, 0x12, 0xaa, 0xac, 0x48, 0xa8, 0x7a, 0x96, 0x84, 0x5a, 0xa0, 0xbe, 0x3b,
-	0xe1, 0x28, 0x64, 0x84, 0x86, 0xef, 0x45, 0xf2, 0xcd, 0x58, 0x3e, 0x05, 0xe9, 0x85, 0xfc, 0x95,
-	0x06, 0x2e, 0xae, 0x3c, 0x11, 0x2e, 0x62, 0x11, 0x0d, 0x99, 0xdc, 0xa8, 0xbc, 0xe5, 0x35, 0x75,
-	0x89, 0xcb, 0x8b, 0xfd, 0x0a, 0xd0, 0x47, 0x14, 0x33, 0x33, 0x2b, 0x37, 0x79, 0x71, 0x7d, 0x93,
-	0x7b, 0x14, 0xbb, 0x32, 0xc4, 0xb8, 0x00, 0x72, 0x31, 0xe2, 0x52, 0x33, 0x55, 0x57, 0x0c, 0x8d,
-	0x1a, 0x28, 0x25, 0xc1, 0x00, 0xc5, 0x31, 0x8d, 0xd3, 0x5b, 0xb7, 0x98, 0x04, 0xbb, 0xc2, 0x14,
-	0x2e, 0x21, 0x8e, 0x31, 0x43, 0xbe, 0x62, 0xd5, 0x2d, 0x62, 0xc8, 0xee, 0x31, 0xe4, 0xa7, 0x6d,
-	0x7e, 0xae, 0x81, 0x97, 0xf7, 0x19, 0xbe, 0x17, 0xf9, 0x90, 0xa3, 0xbb, 0x30, 0x86, 0x01, 0x33,
-	0xae, 0x83, 0x32, 0x1c, 0xf3, 0x21, 0x8d, 0x09, 0x9f, 0xa6, 0x27, 0xc2, 0xfc, 0xe1, 0x61, 0x67,
-	0x33, 0x7d, 0x6d, 0xb7, 0x7d, 0x3f, 0x46, 0x8c, 0xbd, 0xcf, 0x63, 0x12, 0x62, 0x77, 0x19, 0x6a,
-	0x5c, 0x07, 0x85, 0x48, 0x66, 0x90, 0x62, 0xaf, 0x74, 0xcd, 0xf5, 0x6d, 0xa8, 0x0a, 0x3d, 0x5d,
-	0xd0, 0xe4, 0xa6, 0xd1, 0x5b, 0x1b, 0x0f, 0x7e, 0xff, 0xe6, 0xea, 0x32, 0x4f, 0xab, 0x06, 0x2e,
-	0x3d, 0xd1, 0xd2, 0x02, 0xbb, 0xee, 0x4c, 0x03, 0xb9, 0x7d, 0x86, 0x8d, 0x29, 0x00, 0xa7, 0x1e,
-	0x5f, 0x6b, 0xbd, 0xd0, 0x0a, 0xf4, 0xf5, 0x37, 0x9f, 0x11, 0xb0, 0xc8, 0xdf, 0xba, 0xfc, 0xe0,
-	0xc7, 0xdf, 0xbe, 0xc8, 0xbe, 0xd2, 0xaa, 0x39, 0x6c, 0x48, 0x7c, 0x7a, 0xf2, 0x21, 0x91, 0x46,
-	0x0e, 0xf8, 0xc4, 0xf8, 0x10, 0x54, 0x57, 0xd0, 0xba, 0xfc, 0xb7, 0xb9, 0x4f, 0x87, 0xd4, 0xaf,
-	0x3c, 0x33, 0x64, 0xd1, 0x40, 0xef, 0x9d, 0x47, 0xf3, 0x86, 0xf6, 0x78, 0xde, 0xd0, 0x7e, 0x9d,
-	0x37, 0xb4, 0xcf, 0x8e, 0x1a, 0x99, 0xc7, 0x47, 0x8d, 0xcc, 0x4f, 0x47, 0x8d, 0xcc, 0x07, 0xaf,
-	0x9f, 0x3a, 0x58, 0xaa, 0x39, 0xf5, 0x9b, 0x74, 0x9d, 0x89, 0xec, 0x53, 0x9e, 0xad, 0x83, 0x82,
-	0xfc, 0xe4, 0x78, 0xeb, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xab, 0xa4, 0xab, 0x2b, 0x6f, 0x09,
-	0x00, 0x00,
+	// 1396 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcd, 0x6f, 0x1b, 0x45,
+	0x1b, 0xcf, 0xda, 0x1b, 0x7f, 0x3c, 0x76, 0xf3, 0x46, 0xfb, 0xa6, 0xcd, 0xc6, 0x6d, 0xed, 0xd4,
+	0x79, 0xfb, 0xbe, 0x6e, 0x5f, 0x62, 0xab, 0x69, 0xd5, 0x8a, 0x9c, 0x1a, 0xa7, 0x1f, 0x6a, 0x9b,
+	0x40, 0xb5, 0x75, 0x2f, 0x14, 0xc9, 0x9a, 0xec, 0x4e, 0xd6, 0xab, 0x78, 0x67, 0x56, 0x3b, 0x63,
+	0x63, 0x17, 0x71, 0xa9, 0x38, 0x70, 0x83, 0x8a, 0x7f, 0x80, 0x03, 0x5c, 0x38, 0x21, 0x51, 0x71,
+	0xe6, 0x82, 0xa8, 0x38, 0x55, 0x70, 0x41, 0x1c, 0x0c, 0x4a, 0x91, 0x90, 0x7a, 0x83, 0xbf, 0x00,
+	0xcd, 0xec, 0xfa, 0x2b, 0x1b, 0xa7, 0x6d, 0x28, 0xe2, 0xe4, 0x79, 0xe6, 0xf9, 0xcd, 0xf3, 0x3c,
+	0xf3, 0xfc, 0x7e, 0x33, 0x9e, 0x85, 0x05, 0xcc, 0x1b, 0xd8, 0x77, 0x1d, 0xc2, 0x2b, 0xb8, 0xed,
+	0x56, 0xda, 0xe7, 0x2a, 0xbc, 0x53, 0xf6, 0x7c, 0xca, 0xa9, 0x36, 0x3b, 0x70, 0x95, 0x71, 0xdb,
+	0x2d, 0xb7, 0xcf, 0xe5, 0xe6, 0x4d, 0xca, 0x5c, 0xca, 0x2a, 0x2e, 0xb3, 0x05, 0xd2, 0x65, 0x76,
+	0x00, 0xcd, 0x2d, 0x04, 0x8e, 0xba, 0xb4, 0x2a, 0x81, 0x11, 0xba, 0x72, 0x91, 0x04, 0x22, 0x58,
+	0xe0, 0x9b, 0xb3, 0xa9, 0x4d, 0x83, 0x35, 0x62, 0x14, 0xce, 0x9e, 0xb0, 0x29, 0xb5, 0x9b, 0xb8,
+	0x82, 0x3c, 0xa7, 0x82, 0x08, 0xa1, 0x1c, 0x71, 0x87, 0x92, 0x7e, 0xbc, 0x85, 0xd0, 0x2b, 0xad,
+	0xad, 0xd6, 0x76, 0x05, 0x91, 0x6e, 0xe0, 0x2a, 0x7e, 0xa8, 0xc0, 0x91, 0x4d, 0x66, 0x5f, 0x15,
+	0x09, 0x71, 0xcb, 0xad, 0x75, 0xb4, 0x12, 0xa8, 0x16, 0xe2, 0x48, 0x57, 0x16, 0x95, 0x52, 0x66,
+	0x65, 0xae, 0x1c, 0xac, 0x2d, 0xf7, 0xd7, 0x96, 0xd7, 0x48, 0xd7, 0x90, 0x08, 0x6d, 0x01, 0x54,
+	0xe6, 0xdc, 0xc7, 0x7a, 0x6c, 0x51, 0x29, 0x29, 0xd5, 0xe9, 0x67, 0xbd, 0x82, 0xb2, 0x6c, 0xc8,
+	0x29, 0xad, 0x00, 0x6a, 0x03, 0xb1, 0x86, 0x1e, 0x5f, 0x54, 0x4a, 0xe9, 0x6a, 0xe6, 0x8f, 0x5e,
+	0x21, 0xe9, 0x37, 0xbd, 0xd5, 0xe2, 0x72, 0xd1, 0x90, 0x0e, 0x4d, 0x03, 0x75, 0xdb, 0xa7, 0xae,
+	0xae, 0x0a, 0x80, 0x21, 0xc7, 0xab, 0xea, 0x07, 0x9f, 0x14, 0xa6, 0x8a, 0x5f, 0xc6, 0x20, 0xb5,
+	0x81, 0x6d, 0x64, 0x76, 0x6b, 0x1d, 0x6d, 0x0e, 0xa6, 0x09, 0x25, 0x26, 0x96, 0xd5, 0xa8, 0x46,
+	0x60, 0x68, 0xd7, 0x21, 0x6d, 0x23, 0xd1, 0x39, 0xc7, 0x0c, 0xb2, 0xa7, 0xab, 0x67, 0x7f, 0xea,
+	0x15, 0xfe, 0x6b, 0x3b, 0xbc, 0xd1, 0xda, 0x2a, 0x9b, 0xd4, 0x0d, 0xfb, 0x19, 0xfe, 0x2c, 0x33,
+	0x6b, 0xa7, 0xc2, 0xbb, 0x1e, 0x66, 0xe5, 0x1b, 0x84, 0x1b, 0x29, 0x1b, 0xb1, 0xdb, 0x62, 0xad,
+	0x96, 0x87, 0xb8, 0x8d, 0x98, 0xac, 0x52, 0xad, 0x66, 0x77, 0x7b, 0x85, 0xd4, 0x75, 0xc4, 0x36,
+	0x1c, 0xd7, 0xe1, 0x86, 0x70, 0x68, 0x33, 0x10, 0xe3, 0x34, 0xac, 0x31, 0xc6, 0xa9, 0x76, 0x13,
+	0xa6, 0xdb, 0xa8, 0xd9, 0xc2, 0xfa, 0xb4, 0x4c, 0x7a, 0xe1, 0xc5, 0x93, 0xee, 0xf6, 0x0a, 0x89,
+	0x35, 0x97, 0xb6, 0x08, 0x37, 0x82, 0x10, 0xa2, 0x03, 0xb2, 0xcf, 0x89, 0x45, 0xa5, 0x94, 0x0d,
+	0x3b, 0x9a, 0x05, 0xa5, 0xad, 0x27, 0xe5, 0x84, 0xd2, 0x16, 0x96, 0xaf, 0xa7, 0x02, 0xcb, 0x17,
+	0x16, 0xd3, 0xd3, 0x81, 0xc5, 0x56, 0x67, 0x44, 0xaf, 0xbe, 0x7b, 0xb4, 0x9c, 0xa8, 0x75, 0xae,
+	0x20, 0x8e, 0x8a, 0xbf, 0xc7, 0x21, 0xbb, 0x66, 0x9a, 0x98, 0xb1, 0x0d, 0x87, 0xf1, 0x5a, 0x47,
+	0xbb, 0x07, 0x29, 0xb3, 0x81, 0x1c, 0x52, 0x77, 0x2c, 0xd9, 0xbc, 0x74, 0xf5, 0xf2, 0x4b, 0x55,
+	0x9b, 0x5c, 0x17, 0xab, 0x6f, 0x5c, 0x79, 0xd6, 0x2b, 0x24, 0xcd, 0x60, 0x68, 0x84, 0x03, 0x6b,
+	0x48, 0x4b, 0x6c, 0x22, 0x2d, 0xf1, 0xbf, 0x4e, 0x8b, 0x7a, 0x30, 0x2d, 0xd3, 0x51, 0x5a, 0x12,
+	0xaf, 0x8e, 0x96, 0xe4, 0x08, 0x2d, 0xf7, 0x20, 0x85, 0x64, 0x6f, 0x31, 0xd3, 0x53, 0x8b, 0xf1,
+	0x52, 0x66, 0xe5, 0x64, 0x79, 0xef, 0x41, 0x2f, 0x07, 0xdd, 0xaf, 0xb5, 0xbc, 0x26, 0xae, 0x2e,
+	0x3e, 0xee, 0x15, 0xa6, 0x9e, 0xf5, 0x0a, 0x80, 0x06, 0x94, 0x7c, 0xfe, 0x73, 0x01, 0x86, 0x04,
+	0x19, 0x83, 0x80, 0x01, 0xe7, 0xe9, 0x31, 0xce, 0x61, 0x8c, 0xf3, 0xcc, 0x24, 0xce, 0xbf, 0x56,
+	0x21, 0x7b, 0xa5, 0x4b, 0x90, 0xeb, 0x98, 0xd7, 0x30, 0xfe, 0x67, 0x38, 0xbf, 0x09, 0x19, 0xc1,
+	0x39, 0x77, 0xbc, 0xba, 0x89, 0xbc, 0x43, 0xb0, 0x2e, 0x24, 0x53, 0x73, 0xbc, 0x75, 0xe4, 0xf5,
+	0x63, 0x6d, 0x63, 0x2c, 0x63, 0xa9, 0x87, 0x8a, 0x75, 0x0d, 0x63, 0x11, 0x2b, 0x94, 0xd0, 0xf4,
+	0xc1, 0x12, 0x4a, 0x44, 0x25, 0x94, 0x7c, 0x75, 0x12, 0x4a, 0x4d, 0x90, 0x50, 0xfa, 0x6f, 0x91,
+	0x10, 0x8c, 0x49, 0x28, 0x33, 0x26, 0xa1, 0xec, 0x24, 0x09, 0x15, 0x21, 0x77, 0xb5, 0xc3, 0x31,
+	0x61, 0x0e, 0x25, 0x6f, 0x7a, 0xf2, 0x3f, 0x63, 0xf8, 0x57, 0x10, 0x5e, 0xc8, 0x5f, 0xc5, 0xe0,
+	0xf4, 0x64, 0xd0, 0x3a, 0x25, 0x96, 0x23, 0xe6, 0x50, 0x53, 0x2b, 0xc1, 0xec, 0x56, 0x93, 0x9a,
+	0x3b, 0x75, 0xd2, 0x72, 0xb7, 0xb0, 0x5f, 0x77, 0x1d, 0x12, 0x5e, 0xdc, 0x33, 0x72, 0xfe, 0x0d,
+	0x39, 0xbd, 0xe9, 0x90, 0x28, 0x12, 0x75, 0xf4, 0x58, 0x14, 0x89, 0x3a, 0xda, 0x12, 0x1c, 0xe1,
+	0x8e, 0x8b, 0x19, 0x47, 0xae, 0x27, 0x03, 0xca, 0xcb, 0xda, 0xc8, 0x0e, 0x26, 0x45, 0xb8, 0x71,
+	0x10, 0xea, 0xe8, 0xea, 0x5e, 0x10, 0xea, 0x68, 0xb7, 0x60, 0x66, 0x87, 0xd0, 0x77, 0x48, 0x1d,
+	0x99, 0xa6, 0xa0, 0x4b, 0xa8, 0x43, 0x10, 0x91, 0x8f, 0x12, 0x71, 0x4b, 0xe0, 0xd6, 0x02, 0x58,
+	0x55, 0x15, 0x4c, 0x18, 0x47, 0x76, 0x46, 0xe6, 0x98, 0x76, 0x02, 0xd2, 0xcc, 0xb1, 0x09, 0xe2,
+	0x2d, 0x1f, 0x87, 0x57, 0xf8, 0x70, 0x22, 0x6c, 0xdc, 0xfb, 0x0a, 0x2c, 0x4d, 0x6e, 0xdc, 0x1d,
+	0x8f, 0x12, 0x46, 0x7d, 0x6c, 0x69, 0xaf, 0x43, 0x46, 0x68, 0xde, 0xf6, 0x11, 0xe1, 0xd8, 0x0f,
+	0x4f, 0xae, 0xfe, 0xfd, 0xa3, 0xe5, 0xb9, 0xf0, 0x55, 0xb0, 0x66, 0x59, 0x3e, 0x66, 0xec, 0x0e,
+	0xf7, 0x1d, 0x62, 0x1b, 0xb0, 0x8d, 0xf1, 0xf5, 0x00, 0x3b, 0x5e, 0x46, 0x6c, 0xff, 0x32, 0x10,
+	0x64, 0x47, 0xf7, 0xa3, 0xe9, 0x90, 0x44, 0x41, 0xc0, 0x20, 0x95, 0xd1, 0x37, 0xb5, 0x4b, 0x90,
+	0x64, 0x9c, 0xfa, 0xc8, 0x16, 0xb1, 0x44, 0x6b, 0xe6, 0xa3, 0xad, 0xb9, 0xc3, 0x11, 0xc7, 0x61,
+	0x4f, 0xfa, 0xe8, 0xe2, 0xa7, 0x0a, 0x1c, 0x1d, 0x7b, 0x45, 0x18, 0x98, 0x89, 0xed, 0xc9, 0xb3,
+	0x20, 0x1f, 0x02, 0x41, 0x26, 0x39, 0xd6, 0xce, 0x80, 0xda, 0xa4, 0x36, 0x0b, 0x73, 0x1c, 0x8d,
+	0xe6, 0xd8, 0xa0, 0xb6, 0x21, 0x21, 0xda, 0x2c, 0xc4, 0x7d, 0xcc, 0x25, 0xe7, 0x59, 0x43, 0x0c,
+	0xb5, 0x05, 0x48, 0xb5, 0xdd, 0x3a, 0xf6, 0x7d, 0xea, 0x87, 0x7f, 0xcc, 0xc9, 0xb6, 0x7b, 0x55,
+	0x98, 0xc2, 0x25, 0xee, 0x8f, 0x16, 0xc3, 0x56, 0x70, 0xf0, 0x8d, 0xa4, 0x8d, 0xd8, 0x5d, 0x86,
+	0xad, 0xb0, 0x13, 0x0f, 0x15, 0xf8, 0xd7, 0x26, 0xb3, 0xef, 0x7a, 0x16, 0xe2, 0xf8, 0x36, 0xf2,
+	0x91, 0xcb, 0xb4, 0x8b, 0x90, 0x46, 0x2d, 0xde, 0xa0, 0xbe, 0xc3, 0xbb, 0xcf, 0x6d, 0xfd, 0x10,
+	0xaa, 0x5d, 0x84, 0x84, 0x27, 0x23, 0xc8, 0xb6, 0x67, 0x56, 0xf4, 0xe8, 0x36, 0x82, 0x0c, 0x61,
+	0xaf, 0x42, 0xf4, 0xea, 0xcc, 0x83, 0xdf, 0xbe, 0x38, 0x3b, 0x8c, 0x53, 0x5c, 0x80, 0xf9, 0x3d,
+	0x25, 0xf5, 0x7b, 0x57, 0xfc, 0x56, 0x81, 0xdc, 0xc0, 0x17, 0x1c, 0xfc, 0x75, 0x4a, 0xb8, 0x4f,
+	0x9b, 0xe2, 0xfc, 0x1f, 0xba, 0xf2, 0x4b, 0xa0, 0x36, 0x1d, 0xc6, 0x65, 0xdd, 0x33, 0x2b, 0x4b,
+	0x93, 0xae, 0xa1, 0x91, 0x54, 0x86, 0x5c, 0x20, 0xc8, 0x40, 0x96, 0xa5, 0xc7, 0x17, 0xe3, 0xa5,
+	0xb4, 0x21, 0x86, 0xda, 0x31, 0x48, 0xf8, 0xd8, 0xa5, 0x6d, 0xac, 0xab, 0x72, 0x32, 0xb4, 0x22,
+	0x9b, 0xfc, 0x0f, 0x14, 0x27, 0x6f, 0x64, 0xb0, 0xdf, 0x87, 0x0a, 0xfc, 0x7b, 0x93, 0xd9, 0x35,
+	0x6a, 0xdb, 0x4d, 0x7c, 0xdb, 0xc7, 0x26, 0x75, 0x3d, 0xa7, 0x89, 0x0f, 0xbd, 0xd1, 0x11, 0xa1,
+	0xc7, 0xc6, 0x85, 0xae, 0x43, 0x12, 0x13, 0xb4, 0xd5, 0xc4, 0x96, 0x94, 0x56, 0xca, 0xe8, 0x9b,
+	0x91, 0xca, 0x4f, 0xc2, 0xf1, 0x7d, 0x4a, 0x1a, 0x94, 0xfc, 0x99, 0x02, 0x0b, 0x43, 0xfa, 0x06,
+	0xfe, 0x75, 0x4a, 0xb6, 0x1d, 0xfb, 0xd0, 0x85, 0x5f, 0x86, 0x84, 0x29, 0x23, 0x84, 0xda, 0x2a,
+	0xee, 0xa3, 0xad, 0x3d, 0xb9, 0xfa, 0x2a, 0x0b, 0xd6, 0x45, 0xb6, 0xb1, 0x04, 0xa7, 0x26, 0x96,
+	0xd9, 0xdf, 0xcc, 0xca, 0x37, 0x2a, 0xc4, 0x37, 0x99, 0xad, 0xbd, 0x0b, 0x30, 0xf2, 0x3d, 0x50,
+	0x88, 0x26, 0x1f, 0x3b, 0xea, 0xb9, 0xff, 0x3d, 0x07, 0x30, 0x68, 0xd6, 0xd2, 0x83, 0x1f, 0x7e,
+	0xfd, 0x38, 0x76, 0xb2, 0x78, 0xbc, 0x82, 0x88, 0xdf, 0xe5, 0x94, 0x0c, 0xbe, 0x6e, 0x42, 0x6c,
+	0x9d, 0x77, 0xb4, 0xb7, 0x21, 0x3b, 0x76, 0x3e, 0x4f, 0xed, 0x1b, 0x7d, 0x14, 0x92, 0x3b, 0xf3,
+	0x5c, 0xc8, 0xe0, 0x3a, 0x7a, 0x0f, 0xe6, 0x27, 0x1d, 0xa7, 0xd7, 0x0e, 0x88, 0x12, 0x41, 0xe7,
+	0x2e, 0xbc, 0x0c, 0x7a, 0x90, 0xbe, 0x01, 0xb3, 0x11, 0x75, 0x9f, 0xde, 0x37, 0xd2, 0x5e, 0x58,
+	0x6e, 0xf9, 0x85, 0x60, 0x83, 0x4c, 0xf7, 0xe1, 0xd8, 0x04, 0x51, 0xfe, 0xff, 0xa0, 0x6e, 0xed,
+	0x01, 0xe7, 0xce, 0xbf, 0x04, 0xb8, 0x9f, 0xbb, 0xba, 0xfe, 0x78, 0x37, 0xaf, 0x3c, 0xd9, 0xcd,
+	0x2b, 0xbf, 0xec, 0xe6, 0x95, 0x8f, 0x9e, 0xe6, 0xa7, 0x9e, 0x3c, 0xcd, 0x4f, 0xfd, 0xf8, 0x34,
+	0x3f, 0xf5, 0xd6, 0x99, 0x91, 0x27, 0x55, 0x5f, 0x03, 0xfd, 0xdf, 0xf6, 0x4a, 0xa5, 0x23, 0x05,
+	0x21, 0x5f, 0x56, 0x5b, 0x09, 0xf9, 0xc1, 0x79, 0xfe, 0xcf, 0x01, 0x00, 0xca, 0x4b, 0x0b, 0xda,
+	0x6d, 0x0f, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -536,6 +1037,19 @@ type MsgClient interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdateAccessControlList defines a governance operation for adding and
+	// removing addresses of an access control list without replacing all the
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateAccessControlList(ctx context.Context, in *MsgUpdateAccessControlList, opts ...grpc.CallOption) (*MsgUpdateAccessControlListResponse, error)
+	// TogglePrecompile defines a governance operation for enabling or disabling
+	// an available precompiled contract. The authority is hard-coded to the
+	// Cosmos SDK x/gov module account
+	TogglePrecompile(ctx context.Context, in *MsgTogglePrecompile, opts ...grpc.CallOption) (*MsgTogglePrecompileResponse, error)
+	// UpdatePrecompileConfig defines a governance operation for scheduling the
+	// activation height and disabling the methods of a precompiled contract. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdatePrecompileConfig(ctx context.Context, in *MsgUpdatePrecompileConfig, opts ...grpc.CallOption) (*MsgUpdatePrecompileConfigResponse, error)
 }
 
 type msgClient struct {
@@ -564,6 +1078,33 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdateAccessControlList(ctx context.Context, in *MsgUpdateAccessControlList, opts ...grpc.CallOption) (*MsgUpdateAccessControlListResponse, error) {
+	out := new(MsgUpdateAccessControlListResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UpdateAccessControlList", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TogglePrecompile(ctx context.Context, in *MsgTogglePrecompile, opts ...grpc.CallOption) (*MsgTogglePrecompileResponse, error) {
+	out := new(MsgTogglePrecompileResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/TogglePrecompile", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdatePrecompileConfig(ctx context.Context, in *MsgUpdatePrecompileConfig, opts ...grpc.CallOption) (*MsgUpdatePrecompileConfigResponse, error) {
+	out := new(MsgUpdatePrecompileConfigResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.evm.v1.Msg/UpdatePrecompileConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// EthereumTx defines a method submitting Ethereum transactions.
@@ -571,6 +1112,19 @@ type MsgServer interface {
 	// UpdateParams defined a governance operation for updating the x/evm module parameters.
 	// The authority is hard-coded to the Cosmos SDK x/gov module account
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdateAccessControlList defines a governance operation for adding and
+	// removing addresses of an access control list without replacing all the
+	// parameters. The authority is hard-coded to the Cosmos SDK x/gov module
+	// account
+	UpdateAccessControlList(context.Context, *MsgUpdateAccessControlList) (*MsgUpdateAccessControlListResponse, error)
+	// TogglePrecompile defines a governance operation for enabling or disabling
+	// an available precompiled contract. The authority is hard-coded to the
+	// Cosmos SDK x/gov module account
+	TogglePrecompile(context.Context, *MsgTogglePrecompile) (*MsgTogglePrecompileResponse, error)
+	// UpdatePrecompileConfig defines a governance operation for scheduling the
+	// activation height and disabling the methods of a precompiled contract. The
+	// authority is hard-coded to the Cosmos SDK x/gov module account
+	UpdatePrecompileConfig(context.Context, *MsgUpdatePrecompileConfig) (*MsgUpdatePrecompileConfigResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -583,6 +1137,15 @@ func (*UnimplementedMsgServer) EthereumTx(ctx context.Context, req *MsgEthereumT
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdateAccessControlList(ctx context.Context, req *MsgUpdateAccessControlList) (*MsgUpdateAccessControlListResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccessControlList not implemented")
+}
+func (*UnimplementedMsgServer) TogglePrecompile(ctx context.Context, req *MsgTogglePrecompile) (*MsgTogglePrecompileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TogglePrecompile not implemented")
+}
+func (*UnimplementedMsgServer) UpdatePrecompileConfig(ctx context.Context, req *MsgUpdatePrecompileConfig) (*MsgUpdatePrecompileConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePrecompileConfig not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -624,6 +1187,60 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdateAccessControlList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateAccessControlList)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateAccessControlList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/UpdateAccessControlList",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateAccessControlList(ctx, req.(*MsgUpdateAccessControlList))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TogglePrecompile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTogglePrecompile)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TogglePrecompile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/TogglePrecompile",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TogglePrecompile(ctx, req.(*MsgTogglePrecompile))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdatePrecompileConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePrecompileConfig)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePrecompileConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.evm.v1.Msg/UpdatePrecompileConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePrecompileConfig(ctx, req.(*MsgUpdatePrecompileConfig))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.evm.v1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -636,6 +1253,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdateAccessControlList",
+			Handler:    _Msg_UpdateAccessControlList_Handler,
+		},
+		{
+			MethodName: "TogglePrecompile",
+			Handler:    _Msg_TogglePrecompile_Handler,
+		},
+		{
+			MethodName: "UpdatePrecompileConfig",
+			Handler:    _Msg_UpdatePrecompileConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/evm/v1/tx.proto",
@@ -1059,6 +1688,151 @@ func (m *ExtensionOptionsEthereumTx) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtensionOptionsEthereumTxConditional) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTxConditional) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTxConditional) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.KnownAccounts) > 0 {
+		for iNdEx := len(m.KnownAccounts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KnownAccounts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.TimestampMax != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimestampMax))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.TimestampMin != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimestampMin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockNumberMax != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumberMax))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockNumberMin != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.BlockNumberMin))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExtensionOptionsEthereumTxSponsored) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtensionOptionsEthereumTxSponsored) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtensionOptionsEthereumTxSponsored) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeGranter) > 0 {
+		i -= len(m.FeeGranter)
+		copy(dAtA[i:], m.FeeGranter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FeeGranter)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KnownAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KnownAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KnownAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Storage) > 0 {
+		for iNdEx := len(m.Storage) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Storage[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *MsgEthereumTxResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1185,56 +1959,265 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateAccessControlList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgEthereumTx) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateAccessControlList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccessControlList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Data != nil {
-		l = m.Data.Size()
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Remove) > 0 {
+		for iNdEx := len(m.Remove) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Remove[iNdEx])
+			copy(dAtA[i:], m.Remove[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Remove[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	if m.Size_ != 0 {
-		n += 9
+	if len(m.Add) > 0 {
+		for iNdEx := len(m.Add) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Add[iNdEx])
+			copy(dAtA[i:], m.Add[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Add[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.List != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.List))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.From)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *LegacyTx) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateAccessControlListResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateAccessControlListResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateAccessControlListResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovTx(uint64(m.Nonce))
-	}
-	if m.GasPrice != nil {
-		l = m.GasPrice.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.GasLimit != 0 {
-		n += 1 + sovTx(uint64(m.GasLimit))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTogglePrecompile) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTogglePrecompile) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTogglePrecompile) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTogglePrecompileResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTogglePrecompileResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTogglePrecompileResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePrecompileConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrecompileConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrecompileConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgEthereumTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Data != nil {
+		l = m.Data.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Size_ != 0 {
+		n += 9
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.From)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *LegacyTx) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovTx(uint64(m.Nonce))
+	}
+	if m.GasPrice != nil {
+		l = m.GasPrice.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
 	}
 	l = len(m.To)
 	if l > 0 {
@@ -1382,6 +2365,73 @@ func (m *ExtensionOptionsEthereumTx) Size() (n int) {
 	return n
 }
 
+func (m *ExtensionOptionsEthereumTxConditional) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockNumberMin != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumberMin))
+	}
+	if m.BlockNumberMax != 0 {
+		n += 1 + sovTx(uint64(m.BlockNumberMax))
+	}
+	if m.TimestampMin != 0 {
+		n += 1 + sovTx(uint64(m.TimestampMin))
+	}
+	if m.TimestampMax != 0 {
+		n += 1 + sovTx(uint64(m.TimestampMax))
+	}
+	if len(m.KnownAccounts) > 0 {
+		for _, e := range m.KnownAccounts {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *ExtensionOptionsEthereumTxSponsored) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeeGranter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *KnownAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Storage) > 0 {
+		for _, e := range m.Storage {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *MsgEthereumTxResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1436,44 +2486,835 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgUpdateAccessControlList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.List != 0 {
+		n += 1 + sovTx(uint64(m.List))
+	}
+	if len(m.Add) > 0 {
+		for _, s := range m.Add {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Remove) > 0 {
+		for _, s := range m.Remove {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgUpdateAccessControlListResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
 }
-func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
+
+func (m *MsgTogglePrecompile) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgTogglePrecompileResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdatePrecompileConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Config.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdatePrecompileConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Data == nil {
+				m.Data = &types.Any{}
+			}
+			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+			}
+			var v uint64
+			if (iNdEx + 8) > l {
+				return io.ErrUnexpectedEOF
+			}
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.Size_ = float64(math.Float64frombits(v))
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.From = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasPrice = &v
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.To = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.Amount = &v
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			if iNdEx >= l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTx: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1500,29 +3341,50 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Data == nil {
-				m.Data = &types.Any{}
-			}
-			if err := m.Data.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 1 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Size_", wireType)
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
-			var v uint64
-			if (iNdEx + 8) > l {
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
-			iNdEx += 8
-			m.Size_ = float64(math.Float64frombits(v))
-		case 3:
+			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
+			if m.V == nil {
+				m.V = []byte{}
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1532,29 +3394,31 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
+			if m.R == nil {
+				m.R = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field From", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1564,23 +3428,25 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.From = string(dAtA[iNdEx:postIndex])
+			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
+			if m.S == nil {
+				m.S = []byte{}
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1603,7 +3469,7 @@ func (m *MsgEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LegacyTx) Unmarshal(dAtA []byte) error {
+func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1626,13 +3492,49 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LegacyTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LegacyTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.ChainID = &v
+			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
 			}
@@ -1651,9 +3553,9 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1682,12 +3584,48 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.GasTipCap = &v
+			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Int
+			m.GasFeeCap = &v
+			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
 			}
@@ -1706,7 +3644,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
 			}
@@ -1738,7 +3676,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 			}
 			m.To = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1774,7 +3712,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
@@ -1808,7 +3746,41 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.Data = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Accesses = append(m.Accesses, AccessTuple{})
+			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
 			}
@@ -1842,7 +3814,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.V = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
 			}
@@ -1876,7 +3848,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 				m.R = []byte{}
 			}
 			iNdEx = postIndex
-		case 9:
+		case 12:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
 			}
@@ -1931,7 +3903,7 @@ func (m *LegacyTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AccessListTx) Unmarshal(dAtA []byte) error {
+func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,53 +3926,67 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AccessListTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AccessListTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionsEthereumTxConditional) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTxConditional: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTxConditional: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumberMin", wireType)
 			}
-			m.Nonce = 0
+			m.BlockNumberMin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2010,16 +3996,16 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.BlockNumberMin |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumberMax", wireType)
 			}
-			var stringLen uint64
+			m.BlockNumberMax = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2029,33 +4015,16 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockNumberMax |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GasPrice = &v
-			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMin", wireType)
 			}
-			m.GasLimit = 0
+			m.TimestampMin = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2065,16 +4034,16 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				m.TimestampMin |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimestampMax", wireType)
 			}
-			var stringLen uint64
+			m.TimestampMax = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2084,29 +4053,16 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TimestampMax |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.To = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field KnownAccounts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2116,31 +4072,29 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.KnownAccounts = append(m.KnownAccounts, KnownAccount{})
+			if err := m.KnownAccounts[len(m.KnownAccounts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2167,16 +4121,66 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExtensionOptionsEthereumTxSponsored) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTxSponsored: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtensionOptionsEthereumTxSponsored: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeGranter", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2186,29 +4190,27 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeeGranter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -2232,19 +4234,69 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KnownAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 10:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KnownAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KnownAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2254,31 +4306,29 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
-			if m.R == nil {
-				m.R = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Storage", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2288,24 +4338,24 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
+			m.Storage = append(m.Storage, State{})
+			if err := m.Storage[len(m.Storage)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -2329,7 +4379,7 @@ func (m *AccessListTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
+func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2352,15 +4402,15 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DynamicFeeTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DynamicFeeTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChainID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2388,17 +4438,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.ChainID = &v
-			if err := m.ChainID.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
 			}
-			m.Nonce = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2408,16 +4454,31 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Logs = append(m.Logs, &Log{})
+			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasTipCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2427,31 +4488,29 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GasTipCap = &v
-			if err := m.GasTipCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ret == nil {
+				m.Ret = []byte{}
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasFeeCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2479,17 +4538,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.GasFeeCap = &v
-			if err := m.GasFeeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.VmError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
 			}
-			m.GasLimit = 0
+			m.GasUsed = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2499,14 +4554,64 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.GasLimit |= uint64(b&0x7F) << shift
+				m.GasUsed |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field To", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2534,13 +4639,13 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.To = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2550,67 +4655,130 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v github_com_cosmos_cosmos_sdk_types.Int
-			m.Amount = &v
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateAccessControlList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
-			if m.Data == nil {
-				m.Data = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 9:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateAccessControlList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateAccessControlList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Accesses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2620,31 +4788,29 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Accesses = append(m.Accesses, AccessTuple{})
-			if err := m.Accesses[len(m.Accesses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field V", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field List", wireType)
 			}
-			var byteLen int
+			m.List = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2654,31 +4820,16 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.List |= AccessControlList(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.V = append(m.V[:0], dAtA[iNdEx:postIndex]...)
-			if m.V == nil {
-				m.V = []byte{}
-			}
-			iNdEx = postIndex
-		case 11:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field R", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Add", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2688,31 +4839,29 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.R = append(m.R[:0], dAtA[iNdEx:postIndex]...)
-			if m.R == nil {
-				m.R = []byte{}
-			}
+			m.Add = append(m.Add, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 12:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field S", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2722,25 +4871,23 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.S = append(m.S[:0], dAtA[iNdEx:postIndex]...)
-			if m.S == nil {
-				m.S = []byte{}
-			}
+			m.Remove = append(m.Remove, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2763,7 +4910,7 @@ func (m *DynamicFeeTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateAccessControlListResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2786,10 +4933,10 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateAccessControlListResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExtensionOptionsEthereumTx: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateAccessControlListResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2813,7 +4960,7 @@ func (m *ExtensionOptionsEthereumTx) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgTogglePrecompile) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2836,15 +4983,15 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgTogglePrecompile: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEthereumTxResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgTogglePrecompile: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2872,13 +5019,13 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Hash = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Logs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2888,31 +5035,29 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Logs = append(m.Logs, &Log{})
-			if err := m.Logs[len(m.Logs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ret", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2922,77 +5067,62 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
+			m.Enabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ret = append(m.Ret[:0], dAtA[iNdEx:postIndex]...)
-			if m.Ret == nil {
-				m.Ret = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VmError", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTogglePrecompileResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.VmError = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
-			}
-			m.GasUsed = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.GasUsed |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTogglePrecompileResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTogglePrecompileResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3014,7 +5144,7 @@ func (m *MsgEthereumTxResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePrecompileConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3037,10 +5167,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePrecompileConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePrecompileConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3077,7 +5207,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3104,7 +5234,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3129,7 +5259,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePrecompileConfigResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3152,10 +5282,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePrecompileConfigResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePrecompileConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/anryton/anryton/v2/x/feeabs/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// FeeTokens returns the fee tokens with their current rates and gas prices
+func (k Keeper) FeeTokens(
+	c context.Context,
+	_ *types.QueryFeeTokensRequest,
+) (*types.QueryFeeTokensResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	feeTokens := k.GetParams(ctx).FeeTokens
+	rates := make([]types.FeeTokenRate, 0, len(feeTokens))
+
+	for _, feeToken := range feeTokens {
+		rate, err := k.GetRate(ctx, feeToken)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		gasPrice, err := k.GetGasPrice(ctx, feeToken)
+		if err != nil {
+			return nil, status.Error(codes.Unavailable, err.Error())
+		}
+
+		rates = append(rates, types.FeeTokenRate{FeeToken: feeToken, Rate: rate, GasPrice: gasPrice})
+	}
+
+	return &types.QueryFeeTokensResponse{FeeTokens: rates}, nil
+}
+
+// GasPrice returns the current minimum gas price in a fee token
+func (k Keeper) GasPrice(
+	c context.Context,
+	req *types.QueryGasPriceRequest,
+) (*types.QueryGasPriceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	feeToken, found := k.GetParams(ctx).GetFeeToken(req.Denom)
+	if !found {
+		return nil, status.Error(codes.NotFound, errorsmod.Wrapf(types.ErrFeeTokenNotFound, "denom %s", req.Denom).Error())
+	}
+
+	gasPrice, err := k.GetGasPrice(ctx, feeToken)
+	if err != nil {
+		return nil, status.Error(codes.Unavailable, err.Error())
+	}
+
+	return &types.QueryGasPriceResponse{GasPrice: gasPrice}, nil
+}
+
+// Reserve returns the address and the balances of the module reserve
+func (k Keeper) Reserve(
+	c context.Context,
+	_ *types.QueryReserveRequest,
+) (*types.QueryReserveResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	address := sdk.AccAddress(types.ModuleAddress.Bytes())
+
+	return &types.QueryReserveResponse{
+		Address:  address.String(),
+		Balances: k.bankKeeper.GetAllBalances(ctx, address),
+	}, nil
+}
+
+// Params returns the feeabs module params
+func (k Keeper) Params(
+	c context.Context,
+	_ *types.QueryParamsRequest,
+) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	return &types.QueryParamsResponse{Params: params}, nil
+}
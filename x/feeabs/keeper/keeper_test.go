@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/anryton/anryton/v2/testutil"
+	"github.com/anryton/anryton/v2/utils"
+	"github.com/anryton/anryton/v2/x/feeabs/types"
+)
+
+func (suite *KeeperTestSuite) TestGetRate() {
+	suite.SetupTest()
+	k := suite.app.FeeAbsKeeper
+
+	rate, err := k.GetRate(suite.ctx, govToken)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDec(2), rate)
+
+	rate, err = k.GetRate(suite.ctx, twapToken)
+	suite.Require().NoError(err)
+	suite.Require().Equal(twapRate, rate)
+
+	// the TWAP rate requires a registered token pair
+	_, err = k.GetRate(suite.ctx, types.NewTWAPFeeToken("ujuno", oracle.Hex(), 3600))
+	suite.Require().ErrorIs(err, types.ErrInvalidRate)
+
+	// the oracle calls are capped to the oracle gas limit
+	_, err = k.GetRate(suite.ctx, types.NewTWAPFeeToken(twapToken.Denom, loopingOracle.Hex(), 3600))
+	suite.Require().ErrorIs(err, types.ErrInvalidRate)
+}
+
+func (suite *KeeperTestSuite) TestConvertFeesToEVMDenom() {
+	suite.SetupTest()
+	k := suite.app.FeeAbsKeeper
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 10), sdk.NewInt64Coin("uatom", 5), sdk.NewInt64Coin("uosmo", 9), sdk.NewInt64Coin("ujuno", 1))
+	converted, err := k.ConvertFeesToEVMDenom(suite.ctx, fees)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 24), sdk.NewInt64Coin("ujuno", 1)), converted)
+
+	// the fees are left unchanged when the fee abstraction is disabled
+	suite.Require().NoError(k.SetParams(suite.ctx, types.NewParams(false, []types.FeeToken{govToken})))
+	converted, err = k.ConvertFeesToEVMDenom(suite.ctx, fees)
+	suite.Require().NoError(err)
+	suite.Require().Equal(fees, converted)
+}
+
+func (suite *KeeperTestSuite) TestGetSwappableAmount() {
+	suite.SetupTest()
+
+	// 50uatom * 2 + 1000uosmo * 0.5
+	suite.Require().Equal(sdk.NewInt(600), suite.app.FeeAbsKeeper.GetSwappableAmount(suite.ctx, payer))
+	suite.Require().True(suite.app.FeeAbsKeeper.GetSwappableAmount(suite.ctx, authority).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestSwapFeeTokensIfNecessary() {
+	testCases := []struct {
+		name       string
+		reserve    int64
+		fees       int64
+		expErr     error
+		expBalance sdk.Coins
+		expSwapped int64
+		expSwapIn  sdk.Coins
+	}{
+		{
+			"balance sufficient",
+			10_000,
+			100,
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 100), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uosmo", 1000)),
+			0,
+			sdk.Coins{},
+		},
+		{
+			"swap part of the first fee token, rounded up",
+			10_000,
+			151,
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 151), sdk.NewInt64Coin("uatom", 24), sdk.NewInt64Coin("uosmo", 1000)),
+			51,
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 26)),
+		},
+		{
+			"swap all the first fee token and part of the second",
+			10_000,
+			300,
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 300), sdk.NewInt64Coin("uosmo", 800)),
+			200,
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uosmo", 200)),
+		},
+		{
+			"fee tokens not sufficient",
+			10_000,
+			1000,
+			nil,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 700)),
+			600,
+			sdk.NewCoins(sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uosmo", 1000)),
+		},
+		{
+			"empty reserve",
+			0,
+			151,
+			types.ErrInsufficientReserve,
+			nil,
+			0,
+			nil,
+		},
+		{
+			"insufficient reserve",
+			50,
+			151,
+			types.ErrInsufficientReserve,
+			nil,
+			0,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			if tc.reserve > 0 {
+				suite.fundReserve(tc.reserve)
+			}
+
+			swappedIn, swappedOut, err := suite.app.FeeAbsKeeper.SwapFeeTokensIfNecessary(suite.ctx, payer, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, tc.fees)))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, payer))
+			suite.Require().Equal(sdk.NewInt(tc.expSwapped), swappedOut)
+			suite.Require().Equal(tc.expSwapIn, swappedIn)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundSwappedFeeTokens() {
+	testCases := []struct {
+		name       string
+		refund     int64
+		expBalance sdk.Coins
+		expReserve sdk.Coins
+	}{
+		{
+			"refund of half the swapped amount - half the fee tokens swapped back",
+			100,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 200), sdk.NewInt64Coin("uatom", 25), sdk.NewInt64Coin("uosmo", 900)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 9_900), sdk.NewInt64Coin("uatom", 25), sdk.NewInt64Coin("uosmo", 100)),
+		},
+		{
+			"refund higher than the swapped amount - capped to the swapped fee tokens",
+			250,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 100), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uosmo", 1000)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 10_000)),
+		},
+		{
+			"small refund - fee tokens swapped back rounded down",
+			1,
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 299), sdk.NewInt64Coin("uosmo", 801)),
+			sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 9_801), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uosmo", 199)),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.fundReserve(10_000)
+
+			// 50uatom and 200uosmo are swapped for 200 EVM denom
+			swappedIn, swappedOut, err := suite.app.FeeAbsKeeper.SwapFeeTokensIfNecessary(suite.ctx, payer, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 300)))
+			suite.Require().NoError(err)
+
+			err = suite.app.FeeAbsKeeper.RefundSwappedFeeTokens(suite.ctx, payer, swappedIn, swappedOut, sdk.NewInt(tc.refund))
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBalance, suite.app.BankKeeper.GetAllBalances(suite.ctx, payer))
+			suite.Require().Equal(tc.expReserve, suite.app.BankKeeper.GetAllBalances(suite.ctx, types.ModuleAddress.Bytes()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestFundReserve() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	amount := sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1000))
+
+	// governance funds the reserve with a community pool spend
+	suite.Require().NoError(testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, amount))
+	suite.Require().NoError(suite.app.DistrKeeper.FundCommunityPool(suite.ctx, amount, payer.Bytes()))
+	err := suite.app.DistrKeeper.DistributeFromFeePool(suite.ctx, amount, types.ModuleAddress.Bytes())
+	suite.Require().NoError(err)
+
+	res, err := suite.app.FeeAbsKeeper.Reserve(ctx, &types.QueryReserveRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.AccAddress(types.ModuleAddress.Bytes()).String(), res.Address)
+	suite.Require().Equal(amount, res.Balances)
+
+	// the swapped fee tokens accumulate in the reserve
+	_, _, err = suite.app.FeeAbsKeeper.SwapFeeTokensIfNecessary(suite.ctx, payer, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 200)))
+	suite.Require().NoError(err)
+
+	res, err = suite.app.FeeAbsKeeper.Reserve(ctx, &types.QueryReserveRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 900), sdk.NewInt64Coin("uatom", 50)), res.Balances)
+}
+
+func (suite *KeeperTestSuite) TestQueryGasPrice() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.FeeAbsKeeper
+
+	// the base fee of 10 is higher than the min gas price
+	res, err := k.GasPrice(ctx, &types.QueryGasPriceRequest{Denom: "uosmo"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoin("uosmo", sdk.NewInt(20)), res.GasPrice)
+
+	res, err = k.GasPrice(ctx, &types.QueryGasPriceRequest{Denom: "uatom"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoin("uatom", sdk.NewInt(5)), res.GasPrice)
+
+	_, err = k.GasPrice(ctx, &types.QueryGasPriceRequest{Denom: "ujuno"})
+	suite.Require().Error(err)
+
+	tokens, err := k.FeeTokens(ctx, &types.QueryFeeTokensRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(tokens.FeeTokens, 2)
+	suite.Require().Equal(twapRate, tokens.FeeTokens[1].Rate)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	suite.SetupTest()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	k := suite.app.FeeAbsKeeper
+	params := types.NewParams(true, []types.FeeToken{govToken})
+
+	_, err := k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: payer.String(), Params: params})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = k.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, k.GetParams(suite.ctx))
+}
@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"errors"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	"github.com/anryton/anryton/v2/x/feeabs/types"
+)
+
+// GetRate returns the current amount of EVM denom paid for one unit of the fee
+// token, either set by governance or queried from the TWAP oracle of the token.
+func (k Keeper) GetRate(ctx sdk.Context, feeToken types.FeeToken) (sdk.Dec, error) {
+	switch feeToken.RateSource {
+	case types.RATE_SOURCE_GOVERNANCE:
+		return feeToken.Rate, nil
+	case types.RATE_SOURCE_TWAP:
+		return k.getTWAPRate(ctx, feeToken)
+	default:
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidRate, "invalid rate source of fee token %s: %s", feeToken.Denom, feeToken.RateSource)
+	}
+}
+
+// getTWAPRate queries the time weighted average rate of the ERC20 token of the
+// fee token from its oracle contract. The oracle call runs during the ante
+// handler, so its gas is capped to OracleGasLimit.
+func (k Keeper) getTWAPRate(ctx sdk.Context, feeToken types.FeeToken) (sdk.Dec, error) {
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, feeToken.Denom))
+	if !found {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidRate, "fee token %s is not registered as an ERC20 token pair", feeToken.Denom)
+	}
+
+	data, err := types.OracleABI.Pack(types.ConsultMethod, pair.GetERC20Contract(), feeToken.TwapPeriod)
+	if err != nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidRate, "failed to pack the TWAP query of fee token %s: %s", feeToken.Denom, err)
+	}
+
+	oracle := common.HexToAddress(feeToken.OracleContract)
+	msg := ethtypes.NewMessage(
+		types.ModuleAddress,
+		&oracle,
+		0,                    // nonce
+		big.NewInt(0),        // amount
+		types.OracleGasLimit, // gasLimit
+		big.NewInt(0),        // gasFeeCap
+		big.NewInt(0),        // gasTipCap
+		big.NewInt(0),        // gasPrice
+		data,
+		ethtypes.AccessList{}, // AccessList
+		true,                  // isFake
+	)
+
+	res, err := k.evmKeeper.ApplyMessage(ctx, msg, evmtypes.NewNoOpTracer(), false)
+	if err == nil && res.Failed() {
+		err = errors.New(res.VmError)
+	}
+	if err != nil {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidRate, "failed to query the TWAP oracle of fee token %s: %s", feeToken.Denom, err)
+	}
+
+	unpacked, err := types.OracleABI.Unpack(types.ConsultMethod, res.Ret)
+	if err != nil || len(unpacked) == 0 {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidRate, "failed to unpack the TWAP rate of fee token %s", feeToken.Denom)
+	}
+
+	rate, ok := unpacked[0].(*big.Int)
+	if !ok || rate.Sign() <= 0 {
+		return sdk.Dec{}, errorsmod.Wrapf(types.ErrInvalidRate, "TWAP rate of fee token %s must be positive", feeToken.Denom)
+	}
+
+	return sdk.NewDecFromBigIntWithPrec(rate, types.RatePrecision), nil
+}
+
+// GetGasPrice returns the current minimum gas price in the fee token, converted
+// from the base fee, or the global min gas price if higher.
+func (k Keeper) GetGasPrice(ctx sdk.Context, feeToken types.FeeToken) (sdk.DecCoin, error) {
+	rate, err := k.GetRate(ctx, feeToken)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	gasPrice := k.feeMarketKeeper.GetParams(ctx).MinGasPrice
+	if baseFee := k.feeMarketKeeper.GetBaseFee(ctx); baseFee != nil {
+		gasPrice = sdk.MaxDec(gasPrice, sdk.NewDecFromBigInt(baseFee))
+	}
+
+	return sdk.NewDecCoinFromDec(feeToken.Denom, gasPrice.QuoRoundUp(rate)), nil
+}
@@ -0,0 +1,195 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/feeabs/types"
+)
+
+// ConvertFeesToEVMDenom returns the fees with the fee token coins converted to
+// their value in EVM denom at the current rates. The coins of other denoms are
+// left unchanged.
+func (k Keeper) ConvertFeesToEVMDenom(ctx sdk.Context, fees sdk.Coins) (sdk.Coins, error) {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeAbstraction || len(params.FeeTokens) == 0 {
+		return fees, nil
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	converted := sdk.Coins{}
+	evmAmount := sdkmath.ZeroInt()
+
+	for _, fee := range fees {
+		feeToken, found := params.GetFeeToken(fee.Denom)
+		if !found || fee.Denom == evmDenom {
+			converted = append(converted, fee)
+			continue
+		}
+
+		rate, err := k.GetRate(ctx, feeToken)
+		if err != nil {
+			return nil, err
+		}
+		evmAmount = evmAmount.Add(rate.MulInt(fee.Amount).TruncateInt())
+	}
+
+	if evmAmount.IsPositive() {
+		converted = converted.Add(sdk.NewCoin(evmDenom, evmAmount))
+	}
+	return converted, nil
+}
+
+// GetSwappableAmount returns the value in EVM denom of the spendable fee tokens
+// of the account. The fee tokens whose rates are unavailable are ignored.
+func (k Keeper) GetSwappableAmount(ctx sdk.Context, account sdk.AccAddress) sdkmath.Int {
+	params := k.GetParams(ctx)
+	if !params.EnableFeeAbstraction || len(params.FeeTokens) == 0 {
+		return sdkmath.ZeroInt()
+	}
+
+	spendable := k.bankKeeper.SpendableCoins(ctx, account)
+	amount := sdkmath.ZeroInt()
+
+	for _, feeToken := range params.FeeTokens {
+		balance := spendable.AmountOf(feeToken.Denom)
+		if !balance.IsPositive() {
+			continue
+		}
+
+		rate, err := k.GetRate(ctx, feeToken)
+		if err != nil {
+			continue
+		}
+		amount = amount.Add(rate.MulInt(balance).TruncateInt())
+	}
+	return amount
+}
+
+// SwapFeeTokensIfNecessary swaps the fee tokens of the payer against the module
+// reserve when the spendable EVM denom balance of the payer doesn't cover the
+// fees. The fee tokens are used in the order of the params, at their current
+// rates, until the missing amount is covered. It returns the swapped fee tokens
+// and the amount of EVM denom received for them. See types.ModuleAddress for
+// the funding of the reserve.
+func (k Keeper) SwapFeeTokensIfNecessary(ctx sdk.Context, payer sdk.AccAddress, fees sdk.Coins) (sdk.Coins, sdkmath.Int, error) {
+	swappedIn, swappedOut := sdk.Coins{}, sdkmath.ZeroInt()
+
+	params := k.GetParams(ctx)
+	if !params.EnableFeeAbstraction || len(params.FeeTokens) == 0 {
+		return swappedIn, swappedOut, nil
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	spendable := k.bankKeeper.SpendableCoins(ctx, payer)
+
+	missing := fees.AmountOf(evmDenom).Sub(spendable.AmountOf(evmDenom))
+	if !missing.IsPositive() {
+		return swappedIn, swappedOut, nil
+	}
+
+	for _, feeToken := range params.FeeTokens {
+		balance := spendable.AmountOf(feeToken.Denom)
+		if feeToken.Denom == evmDenom || !balance.IsPositive() {
+			continue
+		}
+
+		rate, err := k.GetRate(ctx, feeToken)
+		if err != nil {
+			k.Logger(ctx).Debug("skipping fee token without rate", "denom", feeToken.Denom, "error", err.Error())
+			continue
+		}
+
+		// swap the fee tokens covering the missing amount, rounding up, or the
+		// whole balance if not enough
+		amountIn := sdk.NewDecFromInt(missing).QuoRoundUp(rate).Ceil().TruncateInt()
+		amountOut := missing
+		if amountIn.GT(balance) {
+			amountIn = balance
+			amountOut = rate.MulInt(balance).TruncateInt()
+		}
+		if !amountOut.IsPositive() {
+			continue
+		}
+
+		if err := k.swap(ctx, payer, sdk.NewCoin(feeToken.Denom, amountIn), sdk.NewCoin(evmDenom, amountOut)); err != nil {
+			return nil, sdkmath.ZeroInt(), err
+		}
+		swappedIn = swappedIn.Add(sdk.NewCoin(feeToken.Denom, amountIn))
+		swappedOut = swappedOut.Add(amountOut)
+
+		missing = missing.Sub(amountOut)
+		if !missing.IsPositive() {
+			break
+		}
+	}
+
+	return swappedIn, swappedOut, nil
+}
+
+// RefundSwappedFeeTokens swaps back into fee tokens the refund in EVM denom of
+// fees paid with swapped fee tokens, up to the swapped amount, so that the
+// reserve only pays for the fees actually charged. Each fee token is returned
+// in proportion to the refunded share of the swapped amount, rounding down.
+func (k Keeper) RefundSwappedFeeTokens(ctx sdk.Context, payer sdk.AccAddress, swappedIn sdk.Coins, swappedOut, refund sdkmath.Int) error {
+	if swappedIn.IsZero() || !swappedOut.IsPositive() || !refund.IsPositive() {
+		return nil
+	}
+	if refund.GT(swappedOut) {
+		refund = swappedOut
+	}
+
+	refundedFeeTokens := sdk.Coins{}
+	for _, coin := range swappedIn {
+		refundedFeeTokens = refundedFeeTokens.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(refund).Quo(swappedOut)))
+	}
+	if refundedFeeTokens.IsZero() {
+		return nil
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	refunded := sdk.NewCoin(evmDenom, refund)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.Coins{refunded}); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, refundedFeeTokens); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundFeeTokens,
+			sdk.NewAttribute(types.AttributeKeyAccount, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, refunded.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, refundedFeeTokens.String()),
+		),
+	)
+	return nil
+}
+
+// swap transfers the fee tokens from the payer to the module account, and the
+// EVM denom from the module reserve to the payer.
+func (k Keeper) swap(ctx sdk.Context, payer sdk.AccAddress, amountIn, amountOut sdk.Coin) error {
+	reserve := k.bankKeeper.GetBalance(ctx, types.ModuleAddress.Bytes(), amountOut.Denom)
+	if reserve.IsLT(amountOut) {
+		return errorsmod.Wrapf(types.ErrInsufficientReserve, "reserve %s < %s", reserve, amountOut)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, payer, types.ModuleName, sdk.Coins{amountIn}); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, payer, sdk.Coins{amountOut}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSwapFeeTokens,
+			sdk.NewAttribute(types.AttributeKeyAccount, payer.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountIn, amountIn.String()),
+			sdk.NewAttribute(types.AttributeKeyAmountOut, amountOut.String()),
+		),
+	)
+	return nil
+}
@@ -0,0 +1,97 @@
+package keeper_test
+
+import (
+	"math/big"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/anryton/anryton/v2/app"
+	"github.com/anryton/anryton/v2/testutil"
+	"github.com/anryton/anryton/v2/utils"
+	erc20types "github.com/anryton/anryton/v2/x/erc20/types"
+	"github.com/anryton/anryton/v2/x/evm/statedb"
+	"github.com/anryton/anryton/v2/x/feeabs/types"
+)
+
+var (
+	payer     = sdk.AccAddress([]byte("payer_______________"))
+	authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+	erc20     = common.BytesToAddress([]byte("erc20"))
+
+	// the oracle returns a rate of 0.5 for every token, and the looping
+	// oracle runs out of gas
+	oracle        = common.BytesToAddress([]byte("oracle"))
+	loopingOracle = common.BytesToAddress([]byte("looping_oracle"))
+	oracleCode    = common.FromHex("0x6706f05b59d3b2000060005260206000f3") // RETURN(MSTORE(0, 5e17))
+	loopingCode   = common.FromHex("0x5b600056")                           // JUMPDEST JUMP(0)
+
+	// 1uatom is worth 2 EVM denom, 1uosmo is worth 0.5 EVM denom
+	govToken  = types.NewGovernanceFeeToken("uatom", sdk.NewDec(2))
+	twapToken = types.NewTWAPFeeToken("uosmo", oracle.Hex(), 3600)
+	twapRate  = sdk.NewDecWithPrec(5, 1)
+)
+
+// Test helpers
+func (suite *KeeperTestSuite) DoSetupTest() {
+	checkTx := false
+
+	// init app
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(checkTx, nil, chainID)
+
+	// setup context
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, suite.consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, header)
+
+	// the genesis validator proposes the block, as the EVM coinbase
+	consAddress, err := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.consAddress = consAddress
+	suite.ctx = suite.ctx.WithProposer(consAddress)
+
+	suite.denom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	// the base fee of 10 is higher than the min gas price of 5
+	feemarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feemarketParams.NoBaseFee = false
+	feemarketParams.MinGasPrice = sdk.NewDec(5)
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feemarketParams))
+	suite.app.FeeMarketKeeper.SetBaseFee(suite.ctx, big.NewInt(10))
+
+	for addr, code := range map[common.Address][]byte{
+		oracle:        oracleCode,
+		loopingOracle: loopingCode,
+	} {
+		account := statedb.NewEmptyAccount()
+		account.CodeHash = crypto.Keccak256(code)
+		suite.app.EvmKeeper.SetCode(suite.ctx, account.CodeHash, code)
+		suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, addr, *account))
+	}
+
+	// the uosmo fee token is registered as an ERC20 token pair
+	pair := erc20types.NewTokenPair(erc20, twapToken.Denom, erc20types.OWNER_MODULE)
+	suite.app.Erc20Keeper.SetTokenPair(suite.ctx, pair)
+	suite.app.Erc20Keeper.SetDenomMap(suite.ctx, pair.Denom, pair.GetID())
+	suite.app.Erc20Keeper.SetERC20Map(suite.ctx, erc20, pair.GetID())
+
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, payer, sdk.NewCoins(
+		sdk.NewInt64Coin(suite.denom, 100), sdk.NewInt64Coin("uatom", 50), sdk.NewInt64Coin("uosmo", 1000),
+	))
+	suite.Require().NoError(err)
+
+	err = suite.app.FeeAbsKeeper.SetParams(suite.ctx, types.NewParams(true, []types.FeeToken{govToken, twapToken}))
+	suite.Require().NoError(err)
+}
+
+// fundReserve funds the module reserve with EVM denom
+func (suite *KeeperTestSuite) fundReserve(amount int64) {
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, amount)))
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidParams       = errorsmod.Register(ModuleName, 2, "invalid feeabs params")
+	ErrFeeTokenNotFound    = errorsmod.Register(ModuleName, 3, "fee token not found")
+	ErrInvalidRate         = errorsmod.Register(ModuleName, 4, "invalid fee token rate")
+	ErrInsufficientReserve = errorsmod.Register(ModuleName, 5, "insufficient swap reserve")
+)
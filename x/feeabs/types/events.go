@@ -0,0 +1,11 @@
+package types
+
+// feeabs events
+const (
+	EventTypeSwapFeeTokens   = "swap_fee_tokens"
+	EventTypeRefundFeeTokens = "refund_fee_tokens"
+
+	AttributeKeyAccount   = "account"
+	AttributeKeyAmountIn  = "amount_in"
+	AttributeKeyAmountOut = "amount_out"
+)
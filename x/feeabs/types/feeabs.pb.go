@@ -0,0 +1,1089 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: anryton/feeabs/v1/feeabs.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateSource defines the source of the conversion rate of a fee token.
+type RateSource int32
+
+const (
+	// RATE_SOURCE_UNSPECIFIED defines an invalid rate source
+	RATE_SOURCE_UNSPECIFIED RateSource = 0
+	// RATE_SOURCE_GOVERNANCE defines a fixed rate set by governance
+	RATE_SOURCE_GOVERNANCE RateSource = 1
+	// RATE_SOURCE_TWAP defines a time weighted average rate queried from an
+	// on-chain pool oracle contract
+	RATE_SOURCE_TWAP RateSource = 2
+)
+
+var RateSource_name = map[int32]string{
+	0: "RATE_SOURCE_UNSPECIFIED",
+	1: "RATE_SOURCE_GOVERNANCE",
+	2: "RATE_SOURCE_TWAP",
+}
+
+var RateSource_value = map[string]int32{
+	"RATE_SOURCE_UNSPECIFIED": 0,
+	"RATE_SOURCE_GOVERNANCE":  1,
+	"RATE_SOURCE_TWAP":        2,
+}
+
+func (x RateSource) String() string {
+	return proto.EnumName(RateSource_name, int32(x))
+}
+
+func (RateSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_724c85f7ddafcdbc, []int{0}
+}
+
+// FeeToken defines a token accepted to pay the fees in place of the EVM denom.
+type FeeToken struct {
+	// denom is the bank denom of the token. The tokens with a TWAP rate source
+	// must be registered as a x/erc20 token pair.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate_source is the source of the conversion rate of the token
+	RateSource RateSource `protobuf:"varint,2,opt,name=rate_source,json=rateSource,proto3,enum=anryton.feeabs.v1.RateSource" json:"rate_source,omitempty"`
+	// rate is the amount of EVM denom paid for one unit of the token, used by
+	// the governance rate source
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// oracle_contract is the hex address of the pool oracle contract used by
+	// the TWAP rate source
+	OracleContract string `protobuf:"bytes,4,opt,name=oracle_contract,json=oracleContract,proto3" json:"oracle_contract,omitempty"`
+	// twap_period is the period in seconds of the TWAP rate
+	TwapPeriod uint32 `protobuf:"varint,5,opt,name=twap_period,json=twapPeriod,proto3" json:"twap_period,omitempty"`
+}
+
+func (m *FeeToken) Reset()         { *m = FeeToken{} }
+func (m *FeeToken) String() string { return proto.CompactTextString(m) }
+func (*FeeToken) ProtoMessage()    {}
+func (*FeeToken) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724c85f7ddafcdbc, []int{0}
+}
+func (m *FeeToken) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeToken) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeToken.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeToken) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeToken.Merge(m, src)
+}
+func (m *FeeToken) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeToken) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeToken.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeToken proto.InternalMessageInfo
+
+func (m *FeeToken) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeToken) GetRateSource() RateSource {
+	if m != nil {
+		return m.RateSource
+	}
+	return RATE_SOURCE_UNSPECIFIED
+}
+
+func (m *FeeToken) GetOracleContract() string {
+	if m != nil {
+		return m.OracleContract
+	}
+	return ""
+}
+
+func (m *FeeToken) GetTwapPeriod() uint32 {
+	if m != nil {
+		return m.TwapPeriod
+	}
+	return 0
+}
+
+// Params defines the parameters of the feeabs module.
+type Params struct {
+	// enable_fee_abstraction toggles the payment of the fees with fee tokens
+	EnableFeeAbstraction bool `protobuf:"varint,1,opt,name=enable_fee_abstraction,json=enableFeeAbstraction,proto3" json:"enable_fee_abstraction,omitempty"`
+	// fee_tokens are the tokens accepted to pay the fees, in order of use
+	FeeTokens []FeeToken `protobuf:"bytes,2,rep,name=fee_tokens,json=feeTokens,proto3" json:"fee_tokens"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724c85f7ddafcdbc, []int{1}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnableFeeAbstraction() bool {
+	if m != nil {
+		return m.EnableFeeAbstraction
+	}
+	return false
+}
+
+func (m *Params) GetFeeTokens() []FeeToken {
+	if m != nil {
+		return m.FeeTokens
+	}
+	return nil
+}
+
+// FeeTokenRate defines the current conversion rate of a fee token.
+type FeeTokenRate struct {
+	// fee_token is the fee token
+	FeeToken FeeToken `protobuf:"bytes,1,opt,name=fee_token,json=feeToken,proto3" json:"fee_token"`
+	// rate is the current amount of EVM denom paid for one unit of the token
+	Rate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"rate"`
+	// gas_price is the current minimum gas price in the fee token
+	GasPrice types.DecCoin `protobuf:"bytes,3,opt,name=gas_price,json=gasPrice,proto3" json:"gas_price"`
+}
+
+func (m *FeeTokenRate) Reset()         { *m = FeeTokenRate{} }
+func (m *FeeTokenRate) String() string { return proto.CompactTextString(m) }
+func (*FeeTokenRate) ProtoMessage()    {}
+func (*FeeTokenRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_724c85f7ddafcdbc, []int{2}
+}
+func (m *FeeTokenRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeTokenRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeTokenRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeTokenRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeTokenRate.Merge(m, src)
+}
+func (m *FeeTokenRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeTokenRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeTokenRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeTokenRate proto.InternalMessageInfo
+
+func (m *FeeTokenRate) GetFeeToken() FeeToken {
+	if m != nil {
+		return m.FeeToken
+	}
+	return FeeToken{}
+}
+
+func (m *FeeTokenRate) GetGasPrice() types.DecCoin {
+	if m != nil {
+		return m.GasPrice
+	}
+	return types.DecCoin{}
+}
+
+func init() {
+	proto.RegisterEnum("anryton.feeabs.v1.RateSource", RateSource_name, RateSource_value)
+	proto.RegisterType((*FeeToken)(nil), "anryton.feeabs.v1.FeeToken")
+	proto.RegisterType((*Params)(nil), "anryton.feeabs.v1.Params")
+	proto.RegisterType((*FeeTokenRate)(nil), "anryton.feeabs.v1.FeeTokenRate")
+}
+
+func init() { proto.RegisterFile("anryton/feeabs/v1/feeabs.proto", fileDescriptor_724c85f7ddafcdbc) }
+
+var fileDescriptor_724c85f7ddafcdbc = []byte{
+	// 534 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xf6, 0xa6, 0x69, 0xd5, 0x4c, 0xa0, 0x84, 0x55, 0x54, 0xac, 0x14, 0x9c, 0xa8, 0x07, 0x88,
+	0x10, 0xd8, 0x4a, 0xe0, 0xc4, 0xa1, 0x90, 0xa4, 0x0e, 0xea, 0xa5, 0x8d, 0x9c, 0x14, 0x24, 0x2e,
+	0xd6, 0xda, 0x99, 0x04, 0xab, 0x8d, 0x37, 0xf2, 0x6e, 0x03, 0xbd, 0x71, 0xe4, 0xc8, 0x23, 0x20,
+	0xf1, 0x32, 0x3d, 0x56, 0xe2, 0x82, 0x38, 0x54, 0x28, 0xb9, 0xf0, 0x00, 0x3c, 0x00, 0x5a, 0xff,
+	0xa4, 0x91, 0xe0, 0x80, 0xc4, 0x69, 0x67, 0xbe, 0x6f, 0x66, 0x3c, 0xf3, 0x79, 0x06, 0x0c, 0x16,
+	0x46, 0xe7, 0x92, 0x87, 0xd6, 0x08, 0x91, 0x79, 0xc2, 0x9a, 0x35, 0x52, 0xcb, 0x9c, 0x46, 0x5c,
+	0x72, 0x7a, 0x3b, 0xe5, 0xcd, 0x14, 0x9d, 0x35, 0x2a, 0x86, 0xcf, 0xc5, 0x84, 0x0b, 0xcb, 0x63,
+	0x02, 0xad, 0x59, 0xc3, 0x43, 0xc9, 0x1a, 0x96, 0xcf, 0x83, 0x30, 0x49, 0xa9, 0x94, 0xc7, 0x7c,
+	0xcc, 0x63, 0xd3, 0x52, 0x56, 0x82, 0xee, 0xfe, 0x22, 0xb0, 0xd9, 0x45, 0x1c, 0xf0, 0x13, 0x0c,
+	0x69, 0x19, 0xd6, 0x87, 0x18, 0xf2, 0x89, 0x4e, 0x6a, 0xa4, 0x5e, 0x70, 0x12, 0x87, 0xee, 0x41,
+	0x31, 0x62, 0x12, 0x5d, 0xc1, 0xcf, 0x22, 0x1f, 0xf5, 0x5c, 0x8d, 0xd4, 0xb7, 0x9a, 0xf7, 0xcc,
+	0x3f, 0x3a, 0x30, 0x1d, 0x26, 0xb1, 0x1f, 0x07, 0x39, 0x10, 0x2d, 0x6d, 0xda, 0x86, 0xbc, 0xf2,
+	0xf4, 0x35, 0x55, 0xb4, 0x6d, 0x5e, 0x5c, 0x55, 0xb5, 0xef, 0x57, 0xd5, 0xfb, 0xe3, 0x40, 0xbe,
+	0x3d, 0xf3, 0x4c, 0x9f, 0x4f, 0xac, 0xb4, 0xf3, 0xe4, 0x79, 0x2c, 0x86, 0x27, 0x96, 0x3c, 0x9f,
+	0xa2, 0x30, 0xf7, 0xd1, 0x77, 0xe2, 0x5c, 0xfa, 0x00, 0x6e, 0xf1, 0x88, 0xf9, 0xa7, 0xe8, 0xfa,
+	0x3c, 0x94, 0x11, 0xf3, 0xa5, 0x9e, 0x8f, 0x7b, 0xdc, 0x4a, 0xe0, 0x4e, 0x8a, 0xd2, 0x2a, 0x14,
+	0xe5, 0x3b, 0x36, 0x75, 0xa7, 0x18, 0x05, 0x7c, 0xa8, 0xaf, 0xd7, 0x48, 0xfd, 0xa6, 0x03, 0x0a,
+	0xea, 0xc5, 0xc8, 0xb3, 0xfc, 0xcf, 0xcf, 0x55, 0xb2, 0xfb, 0x81, 0xc0, 0x46, 0x8f, 0x45, 0x6c,
+	0x22, 0xe8, 0x53, 0xd8, 0xc6, 0x90, 0x79, 0xa7, 0xe8, 0x8e, 0x10, 0x5d, 0xe6, 0x89, 0xb8, 0x50,
+	0xc0, 0xc3, 0x58, 0x85, 0x4d, 0xa7, 0x9c, 0xb0, 0x5d, 0xc4, 0xd6, 0x35, 0x47, 0x5f, 0x00, 0xa8,
+	0x70, 0xa9, 0x74, 0x13, 0x7a, 0xae, 0xb6, 0x56, 0x2f, 0x36, 0x77, 0xfe, 0xa2, 0x49, 0xa6, 0x6d,
+	0x3b, 0xaf, 0xe6, 0x76, 0x0a, 0xa3, 0xd4, 0x17, 0xbb, 0x5f, 0x09, 0xdc, 0xc8, 0x58, 0xa5, 0x1c,
+	0xdd, 0x83, 0xc2, 0xb2, 0x64, 0xfc, 0xed, 0x7f, 0xaa, 0xb8, 0x99, 0x55, 0x5c, 0xea, 0x9c, 0xfb,
+	0x0f, 0x9d, 0x9f, 0x43, 0x61, 0xcc, 0x84, 0x3b, 0x8d, 0x02, 0x3f, 0xf9, 0x61, 0xc5, 0xe6, 0x5d,
+	0x33, 0x89, 0x37, 0xd5, 0x62, 0x99, 0xe9, 0x62, 0xa9, 0x94, 0x0e, 0x0f, 0x96, 0x4d, 0x8c, 0x99,
+	0xe8, 0xa9, 0x9c, 0x87, 0x0c, 0xe0, 0x7a, 0x0d, 0xe8, 0x0e, 0xdc, 0x71, 0x5a, 0x03, 0xdb, 0xed,
+	0x1f, 0x1d, 0x3b, 0x1d, 0xdb, 0x3d, 0x3e, 0xec, 0xf7, 0xec, 0xce, 0x41, 0xf7, 0xc0, 0xde, 0x2f,
+	0x69, 0xb4, 0x02, 0xdb, 0xab, 0xe4, 0xcb, 0xa3, 0x57, 0xb6, 0x73, 0xd8, 0x3a, 0xec, 0xd8, 0x25,
+	0x42, 0xcb, 0x50, 0x5a, 0xe5, 0x06, 0xaf, 0x5b, 0xbd, 0x52, 0xae, 0x92, 0xff, 0xf8, 0xc5, 0xd0,
+	0xda, 0xdd, 0x8b, 0xb9, 0x41, 0x2e, 0xe7, 0x06, 0xf9, 0x31, 0x37, 0xc8, 0xa7, 0x85, 0xa1, 0x5d,
+	0x2e, 0x0c, 0xed, 0xdb, 0xc2, 0xd0, 0xde, 0x3c, 0x5a, 0x99, 0x35, 0x3b, 0xa0, 0xec, 0x9d, 0x35,
+	0xad, 0xf7, 0xd9, 0x35, 0xc5, 0x53, 0x7b, 0x1b, 0xf1, 0x05, 0x3c, 0xf9, 0x3d, 0x00, 0x23, 0x4e,
+	0x51, 0x36, 0x6c, 0x03, 0x00, 0x00,
+}
+
+func (this *FeeToken) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeToken)
+	if !ok {
+		that2, ok := that.(FeeToken)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.RateSource != that1.RateSource {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if this.OracleContract != that1.OracleContract {
+		return false
+	}
+	if this.TwapPeriod != that1.TwapPeriod {
+		return false
+	}
+	return true
+}
+func (m *FeeToken) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeToken) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeToken) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TwapPeriod != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.TwapPeriod))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.OracleContract) > 0 {
+		i -= len(m.OracleContract)
+		copy(dAtA[i:], m.OracleContract)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.OracleContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.RateSource != 0 {
+		i = encodeVarintFeeabs(dAtA, i, uint64(m.RateSource))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeeabs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeeTokens) > 0 {
+		for iNdEx := len(m.FeeTokens) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeTokens[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeeabs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EnableFeeAbstraction {
+		i--
+		if m.EnableFeeAbstraction {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeTokenRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeTokenRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeTokenRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.GasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.FeeToken.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintFeeabs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeeabs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeeabs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *FeeToken) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.RateSource != 0 {
+		n += 1 + sovFeeabs(uint64(m.RateSource))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = len(m.OracleContract)
+	if l > 0 {
+		n += 1 + l + sovFeeabs(uint64(l))
+	}
+	if m.TwapPeriod != 0 {
+		n += 1 + sovFeeabs(uint64(m.TwapPeriod))
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableFeeAbstraction {
+		n += 2
+	}
+	if len(m.FeeTokens) > 0 {
+		for _, e := range m.FeeTokens {
+			l = e.Size()
+			n += 1 + l + sovFeeabs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FeeTokenRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.FeeToken.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = m.Rate.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	l = m.GasPrice.Size()
+	n += 1 + l + sovFeeabs(uint64(l))
+	return n
+}
+
+func sovFeeabs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeeabs(x uint64) (n int) {
+	return sovFeeabs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *FeeToken) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeToken: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeToken: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateSource", wireType)
+			}
+			m.RateSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateSource |= RateSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TwapPeriod", wireType)
+			}
+			m.TwapPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TwapPeriod |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableFeeAbstraction", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableFeeAbstraction = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeTokens", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeTokens = append(m.FeeTokens, FeeToken{})
+			if err := m.FeeTokens[len(m.FeeTokens)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeTokenRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeTokenRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeTokenRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeToken", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.FeeToken.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeeabs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeeabs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeeabs(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeeabs
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeeabs
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeeabs
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeeabs
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeeabs
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeeabs        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeeabs          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeeabs = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,42 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	oracle := "0x1D54EcB8583Ca25895c512A8308389fFD581F9c9"
+	govToken := NewGovernanceFeeToken("uatom", sdk.NewDecWithPrec(5, 1))
+	twapToken := NewTWAPFeeToken("uosmo", oracle, 3600)
+
+	testCases := []struct {
+		name      string
+		genState  *GenesisState
+		expectErr bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState(NewParams(true, []FeeToken{govToken, twapToken})), false},
+		{"duplicated fee token", NewGenesisState(NewParams(true, []FeeToken{govToken, govToken})), true},
+		{"invalid denom", NewGenesisState(NewParams(true, []FeeToken{NewGovernanceFeeToken("1", sdk.OneDec())})), true},
+		{"zero governance rate", NewGenesisState(NewParams(true, []FeeToken{NewGovernanceFeeToken("uatom", sdk.ZeroDec())})), true},
+		{"nil governance rate", NewGenesisState(NewParams(true, []FeeToken{{Denom: "uatom", RateSource: RATE_SOURCE_GOVERNANCE}})), true},
+		{"invalid oracle contract", NewGenesisState(NewParams(true, []FeeToken{NewTWAPFeeToken("uosmo", "oracle", 3600)})), true},
+		{"zero oracle contract", NewGenesisState(NewParams(true, []FeeToken{NewTWAPFeeToken("uosmo", "0x0000000000000000000000000000000000000000", 3600)})), true},
+		{"zero twap period", NewGenesisState(NewParams(true, []FeeToken{NewTWAPFeeToken("uosmo", oracle, 0)})), true},
+		{"unspecified rate source", NewGenesisState(NewParams(true, []FeeToken{{Denom: "uatom", Rate: sdk.OneDec()}})), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
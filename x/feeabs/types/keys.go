@@ -0,0 +1,42 @@
+package types
+
+import (
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "feeabs"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// ModuleAddress is the native module address for the feeabs module, holding
+// the reserve of EVM denom swapped for the fee tokens.
+//
+// Nothing mints into the reserve: it is funded at genesis with a bank balance
+// of the module account, or by governance with a community pool spend to the
+// module account, which is allowed to receive coins. The swapped fee tokens
+// accumulate in the reserve, whose balances are returned by the Reserve query.
+// Swaps fail with ErrInsufficientReserve once the EVM denom is exhausted.
+var ModuleAddress common.Address
+
+func init() {
+	ModuleAddress = common.BytesToAddress(authtypes.NewModuleAddress(ModuleName).Bytes())
+}
+
+// prefix bytes for the feeabs persistent store
+const (
+	prefixParams = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixParams = []byte{prefixParams}
+)
@@ -0,0 +1,39 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// ConsultMethod is the method of the TWAP oracles returning the time
+	// weighted average amount of EVM denom paid for one unit of a token,
+	// scaled by 1e18
+	ConsultMethod = "consult"
+
+	// RatePrecision is the decimal precision of the rates returned by the
+	// TWAP oracles
+	RatePrecision = 18
+
+	// OracleGasLimit is the gas limit of the calls to the TWAP oracles, which
+	// are queried by the ante handler to value the fee tokens
+	OracleGasLimit uint64 = 100_000
+)
+
+// oracleABI is the ABI of the ITWAPOracle interface implemented by the pool
+// oracle contracts of the fee tokens with a TWAP rate source.
+const oracleABI = `[
+	{"type":"function","name":"consult","stateMutability":"view","inputs":[{"name":"token","type":"address"},{"name":"period","type":"uint32"}],"outputs":[{"name":"rate","type":"uint256"}]}
+]`
+
+// OracleABI is the parsed ABI of the TWAP oracles.
+var OracleABI abi.ABI
+
+func init() {
+	var err error
+	OracleABI, err = abi.JSON(strings.NewReader(oracleABI))
+	if err != nil {
+		panic(err)
+	}
+}
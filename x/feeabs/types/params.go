@@ -0,0 +1,91 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anrytontypes "github.com/anryton/anryton/v2/types"
+)
+
+// NewParams creates a new Params object
+func NewParams(enableFeeAbstraction bool, feeTokens []FeeToken) Params {
+	return Params{
+		EnableFeeAbstraction: enableFeeAbstraction,
+		FeeTokens:            feeTokens,
+	}
+}
+
+// DefaultParams returns the default feeabs params, without fee tokens
+func DefaultParams() Params {
+	return NewParams(true, []FeeToken{})
+}
+
+// Validate performs a basic validation of the params
+func (p Params) Validate() error {
+	seen := make(map[string]bool)
+	for _, feeToken := range p.FeeTokens {
+		if seen[feeToken.Denom] {
+			return errorsmod.Wrapf(ErrInvalidParams, "duplicated fee token %s", feeToken.Denom)
+		}
+		if err := feeToken.Validate(); err != nil {
+			return errorsmod.Wrap(ErrInvalidParams, err.Error())
+		}
+		seen[feeToken.Denom] = true
+	}
+	return nil
+}
+
+// GetFeeToken returns the fee token of the given denom
+func (p Params) GetFeeToken(denom string) (FeeToken, bool) {
+	for _, feeToken := range p.FeeTokens {
+		if feeToken.Denom == denom {
+			return feeToken, true
+		}
+	}
+	return FeeToken{}, false
+}
+
+// NewGovernanceFeeToken creates a new fee token with a fixed rate
+func NewGovernanceFeeToken(denom string, rate sdk.Dec) FeeToken {
+	return FeeToken{
+		Denom:      denom,
+		RateSource: RATE_SOURCE_GOVERNANCE,
+		Rate:       rate,
+	}
+}
+
+// NewTWAPFeeToken creates a new fee token with a rate queried from a TWAP
+// oracle contract
+func NewTWAPFeeToken(denom, oracleContract string, twapPeriod uint32) FeeToken {
+	return FeeToken{
+		Denom:          denom,
+		RateSource:     RATE_SOURCE_TWAP,
+		Rate:           sdk.ZeroDec(),
+		OracleContract: oracleContract,
+		TwapPeriod:     twapPeriod,
+	}
+}
+
+// Validate performs a basic validation of the fee token
+func (ft FeeToken) Validate() error {
+	if err := sdk.ValidateDenom(ft.Denom); err != nil {
+		return err
+	}
+
+	switch ft.RateSource {
+	case RATE_SOURCE_GOVERNANCE:
+		if ft.Rate.IsNil() || !ft.Rate.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidRate, "rate of fee token %s must be positive: %s", ft.Denom, ft.Rate)
+		}
+	case RATE_SOURCE_TWAP:
+		if err := anrytontypes.ValidateNonZeroAddress(ft.OracleContract); err != nil {
+			return errorsmod.Wrapf(err, "invalid oracle contract of fee token %s", ft.Denom)
+		}
+		if ft.TwapPeriod == 0 {
+			return errorsmod.Wrapf(ErrInvalidRate, "twap period of fee token %s cannot be zero", ft.Denom)
+		}
+	default:
+		return errorsmod.Wrapf(ErrInvalidRate, "invalid rate source of fee token %s: %s", ft.Denom, ft.RateSource)
+	}
+	return nil
+}
@@ -35,10 +35,15 @@ func (k *Keeper) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
 	})
 }
 
-// EndBlock update block gas wanted.
+// EndBlock burns or distributes the escrowed base fees and updates block gas
+// wanted.
 // The EVM end block logic doesn't update the validator set, thus it returns
 // an empty slice.
 func (k *Keeper) EndBlock(ctx sdk.Context, _ abci.RequestEndBlock) {
+	if err := k.DisposeBaseFees(ctx); err != nil {
+		k.Logger(ctx).Error("failed to dispose the escrowed base fees", "error", err.Error())
+	}
+
 	if ctx.BlockGasMeter() == nil {
 		k.Logger(ctx).Error("block gas meter is nil when setting block gas wanted")
 		return
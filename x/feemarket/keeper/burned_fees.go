@@ -0,0 +1,107 @@
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/anryton/anryton/v2/x/feemarket/types"
+)
+
+// DisposeBaseFees burns or sends to the community pool the base fees escrowed
+// by the module account during the block, according to the base fee
+// destination.
+// CONTRACT: this should be only called during EndBlock.
+func (k Keeper) DisposeBaseFees(ctx sdk.Context) error {
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	escrowed := k.bankKeeper.GetAllBalances(ctx, moduleAddr)
+
+	burned := sdk.Coins{}
+	destination := k.GetParams(ctx).BaseFeeDestination
+
+	if !escrowed.IsZero() {
+		var err error
+		switch destination {
+		case types.BASE_FEE_DESTINATION_BURN:
+			err = k.bankKeeper.BurnCoins(ctx, types.ModuleName, escrowed)
+			burned = escrowed
+		case types.BASE_FEE_DESTINATION_COMMUNITY_POOL:
+			err = k.distrKeeper.FundCommunityPool(ctx, escrowed, moduleAddr)
+		default:
+			// the base fee destination was changed during the block
+			err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, escrowed)
+		}
+		if err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeDisposeBaseFee,
+			sdk.NewAttribute(types.AttributeKeyAmount, escrowed.String()),
+			sdk.NewAttribute(types.AttributeKeyDestination, destination.String()),
+		))
+	}
+
+	k.setBurnedFees(ctx, types.KeyPrefixBlockBurnedFees, burned)
+	if !burned.IsZero() {
+		k.SetTotalBurnedFees(ctx, k.GetTotalBurnedFees(ctx).Add(burned...))
+	}
+	return nil
+}
+
+// GetBlockBurnedFees returns the base fees burned in the last block.
+func (k Keeper) GetBlockBurnedFees(ctx sdk.Context) sdk.Coins {
+	return k.getBurnedFees(ctx, types.KeyPrefixBlockBurnedFees)
+}
+
+// GetTotalBurnedFees returns the total base fees burned.
+func (k Keeper) GetTotalBurnedFees(ctx sdk.Context) sdk.Coins {
+	return k.getBurnedFees(ctx, types.KeyPrefixTotalBurnedFees)
+}
+
+// SetTotalBurnedFees sets the total base fees burned.
+func (k Keeper) SetTotalBurnedFees(ctx sdk.Context, burned sdk.Coins) {
+	k.setBurnedFees(ctx, types.KeyPrefixTotalBurnedFees, burned)
+}
+
+// getBurnedFees returns the burned amounts stored by denom under the prefix.
+func (k Keeper) getBurnedFees(ctx sdk.Context, keyPrefix []byte) sdk.Coins {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	burned := sdk.Coins{}
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdkmath.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		burned = append(burned, sdk.NewCoin(string(iterator.Key()), amount))
+	}
+	return burned
+}
+
+// setBurnedFees replaces the burned amounts stored by denom under the prefix.
+func (k Keeper) setBurnedFees(ctx sdk.Context, keyPrefix []byte, burned sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), keyPrefix)
+
+	iterator := store.Iterator(nil, nil)
+	var denoms [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, denom := range denoms {
+		store.Delete(denom)
+	}
+
+	for _, coin := range burned {
+		bz, err := coin.Amount.Marshal()
+		if err != nil {
+			panic(err)
+		}
+		store.Set([]byte(coin.Denom), bz)
+	}
+}
@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/anryton/anryton/v2/testutil"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	"github.com/anryton/anryton/v2/x/feemarket/types"
+)
+
+func (suite *KeeperTestSuite) TestDisposeBaseFees() {
+	escrowed := sdk.NewCoins(sdk.NewInt64Coin(evmtypes.DefaultEVMDenom, 1000))
+
+	testCases := []struct {
+		name         string
+		destination  types.BaseFeeDestination
+		expBurned    sdk.Coins
+		expCollected sdk.Coins
+		expCommunity sdk.DecCoins
+	}{
+		{
+			"burn",
+			types.BASE_FEE_DESTINATION_BURN,
+			escrowed,
+			sdk.Coins{},
+			sdk.DecCoins{},
+		},
+		{
+			"community pool",
+			types.BASE_FEE_DESTINATION_COMMUNITY_POOL,
+			sdk.Coins{},
+			sdk.Coins{},
+			sdk.NewDecCoinsFromCoins(escrowed...),
+		},
+		{
+			"destination changed to the fee collector during the block",
+			types.BASE_FEE_DESTINATION_FEE_COLLECTOR,
+			sdk.Coins{},
+			escrowed,
+			sdk.DecCoins{},
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			k := suite.app.FeeMarketKeeper
+			feeCollector := suite.app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+			params := k.GetParams(suite.ctx)
+			params.BaseFeeDestination = tc.destination
+			suite.Require().NoError(k.SetParams(suite.ctx, params))
+
+			collected := suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector)
+			community := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx)
+			err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, types.ModuleName, escrowed)
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(k.DisposeBaseFees(suite.ctx))
+
+			moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+			suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, moduleAddr).IsZero())
+			suite.Require().Equal(tc.expBurned, k.GetBlockBurnedFees(suite.ctx))
+			suite.Require().Equal(tc.expBurned, k.GetTotalBurnedFees(suite.ctx))
+			suite.Require().Equal(collected.Add(tc.expCollected...), suite.app.BankKeeper.GetAllBalances(suite.ctx, feeCollector))
+			suite.Require().Equal(community.Add(tc.expCommunity...), suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx))
+
+			// the block burned fees are reset on the next block
+			suite.Require().NoError(k.DisposeBaseFees(suite.ctx))
+			suite.Require().Equal(sdk.Coins{}, k.GetBlockBurnedFees(suite.ctx))
+			suite.Require().Equal(tc.expBurned, k.GetTotalBurnedFees(suite.ctx))
+
+			res, err := k.BurnedFees(sdk.WrapSDKContext(suite.ctx), &types.QueryBurnedFeesRequest{})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expBurned, res.TotalBurned)
+		})
+	}
+}
@@ -37,6 +37,16 @@ func (k Keeper) BaseFee(c context.Context, _ *types.QueryBaseFeeRequest) (*types
 	return res, nil
 }
 
+// BurnedFees implements the Query/BurnedFees gRPC method
+func (k Keeper) BurnedFees(c context.Context, _ *types.QueryBurnedFeesRequest) (*types.QueryBurnedFeesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryBurnedFeesResponse{
+		BlockBurned: k.GetBlockBurnedFees(ctx),
+		TotalBurned: k.GetTotalBurnedFees(ctx),
+	}, nil
+}
+
 // BlockGas implements the Query/BlockGas gRPC method
 func (k Keeper) BlockGas(c context.Context, _ *types.QueryBlockGasRequest) (*types.QueryBlockGasResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
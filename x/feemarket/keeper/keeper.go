@@ -24,13 +24,21 @@ type Keeper struct {
 	transientKey storetypes.StoreKey
 	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
 	authority sdk.AccAddress
+	// keepers used to burn or distribute the escrowed base fees
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistributionKeeper
 	// Legacy subspace
 	ss paramstypes.Subspace
 }
 
 // NewKeeper generates new fee market module keeper
 func NewKeeper(
-	cdc codec.BinaryCodec, authority sdk.AccAddress, storeKey, transientKey storetypes.StoreKey, ss paramstypes.Subspace,
+	cdc codec.BinaryCodec,
+	authority sdk.AccAddress,
+	storeKey, transientKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	ss paramstypes.Subspace,
 ) Keeper {
 	// ensure authority account is correctly formatted
 	if err := sdk.VerifyAddressFormat(authority); err != nil {
@@ -42,6 +50,8 @@ func NewKeeper(
 		storeKey:     storeKey,
 		authority:    authority,
 		transientKey: transientKey,
+		bankKeeper:   bankKeeper,
+		distrKeeper:  distrKeeper,
 		ss:           ss,
 	}
 }
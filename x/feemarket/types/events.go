@@ -2,7 +2,10 @@ package types
 
 // feemarket module events
 const (
-	EventTypeFeeMarket = "fee_market"
+	EventTypeFeeMarket      = "fee_market"
+	EventTypeDisposeBaseFee = "dispose_base_fee"
 
-	AttributeKeyBaseFee = "base_fee"
+	AttributeKeyBaseFee     = "base_fee"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyDestination = "destination"
 )
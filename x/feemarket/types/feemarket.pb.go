@@ -24,6 +24,40 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// BaseFeeDestination defines where the base fee share of the EVM tx fees goes
+type BaseFeeDestination int32
+
+const (
+	// BASE_FEE_DESTINATION_FEE_COLLECTOR distributes the base fee to the
+	// stakers with the priority tips
+	BASE_FEE_DESTINATION_FEE_COLLECTOR BaseFeeDestination = 0
+	// BASE_FEE_DESTINATION_BURN burns the base fee
+	BASE_FEE_DESTINATION_BURN BaseFeeDestination = 1
+	// BASE_FEE_DESTINATION_COMMUNITY_POOL sends the base fee to the community
+	// pool
+	BASE_FEE_DESTINATION_COMMUNITY_POOL BaseFeeDestination = 2
+)
+
+var BaseFeeDestination_name = map[int32]string{
+	0: "BASE_FEE_DESTINATION_FEE_COLLECTOR",
+	1: "BASE_FEE_DESTINATION_BURN",
+	2: "BASE_FEE_DESTINATION_COMMUNITY_POOL",
+}
+
+var BaseFeeDestination_value = map[string]int32{
+	"BASE_FEE_DESTINATION_FEE_COLLECTOR":  0,
+	"BASE_FEE_DESTINATION_BURN":           1,
+	"BASE_FEE_DESTINATION_COMMUNITY_POOL": 2,
+}
+
+func (x BaseFeeDestination) String() string {
+	return proto.EnumName(BaseFeeDestination_name, int32(x))
+}
+
+func (BaseFeeDestination) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_4feb8b20cf98e6e1, []int{0}
+}
+
 // Params defines the EVM module parameters
 type Params struct {
 	// no_base_fee forces the EIP-1559 base fee to 0 (needed for 0 price calls)
@@ -43,6 +77,9 @@ type Params struct {
 	// min_gas_multiplier bounds the minimum gas used to be charged
 	// to senders based on gas limit
 	MinGasMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,8,opt,name=min_gas_multiplier,json=minGasMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_gas_multiplier"`
+	// base_fee_destination defines where the base fee share of the EVM tx fees
+	// goes, the priority tips always go to the fee collector
+	BaseFeeDestination BaseFeeDestination `protobuf:"varint,9,opt,name=base_fee_destination,json=baseFeeDestination,proto3,enum=ethermint.feemarket.v1.BaseFeeDestination" json:"base_fee_destination,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -106,7 +143,15 @@ func (m *Params) GetEnableHeight() int64 {
 	return 0
 }
 
+func (m *Params) GetBaseFeeDestination() BaseFeeDestination {
+	if m != nil {
+		return m.BaseFeeDestination
+	}
+	return BASE_FEE_DESTINATION_FEE_COLLECTOR
+}
+
 func init() {
+	proto.RegisterEnum("ethermint.feemarket.v1.BaseFeeDestination", BaseFeeDestination_name, BaseFeeDestination_value)
 	proto.RegisterType((*Params)(nil), "ethermint.feemarket.v1.Params")
 }
 
@@ -115,32 +160,39 @@ func init() {
 }
 
 var fileDescriptor_4feb8b20cf98e6e1 = []byte{
-	// 393 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcd, 0x8a, 0xdb, 0x30,
-	0x10, 0xc7, 0xad, 0x66, 0x37, 0xeb, 0xd5, 0x36, 0x10, 0xc4, 0xb6, 0x98, 0x16, 0xbc, 0xa6, 0x85,
-	0xc5, 0x50, 0x6a, 0xb3, 0xcd, 0xb9, 0x97, 0x34, 0xfd, 0x48, 0xa1, 0x10, 0x7c, 0x2c, 0x05, 0x21,
-	0x3b, 0x13, 0x5b, 0xc4, 0x92, 0x8c, 0xa5, 0x84, 0xe6, 0x2d, 0xfa, 0x58, 0x39, 0xe6, 0x54, 0x4a,
-	0x0f, 0xa1, 0x24, 0x2f, 0x52, 0x62, 0x27, 0x76, 0xae, 0xed, 0x45, 0x1f, 0xf3, 0xff, 0xeb, 0x37,
-	0x23, 0x69, 0xf0, 0x3d, 0x98, 0x0c, 0x4a, 0xc1, 0xa5, 0x09, 0x67, 0x00, 0x82, 0x95, 0x73, 0x30,
-	0xe1, 0xf2, 0xa1, 0xdd, 0x04, 0x45, 0xa9, 0x8c, 0x22, 0x4f, 0x1b, 0x5f, 0xd0, 0x4a, 0xcb, 0x87,
-	0x67, 0xb7, 0xa9, 0x4a, 0x55, 0x65, 0x09, 0x0f, 0xab, 0xda, 0xfd, 0xe2, 0x67, 0x07, 0x77, 0x27,
-	0xac, 0x64, 0x42, 0x13, 0x17, 0xdf, 0x48, 0x45, 0x63, 0xa6, 0x81, 0xce, 0x00, 0x1c, 0xe4, 0x21,
-	0xdf, 0x8e, 0xae, 0xa5, 0x1a, 0x32, 0x0d, 0x1f, 0x00, 0xc8, 0x5b, 0xfc, 0xfc, 0x24, 0xd2, 0x24,
-	0x63, 0x32, 0x05, 0x3a, 0x05, 0xa9, 0x04, 0x97, 0xcc, 0xa8, 0xd2, 0x79, 0xe4, 0x21, 0xbf, 0x17,
-	0x39, 0x71, 0xed, 0x7e, 0x57, 0x19, 0x46, 0xad, 0x4e, 0x06, 0xf8, 0x09, 0xe4, 0x4c, 0x1b, 0x9e,
-	0x70, 0xb3, 0xa2, 0x62, 0x91, 0x1b, 0x5e, 0xe4, 0x1c, 0x4a, 0xa7, 0x53, 0x1d, 0xbc, 0x6d, 0xc5,
-	0x2f, 0x8d, 0x46, 0x5e, 0xe2, 0x1e, 0x48, 0x16, 0xe7, 0x40, 0x33, 0xe0, 0x69, 0x66, 0x9c, 0x4b,
-	0x0f, 0xf9, 0x9d, 0xe8, 0x71, 0x1d, 0xfc, 0x54, 0xc5, 0xc8, 0x18, 0xdb, 0x4d, 0xd5, 0x5d, 0x0f,
-	0xf9, 0xd7, 0xc3, 0x60, 0xbd, 0xbd, 0xb3, 0x7e, 0x6f, 0xef, 0xee, 0x53, 0x6e, 0xb2, 0x45, 0x1c,
-	0x24, 0x4a, 0x84, 0x89, 0xd2, 0x42, 0xe9, 0xe3, 0xf4, 0x5a, 0x4f, 0xe7, 0xa1, 0x59, 0x15, 0xa0,
-	0x83, 0xb1, 0x34, 0xd1, 0xd5, 0xb1, 0x6a, 0x12, 0xe1, 0x9e, 0xe0, 0x92, 0xa6, 0x4c, 0xd3, 0xa2,
-	0xe4, 0x09, 0x38, 0x57, 0xff, 0xcc, 0x1b, 0x41, 0x12, 0xdd, 0x08, 0x2e, 0x3f, 0x32, 0x3d, 0x39,
-	0x20, 0xc8, 0x37, 0x4c, 0x4e, 0xcc, 0xb3, 0x5b, 0xdb, 0xff, 0x05, 0xee, 0xd7, 0xe0, 0xf6, 0x85,
-	0x3e, 0x5f, 0xd8, 0x17, 0xfd, 0xcb, 0xa8, 0xcf, 0x25, 0x37, 0x9c, 0xe5, 0xcd, 0xf7, 0x0d, 0xdf,
-	0xaf, 0x77, 0x2e, 0xda, 0xec, 0x5c, 0xf4, 0x67, 0xe7, 0xa2, 0x1f, 0x7b, 0xd7, 0xda, 0xec, 0x5d,
-	0xeb, 0xd7, 0xde, 0xb5, 0xbe, 0xbe, 0x3a, 0xcb, 0xa5, 0x33, 0x3e, 0x55, 0xc7, 0x71, 0xf9, 0x26,
-	0xfc, 0x7e, 0xd6, 0x5a, 0x55, 0xd2, 0xb8, 0x5b, 0xb5, 0xc9, 0xe0, 0x6f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x14, 0x8b, 0x33, 0xec, 0x7e, 0x02, 0x00, 0x00,
+	// 510 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xcd, 0x6e, 0xda, 0x40,
+	0x10, 0xb6, 0x13, 0x42, 0x60, 0x53, 0x2a, 0x6b, 0x45, 0x2b, 0x37, 0x55, 0x1d, 0x94, 0x48, 0x14,
+	0x45, 0xaa, 0xad, 0x24, 0xe7, 0x1e, 0xc2, 0x4f, 0x5a, 0x57, 0x80, 0x91, 0x43, 0x0e, 0xad, 0x22,
+	0x59, 0x6b, 0x33, 0x31, 0xab, 0xe0, 0x5d, 0xe4, 0xdd, 0xa0, 0xf2, 0x06, 0x55, 0x4f, 0x7d, 0x87,
+	0xbe, 0x42, 0x1f, 0x22, 0xc7, 0x1c, 0xab, 0x1e, 0xa2, 0x0a, 0x5e, 0xa4, 0xc2, 0x10, 0x83, 0x14,
+	0x2e, 0xcd, 0x69, 0x3d, 0xf3, 0x7d, 0xf3, 0x69, 0xfc, 0xcd, 0x0c, 0x2a, 0x83, 0xec, 0x43, 0x1c,
+	0x51, 0x26, 0xad, 0x2b, 0x80, 0x88, 0xc4, 0xd7, 0x20, 0xad, 0xd1, 0xd1, 0x32, 0x30, 0x87, 0x31,
+	0x97, 0x1c, 0xbf, 0x4c, 0x79, 0xe6, 0x12, 0x1a, 0x1d, 0xed, 0x16, 0x43, 0x1e, 0xf2, 0x84, 0x62,
+	0xcd, 0xbe, 0xe6, 0xec, 0xfd, 0x5f, 0x19, 0x94, 0xed, 0x90, 0x98, 0x44, 0x02, 0x1b, 0x68, 0x87,
+	0x71, 0xcf, 0x27, 0x02, 0xbc, 0x2b, 0x00, 0x5d, 0x2d, 0xa9, 0x95, 0x9c, 0x9b, 0x67, 0xbc, 0x4a,
+	0x04, 0x9c, 0x01, 0xe0, 0xf7, 0xe8, 0xf5, 0x03, 0xe8, 0x05, 0x7d, 0xc2, 0x42, 0xf0, 0x7a, 0xc0,
+	0x78, 0x44, 0x19, 0x91, 0x3c, 0xd6, 0x37, 0x4a, 0x6a, 0xa5, 0xe0, 0xea, 0xfe, 0x9c, 0x5d, 0x4b,
+	0x08, 0xf5, 0x25, 0x8e, 0x4f, 0xd0, 0x0b, 0x18, 0x10, 0x21, 0x69, 0x40, 0xe5, 0xd8, 0x8b, 0x6e,
+	0x06, 0x92, 0x0e, 0x07, 0x14, 0x62, 0x7d, 0x33, 0x29, 0x2c, 0x2e, 0xc1, 0x56, 0x8a, 0xe1, 0x03,
+	0x54, 0x00, 0x46, 0xfc, 0x01, 0x78, 0x7d, 0xa0, 0x61, 0x5f, 0xea, 0x5b, 0x25, 0xb5, 0xb2, 0xe9,
+	0x3e, 0x9b, 0x27, 0x3f, 0x26, 0x39, 0x6c, 0xa3, 0x5c, 0xda, 0x75, 0xb6, 0xa4, 0x56, 0xf2, 0x55,
+	0xf3, 0xf6, 0x7e, 0x4f, 0xf9, 0x73, 0xbf, 0x57, 0x0e, 0xa9, 0xec, 0xdf, 0xf8, 0x66, 0xc0, 0x23,
+	0x2b, 0xe0, 0x22, 0xe2, 0x62, 0xf1, 0xbc, 0x13, 0xbd, 0x6b, 0x4b, 0x8e, 0x87, 0x20, 0x4c, 0x9b,
+	0x49, 0x77, 0x7b, 0xd1, 0x35, 0x76, 0x51, 0x21, 0xa2, 0xcc, 0x0b, 0x89, 0xf0, 0x86, 0x31, 0x0d,
+	0x40, 0xdf, 0xfe, 0x6f, 0xbd, 0x3a, 0x04, 0xee, 0x4e, 0x44, 0xd9, 0x07, 0x22, 0x3a, 0x33, 0x09,
+	0x7c, 0x89, 0xf0, 0x83, 0xe6, 0xca, 0x5f, 0xe7, 0x9e, 0x24, 0xac, 0xcd, 0x85, 0x57, 0x1c, 0xba,
+	0x44, 0xc5, 0x74, 0x2a, 0x3d, 0x10, 0x72, 0xe6, 0x36, 0xe5, 0x4c, 0xcf, 0x97, 0xd4, 0xca, 0xf3,
+	0xe3, 0x43, 0x73, 0xfd, 0x36, 0x98, 0x8b, 0xa1, 0xd6, 0x97, 0x15, 0x2e, 0xf6, 0x1f, 0xe5, 0x3e,
+	0x65, 0x72, 0x19, 0x6d, 0xcb, 0xd5, 0x28, 0xa3, 0x92, 0x92, 0x41, 0xba, 0x1c, 0x87, 0xdf, 0x55,
+	0x84, 0x1f, 0x4b, 0xe0, 0x32, 0xda, 0xaf, 0x9e, 0x9e, 0x37, 0xbc, 0xb3, 0x46, 0xc3, 0xab, 0x37,
+	0xce, 0xbb, 0x76, 0xfb, 0xb4, 0x6b, 0x3b, 0xed, 0x24, 0xae, 0x39, 0xcd, 0x66, 0xa3, 0xd6, 0x75,
+	0x5c, 0x4d, 0xc1, 0x6f, 0xd0, 0xab, 0xb5, 0xbc, 0xea, 0x85, 0xdb, 0xd6, 0x54, 0xfc, 0x16, 0x1d,
+	0xac, 0x85, 0x6b, 0x4e, 0xab, 0x75, 0xd1, 0xb6, 0xbb, 0x9f, 0xbd, 0x8e, 0xe3, 0x34, 0xb5, 0x8d,
+	0xdd, 0xcc, 0xb7, 0x9f, 0x86, 0x52, 0xb5, 0x6f, 0x27, 0x86, 0x7a, 0x37, 0x31, 0xd4, 0xbf, 0x13,
+	0x43, 0xfd, 0x31, 0x35, 0x94, 0xbb, 0xa9, 0xa1, 0xfc, 0x9e, 0x1a, 0xca, 0x17, 0x6b, 0xc5, 0x56,
+	0xc2, 0xe2, 0xb1, 0xe4, 0x2c, 0x7d, 0x47, 0xc7, 0xd6, 0xd7, 0x95, 0x4b, 0x4a, 0x3c, 0xf6, 0xb3,
+	0xc9, 0x55, 0x9c, 0xfc, 0x1b, 0x00, 0xd6, 0xe1, 0xeb, 0x9d, 0x6d, 0x03, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -163,6 +215,11 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFeeDestination != 0 {
+		i = encodeVarintFeemarket(dAtA, i, uint64(m.BaseFeeDestination))
+		i--
+		dAtA[i] = 0x48
+	}
 	{
 		size := m.MinGasMultiplier.Size()
 		i -= size
@@ -256,6 +313,9 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovFeemarket(uint64(l))
 	l = m.MinGasMultiplier.Size()
 	n += 1 + l + sovFeemarket(uint64(l))
+	if m.BaseFeeDestination != 0 {
+		n += 1 + sovFeemarket(uint64(m.BaseFeeDestination))
+	}
 	return n
 }
 
@@ -473,6 +533,25 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeDestination", wireType)
+			}
+			m.BaseFeeDestination = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeemarket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeDestination |= BaseFeeDestination(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipFeemarket(dAtA[iNdEx:])
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesisState sets default fee market genesis state.
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
@@ -19,5 +21,8 @@ func NewGenesisState(params Params, blockGas uint64) *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if err := gs.TotalBurned.Validate(); err != nil {
+		return fmt.Errorf("invalid total burned base fees: %w", err)
+	}
 	return gs.Params.Validate()
 }
@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -30,6 +32,8 @@ type GenesisState struct {
 	// block_gas is the amount of gas wanted on the last block before the upgrade.
 	// Zero by default.
 	BlockGas uint64 `protobuf:"varint,3,opt,name=block_gas,json=blockGas,proto3" json:"block_gas,omitempty"`
+	// total_burned is the total amount of base fees burned
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,6 +83,13 @@ func (m *GenesisState) GetBlockGas() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ethermint.feemarket.v1.GenesisState")
 }
@@ -88,23 +99,28 @@ func init() {
 }
 
 var fileDescriptor_6241c21661288629 = []byte{
-	// 245 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x49, 0x2d, 0xc9, 0x48,
-	0x2d, 0xca, 0xcd, 0xcc, 0x2b, 0xd1, 0x4f, 0x4b, 0x4d, 0xcd, 0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1,
-	0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x83, 0xab, 0xd2, 0x83, 0xab, 0xd2, 0x2b, 0x33, 0x94, 0x52, 0xc3, 0xa1, 0x1b, 0xa1,
-	0x08, 0xac, 0x5f, 0x4a, 0x24, 0x3d, 0x3f, 0x3d, 0x1f, 0xcc, 0xd4, 0x07, 0xb1, 0x20, 0xa2, 0x4a,
-	0x95, 0x5c, 0x3c, 0xee, 0x10, 0x6b, 0x82, 0x4b, 0x12, 0x4b, 0x52, 0x85, 0x6c, 0xb8, 0xd8, 0x0a,
-	0x12, 0x8b, 0x12, 0x73, 0x8b, 0x25, 0x18, 0x15, 0x18, 0x35, 0xb8, 0x8d, 0xe4, 0xf4, 0xb0, 0x5b,
-	0xab, 0x17, 0x00, 0x56, 0xe5, 0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x34,
-	0x17, 0x67, 0x52, 0x4e, 0x7e, 0x72, 0x76, 0x7c, 0x7a, 0x62, 0xb1, 0x04, 0xb3, 0x02, 0xa3, 0x06,
-	0x4b, 0x10, 0x07, 0x58, 0xc0, 0x3d, 0xb1, 0xd8, 0x8b, 0x85, 0x83, 0x49, 0x80, 0x39, 0x88, 0x23,
-	0x29, 0xb1, 0x38, 0x35, 0x3e, 0x2d, 0x35, 0xd5, 0xc9, 0xf5, 0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f,
-	0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b,
-	0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd3, 0x33, 0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5,
-	0x8b, 0x33, 0x32, 0x53, 0xf2, 0xa1, 0x64, 0x99, 0x91, 0x7e, 0x05, 0x92, 0x27, 0x4b, 0x2a, 0x0b,
-	0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x1e, 0x31, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0x96, 0xec, 0x25,
-	0xf5, 0x46, 0x01, 0x00, 0x00,
+	// 332 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x10, 0x86, 0x63, 0x1a, 0x55, 0x25, 0xed, 0x80, 0x22, 0x84, 0x4a, 0x91, 0xdc, 0x0a, 0x21, 0x94,
+	0x05, 0x9b, 0x94, 0x95, 0x29, 0x0c, 0x15, 0x4c, 0x28, 0x6c, 0x2c, 0x95, 0x93, 0x5e, 0xd3, 0xa8,
+	0x8d, 0x5d, 0xd9, 0x6e, 0x44, 0xdf, 0x82, 0xe7, 0xe0, 0x49, 0x3a, 0x76, 0x64, 0x02, 0xd4, 0x4e,
+	0xbc, 0x05, 0x8a, 0x13, 0x95, 0x0e, 0x30, 0xdd, 0xe9, 0xfc, 0xff, 0xf7, 0x7f, 0x3e, 0xe7, 0x02,
+	0xf4, 0x04, 0x64, 0x96, 0x72, 0x4d, 0xc7, 0x00, 0x19, 0x93, 0x53, 0xd0, 0x34, 0xf7, 0x69, 0x02,
+	0x1c, 0x54, 0xaa, 0xc8, 0x5c, 0x0a, 0x2d, 0xdc, 0x93, 0x9d, 0x8a, 0xec, 0x54, 0x24, 0xf7, 0x3b,
+	0x97, 0xff, 0xb8, 0x7f, 0x45, 0xc6, 0xdf, 0xc1, 0xb1, 0x50, 0x99, 0x50, 0x34, 0x62, 0x0a, 0x68,
+	0xee, 0x47, 0xa0, 0x99, 0x4f, 0x63, 0x91, 0xf2, 0xea, 0xfd, 0x38, 0x11, 0x89, 0x30, 0x2d, 0x2d,
+	0xba, 0x72, 0x7a, 0xfe, 0x8d, 0x9c, 0xd6, 0xa0, 0xe4, 0x78, 0xd2, 0x4c, 0x83, 0x7b, 0xeb, 0xd4,
+	0xe7, 0x4c, 0xb2, 0x4c, 0xb5, 0x51, 0x0f, 0x79, 0xcd, 0x3e, 0x26, 0x7f, 0x73, 0x91, 0x47, 0xa3,
+	0x0a, 0xec, 0xd5, 0x47, 0xd7, 0x0a, 0x2b, 0x8f, 0x7b, 0xe6, 0x1c, 0x46, 0x33, 0x11, 0x4f, 0x87,
+	0x09, 0x53, 0xed, 0x5a, 0x0f, 0x79, 0x76, 0xd8, 0x30, 0x83, 0x01, 0x53, 0x2e, 0x77, 0x5a, 0x5a,
+	0x68, 0x36, 0x1b, 0x46, 0x0b, 0xc9, 0x61, 0xd4, 0xb6, 0x7b, 0x35, 0xaf, 0xd9, 0x3f, 0x25, 0x25,
+	0x38, 0x29, 0xc0, 0x49, 0x05, 0x4e, 0xee, 0x44, 0xca, 0x83, 0xeb, 0x62, 0xf7, 0xdb, 0x67, 0xd7,
+	0x4b, 0x52, 0x3d, 0x59, 0x44, 0x24, 0x16, 0x19, 0xad, 0x7e, 0x59, 0x96, 0x2b, 0x35, 0x9a, 0x52,
+	0xbd, 0x9c, 0x83, 0x32, 0x06, 0x15, 0x36, 0x4d, 0x40, 0x60, 0xf6, 0x3f, 0xd8, 0x8d, 0x83, 0xa3,
+	0x5a, 0xd8, 0x28, 0xf6, 0x0e, 0xc7, 0x00, 0xc1, 0xfd, 0x6a, 0x83, 0xd1, 0x7a, 0x83, 0xd1, 0xd7,
+	0x06, 0xa3, 0xd7, 0x2d, 0xb6, 0xd6, 0x5b, 0x6c, 0xbd, 0x6f, 0xb1, 0xf5, 0x4c, 0xf7, 0x02, 0x18,
+	0x97, 0x4b, 0x2d, 0xf8, 0xae, 0xe6, 0x7d, 0xfa, 0xb2, 0x77, 0x79, 0x93, 0x16, 0xd5, 0xcd, 0xf5,
+	0x6e, 0x7e, 0x06, 0x00, 0xcf, 0x97, 0xdd, 0xb3, 0xdb, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -127,6 +143,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if m.BlockGas != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.BlockGas))
 		i--
@@ -167,6 +197,12 @@ func (m *GenesisState) Size() (n int) {
 	if m.BlockGas != 0 {
 		n += 1 + sovGenesis(uint64(m.BlockGas))
 	}
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -257,6 +293,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
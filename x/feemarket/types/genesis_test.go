@@ -3,6 +3,7 @@ package types
 import (
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -30,6 +31,7 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			&GenesisState{
 				DefaultParams(),
 				uint64(1),
+				nil,
 			},
 			true,
 		},
@@ -41,6 +43,14 @@ func (suite *GenesisTestSuite) TestValidateGenesis() {
 			),
 			true,
 		},
+		{
+			"invalid total burned",
+			&GenesisState{
+				Params:      DefaultParams(),
+				TotalBurned: sdk.Coins{{Denom: "aanryton", Amount: sdk.NewInt(-1)}},
+			},
+			false,
+		},
 		{
 			"empty genesis",
 			&GenesisState{
@@ -20,6 +20,8 @@ const (
 const (
 	prefixBlockGasWanted    = iota + 1
 	deprecatedPrefixBaseFee // unused
+	prefixBlockBurnedFees
+	prefixTotalBurnedFees
 )
 
 const (
@@ -28,7 +30,9 @@ const (
 
 // KVStore key prefixes
 var (
-	KeyPrefixBlockGasWanted = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockGasWanted  = []byte{prefixBlockGasWanted}
+	KeyPrefixBlockBurnedFees = []byte{prefixBlockBurnedFees}
+	KeyPrefixTotalBurnedFees = []byte{prefixTotalBurnedFees}
 )
 
 // Transient Store key prefixes
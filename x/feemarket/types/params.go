@@ -102,6 +102,10 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if _, ok := BaseFeeDestination_name[int32(p.BaseFeeDestination)]; !ok {
+		return fmt.Errorf("invalid base fee destination: %d", p.BaseFeeDestination)
+	}
+
 	return validateMinGasPrice(p.MinGasPrice)
 }
 
@@ -117,6 +121,13 @@ func (p *Params) IsBaseFeeEnabled(height int64) bool {
 	return !p.NoBaseFee && height >= p.EnableHeight
 }
 
+// IsBaseFeeEscrowed returns true if the base fee share of the EVM tx fees is
+// escrowed by the module account until the end of the block, to be burned or
+// sent to the community pool, instead of going to the fee collector.
+func (p Params) IsBaseFeeEscrowed() bool {
+	return p.BaseFeeDestination != BASE_FEE_DESTINATION_FEE_COLLECTOR
+}
+
 func validateMinGasPrice(i interface{}) error {
 	v, ok := i.(sdk.Dec)
 
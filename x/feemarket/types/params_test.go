@@ -54,6 +54,16 @@ func (suite *ParamsTestSuite) TestParamsValidate() {
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), DefaultMinGasPrice, sdk.NewDecWithPrec(-5, 1)),
 			true,
 		},
+		{
+			"valid: base fee burned",
+			Params{BaseFeeChangeDenominator: 8, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BaseFeeDestination: BASE_FEE_DESTINATION_BURN},
+			false,
+		},
+		{
+			"invalid: unknown base fee destination",
+			Params{BaseFeeChangeDenominator: 8, BaseFee: sdkmath.ZeroInt(), MinGasPrice: DefaultMinGasPrice, MinGasMultiplier: DefaultMinGasMultiplier, BaseFeeDestination: 3},
+			true,
+		},
 		{
 			"invalid: min gas multiplier bigger than 1",
 			NewParams(true, 7, 3, 2000000000, int64(544435345345435345), sdk.NewDecWithPrec(20, 4), sdk.NewDec(2)),
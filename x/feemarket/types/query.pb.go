@@ -7,6 +7,7 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -274,6 +275,100 @@ func (m *QueryBlockGasResponse) GetGas() int64 {
 	return 0
 }
 
+// QueryBurnedFeesRequest defines the request type for querying the burned base
+// fees.
+type QueryBurnedFeesRequest struct {
+}
+
+func (m *QueryBurnedFeesRequest) Reset()         { *m = QueryBurnedFeesRequest{} }
+func (m *QueryBurnedFeesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesRequest) ProtoMessage()    {}
+func (*QueryBurnedFeesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{6}
+}
+func (m *QueryBurnedFeesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesRequest.Merge(m, src)
+}
+func (m *QueryBurnedFeesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesRequest proto.InternalMessageInfo
+
+// QueryBurnedFeesResponse returns the base fees burned in the last block and
+// in total.
+type QueryBurnedFeesResponse struct {
+	// block_burned is the amount of base fees burned in the last block
+	BlockBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=block_burned,json=blockBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_burned"`
+	// total_burned is the total amount of base fees burned
+	TotalBurned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total_burned,json=totalBurned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_burned"`
+}
+
+func (m *QueryBurnedFeesResponse) Reset()         { *m = QueryBurnedFeesResponse{} }
+func (m *QueryBurnedFeesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedFeesResponse) ProtoMessage()    {}
+func (*QueryBurnedFeesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_71a07c1ffd85fde2, []int{7}
+}
+func (m *QueryBurnedFeesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedFeesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedFeesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedFeesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedFeesResponse.Merge(m, src)
+}
+func (m *QueryBurnedFeesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedFeesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedFeesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedFeesResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedFeesResponse) GetBlockBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockBurned
+	}
+	return nil
+}
+
+func (m *QueryBurnedFeesResponse) GetTotalBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalBurned
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "ethermint.feemarket.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "ethermint.feemarket.v1.QueryParamsResponse")
@@ -281,6 +376,8 @@ func init() {
 	proto.RegisterType((*QueryBaseFeeResponse)(nil), "ethermint.feemarket.v1.QueryBaseFeeResponse")
 	proto.RegisterType((*QueryBlockGasRequest)(nil), "ethermint.feemarket.v1.QueryBlockGasRequest")
 	proto.RegisterType((*QueryBlockGasResponse)(nil), "ethermint.feemarket.v1.QueryBlockGasResponse")
+	proto.RegisterType((*QueryBurnedFeesRequest)(nil), "ethermint.feemarket.v1.QueryBurnedFeesRequest")
+	proto.RegisterType((*QueryBurnedFeesResponse)(nil), "ethermint.feemarket.v1.QueryBurnedFeesResponse")
 }
 
 func init() {
@@ -288,35 +385,44 @@ func init() {
 }
 
 var fileDescriptor_71a07c1ffd85fde2 = []byte{
-	// 448 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x8e, 0x29, 0x74, 0xc3, 0x5c, 0x90, 0xe9, 0x26, 0x14, 0x15, 0x17, 0x45, 0x30, 0xc1, 0xc6,
-	0x62, 0xad, 0x5c, 0x39, 0x45, 0x1a, 0x88, 0x1b, 0x84, 0x1b, 0x12, 0x9a, 0x9c, 0xee, 0x2d, 0x8d,
-	0xba, 0xc4, 0x59, 0xec, 0x56, 0xec, 0x8a, 0xc4, 0x85, 0x03, 0x42, 0xe2, 0x77, 0xf0, 0x3f, 0x7a,
-	0xac, 0xc4, 0x05, 0x71, 0xa8, 0x50, 0xcb, 0x0f, 0x41, 0xb1, 0x9d, 0xd2, 0x40, 0x0b, 0xbd, 0x24,
-	0xd6, 0xcb, 0xf7, 0xbe, 0xef, 0x7b, 0xdf, 0x73, 0xb0, 0x07, 0xaa, 0x0f, 0x45, 0x9a, 0x64, 0x8a,
-	0x9d, 0x01, 0xa4, 0xbc, 0x18, 0x80, 0x62, 0xa3, 0x23, 0x76, 0x31, 0x84, 0xe2, 0xd2, 0xcf, 0x0b,
-	0xa1, 0x04, 0xd9, 0x5d, 0x60, 0xfc, 0x05, 0xc6, 0x1f, 0x1d, 0xb9, 0x7b, 0x6b, 0x7a, 0x7f, 0x83,
-	0x74, 0xbf, 0xdb, 0x8a, 0x45, 0x2c, 0xf4, 0x91, 0x95, 0x27, 0x5b, 0x6d, 0xc7, 0x42, 0xc4, 0xe7,
-	0xc0, 0x78, 0x9e, 0x30, 0x9e, 0x65, 0x42, 0x71, 0x95, 0x88, 0x4c, 0x9a, 0xaf, 0x5e, 0x0b, 0x93,
-	0x97, 0xa5, 0x85, 0x17, 0xbc, 0xe0, 0xa9, 0x0c, 0xe1, 0x62, 0x08, 0x52, 0x79, 0xaf, 0xf0, 0xad,
-	0x5a, 0x55, 0xe6, 0x22, 0x93, 0x40, 0x9e, 0xe0, 0x66, 0xae, 0x2b, 0xb7, 0xd1, 0x5d, 0xf4, 0xe0,
-	0x46, 0x97, 0xfa, 0xab, 0x1d, 0xfb, 0xa6, 0x2f, 0xb8, 0x3a, 0x9e, 0x76, 0x9c, 0xd0, 0xf6, 0x78,
-	0x3b, 0x96, 0x34, 0xe0, 0x12, 0x9e, 0x02, 0x54, 0x5a, 0x6f, 0x70, 0xab, 0x5e, 0xb6, 0x62, 0xc7,
-	0x78, 0x3b, 0xe2, 0x12, 0x4e, 0xce, 0x00, 0xb4, 0xdc, 0xf5, 0x60, 0xff, 0xfb, 0xb4, 0xb3, 0x17,
-	0x27, 0xaa, 0x3f, 0x8c, 0xfc, 0x9e, 0x48, 0x59, 0x4f, 0xc8, 0x54, 0x48, 0xfb, 0x3a, 0x94, 0xa7,
-	0x03, 0xa6, 0x2e, 0x73, 0x90, 0xfe, 0xf3, 0x4c, 0x85, 0x5b, 0x91, 0xa1, 0xf3, 0x76, 0x2b, 0xfa,
-	0x73, 0xd1, 0x1b, 0x3c, 0xe3, 0x8b, 0x11, 0x1f, 0xe2, 0x9d, 0x3f, 0xea, 0x56, 0xf7, 0x26, 0x6e,
-	0xc4, 0xdc, 0x4c, 0xd8, 0x08, 0xcb, 0x63, 0xf7, 0x4b, 0x03, 0x5f, 0xd3, 0x58, 0xf2, 0x1e, 0xe1,
-	0xa6, 0x99, 0x8d, 0xec, 0xaf, 0x9b, 0xfd, 0xef, 0x38, 0xdd, 0x83, 0x8d, 0xb0, 0x46, 0xdf, 0xf3,
-	0xde, 0x7d, 0xfd, 0xf9, 0xf9, 0x4a, 0x9b, 0xb8, 0x4c, 0xf6, 0x93, 0x53, 0x51, 0x5f, 0xb9, 0x89,
-	0x92, 0x7c, 0x40, 0x78, 0xcb, 0xe6, 0x45, 0xfe, 0x4d, 0x5e, 0x0f, 0xdb, 0x7d, 0xb4, 0x19, 0xd8,
-	0x5a, 0xb9, 0xa7, 0xad, 0x50, 0xd2, 0x5e, 0x65, 0xa5, 0x5a, 0x0e, 0xf9, 0x88, 0xf0, 0x76, 0x95,
-	0x22, 0xf9, 0x8f, 0x40, 0x7d, 0x09, 0xee, 0xe1, 0x86, 0x68, 0xeb, 0xe7, 0xbe, 0xf6, 0xd3, 0x21,
-	0x77, 0x56, 0xfa, 0x29, 0xd1, 0x27, 0x31, 0x97, 0xc1, 0xf1, 0x78, 0x46, 0xd1, 0x64, 0x46, 0xd1,
-	0x8f, 0x19, 0x45, 0x9f, 0xe6, 0xd4, 0x99, 0xcc, 0xa9, 0xf3, 0x6d, 0x4e, 0x9d, 0xd7, 0x07, 0x4b,
-	0xb7, 0xc7, 0x50, 0x98, 0xe7, 0xa8, 0xcb, 0xde, 0x2e, 0xb1, 0xe9, 0x6b, 0x14, 0x35, 0xf5, 0x1f,
-	0xf2, 0xf8, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfc, 0x5f, 0x23, 0xb5, 0xbb, 0x03, 0x00, 0x00,
+	// 577 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x31, 0x6f, 0x13, 0x31,
+	0x18, 0x8d, 0x9b, 0x92, 0x16, 0x87, 0x01, 0x99, 0x34, 0x84, 0x53, 0x75, 0x49, 0x0f, 0x14, 0xd2,
+	0x42, 0x6d, 0x12, 0x56, 0xa6, 0x20, 0x8a, 0xba, 0x41, 0xd8, 0x90, 0x50, 0xe5, 0x4b, 0xdd, 0xeb,
+	0x29, 0x89, 0x9d, 0x9e, 0x9d, 0x88, 0xac, 0x2c, 0x2c, 0x0c, 0x95, 0x58, 0xf8, 0x0d, 0xfc, 0x92,
+	0x4e, 0xa8, 0x12, 0x0b, 0x62, 0x28, 0x28, 0xe1, 0x4f, 0xb0, 0xa1, 0xb3, 0x7d, 0x69, 0xd3, 0x36,
+	0xe5, 0x96, 0x4e, 0x67, 0x7d, 0x7e, 0xdf, 0x7b, 0xef, 0xfb, 0xfc, 0x12, 0xe8, 0x31, 0xb5, 0xcf,
+	0xa2, 0x5e, 0xc8, 0x15, 0xd9, 0x63, 0xac, 0x47, 0xa3, 0x0e, 0x53, 0x64, 0x58, 0x27, 0x07, 0x03,
+	0x16, 0x8d, 0x70, 0x3f, 0x12, 0x4a, 0xa0, 0xe2, 0x14, 0x83, 0xa7, 0x18, 0x3c, 0xac, 0x3b, 0x6e,
+	0x5b, 0xc8, 0x9e, 0x90, 0xc4, 0xa7, 0x92, 0x91, 0x61, 0xdd, 0x67, 0x8a, 0xd6, 0x49, 0x5b, 0x84,
+	0xdc, 0xf4, 0x39, 0xd5, 0x39, 0xdc, 0xa7, 0x24, 0x06, 0x57, 0x08, 0x44, 0x20, 0xf4, 0x91, 0xc4,
+	0x27, 0x5b, 0x5d, 0x0d, 0x84, 0x08, 0xba, 0x8c, 0xd0, 0x7e, 0x48, 0x28, 0xe7, 0x42, 0x51, 0x15,
+	0x0a, 0x2e, 0xcd, 0xad, 0x57, 0x80, 0xe8, 0x75, 0x6c, 0xf1, 0x15, 0x8d, 0x68, 0x4f, 0xb6, 0xd8,
+	0xc1, 0x80, 0x49, 0xe5, 0xbd, 0x81, 0x77, 0x66, 0xaa, 0xb2, 0x2f, 0xb8, 0x64, 0xe8, 0x19, 0xcc,
+	0xf5, 0x75, 0xa5, 0x04, 0x2a, 0xa0, 0x96, 0x6f, 0xb8, 0xf8, 0xf2, 0x89, 0xb0, 0xe9, 0x6b, 0x2e,
+	0x1e, 0x9d, 0x94, 0x33, 0x2d, 0xdb, 0xe3, 0xad, 0x58, 0xd2, 0x26, 0x95, 0x6c, 0x8b, 0xb1, 0x44,
+	0xeb, 0x1d, 0x2c, 0xcc, 0x96, 0xad, 0xd8, 0x0b, 0xb8, 0x1c, 0x2f, 0x64, 0x67, 0x8f, 0x31, 0x2d,
+	0x77, 0xb3, 0xb9, 0xf1, 0xf3, 0xa4, 0x5c, 0x0d, 0x42, 0xb5, 0x3f, 0xf0, 0x71, 0x5b, 0xf4, 0x88,
+	0x5d, 0x9b, 0xf9, 0x6c, 0xca, 0xdd, 0x0e, 0x51, 0xa3, 0x3e, 0x93, 0x78, 0x9b, 0xab, 0xd6, 0x92,
+	0x6f, 0xe8, 0xbc, 0x62, 0x42, 0xdf, 0x15, 0xed, 0xce, 0x4b, 0x3a, 0x1d, 0x71, 0x1d, 0xae, 0x9c,
+	0xab, 0x5b, 0xdd, 0xdb, 0x30, 0x1b, 0x50, 0x33, 0x61, 0xb6, 0x15, 0x1f, 0xbd, 0x12, 0x2c, 0x1a,
+	0xe8, 0x20, 0xe2, 0x6c, 0x77, 0x8b, 0xb1, 0x29, 0xc9, 0x5f, 0x00, 0xef, 0x5e, 0xb8, 0xb2, 0x3c,
+	0x1c, 0xde, 0xf2, 0x63, 0xee, 0x1d, 0x5f, 0xdf, 0x95, 0x40, 0x25, 0x5b, 0xcb, 0x37, 0xee, 0x61,
+	0x63, 0x17, 0xc7, 0xfe, 0xb0, 0x7d, 0x6c, 0xfc, 0x5c, 0x84, 0xbc, 0xf9, 0x24, 0xde, 0xd6, 0xd7,
+	0x5f, 0xe5, 0x5a, 0x8a, 0x11, 0xe3, 0x06, 0xd9, 0xca, 0x6b, 0x01, 0xa3, 0x1d, 0xeb, 0x29, 0xa1,
+	0x68, 0x37, 0xd1, 0x5b, 0xb8, 0x06, 0x3d, 0x2d, 0x60, 0xf4, 0x1a, 0xdf, 0x16, 0xe1, 0x0d, 0x3d,
+	0x3b, 0xfa, 0x08, 0x60, 0xce, 0xbc, 0x38, 0xda, 0x98, 0x97, 0x88, 0x8b, 0x21, 0x73, 0x1e, 0xa5,
+	0xc2, 0x9a, 0x6d, 0x7a, 0x0f, 0x3e, 0x7c, 0xff, 0xf3, 0x79, 0xc1, 0x45, 0xab, 0x84, 0xf2, 0x68,
+	0xa4, 0x04, 0x9f, 0xfd, 0x29, 0x98, 0x88, 0xa1, 0x4f, 0x00, 0x2e, 0xd9, 0x1c, 0xa1, 0xab, 0xe9,
+	0x67, 0x43, 0xe8, 0x3c, 0x4e, 0x07, 0xb6, 0x66, 0xaa, 0xda, 0x4c, 0x05, 0xb9, 0x97, 0x9b, 0x49,
+	0x62, 0x8b, 0x0e, 0x01, 0x5c, 0x4e, 0xf2, 0x85, 0xfe, 0x23, 0x31, 0x1b, 0x4f, 0x67, 0x33, 0x25,
+	0xda, 0x3a, 0x7a, 0xa8, 0x1d, 0xad, 0xa1, 0xf2, 0x1c, 0x47, 0x3a, 0x88, 0x01, 0x95, 0xe8, 0x0b,
+	0x80, 0xf0, 0x34, 0xac, 0x08, 0x5f, 0x2d, 0x73, 0x3e, 0xf0, 0x0e, 0x49, 0x8d, 0xb7, 0xc6, 0xd6,
+	0xb5, 0xb1, 0xfb, 0x68, 0x6d, 0x8e, 0x31, 0xdd, 0x11, 0x2f, 0x4b, 0x36, 0xb7, 0x8f, 0xc6, 0x2e,
+	0x38, 0x1e, 0xbb, 0xe0, 0xf7, 0xd8, 0x05, 0x87, 0x13, 0x37, 0x73, 0x3c, 0x71, 0x33, 0x3f, 0x26,
+	0x6e, 0xe6, 0x2d, 0x39, 0x93, 0xd0, 0x84, 0x26, 0xf9, 0x0e, 0x1b, 0xe4, 0xfd, 0x19, 0x4e, 0x1d,
+	0x57, 0x3f, 0xa7, 0xff, 0xdc, 0x9e, 0xfe, 0x1b, 0x00, 0xee, 0x12, 0xd0, 0xe5, 0x96, 0x05, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -337,6 +443,8 @@ type QueryClient interface {
 	BaseFee(ctx context.Context, in *QueryBaseFeeRequest, opts ...grpc.CallOption) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(ctx context.Context, in *QueryBlockGasRequest, opts ...grpc.CallOption) (*QueryBlockGasResponse, error)
+	// BurnedFees queries the base fees burned in the last block and in total
+	BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error)
 }
 
 type queryClient struct {
@@ -374,6 +482,15 @@ func (c *queryClient) BlockGas(ctx context.Context, in *QueryBlockGasRequest, op
 	return out, nil
 }
 
+func (c *queryClient) BurnedFees(ctx context.Context, in *QueryBurnedFeesRequest, opts ...grpc.CallOption) (*QueryBurnedFeesResponse, error) {
+	out := new(QueryBurnedFeesResponse)
+	err := c.cc.Invoke(ctx, "/ethermint.feemarket.v1.Query/BurnedFees", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params queries the parameters of x/feemarket module.
@@ -382,6 +499,8 @@ type QueryServer interface {
 	BaseFee(context.Context, *QueryBaseFeeRequest) (*QueryBaseFeeResponse, error)
 	// BlockGas queries the gas used at a given block height
 	BlockGas(context.Context, *QueryBlockGasRequest) (*QueryBlockGasResponse, error)
+	// BurnedFees queries the base fees burned in the last block and in total
+	BurnedFees(context.Context, *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -397,6 +516,9 @@ func (*UnimplementedQueryServer) BaseFee(ctx context.Context, req *QueryBaseFeeR
 func (*UnimplementedQueryServer) BlockGas(ctx context.Context, req *QueryBlockGasRequest) (*QueryBlockGasResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockGas not implemented")
 }
+func (*UnimplementedQueryServer) BurnedFees(ctx context.Context, req *QueryBurnedFeesRequest) (*QueryBurnedFeesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedFees not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -456,6 +578,24 @@ func _Query_BlockGas_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BurnedFees_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedFeesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedFees(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ethermint.feemarket.v1.Query/BurnedFees",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedFees(ctx, req.(*QueryBurnedFeesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "ethermint.feemarket.v1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -472,6 +612,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockGas",
 			Handler:    _Query_BlockGas_Handler,
 		},
+		{
+			MethodName: "BurnedFees",
+			Handler:    _Query_BurnedFees_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "ethermint/feemarket/v1/query.proto",
@@ -642,6 +786,80 @@ func (m *QueryBlockGasResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryBurnedFeesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedFeesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedFeesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedFeesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalBurned) > 0 {
+		for iNdEx := len(m.TotalBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.BlockBurned) > 0 {
+		for iNdEx := len(m.BlockBurned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockBurned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -716,6 +934,36 @@ func (m *QueryBlockGasResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryBurnedFeesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedFeesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockBurned) > 0 {
+		for _, e := range m.BlockBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.TotalBurned) > 0 {
+		for _, e := range m.TotalBurned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1110,6 +1358,174 @@ func (m *QueryBlockGasResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryBurnedFeesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedFeesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedFeesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockBurned = append(m.BlockBurned, types.Coin{})
+			if err := m.BlockBurned[len(m.BlockBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBurned", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalBurned = append(m.TotalBurned, types.Coin{})
+			if err := m.TotalBurned[len(m.TotalBurned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
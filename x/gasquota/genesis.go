@@ -0,0 +1,27 @@
+package gasquota
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/gasquota/keeper"
+	"github.com/anryton/anryton/v2/x/gasquota/types"
+)
+
+// InitGenesis import module genesis
+func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+
+	for _, usage := range data.GasUsages {
+		k.SetGasUsage(ctx, usage)
+	}
+}
+
+// ExportGenesis export module status
+func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
+	return &types.GenesisState{
+		Params:    k.GetParams(ctx),
+		GasUsages: k.GetGasUsages(ctx),
+	}
+}
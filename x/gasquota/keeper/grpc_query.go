@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/anryton/anryton/v2/x/gasquota/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// GasQuota returns the gas allowance of an account for the current epoch
+func (k Keeper) GasQuota(c context.Context, req *types.QueryGasQuotaRequest) (*types.QueryGasQuotaResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var addr sdk.AccAddress
+	if common.IsHexAddress(req.Address) {
+		addr = common.HexToAddress(req.Address).Bytes()
+	} else {
+		var err error
+		if addr, err = sdk.AccAddressFromBech32(req.Address); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid address %s: %s", req.Address, err)
+		}
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	epochNumber, quota, used, _ := k.GetRemainingGas(ctx, addr)
+
+	return &types.QueryGasQuotaResponse{
+		EpochNumber:  epochNumber,
+		GasQuota:     quota,
+		GasUsed:      used,
+		GasRemaining: quota - used,
+	}, nil
+}
+
+// Params returns the gasquota module params
+func (k Keeper) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	return &types.QueryParamsResponse{Params: k.GetParams(ctx)}, nil
+}
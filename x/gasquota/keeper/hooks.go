@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks wrapper struct for the gasquota keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the wrapper struct for the epoch hooks
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd is a no-op for the gasquota module
+func (h Hooks) AfterEpochEnd(_ sdk.Context, _ string, _ int64) {}
+
+// BeforeEpochStart prunes the usages of the ended epoch, the allowances of
+// the started epoch are tracked under its own number
+func (h Hooks) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) {
+	if epochIdentifier != h.k.GetParams(ctx).EpochIdentifier {
+		return
+	}
+	h.k.DeleteGasUsages(ctx, epochNumber-1)
+}
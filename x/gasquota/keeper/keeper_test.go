@@ -0,0 +1,150 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
+	"github.com/anryton/anryton/v2/x/gasquota/types"
+)
+
+func (suite *KeeperTestSuite) TestGetRemainingGas() {
+	suite.SetupTest()
+
+	// 10 bonded tokens grant 10k gas per epoch
+	epochNumber, quota, used, found := suite.keeper.GetRemainingGas(suite.ctx, staker)
+	suite.Require().True(found)
+	suite.Require().Equal(int64(1), epochNumber)
+	suite.Require().Equal(uint64(10_000), quota)
+	suite.Require().Zero(used)
+
+	// the accounts below the min stake have no allowance
+	_, quota, _, found = suite.keeper.GetRemainingGas(suite.ctx, smallStaker)
+	suite.Require().True(found)
+	suite.Require().Zero(quota)
+
+	_, quota, _, _ = suite.keeper.GetRemainingGas(suite.ctx, other)
+	suite.Require().Zero(quota)
+
+	// no allowance is granted for an unknown epoch identifier
+	params := suite.keeper.GetParams(suite.ctx)
+	params.EpochIdentifier = "minute"
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	_, _, _, found = suite.keeper.GetRemainingGas(suite.ctx, staker)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestSubsidizeFees() {
+	suite.SetupTest()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("aanryton", 1000))
+
+	// the fees are fully covered by the allowance
+	remaining, covered := suite.keeper.SubsidizeFees(suite.ctx, staker, fees, 6_000)
+	suite.Require().True(remaining.IsZero())
+	suite.Require().Equal(uint64(6_000), covered)
+
+	// the fees of the gas exceeding the allowance are charged, rounding up
+	remaining, covered = suite.keeper.SubsidizeFees(suite.ctx, staker, fees, 6_000)
+	suite.Require().Equal(uint64(4_000), covered)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("aanryton", 334)), remaining)
+
+	// the allowance is exhausted for the epoch
+	remaining, covered = suite.keeper.SubsidizeFees(suite.ctx, staker, fees, 1_000)
+	suite.Require().Equal(fees, remaining)
+	suite.Require().Zero(covered)
+
+	// the allowance is renewed by the next epoch
+	suite.setEpoch(2)
+	remaining, covered = suite.keeper.SubsidizeFees(suite.ctx, staker, fees, 1_000)
+	suite.Require().True(remaining.IsZero())
+	suite.Require().Equal(uint64(1_000), covered)
+
+	usage, found := suite.keeper.GetGasUsage(suite.ctx, 2, staker)
+	suite.Require().True(found)
+	suite.Require().Equal(types.GasUsage{Address: staker.String(), EpochNumber: 2, GasUsed: 1_000}, usage)
+
+	// the accounts without allowance pay the fees
+	remaining, covered = suite.keeper.SubsidizeFees(suite.ctx, other, fees, 1_000)
+	suite.Require().Equal(fees, remaining)
+	suite.Require().Zero(covered)
+
+	// the zero fees don't consume the allowance
+	remaining, covered = suite.keeper.SubsidizeFees(suite.ctx, staker, sdk.Coins{}, 1_000)
+	suite.Require().True(remaining.IsZero())
+	suite.Require().Zero(covered)
+
+	// no allowance is consumed when disabled
+	params := suite.keeper.GetParams(suite.ctx)
+	params.EnableGasQuota = false
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	remaining, covered = suite.keeper.SubsidizeFees(suite.ctx, staker, fees, 1_000)
+	suite.Require().Equal(fees, remaining)
+	suite.Require().Zero(covered)
+}
+
+func (suite *KeeperTestSuite) TestRefundGasQuota() {
+	suite.SetupTest()
+	suite.keeper.SubsidizeFees(suite.ctx, staker, sdk.NewCoins(sdk.NewInt64Coin("aanryton", 1000)), 6_000)
+
+	// the unused covered gas is credited back to the allowance
+	suite.keeper.RefundGasQuota(suite.ctx, staker, 2_000)
+	_, _, used, _ := suite.keeper.GetRemainingGas(suite.ctx, staker)
+	suite.Require().Equal(uint64(4_000), used)
+
+	// the refund is capped to the consumed allowance
+	suite.keeper.RefundGasQuota(suite.ctx, staker, 10_000)
+	_, _, used, _ = suite.keeper.GetRemainingGas(suite.ctx, staker)
+	suite.Require().Zero(used)
+
+	// nothing is credited to the accounts without usage
+	suite.keeper.RefundGasQuota(suite.ctx, other, 1_000)
+	_, found := suite.keeper.GetGasUsage(suite.ctx, 1, other)
+	suite.Require().False(found)
+}
+
+func (suite *KeeperTestSuite) TestBeforeEpochStart() {
+	suite.SetupTest()
+	fees := sdk.NewCoins(sdk.NewInt64Coin("aanryton", 1000))
+	suite.keeper.SubsidizeFees(suite.ctx, staker, fees, 1_000)
+	suite.setEpoch(2)
+	suite.keeper.SubsidizeFees(suite.ctx, staker, fees, 2_000)
+	suite.Require().Len(suite.keeper.GetGasUsages(suite.ctx), 2)
+
+	// the usages are only pruned at the start of the epochs of the allowance
+	suite.keeper.Hooks().BeforeEpochStart(suite.ctx, epochstypes.WeekEpochID, 2)
+	suite.Require().Len(suite.keeper.GetGasUsages(suite.ctx), 2)
+
+	// only the usages of the ended epoch are pruned
+	suite.keeper.Hooks().BeforeEpochStart(suite.ctx, epochstypes.DayEpochID, 2)
+	suite.Require().Equal(
+		[]types.GasUsage{{Address: staker.String(), EpochNumber: 2, GasUsed: 2_000}},
+		suite.keeper.GetGasUsages(suite.ctx),
+	)
+}
+
+func (suite *KeeperTestSuite) TestQueryGasQuota() {
+	suite.SetupTest()
+	suite.keeper.SubsidizeFees(suite.ctx, staker, sdk.NewCoins(sdk.NewInt64Coin("aanryton", 1000)), 2_500)
+
+	for _, address := range []string{staker.String(), common.BytesToAddress(staker).Hex()} {
+		res, err := suite.keeper.GasQuota(sdk.WrapSDKContext(suite.ctx), &types.QueryGasQuotaRequest{Address: address})
+		suite.Require().NoError(err)
+		suite.Require().Equal(&types.QueryGasQuotaResponse{EpochNumber: 1, GasQuota: 10_000, GasUsed: 2_500, GasRemaining: 7_500}, res)
+	}
+
+	_, err := suite.keeper.GasQuota(sdk.WrapSDKContext(suite.ctx), &types.QueryGasQuotaRequest{Address: "staker"})
+	suite.Require().Error(err)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	suite.SetupTest()
+	params := types.NewParams(true, 10, sdk.ZeroInt(), epochstypes.WeekEpochID)
+
+	_, err := suite.keeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{Authority: staker.String(), Params: params})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = suite.keeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+}
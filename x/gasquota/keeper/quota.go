@@ -0,0 +1,193 @@
+package keeper
+
+import (
+	"math"
+	"strconv"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/gasquota/types"
+)
+
+// GetGasUsage returns the gas allowance consumed by an account during an
+// epoch.
+func (k Keeper) GetGasUsage(ctx sdk.Context, epochNumber int64, addr sdk.AccAddress) (types.GasUsage, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasUsage)
+	bz := store.Get(types.GetGasUsageKey(epochNumber, addr))
+	if len(bz) == 0 {
+		return types.GasUsage{}, false
+	}
+
+	var usage types.GasUsage
+	k.cdc.MustUnmarshal(bz, &usage)
+	return usage, true
+}
+
+// SetGasUsage stores the gas allowance consumed by an account.
+func (k Keeper) SetGasUsage(ctx sdk.Context, usage types.GasUsage) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasUsage)
+	key := types.GetGasUsageKey(usage.EpochNumber, sdk.MustAccAddressFromBech32(usage.Address))
+	store.Set(key, k.cdc.MustMarshal(&usage))
+}
+
+// GetGasUsages returns the gas allowances consumed by all the accounts.
+func (k Keeper) GetGasUsages(ctx sdk.Context) []types.GasUsage {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasUsage)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	usages := []types.GasUsage{}
+	for ; iterator.Valid(); iterator.Next() {
+		var usage types.GasUsage
+		k.cdc.MustUnmarshal(iterator.Value(), &usage)
+		usages = append(usages, usage)
+	}
+	return usages
+}
+
+// DeleteGasUsages removes the gas allowances consumed by all the accounts
+// during an epoch.
+func (k Keeper) DeleteGasUsages(ctx sdk.Context, epochNumber int64) {
+	usageStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixGasUsage)
+	store := prefix.NewStore(usageStore, types.GetGasUsageEpochPrefix(epochNumber))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetGasQuota returns the gas allowance per epoch of an account, which is
+// proportional to its bonded tokens. The accounts with a stake lower than the
+// min stake have no allowance.
+func (k Keeper) GetGasQuota(ctx sdk.Context, params types.Params, addr sdk.AccAddress) uint64 {
+	stake := k.stakingKeeper.GetDelegatorBonded(ctx, addr)
+	if !stake.IsPositive() || stake.LT(params.MinStake) {
+		return 0
+	}
+
+	quota := stake.Quo(k.stakingKeeper.PowerReduction(ctx)).Mul(sdkmath.NewIntFromUint64(params.GasPerStakedToken))
+	if !quota.IsUint64() {
+		return math.MaxUint64
+	}
+	return quota.Uint64()
+}
+
+// GetRemainingGas returns the current epoch, the gas allowance and the gas
+// consumed during the epoch by an account. The found flag is false when the
+// gas quota is disabled or the epoch identifier is not registered.
+func (k Keeper) GetRemainingGas(ctx sdk.Context, addr sdk.AccAddress) (epochNumber int64, quota, used uint64, found bool) {
+	params := k.GetParams(ctx)
+	if !params.EnableGasQuota {
+		return 0, 0, 0, false
+	}
+
+	epoch, found := k.epochsKeeper.GetEpochInfo(ctx, params.EpochIdentifier)
+	if !found {
+		return 0, 0, 0, false
+	}
+
+	quota = k.GetGasQuota(ctx, params, addr)
+	if usage, found := k.GetGasUsage(ctx, epoch.CurrentEpoch, addr); found {
+		used = usage.GasUsed
+	}
+	if used > quota {
+		used = quota
+	}
+	return epoch.CurrentEpoch, quota, used, true
+}
+
+// ConsumeGasQuota consumes up to the given gas from the remaining allowance of
+// an account and returns the covered gas.
+func (k Keeper) ConsumeGasQuota(ctx sdk.Context, addr sdk.AccAddress, gas uint64) uint64 {
+	epochNumber, quota, used, found := k.GetRemainingGas(ctx, addr)
+	if !found || used >= quota {
+		return 0
+	}
+
+	covered := quota - used
+	if gas < covered {
+		covered = gas
+	}
+	if covered == 0 {
+		return 0
+	}
+
+	k.SetGasUsage(ctx, types.GasUsage{
+		Address:     addr.String(),
+		EpochNumber: epochNumber,
+		GasUsed:     used + covered,
+	})
+	return covered
+}
+
+// RefundGasQuota credits back up to the given gas to the allowance of an
+// account consumed during the current epoch. It is called with the covered gas
+// left unused by the execution of a transaction.
+func (k Keeper) RefundGasQuota(ctx sdk.Context, addr sdk.AccAddress, gas uint64) {
+	epochNumber, _, used, found := k.GetRemainingGas(ctx, addr)
+	if !found || used == 0 || gas == 0 {
+		return
+	}
+
+	refunded := gas
+	if refunded > used {
+		refunded = used
+	}
+
+	k.SetGasUsage(ctx, types.GasUsage{
+		Address:     addr.String(),
+		EpochNumber: epochNumber,
+		GasUsed:     used - refunded,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundGasQuota,
+			sdk.NewAttribute(types.AttributeKeyAccount, addr.String()),
+			sdk.NewAttribute(types.AttributeKeyGasRefunded, strconv.FormatUint(refunded, 10)),
+		),
+	)
+}
+
+// SubsidizeFees covers the fees of a transaction with the gas allowance of the
+// fee payer. It returns the fees left to pay, which are reduced in proportion
+// to the covered gas rounding up the uncovered part, and the covered gas.
+func (k Keeper) SubsidizeFees(ctx sdk.Context, feePayer sdk.AccAddress, fees sdk.Coins, gas uint64) (sdk.Coins, uint64) {
+	if fees.IsZero() || gas == 0 {
+		return fees, 0
+	}
+
+	covered := k.ConsumeGasQuota(ctx, feePayer, gas)
+	if covered == 0 {
+		return fees, 0
+	}
+
+	total := sdkmath.NewIntFromUint64(gas)
+	uncovered := sdkmath.NewIntFromUint64(gas - covered)
+	remaining := sdk.Coins{}
+	for _, fee := range fees {
+		amount := fee.Amount.Mul(uncovered).Add(total).SubRaw(1).Quo(total)
+		remaining = remaining.Add(sdk.NewCoin(fee.Denom, amount))
+	}
+
+	_, quota, used, _ := k.GetRemainingGas(ctx, feePayer)
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeConsumeGasQuota,
+			sdk.NewAttribute(types.AttributeKeyAccount, feePayer.String()),
+			sdk.NewAttribute(types.AttributeKeyGasCovered, strconv.FormatUint(covered, 10)),
+			sdk.NewAttribute(types.AttributeKeyGasRemaining, strconv.FormatUint(quota-used, 10)),
+			sdk.NewAttribute(types.AttributeKeyFeeSubsidized, fees.Sub(remaining...).String()),
+		),
+	)
+
+	return remaining, covered
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/anryton/anryton/v2/app"
+	"github.com/anryton/anryton/v2/testutil"
+	anrytontypes "github.com/anryton/anryton/v2/types"
+	"github.com/anryton/anryton/v2/utils"
+	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
+	"github.com/anryton/anryton/v2/x/gasquota/types"
+)
+
+var (
+	staker      = sdk.AccAddress([]byte("staker______________"))
+	smallStaker = sdk.AccAddress([]byte("small_staker________"))
+	other       = sdk.AccAddress([]byte("other_______________"))
+	authority   = authtypes.NewModuleAddress(govtypes.ModuleName)
+)
+
+// Test helpers
+func (suite *KeeperTestSuite) DoSetupTest() {
+	checkTx := false
+
+	// init app
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(checkTx, nil, chainID)
+
+	// setup context
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, suite.consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, header)
+	suite.keeper = suite.app.GasQuotaKeeper
+
+	// the staker bonds 10 tokens and the small staker half a token
+	suite.delegate(staker, anrytontypes.PowerReduction.MulRaw(10))
+	suite.delegate(smallStaker, anrytontypes.PowerReduction.QuoRaw(2))
+
+	// each bonded token grants 1k gas per day epoch
+	params := types.NewParams(true, 1000, anrytontypes.PowerReduction, epochstypes.DayEpochID)
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.setEpoch(1)
+}
+
+// delegate bonds the amount of the delegator to a bonded validator
+func (suite *KeeperTestSuite) delegate(delegator sdk.AccAddress, amount sdkmath.Int) {
+	bondDenom := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	err := testutil.FundAccount(suite.ctx, suite.app.BankKeeper, delegator, sdk.NewCoins(sdk.NewCoin(bondDenom, amount)))
+	suite.Require().NoError(err)
+
+	validators := suite.app.StakingKeeper.GetValidators(suite.ctx, 2)
+	validator := validators[0]
+	if validator.Status != stakingtypes.Bonded {
+		validator = validators[1]
+	}
+
+	_, err = suite.app.StakingKeeper.Delegate(suite.ctx, delegator, amount, stakingtypes.Unbonded, validator, true)
+	suite.Require().NoError(err)
+}
+
+// setEpoch sets the current day epoch
+func (suite *KeeperTestSuite) setEpoch(epochNumber int64) {
+	epoch, found := suite.app.EpochsKeeper.GetEpochInfo(suite.ctx, epochstypes.DayEpochID)
+	suite.Require().True(found)
+	epoch.CurrentEpoch = epochNumber
+	suite.app.EpochsKeeper.SetEpochInfo(suite.ctx, epoch)
+}
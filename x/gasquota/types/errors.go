@@ -0,0 +1,10 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidParams = errorsmod.Register(ModuleName, 2, "invalid gasquota params")
+)
@@ -0,0 +1,13 @@
+package types
+
+// gasquota events
+const (
+	EventTypeConsumeGasQuota = "consume_gas_quota"
+	EventTypeRefundGasQuota  = "refund_gas_quota"
+
+	AttributeKeyAccount       = "account"
+	AttributeKeyGasCovered    = "gas_covered"
+	AttributeKeyGasRefunded   = "gas_refunded"
+	AttributeKeyGasRemaining  = "gas_remaining"
+	AttributeKeyFeeSubsidized = "fee_subsidized"
+)
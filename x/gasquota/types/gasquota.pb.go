@@ -0,0 +1,736 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: anryton/gasquota/v1/gasquota.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the gasquota module.
+type Params struct {
+	// enable_gas_quota toggles the gas allowance of the stakers
+	EnableGasQuota bool `protobuf:"varint,1,opt,name=enable_gas_quota,json=enableGasQuota,proto3" json:"enable_gas_quota,omitempty"`
+	// gas_per_staked_token is the gas allowance per epoch of each bonded token
+	GasPerStakedToken uint64 `protobuf:"varint,2,opt,name=gas_per_staked_token,json=gasPerStakedToken,proto3" json:"gas_per_staked_token,omitempty"`
+	// min_stake is the minimum bonded amount, in the bond denom, of an account
+	// to be granted a gas allowance
+	MinStake github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=min_stake,json=minStake,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"min_stake"`
+	// epoch_identifier is the x/epochs identifier of the epochs at the start of
+	// which the gas allowances are renewed
+	EpochIdentifier string `protobuf:"bytes,4,opt,name=epoch_identifier,json=epochIdentifier,proto3" json:"epoch_identifier,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c2202bf6e7acd6, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnableGasQuota() bool {
+	if m != nil {
+		return m.EnableGasQuota
+	}
+	return false
+}
+
+func (m *Params) GetGasPerStakedToken() uint64 {
+	if m != nil {
+		return m.GasPerStakedToken
+	}
+	return 0
+}
+
+func (m *Params) GetEpochIdentifier() string {
+	if m != nil {
+		return m.EpochIdentifier
+	}
+	return ""
+}
+
+// GasUsage defines the gas allowance consumed by an account during an epoch.
+type GasUsage struct {
+	// address is the bech32 address of the account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// epoch_number is the epoch of the consumed gas. The usage is reset when
+	// the epoch changes.
+	EpochNumber int64 `protobuf:"varint,2,opt,name=epoch_number,json=epochNumber,proto3" json:"epoch_number,omitempty"`
+	// gas_used is the gas allowance consumed during the epoch
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+}
+
+func (m *GasUsage) Reset()         { *m = GasUsage{} }
+func (m *GasUsage) String() string { return proto.CompactTextString(m) }
+func (*GasUsage) ProtoMessage()    {}
+func (*GasUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_37c2202bf6e7acd6, []int{1}
+}
+func (m *GasUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GasUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GasUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GasUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GasUsage.Merge(m, src)
+}
+func (m *GasUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *GasUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_GasUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GasUsage proto.InternalMessageInfo
+
+func (m *GasUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GasUsage) GetEpochNumber() int64 {
+	if m != nil {
+		return m.EpochNumber
+	}
+	return 0
+}
+
+func (m *GasUsage) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "anryton.gasquota.v1.Params")
+	proto.RegisterType((*GasUsage)(nil), "anryton.gasquota.v1.GasUsage")
+}
+
+func init() {
+	proto.RegisterFile("anryton/gasquota/v1/gasquota.proto", fileDescriptor_37c2202bf6e7acd6)
+}
+
+var fileDescriptor_37c2202bf6e7acd6 = []byte{
+	// 368 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x4c, 0x91, 0xc1, 0x4e, 0xe2, 0x40,
+	0x1c, 0xc6, 0x3b, 0x4b, 0x03, 0x65, 0x76, 0xb3, 0xcb, 0xce, 0x72, 0xe8, 0x7a, 0x28, 0xc8, 0xc1,
+	0xd4, 0x83, 0x6d, 0xd0, 0x9b, 0x47, 0x2e, 0x48, 0x4c, 0x0c, 0x56, 0xbd, 0x78, 0x69, 0xa6, 0x74,
+	0x1c, 0x1a, 0xec, 0x4c, 0x9d, 0x99, 0x12, 0x79, 0x0b, 0x1f, 0xc1, 0xc7, 0xe1, 0xc8, 0xd1, 0x68,
+	0x42, 0x0c, 0x5c, 0x7c, 0x0c, 0xd3, 0x29, 0xa0, 0xa7, 0xff, 0xbf, 0xbf, 0xff, 0xd7, 0x2f, 0xf3,
+	0xe5, 0x83, 0x1d, 0xcc, 0xc4, 0x4c, 0x71, 0xe6, 0x53, 0x2c, 0x1f, 0x72, 0xae, 0xb0, 0x3f, 0xed,
+	0xee, 0x76, 0x2f, 0x13, 0x5c, 0x71, 0xf4, 0x6f, 0xa3, 0xf1, 0x76, 0x7c, 0xda, 0xdd, 0x6b, 0x52,
+	0x4e, 0xb9, 0xbe, 0xfb, 0xc5, 0x56, 0x4a, 0x3b, 0x6f, 0x00, 0x56, 0x87, 0x58, 0xe0, 0x54, 0x22,
+	0x17, 0x36, 0x08, 0xc3, 0xd1, 0x3d, 0x09, 0x29, 0x96, 0xa1, 0xfe, 0xcf, 0x06, 0x6d, 0xe0, 0x5a,
+	0xc1, 0xef, 0x92, 0xf7, 0xb1, 0xbc, 0x2c, 0x28, 0xf2, 0x61, 0xb3, 0x90, 0x64, 0x44, 0x84, 0x52,
+	0xe1, 0x09, 0x89, 0x43, 0xc5, 0x27, 0x84, 0xd9, 0x3f, 0xda, 0xc0, 0x35, 0x83, 0xbf, 0x14, 0xcb,
+	0x21, 0x11, 0x57, 0xfa, 0x72, 0x5d, 0x1c, 0xd0, 0x39, 0xac, 0xa7, 0x09, 0x2b, 0xc5, 0x76, 0xa5,
+	0x0d, 0xdc, 0x7a, 0xcf, 0x9b, 0x2f, 0x5b, 0xc6, 0xeb, 0xb2, 0x75, 0x40, 0x13, 0x35, 0xce, 0x23,
+	0x6f, 0xc4, 0x53, 0x7f, 0xc4, 0x65, 0xca, 0xe5, 0x66, 0x1c, 0xc9, 0x78, 0xe2, 0xab, 0x59, 0x46,
+	0xa4, 0x37, 0x60, 0x2a, 0xb0, 0xd2, 0x84, 0x69, 0x4b, 0x74, 0x08, 0x1b, 0x24, 0xe3, 0xa3, 0x71,
+	0x98, 0xc4, 0x84, 0xa9, 0xe4, 0x2e, 0x21, 0xc2, 0x36, 0x0b, 0xcf, 0xe0, 0x8f, 0xe6, 0x83, 0x1d,
+	0xee, 0x8c, 0xa1, 0xd5, 0xc7, 0xf2, 0x46, 0x62, 0x4a, 0x90, 0x0d, 0x6b, 0x38, 0x8e, 0x05, 0x91,
+	0x52, 0xa7, 0xaa, 0x07, 0xdb, 0x4f, 0xb4, 0x0f, 0x7f, 0x95, 0x86, 0x2c, 0x4f, 0x23, 0x22, 0x74,
+	0x8c, 0x4a, 0xf0, 0x53, 0xb3, 0x0b, 0x8d, 0xd0, 0x7f, 0x68, 0x15, 0x89, 0x73, 0x49, 0x62, 0xfd,
+	0x7e, 0x33, 0xa8, 0xd1, 0xc2, 0x98, 0xc4, 0xa7, 0xe6, 0xc7, 0x73, 0x0b, 0xf4, 0xce, 0xe6, 0x2b,
+	0x07, 0x2c, 0x56, 0x0e, 0x78, 0x5f, 0x39, 0xe0, 0x69, 0xed, 0x18, 0x8b, 0xb5, 0x63, 0xbc, 0xac,
+	0x1d, 0xe3, 0xd6, 0xfb, 0x16, 0x70, 0xdb, 0xdd, 0x76, 0x4e, 0x8f, 0xfd, 0xc7, 0xaf, 0x22, 0x75,
+	0xd8, 0xa8, 0xaa, 0x8b, 0x39, 0xf9, 0x1c, 0x00, 0x8d, 0x88, 0x70, 0xfa, 0xe9, 0x01, 0x00, 0x00,
+}
+
+func (this *GasUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*GasUsage)
+	if !ok {
+		that2, ok := that.(GasUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.EpochNumber != that1.EpochNumber {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EpochIdentifier) > 0 {
+		i -= len(m.EpochIdentifier)
+		copy(dAtA[i:], m.EpochIdentifier)
+		i = encodeVarintGasquota(dAtA, i, uint64(len(m.EpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.MinStake.Size()
+		i -= size
+		if _, err := m.MinStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGasquota(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.GasPerStakedToken != 0 {
+		i = encodeVarintGasquota(dAtA, i, uint64(m.GasPerStakedToken))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EnableGasQuota {
+		i--
+		if m.EnableGasQuota {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GasUsed != 0 {
+		i = encodeVarintGasquota(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.EpochNumber != 0 {
+		i = encodeVarintGasquota(dAtA, i, uint64(m.EpochNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGasquota(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGasquota(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGasquota(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnableGasQuota {
+		n += 2
+	}
+	if m.GasPerStakedToken != 0 {
+		n += 1 + sovGasquota(uint64(m.GasPerStakedToken))
+	}
+	l = m.MinStake.Size()
+	n += 1 + l + sovGasquota(uint64(l))
+	l = len(m.EpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGasquota(uint64(l))
+	}
+	return n
+}
+
+func (m *GasUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGasquota(uint64(l))
+	}
+	if m.EpochNumber != 0 {
+		n += 1 + sovGasquota(uint64(m.EpochNumber))
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovGasquota(uint64(m.GasUsed))
+	}
+	return n
+}
+
+func sovGasquota(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGasquota(x uint64) (n int) {
+	return sovGasquota(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasquota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnableGasQuota", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnableGasQuota = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPerStakedToken", wireType)
+			}
+			m.GasPerStakedToken = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasPerStakedToken |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasquota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasquota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasquota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasquota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasquota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasquota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGasquota
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGasquota
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGasquota
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochNumber", wireType)
+			}
+			m.EpochNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGasquota(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGasquota
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGasquota(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGasquota
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGasquota
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGasquota
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGasquota
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGasquota
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGasquota        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGasquota          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGasquota = fmt.Errorf("proto: unexpected end of group")
+)
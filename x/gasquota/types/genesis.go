@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state instance
+func NewGenesisState(params Params, usages []GasUsage) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		GasUsages: usages,
+	}
+}
+
+// DefaultGenesisState returns the default gasquota genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GasUsage{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, usage := range gs.GasUsages {
+		key := fmt.Sprintf("%d/%s", usage.EpochNumber, usage.Address)
+		if seen[key] {
+			return fmt.Errorf("duplicated gas usage for account %s in epoch %d", usage.Address, usage.EpochNumber)
+		}
+		if _, err := sdk.AccAddressFromBech32(usage.Address); err != nil {
+			return fmt.Errorf("invalid gas usage address %s: %w", usage.Address, err)
+		}
+		seen[key] = true
+	}
+	return nil
+}
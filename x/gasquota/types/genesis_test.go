@@ -0,0 +1,38 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	usage := GasUsage{Address: sdk.AccAddress([]byte("staker______________")).String(), EpochNumber: 1, GasUsed: 100}
+
+	testCases := []struct {
+		name      string
+		genState  *GenesisState
+		expectErr bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState(DefaultParams(), []GasUsage{usage}), false},
+		{"usages of different epochs", NewGenesisState(DefaultParams(), []GasUsage{usage, {Address: usage.Address, EpochNumber: 2, GasUsed: 100}}), false},
+		{"duplicated usage", NewGenesisState(DefaultParams(), []GasUsage{usage, usage}), true},
+		{"invalid address", NewGenesisState(DefaultParams(), []GasUsage{{Address: "staker"}}), true},
+		{"negative min stake", NewGenesisState(NewParams(true, 1, sdk.NewInt(-1), "day"), nil), true},
+		{"nil min stake", NewGenesisState(Params{EpochIdentifier: "day"}, nil), true},
+		{"empty epoch identifier", NewGenesisState(NewParams(true, 1, sdk.OneInt(), ""), nil), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
+)
+
+// StakingKeeper defines the expected staking keeper used to compute the gas
+// allowance of the stakers.
+type StakingKeeper interface {
+	GetDelegatorBonded(ctx sdk.Context, delegator sdk.AccAddress) sdkmath.Int
+	PowerReduction(ctx sdk.Context) sdkmath.Int
+}
+
+// EpochsKeeper defines the expected epochs keeper used to renew the gas
+// allowances on every epoch.
+type EpochsKeeper interface {
+	GetEpochInfo(ctx sdk.Context, identifier string) (epochstypes.EpochInfo, bool)
+}
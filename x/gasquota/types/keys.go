@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "gasquota"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the gasquota persistent store
+const (
+	prefixParams = iota + 1
+	prefixGasUsage
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixParams   = []byte{prefixParams}
+	KeyPrefixGasUsage = []byte{prefixGasUsage}
+)
+
+// GetGasUsageEpochPrefix returns the prefix of the gas usages of an epoch
+// under KeyPrefixGasUsage, so that the usages of an ended epoch are pruned
+// without iterating the other epochs.
+func GetGasUsageEpochPrefix(epochNumber int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(epochNumber))
+}
+
+// GetGasUsageKey returns the key of the gas usage of an account during an
+// epoch under KeyPrefixGasUsage
+func GetGasUsageKey(epochNumber int64, addr sdk.AccAddress) []byte {
+	return append(GetGasUsageEpochPrefix(epochNumber), addr.Bytes()...)
+}
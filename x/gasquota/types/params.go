@@ -0,0 +1,36 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anrytontypes "github.com/anryton/anryton/v2/types"
+	epochstypes "github.com/anryton/anryton/v2/x/epochs/types"
+)
+
+// NewParams creates a new Params object
+func NewParams(enableGasQuota bool, gasPerStakedToken uint64, minStake sdk.Int, epochIdentifier string) Params {
+	return Params{
+		EnableGasQuota:    enableGasQuota,
+		GasPerStakedToken: gasPerStakedToken,
+		MinStake:          minStake,
+		EpochIdentifier:   epochIdentifier,
+	}
+}
+
+// DefaultParams returns the default gasquota params, each bonded token grants
+// 50k gas per day to the accounts staking at least one token
+func DefaultParams() Params {
+	return NewParams(true, 50_000, anrytontypes.PowerReduction, epochstypes.DayEpochID)
+}
+
+// Validate performs a basic validation of the params
+func (p Params) Validate() error {
+	if p.MinStake.IsNil() || p.MinStake.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidParams, "min stake cannot be nil or negative: %s", p.MinStake)
+	}
+	if err := epochstypes.ValidateEpochIdentifierString(p.EpochIdentifier); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+	return nil
+}
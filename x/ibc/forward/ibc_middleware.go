@@ -0,0 +1,135 @@
+package forward
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/anryton/anryton/v2/ibc"
+	"github.com/anryton/anryton/v2/x/ibc/forward/keeper"
+	"github.com/anryton/anryton/v2/x/ibc/forward/types"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the packet forward
+// middleware given the forward keeper and the underlying application. It
+// forwards the received ICS-20 tokens to the next chain requested by the
+// packet memo and acknowledges the received packet asynchronously, once the
+// forwarded packet is acknowledged or times out.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the packet memo requests a forward, the receiver of the packet is
+// replaced by a deterministic intermediate receiver derived from the channel
+// and the original sender, and the memo is cleared. The tokens are received by
+// the intermediate receiver through the underlying application and then sent
+// to the next chain. No acknowledgement is returned as it is written once the
+// forwarded packet is acknowledged or times out. An error acknowledgement is
+// returned if the packet can't be forwarded, which reverts the transfer and
+// refunds the sender on the counterparty chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS-20 packet, continue with the rest of the stack
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	metadata, isForward, err := types.ParseMemo(data.Memo)
+	if !isForward {
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	receiver := types.DeriveIntermediateReceiver(packet.DestinationChannel, data.Sender)
+
+	override := data
+	override.Receiver = receiver.String()
+	override.Memo = ""
+
+	overridePacket := packet
+	overridePacket.Data = override.GetBytes()
+
+	ack := im.Module.OnRecvPacket(ctx, overridePacket, relayer)
+
+	// return if the acknowledgement is an error ACK
+	if !ack.Success() {
+		return ack
+	}
+
+	if err := im.keeper.ForwardPacket(ctx, packet, data, metadata, receiver); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	// the acknowledgement is written asynchronously
+	return nil
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// After the underlying application processes the acknowledgement, the
+// original packet of a forwarded packet is acknowledged with it.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	inFlight, found := im.keeper.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	return im.keeper.OnAcknowledgementPacket(ctx, packet, inFlight, ack)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// After the underlying application refunds the intermediate receiver, a timed
+// out forwarded packet is sent again or its original packet is acknowledged
+// with an error.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	inFlight, found := im.keeper.GetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return nil
+	}
+
+	return im.keeper.OnTimeoutPacket(ctx, packet, inFlight)
+}
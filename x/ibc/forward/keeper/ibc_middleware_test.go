@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+
+	ibctesting "github.com/anryton/anryton/v2/ibc/testing"
+	"github.com/anryton/anryton/v2/x/ibc/forward/types"
+)
+
+// sendFromOsmosis sends the uosmo coins from osmosis to Anryton with the given
+// memo and returns the sent packet.
+func (suite *KeeperTestSuite) sendFromOsmosis(amount int64, memo string) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.osmosisEndpoint().ChannelConfig.PortID, suite.osmosisEndpoint().ChannelID,
+		sdk.NewCoin("uosmo", sdk.NewInt(amount)),
+		suite.IBCOsmosisChain.SenderAccount.GetAddress().String(),
+		suite.AnrytonChain.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1000, 1000), 0, memo,
+	)
+	res, err := ibctesting.SendMsgs(suite.IBCOsmosisChain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	// the client of osmosis on Anryton proves the packet commitment
+	suite.Require().NoError(suite.receivingEndpoint().UpdateClient())
+	return packet
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketInvalidMemo() {
+	suite.SetupTest()
+
+	packet := suite.sendFromOsmosis(forwardAmount, `{"forward":{"receiver":"","channel":"channel-1"}}`)
+
+	res, err := suite.receivingEndpoint().RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var acknowledgement channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	suite.Require().False(acknowledgement.Success())
+
+	// nothing is forwarded
+	ctx := suite.AnrytonChain.GetContext()
+	sequence, _ := suite.app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, suite.forwardingEndpoint().ChannelConfig.PortID, suite.forwardingEndpoint().ChannelID)
+	suite.Require().Equal(uint64(1), sequence)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketForward() {
+	testCases := []struct {
+		name       string
+		receiver   func() string
+		expSuccess bool
+	}{
+		{
+			"forward acknowledged by the next chain",
+			func() string { return suite.IBCCosmosChain.SenderAccount.GetAddress().String() },
+			true,
+		},
+		{
+			"forward rejected by the next chain - refund the sender",
+			func() string { return "invalid" },
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			osmosisApp := suite.IBCOsmosisChain.GetSimApp()
+			osmosisSender := suite.IBCOsmosisChain.SenderAccount.GetAddress()
+			balanceBefore := osmosisApp.BankKeeper.GetBalance(suite.IBCOsmosisChain.GetContext(), osmosisSender, "uosmo")
+
+			memo, err := types.NewForwardMemo([]types.ForwardMetadata{{
+				Receiver: tc.receiver(),
+				Channel:  suite.forwardingEndpoint().ChannelID,
+			}}, "")
+			suite.Require().NoError(err)
+
+			packet := suite.sendFromOsmosis(forwardAmount, memo)
+
+			// the packet is received and forwarded, the acknowledgement is asynchronous
+			res, err := suite.receivingEndpoint().RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+			_, err = ibcgotesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().Error(err)
+
+			forwarded, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+			suite.Require().Equal(suite.forwardingEndpoint().ChannelID, forwarded.SourceChannel)
+
+			ctx := suite.AnrytonChain.GetContext()
+			_, found := suite.app.ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence)
+			suite.Require().True(found)
+
+			// relay the forwarded packet to the cosmos chain
+			cosmosEndpoint := suite.pathAnrytonCosmos.EndpointB
+			suite.Require().NoError(cosmosEndpoint.UpdateClient())
+			res, err = cosmosEndpoint.RecvPacketWithResult(forwarded)
+			suite.Require().NoError(err)
+			ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var acknowledgement channeltypes.Acknowledgement
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+			suite.Require().Equal(tc.expSuccess, acknowledgement.Success())
+
+			// the forwarded packet acknowledgement acknowledges the original packet
+			err = suite.forwardingEndpoint().AcknowledgePacket(forwarded, ack)
+			suite.Require().NoError(err)
+
+			ctx = suite.AnrytonChain.GetContext()
+			_, found = suite.app.ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence)
+			suite.Require().False(found)
+			commitment, found := suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(ack), commitment)
+
+			// relay the acknowledgement of the original packet to osmosis
+			suite.Require().NoError(suite.osmosisEndpoint().UpdateClient())
+			err = suite.osmosisEndpoint().AcknowledgePacket(packet, ack)
+			suite.Require().NoError(err)
+
+			balance := osmosisApp.BankKeeper.GetBalance(suite.IBCOsmosisChain.GetContext(), osmosisSender, "uosmo")
+			if tc.expSuccess {
+				suite.Require().Equal(balanceBefore.SubAmount(sdk.NewInt(forwardAmount)), balance)
+			} else {
+				suite.Require().Equal(balanceBefore, balance)
+			}
+		})
+	}
+}
@@ -0,0 +1,342 @@
+package keeper
+
+import (
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/anryton/anryton/v2/ibc"
+	erc20types "github.com/anryton/anryton/v2/x/erc20/types"
+	"github.com/anryton/anryton/v2/x/ibc/forward/types"
+)
+
+// Keeper forwards the received ICS-20 packets to the next chain requested by
+// the packet memo and acknowledges them once the forwarded packets are
+// acknowledged or time out.
+type Keeper struct {
+	storeKey       storetypes.StoreKey
+	cdc            codec.BinaryCodec
+	transferKeeper types.TransferKeeper
+	channelKeeper  types.ChannelKeeper
+	scopedKeeper   types.ScopedKeeper
+	bankKeeper     types.BankKeeper
+	erc20Keeper    types.ERC20Keeper
+}
+
+// NewKeeper returns a new instance of the IBC packet forward keeper.
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	cdc codec.BinaryCodec,
+	transferKeeper types.TransferKeeper,
+	channelKeeper types.ChannelKeeper,
+	scopedKeeper types.ScopedKeeper,
+	bankKeeper types.BankKeeper,
+	erc20Keeper types.ERC20Keeper,
+) Keeper {
+	return Keeper{
+		storeKey:       storeKey,
+		cdc:            cdc,
+		transferKeeper: transferKeeper,
+		channelKeeper:  channelKeeper,
+		scopedKeeper:   scopedKeeper,
+		bankKeeper:     bankKeeper,
+		erc20Keeper:    erc20Keeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// ForwardPacket sends the tokens received by the intermediate receiver to the
+// next chain and stores the received packet, which is acknowledged once the
+// forwarded packet is acknowledged or times out.
+func (k Keeper) ForwardPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	data transfertypes.FungibleTokenPacketData,
+	metadata types.ForwardMetadata,
+	receiver sdk.AccAddress,
+) error {
+	memo, err := metadata.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	timeout := metadata.GetTimeout()
+	msg := transfertypes.NewMsgTransfer(
+		metadata.Port, metadata.Channel, coin,
+		receiver.String(), metadata.Receiver,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(timeout).UnixNano()),
+		memo,
+	)
+
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrForwardFailed, err.Error())
+	}
+
+	bz, err := packet.Marshal()
+	if err != nil {
+		return err
+	}
+
+	k.SetInFlightPacket(ctx, metadata.Port, metadata.Channel, res.Sequence, types.InFlightPacket{
+		OriginalPacket:   bz,
+		RetriesRemaining: uint32(metadata.GetRetries()),
+		Timeout:          timeout,
+	})
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForward,
+			sdk.NewAttribute(types.AttributeKeyReceiver, metadata.Receiver),
+			sdk.NewAttribute(types.AttributeKeyIntermediateReceiver, receiver.String()),
+			sdk.NewAttribute(types.AttributeKeyPort, metadata.Port),
+			sdk.NewAttribute(types.AttributeKeyChannel, metadata.Channel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyOriginalChannel, packet.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeyOriginalSequence, strconv.FormatUint(packet.Sequence, 10)),
+		),
+	)
+
+	return nil
+}
+
+// OnAcknowledgementPacket acknowledges the original packet of the forwarded
+// packet with the acknowledgement of the next chain. If the forward failed,
+// the tokens received by the intermediate receiver are reverted first so that
+// the sender is refunded on the previous chain.
+func (k Keeper) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	inFlight types.InFlightPacket,
+	ack channeltypes.Acknowledgement,
+) error {
+	k.DeleteInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	var original channeltypes.Packet
+	if err := original.Unmarshal(inFlight.OriginalPacket); err != nil {
+		return err
+	}
+
+	if !ack.Success() {
+		if err := k.refund(ctx, original); err != nil {
+			return err
+		}
+	}
+
+	return k.writeAcknowledgement(ctx, original, ack)
+}
+
+// OnTimeoutPacket sends the timed out forwarded packet again if it has
+// retries remaining. Otherwise the tokens received by the intermediate
+// receiver are reverted and the original packet is acknowledged with an
+// error so that the sender is refunded on the previous chain.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet, inFlight types.InFlightPacket) error {
+	k.DeleteInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	if inFlight.RetriesRemaining > 0 {
+		err := k.retry(ctx, packet, inFlight)
+		if err == nil {
+			return nil
+		}
+
+		k.Logger(ctx).Debug(
+			"failed to retry forwarded packet",
+			"channel", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error(),
+		)
+	}
+
+	var original channeltypes.Packet
+	if err := original.Unmarshal(inFlight.OriginalPacket); err != nil {
+		return err
+	}
+
+	if err := k.refund(ctx, original); err != nil {
+		return err
+	}
+
+	err := errorsmod.Wrapf(
+		types.ErrForwardTimeout, "port ID (%s) channel ID (%s) sequence (%d)",
+		packet.SourcePort, packet.SourceChannel, packet.Sequence,
+	)
+	return k.writeAcknowledgement(ctx, original, channeltypes.NewErrorAcknowledgement(err))
+}
+
+// retry sends the timed out forwarded packet again, from the intermediate
+// receiver that has been refunded by the transfer module.
+func (k Keeper) retry(ctx sdk.Context, packet channeltypes.Packet, inFlight types.InFlightPacket) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return err
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		packet.SourcePort, packet.SourceChannel,
+		ibc.GetSentCoin(data.Denom, data.Amount),
+		data.Sender, data.Receiver,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(inFlight.Timeout).UnixNano()),
+		data.Memo,
+	)
+
+	// the retry doesn't modify the state if it fails
+	cacheCtx, writeFn := ctx.CacheContext()
+	res, err := k.transferKeeper.Transfer(sdk.WrapSDKContext(cacheCtx), msg)
+	if err != nil {
+		return err
+	}
+	writeFn()
+
+	inFlight.RetriesRemaining--
+	k.SetInFlightPacket(ctx, packet.SourcePort, packet.SourceChannel, res.Sequence, inFlight)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardRetry,
+			sdk.NewAttribute(types.AttributeKeyPort, packet.SourcePort),
+			sdk.NewAttribute(types.AttributeKeyChannel, packet.SourceChannel),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(res.Sequence, 10)),
+			sdk.NewAttribute(types.AttributeKeyRetriesRemaining, strconv.FormatUint(uint64(inFlight.RetriesRemaining), 10)),
+		),
+	)
+
+	return nil
+}
+
+// refund reverts the tokens received by the intermediate receiver for the
+// original packet, as the transfer module would have done if the packet had
+// been acknowledged with an error: the native tokens are escrowed again and
+// the vouchers are burned.
+func (k Keeper) refund(ctx sdk.Context, original channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(original.GetData(), &data); err != nil {
+		return err
+	}
+
+	receiver := types.DeriveIntermediateReceiver(original.DestinationChannel, data.Sender)
+	coin := ibc.GetReceivedCoin(
+		original.SourcePort, original.SourceChannel,
+		original.DestinationPort, original.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	if err := k.convertERC20(ctx, receiver, coin); err != nil {
+		return err
+	}
+
+	coins := sdk.NewCoins(coin)
+	if transfertypes.ReceiverChainIsSource(original.SourcePort, original.SourceChannel, data.Denom) {
+		escrow := transfertypes.GetEscrowAddress(original.DestinationPort, original.DestinationChannel)
+		if err := k.bankKeeper.SendCoins(ctx, receiver, escrow, coins); err != nil {
+			return err
+		}
+
+		total := k.transferKeeper.GetTotalEscrowForDenom(ctx, coin.Denom)
+		k.transferKeeper.SetTotalEscrowForDenom(ctx, total.Add(coin))
+	} else {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, receiver, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, transfertypes.ModuleName, coins); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForwardRefund,
+			sdk.NewAttribute(types.AttributeKeyIntermediateReceiver, receiver.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, coin.String()),
+			sdk.NewAttribute(types.AttributeKeyOriginalChannel, original.DestinationChannel),
+			sdk.NewAttribute(types.AttributeKeyOriginalSequence, strconv.FormatUint(original.Sequence, 10)),
+		),
+	)
+
+	return nil
+}
+
+// convertERC20 converts back the refunded tokens that the ERC20 middleware
+// converted to their ERC20 representation, so that the intermediate receiver
+// bank balance holds the coin.
+func (k Keeper) convertERC20(ctx sdk.Context, receiver sdk.AccAddress, coin sdk.Coin) error {
+	balance := k.bankKeeper.GetBalance(ctx, receiver, coin.Denom)
+	if !balance.IsLT(coin) {
+		return nil
+	}
+
+	if pairID := k.erc20Keeper.GetTokenPairID(ctx, coin.Denom); len(pairID) > 0 && k.erc20Keeper.IsERC20Enabled(ctx) {
+		pair, found := k.erc20Keeper.GetTokenPair(ctx, pairID)
+		if found && pair.Enabled {
+			amount := coin.Amount.Sub(balance.Amount)
+			msg := erc20types.NewMsgConvertERC20(amount, receiver, pair.GetERC20Contract(), common.BytesToAddress(receiver))
+			if _, err := k.erc20Keeper.ConvertERC20(sdk.WrapSDKContext(ctx), msg); err != nil {
+				return errorsmod.Wrapf(types.ErrInsufficientBalance, "failed to convert %s: %s", coin.Denom, err)
+			}
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(types.ErrInsufficientBalance, "%s < %s", balance, coin)
+}
+
+// writeAcknowledgement writes the asynchronous acknowledgement of the original
+// packet.
+func (k Keeper) writeAcknowledgement(ctx sdk.Context, original channeltypes.Packet, ack exported.Acknowledgement) error {
+	chanCap, ok := k.scopedKeeper.GetCapability(ctx, host.ChannelCapabilityPath(original.DestinationPort, original.DestinationChannel))
+	if !ok {
+		return errorsmod.Wrapf(
+			channeltypes.ErrChannelCapabilityNotFound, "port ID (%s) channel ID (%s)",
+			original.DestinationPort, original.DestinationChannel,
+		)
+	}
+
+	return k.channelKeeper.WriteAcknowledgement(ctx, chanCap, original, ack)
+}
+
+// SetInFlightPacket stores the in-flight packet forwarded on the given port
+// and channel with the given sequence.
+func (k Keeper) SetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64, inFlight types.InFlightPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	store.Set(types.InFlightPacketKey(portID, channelID, sequence), k.cdc.MustMarshal(&inFlight))
+}
+
+// GetInFlightPacket returns the in-flight packet forwarded on the given port
+// and channel with the given sequence.
+func (k Keeper) GetInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) (types.InFlightPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	bz := store.Get(types.InFlightPacketKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return types.InFlightPacket{}, false
+	}
+
+	var inFlight types.InFlightPacket
+	k.cdc.MustUnmarshal(bz, &inFlight)
+	return inFlight, true
+}
+
+// DeleteInFlightPacket removes the in-flight packet forwarded on the given
+// port and channel with the given sequence.
+func (k Keeper) DeleteInFlightPacket(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixInFlightPacket)
+	store.Delete(types.InFlightPacketKey(portID, channelID, sequence))
+}
@@ -0,0 +1,290 @@
+package keeper_test
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/anryton/anryton/v2/contracts"
+	"github.com/anryton/anryton/v2/utils"
+	erc20types "github.com/anryton/anryton/v2/x/erc20/types"
+	"github.com/anryton/anryton/v2/x/ibc/forward/types"
+)
+
+const forwardAmount = int64(100)
+
+// forward receives the coin on the intermediate receiver and forwards it to
+// the cosmos chain. It returns the original and the forwarded packets.
+func (suite *KeeperTestSuite) forward(ctx sdk.Context, denom string, coin sdk.Coin, retries uint8) (channeltypes.Packet, channeltypes.Packet) {
+	original, data := suite.originalPacket(denom, coin.Amount.String(), "", 1)
+	receiver := types.DeriveIntermediateReceiver(original.DestinationChannel, data.Sender)
+	suite.fundIntermediateReceiver(ctx, receiver, sdk.NewCoins(coin))
+
+	metadata := suite.forwardMetadata(retries)
+	sequence, found := suite.app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+	suite.Require().True(found)
+
+	err := suite.app.ForwardKeeper.ForwardPacket(ctx, original, data, metadata, receiver)
+	suite.Require().NoError(err)
+
+	forwardedDenom := coin.Denom
+	if trace, err := suite.app.TransferKeeper.DenomPathFromHash(ctx, coin.Denom); err == nil {
+		forwardedDenom = trace
+	}
+
+	forwardedData := transfertypes.NewFungibleTokenPacketData(
+		forwardedDenom, coin.Amount.String(), receiver.String(), metadata.Receiver, "",
+	)
+	forwarded := channeltypes.NewPacket(
+		forwardedData.GetBytes(), sequence,
+		metadata.Port, metadata.Channel,
+		suite.pathAnrytonCosmos.EndpointB.ChannelConfig.PortID, suite.pathAnrytonCosmos.EndpointB.ChannelID,
+		clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(types.DefaultTimeout).UnixNano()),
+	)
+
+	return original, forwarded
+}
+
+// nativeDenom returns the denom of a packet returning the native coin to
+// Anryton.
+func (suite *KeeperTestSuite) nativeDenom() string {
+	return transfertypes.GetPrefixedDenom(suite.osmosisEndpoint().ChannelConfig.PortID, suite.osmosisEndpoint().ChannelID, utils.BaseDenom)
+}
+
+func (suite *KeeperTestSuite) TestForwardPacket() {
+	testCases := []struct {
+		name     string
+		malleate func(ctx sdk.Context, receiver sdk.AccAddress, coin sdk.Coin)
+		expPass  bool
+	}{
+		{
+			"fail - insufficient intermediate receiver balance",
+			func(sdk.Context, sdk.AccAddress, sdk.Coin) {},
+			false,
+		},
+		{
+			"pass - forward the received voucher",
+			func(ctx sdk.Context, receiver sdk.AccAddress, coin sdk.Coin) {
+				suite.fundIntermediateReceiver(ctx, receiver, sdk.NewCoins(coin))
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.AnrytonChain.GetContext()
+
+			coin := suite.receivedVoucher(ctx, "uosmo", forwardAmount)
+			original, data := suite.originalPacket("uosmo", coin.Amount.String(), "", 1)
+			receiver := types.DeriveIntermediateReceiver(original.DestinationChannel, data.Sender)
+			tc.malleate(ctx, receiver, coin)
+
+			metadata := suite.forwardMetadata(2)
+			sequence, _ := suite.app.IBCKeeper.ChannelKeeper.GetNextSequenceSend(ctx, metadata.Port, metadata.Channel)
+
+			err := suite.app.ForwardKeeper.ForwardPacket(ctx, original, data, metadata, receiver)
+			if !tc.expPass {
+				suite.Require().ErrorIs(err, types.ErrForwardFailed)
+				return
+			}
+			suite.Require().NoError(err)
+
+			inFlight, found := suite.app.ForwardKeeper.GetInFlightPacket(ctx, metadata.Port, metadata.Channel, sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(uint32(2), inFlight.RetriesRemaining)
+			suite.Require().Equal(types.DefaultTimeout, inFlight.Timeout)
+
+			var stored channeltypes.Packet
+			suite.Require().NoError(stored.Unmarshal(inFlight.OriginalPacket))
+			suite.Require().Equal(original, stored)
+
+			// the tokens are escrowed for the forwarded packet
+			balance := suite.app.BankKeeper.GetBalance(ctx, receiver, coin.Denom)
+			suite.Require().True(balance.IsZero())
+			escrow := transfertypes.GetEscrowAddress(metadata.Port, metadata.Channel)
+			suite.Require().Equal(coin, suite.app.BankKeeper.GetBalance(ctx, escrow, coin.Denom))
+
+			// the original packet is not acknowledged yet
+			_, found = suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, original.DestinationPort, original.DestinationChannel, original.Sequence)
+			suite.Require().False(found)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	testCases := []struct {
+		name   string
+		native bool
+		ack    channeltypes.Acknowledgement
+	}{
+		{
+			"success acknowledgement",
+			false,
+			channeltypes.NewResultAcknowledgement([]byte{byte(1)}),
+		},
+		{
+			"error acknowledgement - burn the refunded voucher",
+			false,
+			channeltypes.NewErrorAcknowledgement(types.ErrForwardFailed),
+		},
+		{
+			"error acknowledgement - escrow the refunded native coin again",
+			true,
+			channeltypes.NewErrorAcknowledgement(types.ErrForwardFailed),
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.AnrytonChain.GetContext()
+
+			denom := "uosmo"
+			coin := suite.receivedVoucher(ctx, denom, forwardAmount)
+			if tc.native {
+				denom = suite.nativeDenom()
+				coin = sdk.NewCoin(utils.BaseDenom, sdk.NewInt(forwardAmount))
+			}
+
+			original, forwarded := suite.forward(ctx, denom, coin, 0)
+			receiver := sdk.MustAccAddressFromBech32(suite.forwardedData(forwarded).Sender)
+			inFlight, found := suite.app.ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence)
+			suite.Require().True(found)
+
+			supplyBefore := suite.app.BankKeeper.GetSupply(ctx, coin.Denom)
+			escrow := transfertypes.GetEscrowAddress(original.DestinationPort, original.DestinationChannel)
+			escrowBefore := suite.app.BankKeeper.GetBalance(ctx, escrow, coin.Denom)
+
+			if !tc.ack.Success() {
+				// the transfer module refunds the intermediate receiver
+				suite.fundIntermediateReceiver(ctx, receiver, sdk.NewCoins(coin))
+			}
+
+			err := suite.app.ForwardKeeper.OnAcknowledgementPacket(ctx, forwarded, inFlight, tc.ack)
+			suite.Require().NoError(err)
+
+			_, found = suite.app.ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence)
+			suite.Require().False(found)
+
+			// the original packet is acknowledged with the forwarded packet acknowledgement
+			commitment, found := suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, original.DestinationPort, original.DestinationChannel, original.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(tc.ack.Acknowledgement()), commitment)
+
+			if tc.ack.Success() {
+				return
+			}
+
+			// the refund is reverted on the intermediate receiver
+			suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, receiver, coin.Denom).IsZero())
+			if tc.native {
+				suite.Require().Equal(escrowBefore.Add(coin), suite.app.BankKeeper.GetBalance(ctx, escrow, coin.Denom))
+			} else {
+				suite.Require().Equal(supplyBefore, suite.app.BankKeeper.GetSupply(ctx, coin.Denom))
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	testCases := []struct {
+		name     string
+		retries  uint8
+		expRetry bool
+	}{
+		{
+			"retry the forwarded packet",
+			1,
+			true,
+		},
+		{
+			"no retries remaining - refund and acknowledge with an error",
+			0,
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			ctx := suite.AnrytonChain.GetContext()
+
+			coin := suite.receivedVoucher(ctx, "uosmo", forwardAmount)
+			original, forwarded := suite.forward(ctx, "uosmo", coin, tc.retries)
+			receiver := sdk.MustAccAddressFromBech32(suite.forwardedData(forwarded).Sender)
+			inFlight, found := suite.app.ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence)
+			suite.Require().True(found)
+
+			// the transfer module refunds the intermediate receiver
+			suite.fundIntermediateReceiver(ctx, receiver, sdk.NewCoins(coin))
+
+			err := suite.app.ForwardKeeper.OnTimeoutPacket(ctx, forwarded, inFlight)
+			suite.Require().NoError(err)
+
+			_, found = suite.app.ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence)
+			suite.Require().False(found)
+
+			_, acknowledged := suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, original.DestinationPort, original.DestinationChannel, original.Sequence)
+			retried, found := suite.app.ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence+1)
+			suite.Require().Equal(tc.expRetry, found)
+			suite.Require().Equal(!tc.expRetry, acknowledged)
+			suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, receiver, coin.Denom).IsZero())
+
+			if tc.expRetry {
+				suite.Require().Equal(uint32(tc.retries-1), retried.RetriesRemaining)
+				suite.Require().Equal(inFlight.OriginalPacket, retried.OriginalPacket)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRefundConvertERC20() {
+	suite.SetupTest()
+	ctx := suite.AnrytonChain.GetContext()
+
+	coin := suite.receivedVoucher(ctx, "uosmo", forwardAmount)
+	original, forwarded := suite.forward(ctx, "uosmo", coin, 0)
+	receiver := sdk.MustAccAddressFromBech32(suite.forwardedData(forwarded).Sender)
+	inFlight, found := suite.app.ForwardKeeper.GetInFlightPacket(ctx, forwarded.SourcePort, forwarded.SourceChannel, forwarded.Sequence)
+	suite.Require().True(found)
+
+	pair, err := suite.app.Erc20Keeper.RegisterCoin(ctx, banktypes.Metadata{
+		Description: "IBC Coin for IBC Osmosis Chain",
+		Base:        coin.Denom,
+		DenomUnits:  []*banktypes.DenomUnit{{Denom: "uosmo", Exponent: 0}},
+		Name:        coin.Denom,
+		Symbol:      "OSMO",
+		Display:     "uosmo",
+	})
+	suite.Require().NoError(err)
+
+	// the ERC20 middleware converts the refunded voucher to its ERC20 representation
+	suite.fundIntermediateReceiver(ctx, receiver, sdk.NewCoins(coin))
+	_, err = suite.app.Erc20Keeper.ConvertCoin(sdk.WrapSDKContext(ctx), erc20types.NewMsgConvertCoin(coin, common.BytesToAddress(receiver), receiver))
+	suite.Require().NoError(err)
+
+	ack := channeltypes.NewErrorAcknowledgement(types.ErrForwardFailed)
+	err = suite.app.ForwardKeeper.OnAcknowledgementPacket(ctx, forwarded, inFlight, ack)
+	suite.Require().NoError(err)
+
+	// the ERC20 tokens are converted back before the refund is reverted
+	erc20Balance := suite.app.Erc20Keeper.BalanceOf(ctx, contracts.ERC20MinterBurnerDecimalsContract.ABI, pair.GetERC20Contract(), common.BytesToAddress(receiver))
+	suite.Require().Zero(erc20Balance.Sign())
+	suite.Require().True(suite.app.BankKeeper.GetBalance(ctx, receiver, coin.Denom).IsZero())
+
+	_, found = suite.app.IBCKeeper.ChannelKeeper.GetPacketAcknowledgement(ctx, original.DestinationPort, original.DestinationChannel, original.Sequence)
+	suite.Require().True(found)
+}
+
+// forwardedData returns the ICS-20 data of the forwarded packet.
+func (suite *KeeperTestSuite) forwardedData(packet channeltypes.Packet) transfertypes.FungibleTokenPacketData {
+	var data transfertypes.FungibleTokenPacketData
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data))
+	return data
+}
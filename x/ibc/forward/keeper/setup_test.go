@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/anryton/anryton/v2/app"
+	ibctesting "github.com/anryton/anryton/v2/ibc/testing"
+	"github.com/anryton/anryton/v2/utils"
+	"github.com/anryton/anryton/v2/x/ibc/forward/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.Anryton
+
+	coordinator *ibcgotesting.Coordinator
+
+	// testing chains used for convenience and readability
+	AnrytonChain    *ibcgotesting.TestChain
+	IBCOsmosisChain *ibcgotesting.TestChain
+	IBCCosmosChain  *ibcgotesting.TestChain
+
+	// pathOsmosisAnryton is the path of the received packets and
+	// pathAnrytonCosmos the path of the forwarded packets
+	pathOsmosisAnryton *ibctesting.Path
+	pathAnrytonCosmos  *ibctesting.Path
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	// initializes 3 test chains
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 2)
+	suite.AnrytonChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.IBCOsmosisChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	suite.IBCCosmosChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(3))
+	suite.coordinator.CommitNBlocks(suite.AnrytonChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCOsmosisChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCCosmosChain, 2)
+
+	suite.app = suite.AnrytonChain.App.(*app.Anryton)
+	ctx := suite.AnrytonChain.GetContext()
+	evmParams := suite.app.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = utils.BaseDenom
+	err := suite.app.EvmKeeper.SetParams(ctx, evmParams)
+	suite.Require().NoError(err)
+
+	// Set block proposer once, so its carried over on the ibc-go-testing suite
+	validators := suite.app.StakingKeeper.GetValidators(ctx, 2)
+	cons, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.AnrytonChain.CurrentHeader.ProposerAddress = cons.Bytes()
+
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(ctx, validators[0])
+	suite.Require().NoError(err)
+
+	// Mint coins on the osmosis side which are forwarded through Anryton
+	coins := sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(10000000)))
+	osmosisApp := suite.IBCOsmosisChain.GetSimApp()
+	err = osmosisApp.BankKeeper.MintCoins(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = osmosisApp.BankKeeper.SendCoinsFromModuleToAccount(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, suite.IBCOsmosisChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	// Mint coins for IBC tx fee on Osmosis and Cosmos chains
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	stkCoin := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amt))
+	for _, chain := range []*ibcgotesting.TestChain{suite.IBCOsmosisChain, suite.IBCCosmosChain} {
+		err = chain.GetSimApp().BankKeeper.MintCoins(chain.GetContext(), minttypes.ModuleName, stkCoin)
+		suite.Require().NoError(err)
+		err = chain.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(chain.GetContext(), minttypes.ModuleName, chain.SenderAccount.GetAddress(), stkCoin)
+		suite.Require().NoError(err)
+	}
+
+	// Mint coins for IBC tx fee on Anryton
+	coins = sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, amt))
+	err = suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, suite.AnrytonChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	suite.pathOsmosisAnryton = ibctesting.NewTransferPath(suite.IBCOsmosisChain, suite.AnrytonChain) // clientID, connectionID, channelID empty
+	ibctesting.SetupPath(suite.coordinator, suite.pathOsmosisAnryton)                                // clientID, connectionID, channelID filled
+	suite.pathAnrytonCosmos = ibctesting.NewTransferPath(suite.AnrytonChain, suite.IBCCosmosChain)
+	ibctesting.SetupPath(suite.coordinator, suite.pathAnrytonCosmos)
+}
+
+// osmosisEndpoint returns the endpoint of the received packets on the osmosis
+// chain.
+func (suite *KeeperTestSuite) osmosisEndpoint() *ibctesting.Endpoint {
+	return suite.pathOsmosisAnryton.EndpointA
+}
+
+// receivingEndpoint returns the endpoint of the received packets on the
+// Anryton chain.
+func (suite *KeeperTestSuite) receivingEndpoint() *ibctesting.Endpoint {
+	return suite.pathOsmosisAnryton.EndpointB
+}
+
+// forwardingEndpoint returns the endpoint of the forwarded packets on the
+// Anryton chain.
+func (suite *KeeperTestSuite) forwardingEndpoint() *ibctesting.Endpoint {
+	return suite.pathAnrytonCosmos.EndpointA
+}
+
+// originalPacket returns a packet received by Anryton from osmosis with the
+// given denom, amount and memo.
+func (suite *KeeperTestSuite) originalPacket(denom, amount, memo string, sequence uint64) (channeltypes.Packet, transfertypes.FungibleTokenPacketData) {
+	data := transfertypes.NewFungibleTokenPacketData(
+		denom, amount,
+		suite.IBCOsmosisChain.SenderAccount.GetAddress().String(),
+		suite.AnrytonChain.SenderAccount.GetAddress().String(),
+		memo,
+	)
+
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), sequence,
+		suite.osmosisEndpoint().ChannelConfig.PortID, suite.osmosisEndpoint().ChannelID,
+		suite.receivingEndpoint().ChannelConfig.PortID, suite.receivingEndpoint().ChannelID,
+		clienttypes.NewHeight(1, 1000), 0,
+	)
+	return packet, data
+}
+
+// forwardMetadata returns the next hop to the cosmos chain.
+func (suite *KeeperTestSuite) forwardMetadata(retries uint8) types.ForwardMetadata {
+	return types.ForwardMetadata{
+		Receiver: suite.IBCCosmosChain.SenderAccount.GetAddress().String(),
+		Port:     suite.forwardingEndpoint().ChannelConfig.PortID,
+		Channel:  suite.forwardingEndpoint().ChannelID,
+		Retries:  &retries,
+	}
+}
+
+// receivedVoucher returns the voucher of the given osmosis denom received
+// by Anryton and registers its denom trace.
+func (suite *KeeperTestSuite) receivedVoucher(ctx sdk.Context, denom string, amount int64) sdk.Coin {
+	trace := transfertypes.ParseDenomTrace(
+		transfertypes.GetPrefixedDenom(suite.receivingEndpoint().ChannelConfig.PortID, suite.receivingEndpoint().ChannelID, denom),
+	)
+	suite.app.TransferKeeper.SetDenomTrace(ctx, trace)
+	return sdk.NewCoin(trace.IBCDenom(), sdk.NewInt(amount))
+}
+
+// fundIntermediateReceiver mints the coins to the intermediate receiver, as
+// the transfer module does when the packet is received or refunded.
+func (suite *KeeperTestSuite) fundIntermediateReceiver(ctx sdk.Context, receiver sdk.AccAddress, coins sdk.Coins) {
+	err := suite.app.BankKeeper.MintCoins(ctx, transfertypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, transfertypes.ModuleName, receiver, coins)
+	suite.Require().NoError(err)
+}
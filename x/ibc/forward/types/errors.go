@@ -0,0 +1,13 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidMemo         = errorsmod.Register(ModuleName, 2, "invalid packet forward memo")
+	ErrForwardFailed       = errorsmod.Register(ModuleName, 3, "failed to forward packet")
+	ErrForwardTimeout      = errorsmod.Register(ModuleName, 4, "forwarded packet timed out")
+	ErrInsufficientBalance = errorsmod.Register(ModuleName, 5, "insufficient intermediate receiver balance")
+)
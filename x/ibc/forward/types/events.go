@@ -0,0 +1,17 @@
+package types
+
+// ibc packet forward events
+const (
+	EventTypeForward       = "ibc_forward"
+	EventTypeForwardRetry  = "ibc_forward_retry"
+	EventTypeForwardRefund = "ibc_forward_refund"
+
+	AttributeKeyReceiver             = "receiver"
+	AttributeKeyIntermediateReceiver = "intermediate_receiver"
+	AttributeKeyPort                 = "port"
+	AttributeKeyChannel              = "channel"
+	AttributeKeySequence             = "sequence"
+	AttributeKeyRetriesRemaining     = "retries_remaining"
+	AttributeKeyOriginalChannel      = "original_channel"
+	AttributeKeyOriginalSequence     = "original_sequence"
+)
@@ -0,0 +1,423 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: anryton/forward/v1/forward.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// InFlightPacket defines a received ICS-20 packet that has been forwarded to
+// the next chain and whose acknowledgement is pending until the forwarded
+// packet is acknowledged or times out.
+type InFlightPacket struct {
+	// original_packet is the proto encoded packet received from the previous
+	// chain, which is acknowledged asynchronously
+	OriginalPacket []byte `protobuf:"bytes,1,opt,name=original_packet,json=originalPacket,proto3" json:"original_packet,omitempty"`
+	// retries_remaining is the number of times the forwarded packet is sent
+	// again after it times out
+	RetriesRemaining uint32 `protobuf:"varint,2,opt,name=retries_remaining,json=retriesRemaining,proto3" json:"retries_remaining,omitempty"`
+	// timeout is the relative timeout of the forwarded packet
+	Timeout time.Duration `protobuf:"bytes,3,opt,name=timeout,proto3,stdduration" json:"timeout"`
+}
+
+func (m *InFlightPacket) Reset()         { *m = InFlightPacket{} }
+func (m *InFlightPacket) String() string { return proto.CompactTextString(m) }
+func (*InFlightPacket) ProtoMessage()    {}
+func (*InFlightPacket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e96e10108d2671e7, []int{0}
+}
+func (m *InFlightPacket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *InFlightPacket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_InFlightPacket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *InFlightPacket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InFlightPacket.Merge(m, src)
+}
+func (m *InFlightPacket) XXX_Size() int {
+	return m.Size()
+}
+func (m *InFlightPacket) XXX_DiscardUnknown() {
+	xxx_messageInfo_InFlightPacket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InFlightPacket proto.InternalMessageInfo
+
+func (m *InFlightPacket) GetOriginalPacket() []byte {
+	if m != nil {
+		return m.OriginalPacket
+	}
+	return nil
+}
+
+func (m *InFlightPacket) GetRetriesRemaining() uint32 {
+	if m != nil {
+		return m.RetriesRemaining
+	}
+	return 0
+}
+
+func (m *InFlightPacket) GetTimeout() time.Duration {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*InFlightPacket)(nil), "anryton.forward.v1.InFlightPacket")
+}
+
+func init() { proto.RegisterFile("anryton/forward/v1/forward.proto", fileDescriptor_e96e10108d2671e7) }
+
+var fileDescriptor_e96e10108d2671e7 = []byte{
+	// 280 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xb1, 0x4e, 0xc3, 0x30,
+	0x18, 0x84, 0x63, 0x90, 0x00, 0x05, 0x28, 0x10, 0x31, 0x84, 0x0e, 0x6e, 0xc4, 0x42, 0x24, 0x24,
+	0x5b, 0x29, 0x33, 0x4b, 0x85, 0x90, 0x10, 0x0b, 0xca, 0xc8, 0x52, 0x39, 0xa9, 0xeb, 0x5a, 0x24,
+	0xfe, 0x23, 0xd7, 0x09, 0xf4, 0x2d, 0x18, 0x79, 0x01, 0xde, 0xa5, 0x63, 0x47, 0x26, 0x40, 0xc9,
+	0x8b, 0x20, 0x92, 0xb8, 0x93, 0xcf, 0xdf, 0x9d, 0xe5, 0xd3, 0xb9, 0x01, 0x53, 0x7a, 0x65, 0x40,
+	0xd1, 0x39, 0xe8, 0x57, 0xa6, 0x67, 0xb4, 0x8a, 0xac, 0x24, 0x85, 0x06, 0x03, 0x9e, 0xd7, 0x27,
+	0x88, 0xc5, 0x55, 0x34, 0x3c, 0x17, 0x20, 0xa0, 0xb5, 0xe9, 0xbf, 0xea, 0x92, 0x43, 0x2c, 0x00,
+	0x44, 0xc6, 0x69, 0x7b, 0x4b, 0xca, 0x39, 0x9d, 0x95, 0x9a, 0x19, 0x09, 0xaa, 0xf3, 0x2f, 0x3f,
+	0x91, 0x3b, 0x78, 0x50, 0xf7, 0x99, 0x14, 0x0b, 0xf3, 0xc4, 0xd2, 0x17, 0x6e, 0xbc, 0x2b, 0xf7,
+	0x04, 0xb4, 0x14, 0x52, 0xb1, 0x6c, 0x5a, 0xb4, 0xc8, 0x47, 0x01, 0x0a, 0x8f, 0xe2, 0x81, 0xc5,
+	0x7d, 0xf0, 0xda, 0x3d, 0xd3, 0xdc, 0x68, 0xc9, 0x97, 0x53, 0xcd, 0x73, 0x26, 0x95, 0x54, 0xc2,
+	0xdf, 0x09, 0x50, 0x78, 0x1c, 0x9f, 0xf6, 0x46, 0x6c, 0xb9, 0x77, 0xeb, 0xee, 0x1b, 0x99, 0x73,
+	0x28, 0x8d, 0xbf, 0x1b, 0xa0, 0xf0, 0x70, 0x7c, 0x41, 0xba, 0x6a, 0xc4, 0x56, 0x23, 0x77, 0x7d,
+	0xb5, 0xc9, 0xc1, 0xfa, 0x7b, 0xe4, 0x7c, 0xfc, 0x8c, 0x50, 0x6c, 0xdf, 0x4c, 0x1e, 0xd7, 0x35,
+	0x46, 0x9b, 0x1a, 0xa3, 0xdf, 0x1a, 0xa3, 0xf7, 0x06, 0x3b, 0x9b, 0x06, 0x3b, 0x5f, 0x0d, 0x76,
+	0x9e, 0x23, 0x21, 0xcd, 0xa2, 0x4c, 0x48, 0x0a, 0x39, 0xb5, 0xc3, 0xd9, 0xb3, 0x1a, 0xd3, 0x37,
+	0x2a, 0x93, 0x74, 0xbb, 0xa4, 0x59, 0x15, 0x7c, 0x99, 0xec, 0xb5, 0x5f, 0xde, 0xfc, 0x0d, 0x00,
+	0x11, 0x7d, 0x70, 0xf2, 0x69, 0x01, 0x00, 0x00,
+}
+
+func (m *InFlightPacket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *InFlightPacket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *InFlightPacket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Timeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintForward(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.RetriesRemaining != 0 {
+		i = encodeVarintForward(dAtA, i, uint64(m.RetriesRemaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.OriginalPacket) > 0 {
+		i -= len(m.OriginalPacket)
+		copy(dAtA[i:], m.OriginalPacket)
+		i = encodeVarintForward(dAtA, i, uint64(len(m.OriginalPacket)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintForward(dAtA []byte, offset int, v uint64) int {
+	offset -= sovForward(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *InFlightPacket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OriginalPacket)
+	if l > 0 {
+		n += 1 + l + sovForward(uint64(l))
+	}
+	if m.RetriesRemaining != 0 {
+		n += 1 + sovForward(uint64(m.RetriesRemaining))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Timeout)
+	n += 1 + l + sovForward(uint64(l))
+	return n
+}
+
+func sovForward(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozForward(x uint64) (n int) {
+	return sovForward(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *InFlightPacket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: InFlightPacket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: InFlightPacket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OriginalPacket", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OriginalPacket = append(m.OriginalPacket[:0], dAtA[iNdEx:postIndex]...)
+			if m.OriginalPacket == nil {
+				m.OriginalPacket = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesRemaining", wireType)
+			}
+			m.RetriesRemaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesRemaining |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthForward
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthForward
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Timeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipForward(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthForward
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipForward(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowForward
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowForward
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthForward
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupForward
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthForward
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthForward        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowForward          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupForward = fmt.Errorf("proto: unexpected end of group")
+)
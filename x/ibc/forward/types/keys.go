@@ -0,0 +1,46 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC packet forward middleware name
+	ModuleName = "ibcforward"
+
+	// StoreKey to be used when creating the KVStore. It differs from the
+	// module name, which has the IBC store key as prefix.
+	StoreKey = "forward-for-ibc"
+
+	// ReceiverPrefix is the prefix of the hash used to derive the intermediate
+	// receiver of the forwarded packets
+	ReceiverPrefix = "ibc-forward-intermediary"
+)
+
+// prefix bytes for the IBC packet forward persistent store
+const (
+	prefixInFlightPacket = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixInFlightPacket = []byte{prefixInFlightPacket}
+)
+
+// InFlightPacketKey returns the key of the in-flight packet forwarded on the
+// given port and channel with the given sequence.
+func InFlightPacketKey(portID, channelID string, sequence uint64) []byte {
+	key := make([]byte, 0, len(portID)+len(channelID)+10)
+	key = append(key, []byte(portID+"/"+channelID+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// DeriveIntermediateReceiver returns the deterministic address that receives
+// the transferred funds and sends them to the next chain on behalf of the
+// original sender of the packet. The address is derived from the destination
+// channel of the packet and the sender on the counterparty chain.
+func DeriveIntermediateReceiver(channel, originalSender string) sdk.AccAddress {
+	return address.Hash(ReceiverPrefix, []byte(channel+"/"+originalSender))[:20]
+}
@@ -0,0 +1,219 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+const (
+	// DefaultTimeout is the relative timeout of the forwarded packets when the
+	// memo doesn't set it
+	DefaultTimeout = 28 * 24 * time.Hour
+	// DefaultRetries is the number of times a forwarded packet is sent again
+	// after it times out when the memo doesn't set it
+	DefaultRetries uint8 = 3
+)
+
+// Memo is the ICS-20 packet memo requesting the received tokens to be
+// forwarded to the next chain, e.g.
+//
+//	{"forward":{"receiver":"cosmos1..","port":"transfer","channel":"channel-1","timeout":"10m","retries":2,"next":{..}}}
+//
+// The next field is used as the memo of the forwarded packet, which allows
+// multi-hop routes. Other memo fields are ignored.
+type Memo struct {
+	Forward *ForwardMetadata `json:"forward,omitempty"`
+}
+
+// ForwardMetadata is the next hop requested by the memo.
+type ForwardMetadata struct {
+	// Receiver is the address of the receiver on the next chain
+	Receiver string `json:"receiver"`
+	// Port is the port of the forwarded packet, defaults to transfer
+	Port string `json:"port,omitempty"`
+	// Channel is the channel of the forwarded packet
+	Channel string `json:"channel"`
+	// Timeout is the relative timeout of the forwarded packet
+	Timeout Duration `json:"timeout,omitempty"`
+	// Retries is the number of times the forwarded packet is sent again after
+	// it times out
+	Retries *uint8 `json:"retries,omitempty"`
+	// Next is the memo of the forwarded packet, either a JSON object or string
+	Next json.RawMessage `json:"next,omitempty"`
+}
+
+// Duration is a time.Duration that is decoded from either a duration string,
+// e.g. "10m", or a number of nanoseconds.
+type Duration time.Duration
+
+// MarshalJSON implements json.Marshaler.
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (d *Duration) UnmarshalJSON(bz []byte) error {
+	var value interface{}
+	if err := json.Unmarshal(bz, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case float64:
+		*d = Duration(v)
+	case string:
+		duration, err := time.ParseDuration(v)
+		if err != nil {
+			return err
+		}
+		*d = Duration(duration)
+	default:
+		return fmt.Errorf("invalid duration %s", bz)
+	}
+	return nil
+}
+
+// ParseMemo parses the forward metadata of the ICS-20 packet memo. It returns
+// false if the memo doesn't request a forward, in which case the packet is
+// processed by the rest of the stack.
+func ParseMemo(memo string) (ForwardMetadata, bool, error) {
+	if memo == "" {
+		return ForwardMetadata{}, false, nil
+	}
+
+	// memos that are not JSON objects are plain transfer notes
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return ForwardMetadata{}, false, nil
+	}
+
+	if _, ok := fields["forward"]; !ok {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var m Memo
+	if err := json.Unmarshal([]byte(memo), &m); err != nil {
+		return ForwardMetadata{}, true, errorsmod.Wrap(ErrInvalidMemo, err.Error())
+	}
+	if m.Forward == nil {
+		return ForwardMetadata{}, true, errorsmod.Wrap(ErrInvalidMemo, "empty forward")
+	}
+
+	metadata := *m.Forward
+	if metadata.Port == "" {
+		metadata.Port = transfertypes.PortID
+	}
+
+	return metadata, true, metadata.Validate()
+}
+
+// Validate checks the next hop of the forward metadata.
+func (m ForwardMetadata) Validate() error {
+	if m.Receiver == "" {
+		return errorsmod.Wrap(ErrInvalidMemo, "empty forward receiver")
+	}
+	if err := host.PortIdentifierValidator(m.Port); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMemo, "invalid forward port %s: %s", m.Port, err)
+	}
+	if err := host.ChannelIdentifierValidator(m.Channel); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMemo, "invalid forward channel %s: %s", m.Channel, err)
+	}
+	if m.Timeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidMemo, "negative forward timeout %s", time.Duration(m.Timeout))
+	}
+	if _, err := m.NextMemo(); err != nil {
+		return err
+	}
+	return nil
+}
+
+// GetTimeout returns the relative timeout of the forwarded packet.
+func (m ForwardMetadata) GetTimeout() time.Duration {
+	if m.Timeout == 0 {
+		return DefaultTimeout
+	}
+	return time.Duration(m.Timeout)
+}
+
+// GetRetries returns the number of times the forwarded packet is sent again
+// after it times out.
+func (m ForwardMetadata) GetRetries() uint8 {
+	if m.Retries == nil {
+		return DefaultRetries
+	}
+	return *m.Retries
+}
+
+// NextMemo returns the memo of the forwarded packet. The next field can be
+// either a JSON object, which is compacted, or a JSON string.
+func (m ForwardMetadata) NextMemo() (string, error) {
+	next := bytes.TrimSpace(m.Next)
+	if len(next) == 0 || bytes.Equal(next, []byte("null")) {
+		return "", nil
+	}
+
+	switch next[0] {
+	case '{':
+		var buf bytes.Buffer
+		if err := json.Compact(&buf, next); err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidMemo, "invalid forward next: %s", err)
+		}
+		return buf.String(), nil
+	case '"':
+		var memo string
+		if err := json.Unmarshal(next, &memo); err != nil {
+			return "", errorsmod.Wrapf(ErrInvalidMemo, "invalid forward next: %s", err)
+		}
+		return memo, nil
+	default:
+		return "", errorsmod.Wrap(ErrInvalidMemo, "forward next must be a JSON object or string")
+	}
+}
+
+// NewForwardMemo returns the memo of a packet forwarded through the given hops,
+// in order. The memo is set as the next memo of the last hop, it is embedded
+// as a JSON object if it is one or as a string otherwise.
+func NewForwardMemo(hops []ForwardMetadata, memo string) (string, error) {
+	if len(hops) == 0 {
+		return "", errorsmod.Wrap(ErrInvalidMemo, "empty forward hops")
+	}
+
+	next := memo
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := hops[i]
+
+		switch {
+		case next == "":
+			hop.Next = nil
+		case json.Valid([]byte(next)) && bytes.HasPrefix(bytes.TrimSpace([]byte(next)), []byte("{")):
+			hop.Next = json.RawMessage(next)
+		default:
+			bz, err := json.Marshal(next)
+			if err != nil {
+				return "", err
+			}
+			hop.Next = bz
+		}
+
+		if hop.Port == "" {
+			hop.Port = transfertypes.PortID
+		}
+		if err := hop.Validate(); err != nil {
+			return "", errorsmod.Wrapf(err, "hop %d", i)
+		}
+
+		bz, err := json.Marshal(Memo{Forward: &hop})
+		if err != nil {
+			return "", err
+		}
+		next = string(bz)
+	}
+
+	return next, nil
+}
@@ -0,0 +1,147 @@
+package types
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMemo(t *testing.T) {
+	testCases := []struct {
+		name       string
+		memo       string
+		expForward bool
+		expNext    string
+		expTimeout time.Duration
+		expRetries uint8
+		expectErr  bool
+	}{
+		{"empty memo", "", false, "", 0, 0, false},
+		{"plain text memo", "thanks for the tokens", false, "", 0, 0, false},
+		{"json memo without forward", `{"evm":{"contract":"0x3B98c72760f7BBa69D62ED6f48278451251948e7"}}`, false, "", 0, 0, false},
+		{
+			"forward with defaults",
+			`{"forward":{"receiver":"cosmos1receiver","channel":"channel-1"}}`,
+			true, "", DefaultTimeout, DefaultRetries, false,
+		},
+		{
+			"forward with timeout string and retries",
+			`{"forward":{"receiver":"cosmos1receiver","port":"transfer","channel":"channel-1","timeout":"10m","retries":0}}`,
+			true, "", 10 * time.Minute, 0, false,
+		},
+		{
+			"forward with timeout nanoseconds",
+			`{"forward":{"receiver":"cosmos1receiver","channel":"channel-1","timeout":60000000000}}`,
+			true, "", time.Minute, DefaultRetries, false,
+		},
+		{
+			"forward with next object",
+			`{"forward":{"receiver":"cosmos1receiver","channel":"channel-1","next":{"forward": {"receiver":"osmo1receiver","channel":"channel-2"}}}}`,
+			true, `{"forward":{"receiver":"osmo1receiver","channel":"channel-2"}}`, DefaultTimeout, DefaultRetries, false,
+		},
+		{
+			"forward with next string",
+			`{"forward":{"receiver":"cosmos1receiver","channel":"channel-1","next":"{\"forward\":{}}"}}`,
+			true, `{"forward":{}}`, DefaultTimeout, DefaultRetries, false,
+		},
+		{"null forward", `{"forward":null}`, true, "", 0, 0, true},
+		{"empty receiver", `{"forward":{"channel":"channel-1"}}`, true, "", 0, 0, true},
+		{"invalid channel", `{"forward":{"receiver":"cosmos1receiver","channel":"ch"}}`, true, "", 0, 0, true},
+		{"invalid timeout", `{"forward":{"receiver":"cosmos1receiver","channel":"channel-1","timeout":"1 minute"}}`, true, "", 0, 0, true},
+		{"negative timeout", `{"forward":{"receiver":"cosmos1receiver","channel":"channel-1","timeout":"-1m"}}`, true, "", 0, 0, true},
+		{"invalid next", `{"forward":{"receiver":"cosmos1receiver","channel":"channel-1","next":[1]}}`, true, "", 0, 0, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, isForward, err := ParseMemo(tc.memo)
+			require.Equal(t, tc.expForward, isForward)
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrInvalidMemo)
+				return
+			}
+			require.NoError(t, err)
+			if !isForward {
+				return
+			}
+
+			require.Equal(t, "transfer", metadata.Port)
+			require.Equal(t, tc.expTimeout, metadata.GetTimeout())
+			require.Equal(t, tc.expRetries, metadata.GetRetries())
+
+			next, err := metadata.NextMemo()
+			require.NoError(t, err)
+			require.Equal(t, tc.expNext, next)
+		})
+	}
+}
+
+func TestNewForwardMemo(t *testing.T) {
+	retries := uint8(1)
+	hops := []ForwardMetadata{
+		{Receiver: "cosmos1receiver", Channel: "channel-1", Timeout: Duration(time.Minute)},
+		{Receiver: "osmo1receiver", Port: "transfer", Channel: "channel-2", Retries: &retries},
+	}
+
+	testCases := []struct {
+		name      string
+		hops      []ForwardMetadata
+		memo      string
+		expNext   string
+		expectErr bool
+	}{
+		{"without memo", hops, "", "", false},
+		{"with JSON memo", hops, `{"wasm":{"contract":"osmo1contract","msg":{"a":{}}}}`, `{"wasm":{"contract":"osmo1contract","msg":{"a":{}}}}`, false},
+		{"with text memo", hops, "thanks", "thanks", false},
+		{"no hops", nil, "", "", true},
+		{"invalid hop", []ForwardMetadata{{Receiver: "cosmos1receiver"}}, "", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memo, err := NewForwardMemo(tc.hops, tc.memo)
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrInvalidMemo)
+				return
+			}
+			require.NoError(t, err)
+
+			// walk the route and check every hop
+			for i, hop := range tc.hops {
+				metadata, isForward, err := ParseMemo(memo)
+				require.NoError(t, err)
+				require.True(t, isForward)
+				require.Equal(t, hop.Receiver, metadata.Receiver)
+				require.Equal(t, hop.Channel, metadata.Channel)
+				require.Equal(t, hop.GetTimeout(), metadata.GetTimeout())
+				require.Equal(t, hop.GetRetries(), metadata.GetRetries(), "hop %d", i)
+
+				memo, err = metadata.NextMemo()
+				require.NoError(t, err)
+			}
+
+			require.Equal(t, tc.expNext, memo)
+		})
+	}
+}
+
+func TestDurationJSON(t *testing.T) {
+	bz, err := json.Marshal(Duration(90 * time.Second))
+	require.NoError(t, err)
+	require.Equal(t, `"1m30s"`, string(bz))
+
+	var d Duration
+	require.NoError(t, json.Unmarshal(bz, &d))
+	require.Equal(t, Duration(90*time.Second), d)
+
+	require.Error(t, json.Unmarshal([]byte("true"), &d))
+}
+
+func TestDeriveIntermediateReceiver(t *testing.T) {
+	receiver := DeriveIntermediateReceiver("channel-0", "cosmos1sender")
+	require.Len(t, receiver, 20)
+	require.Equal(t, receiver, DeriveIntermediateReceiver("channel-0", "cosmos1sender"))
+	require.NotEqual(t, receiver, DeriveIntermediateReceiver("channel-1", "cosmos1sender"))
+}
@@ -0,0 +1,130 @@
+package hooks
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/anryton/anryton/v2/ibc"
+	"github.com/anryton/anryton/v2/x/ibc/hooks/keeper"
+	"github.com/anryton/anryton/v2/x/ibc/hooks/types"
+)
+
+var _ porttypes.IBCModule = &IBCMiddleware{}
+
+// IBCMiddleware implements the ICS26 callbacks for the IBC hooks middleware
+// given the hooks keeper and the underlying application. It executes the EVM
+// or CosmWasm contract calls requested by the ICS-20 packet memos and the
+// acknowledgement and timeout callbacks of the EVM contracts.
+type IBCMiddleware struct {
+	*ibc.Module
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware given the keeper and underlying application
+func NewIBCMiddleware(k keeper.Keeper, app porttypes.IBCModule) IBCMiddleware {
+	return IBCMiddleware{
+		Module: ibc.NewModule(app),
+		keeper: k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+// If the packet memo requests a hook, the receiver of the packet is replaced by
+// a deterministic intermediate sender derived from the channel and the
+// original sender. The tokens are received by the intermediate sender through
+// the underlying application and then the contract call is executed on its
+// behalf. An error acknowledgement is returned if the hook fails, which
+// reverts the transfer and refunds the sender on the counterparty chain.
+func (im IBCMiddleware) OnRecvPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) exported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS-20 packet, continue with the rest of the stack
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	memo, isHook, err := types.ParseMemo(data.Memo)
+	if !isHook {
+		return im.Module.OnRecvPacket(ctx, packet, relayer)
+	}
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := memo.ValidateReceiver(data.Receiver); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	sender := types.DeriveIntermediateSender(packet.DestinationChannel, data.Sender)
+
+	data.Receiver = sender.String()
+	packet.Data = data.GetBytes()
+
+	ack := im.Module.OnRecvPacket(ctx, packet, relayer)
+
+	// return if the acknowledgement is an error ACK
+	if !ack.Success() {
+		return ack
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	if err := im.keeper.ExecuteHook(ctx, memo, sender, coin); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	return ack
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+// After the underlying application processes the acknowledgement, it invokes
+// the onAcknowledgement callback of the contract that sent the transfer
+// through the ICS20 precompile, if registered.
+func (im IBCMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return errorsmod.Wrapf(errortypes.ErrUnknownRequest, "cannot unmarshal ICS-20 transfer packet acknowledgement: %v", err)
+	}
+
+	im.keeper.OnAcknowledgementPacket(ctx, packet, ack, acknowledgement)
+	return nil
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+// After the underlying application refunds the sender, it invokes the
+// onTimeout callback of the contract that sent the transfer through the ICS20
+// precompile, if registered.
+func (im IBCMiddleware) OnTimeoutPacket(
+	ctx sdk.Context,
+	packet channeltypes.Packet,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.Module.OnTimeoutPacket(ctx, packet, relayer); err != nil {
+		return err
+	}
+
+	im.keeper.OnTimeoutPacket(ctx, packet)
+	return nil
+}
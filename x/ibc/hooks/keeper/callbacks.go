@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	"github.com/anryton/anryton/v2/x/ibc/hooks/types"
+)
+
+// SetCallback registers the contract to receive the acknowledgement or timeout
+// callback of the packet sent on the given port and channel with the given
+// sequence.
+func (k Keeper) SetCallback(ctx sdk.Context, portID, channelID string, sequence uint64, contract common.Address) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCallback)
+	store.Set(types.CallbackKey(portID, channelID, sequence), contract.Bytes())
+}
+
+// GetCallback returns the contract registered to receive the callback of the
+// packet.
+func (k Keeper) GetCallback(ctx sdk.Context, portID, channelID string, sequence uint64) (common.Address, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCallback)
+	bz := store.Get(types.CallbackKey(portID, channelID, sequence))
+	if len(bz) == 0 {
+		return common.Address{}, false
+	}
+	return common.BytesToAddress(bz), true
+}
+
+// DeleteCallback removes the callback registered for the packet.
+func (k Keeper) DeleteCallback(ctx sdk.Context, portID, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixCallback)
+	store.Delete(types.CallbackKey(portID, channelID, sequence))
+}
+
+// OnAcknowledgementPacket invokes the onAcknowledgement callback of the
+// contract registered for the packet, if any.
+func (k Keeper) OnAcknowledgementPacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement, acknowledgement []byte) {
+	contract, found := k.GetCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.DeleteCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	data, err := types.CallbacksABI.Pack(
+		types.OnAcknowledgementMethod,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence,
+		ack.Success(), acknowledgement,
+	)
+	if err != nil {
+		// NOTE: shouldn't happen as the arguments match the ABI
+		k.Logger(ctx).Error("failed to pack acknowledgement callback", "error", err.Error())
+		return
+	}
+
+	k.executeCallback(ctx, packet, contract, types.OnAcknowledgementMethod, data)
+}
+
+// OnTimeoutPacket invokes the onTimeout callback of the contract registered
+// for the packet, if any.
+func (k Keeper) OnTimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	contract, found := k.GetCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.DeleteCallback(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	data, err := types.CallbacksABI.Pack(
+		types.OnTimeoutMethod,
+		packet.SourcePort, packet.SourceChannel, packet.Sequence,
+	)
+	if err != nil {
+		// NOTE: shouldn't happen as the arguments match the ABI
+		k.Logger(ctx).Error("failed to pack timeout callback", "error", err.Error())
+		return
+	}
+
+	k.executeCallback(ctx, packet, contract, types.OnTimeoutMethod, data)
+}
+
+// executeCallback calls the contract from the ICS20 precompile address with
+// the CallbackGasLimit. The callback can't fail the packet lifecycle: if the
+// call fails its state changes are discarded and the failure is emitted as an
+// event. The gas used is charged to the relayer.
+func (k Keeper) executeCallback(ctx sdk.Context, packet channeltypes.Packet, contract common.Address, method string, data []byte) {
+	cacheCtx, writeFn := ctx.CacheContext()
+
+	err := k.callContract(cacheCtx, contract, data)
+	if err == nil {
+		writeFn()
+	} else {
+		k.Logger(ctx).Debug(
+			"ibc callback failed",
+			"method", method, "contract", contract.Hex(),
+			"channel", packet.SourceChannel, "sequence", packet.Sequence,
+			"error", err.Error(),
+		)
+	}
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyCallbackMethod, method),
+		sdk.NewAttribute(types.AttributeKeyContract, contract.Hex()),
+		sdk.NewAttribute(types.AttributeKeySourceChannel, packet.SourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.Sequence, 10)),
+	}
+	if err != nil {
+		attrs = append(attrs, sdk.NewAttribute(types.AttributeKeyError, err.Error()))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeIBCCallback, attrs...))
+}
+
+// callContract executes the callback call on the contract.
+func (k Keeper) callContract(ctx sdk.Context, contract common.Address, data []byte) error {
+	from := common.HexToAddress(evmtypes.ICS20PrecompileAddress)
+	msg := ethtypes.NewMessage(
+		from,
+		&contract,
+		k.evmKeeper.GetNonce(ctx, from),
+		big.NewInt(0),          // amount
+		types.CallbackGasLimit, // gasLimit
+		big.NewInt(0),          // gasPrice
+		big.NewInt(0),          // gasFeeCap
+		big.NewInt(0),          // gasTipCap
+		data,
+		ethtypes.AccessList{}, // AccessList
+		false,                 // isFake
+	)
+
+	// The EVM gas used already accounts for the state changes of the call, so
+	// the store accesses are not metered and the gas used is consumed once.
+	res, err := k.evmKeeper.ApplyMessage(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), msg, evmtypes.NewNoOpTracer(), true)
+	if err != nil {
+		return err
+	}
+
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "ibc callback")
+
+	if res.Failed() {
+		if reason, ok := evmtypes.DecodeRevertReason(res.Ret); ok {
+			return errorsmod.Wrapf(evmtypes.ErrVMExecution, "%s: %s", res.VmError, reason)
+		}
+		return errorsmod.Wrap(evmtypes.ErrVMExecution, res.VmError)
+	}
+
+	return nil
+}
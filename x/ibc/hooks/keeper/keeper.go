@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cometbft/cometbft/libs/log"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/anryton/anryton/v2/contracts"
+	erc20types "github.com/anryton/anryton/v2/x/erc20/types"
+	"github.com/anryton/anryton/v2/x/ibc/hooks/types"
+)
+
+// Keeper executes the contract calls requested by the ICS-20 packet memos and
+// the acknowledgement and timeout callbacks of the transfers sent by EVM
+// contracts.
+type Keeper struct {
+	storeKey    storetypes.StoreKey
+	bankKeeper  types.BankKeeper
+	erc20Keeper types.ERC20Keeper
+	evmKeeper   types.EVMKeeper
+	wasmKeeper  types.WasmKeeper
+}
+
+// NewKeeper returns a new instance of the IBC hooks keeper. The wasm keeper is
+// optional, wasm hooks are rejected if it's nil.
+func NewKeeper(
+	storeKey storetypes.StoreKey,
+	bankKeeper types.BankKeeper,
+	erc20Keeper types.ERC20Keeper,
+	evmKeeper types.EVMKeeper,
+	wasmKeeper types.WasmKeeper,
+) Keeper {
+	return Keeper{
+		storeKey:    storeKey,
+		bankKeeper:  bankKeeper,
+		erc20Keeper: erc20Keeper,
+		evmKeeper:   evmKeeper,
+		wasmKeeper:  wasmKeeper,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// ExecuteHook executes the hook of the memo from the intermediate sender,
+// which has been credited with the received coin.
+func (k Keeper) ExecuteHook(ctx sdk.Context, memo types.Memo, sender sdk.AccAddress, coin sdk.Coin) error {
+	var (
+		hookType string
+		err      error
+	)
+
+	switch {
+	case memo.EVM != nil:
+		hookType = types.HookTypeEVM
+		err = k.executeEVMHook(ctx, *memo.EVM, sender, coin)
+	case memo.Wasm != nil:
+		hookType = types.HookTypeWasm
+		err = k.executeWasmHook(ctx, *memo.Wasm, sender, coin)
+	default:
+		return errorsmod.Wrap(types.ErrInvalidMemo, "empty hook")
+	}
+
+	if err != nil {
+		k.Logger(ctx).Debug(
+			"ibc hook execution failed",
+			"type", hookType, "contract", memo.Contract(), "error", err.Error(),
+		)
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIBCHook,
+			sdk.NewAttribute(types.AttributeKeyHookType, hookType),
+			sdk.NewAttribute(types.AttributeKeyContract, memo.Contract()),
+			sdk.NewAttribute(types.AttributeKeyIntermediateSender, sender.String()),
+		),
+	)
+
+	return nil
+}
+
+// executeEVMHook calls the EVM contract with the memo calldata. If the received
+// coin has been converted to its ERC20 representation by the ERC20 middleware,
+// the contract is approved to spend the received amount so that it can pull
+// the tokens with transferFrom.
+func (k Keeper) executeEVMHook(ctx sdk.Context, hook types.EVMHook, sender sdk.AccAddress, coin sdk.Coin) error {
+	from := common.BytesToAddress(sender)
+	contract := hook.ContractAddress()
+
+	if pair, ok := k.convertedTokenPair(ctx, sender, coin); ok {
+		erc20 := contracts.ERC20MinterBurnerDecimalsContract.ABI
+		if _, err := k.erc20Keeper.CallEVM(
+			ctx, erc20, from, pair.GetERC20Contract(), true,
+			"approve", contract, coin.Amount.BigInt(),
+		); err != nil {
+			return errorsmod.Wrapf(types.ErrEVMHookFailed, "failed to approve %s: %s", contract, err)
+		}
+	}
+
+	if _, err := k.erc20Keeper.CallEVMWithData(ctx, from, &contract, hook.Calldata, true); err != nil {
+		return errorsmod.Wrap(types.ErrEVMHookFailed, err.Error())
+	}
+
+	return nil
+}
+
+// executeWasmHook executes the wasm contract with the memo message and the
+// received coin as funds. If the received coin has been converted to its ERC20
+// representation by the ERC20 middleware, it is converted back first.
+func (k Keeper) executeWasmHook(ctx sdk.Context, hook types.WasmHook, sender sdk.AccAddress, coin sdk.Coin) error {
+	if k.wasmKeeper == nil {
+		return errorsmod.Wrap(types.ErrWasmHookFailed, "wasm hooks are not supported")
+	}
+
+	if pair, ok := k.convertedTokenPair(ctx, sender, coin); ok {
+		from := common.BytesToAddress(sender)
+		msg := erc20types.NewMsgConvertERC20(coin.Amount, sender, pair.GetERC20Contract(), from)
+		if _, err := k.erc20Keeper.ConvertERC20(sdk.WrapSDKContext(ctx), msg); err != nil {
+			return errorsmod.Wrapf(types.ErrWasmHookFailed, "failed to convert %s: %s", coin.Denom, err)
+		}
+	}
+
+	if balance := k.bankKeeper.GetBalance(ctx, sender, coin.Denom); balance.IsLT(coin) {
+		return errorsmod.Wrapf(types.ErrInsufficientBalance, "%s < %s", balance, coin)
+	}
+
+	contract, err := sdk.AccAddressFromBech32(hook.Contract)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrInvalidMemo, err.Error())
+	}
+
+	if _, err := k.wasmKeeper.Execute(ctx, contract, sender, hook.Msg, sdk.NewCoins(coin)); err != nil {
+		return errorsmod.Wrap(types.ErrWasmHookFailed, err.Error())
+	}
+
+	return nil
+}
+
+// convertedTokenPair returns the token pair of the received coin if the ERC20
+// middleware converted it to its ERC20 representation, i.e. the bank balance
+// of the sender doesn't hold the received amount.
+func (k Keeper) convertedTokenPair(ctx sdk.Context, sender sdk.AccAddress, coin sdk.Coin) (erc20types.TokenPair, bool) {
+	if !k.erc20Keeper.IsERC20Enabled(ctx) {
+		return erc20types.TokenPair{}, false
+	}
+
+	pairID := k.erc20Keeper.GetTokenPairID(ctx, coin.Denom)
+	if len(pairID) == 0 {
+		return erc20types.TokenPair{}, false
+	}
+
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, pairID)
+	if !found || !pair.Enabled {
+		return erc20types.TokenPair{}, false
+	}
+
+	if !k.bankKeeper.GetBalance(ctx, sender, coin.Denom).IsLT(coin) {
+		return erc20types.TokenPair{}, false
+	}
+
+	return pair, true
+}
@@ -0,0 +1,34 @@
+package types
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+const (
+	// OnAcknowledgementMethod is the callback method invoked when the packet
+	// sent by the contract is acknowledged
+	OnAcknowledgementMethod = "onAcknowledgement"
+	// OnTimeoutMethod is the callback method invoked when the packet sent by
+	// the contract times out
+	OnTimeoutMethod = "onTimeout"
+)
+
+// callbacksABI is the ABI of the IICS20Callbacks interface implemented by the
+// contracts that send transfers with callbacks through the ICS20 precompile.
+const callbacksABI = `[
+	{"type":"function","name":"onAcknowledgement","stateMutability":"nonpayable","inputs":[{"name":"sourcePort","type":"string"},{"name":"sourceChannel","type":"string"},{"name":"sequence","type":"uint64"},{"name":"success","type":"bool"},{"name":"acknowledgement","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"onTimeout","stateMutability":"nonpayable","inputs":[{"name":"sourcePort","type":"string"},{"name":"sourceChannel","type":"string"},{"name":"sequence","type":"uint64"}],"outputs":[]}
+]`
+
+// CallbacksABI is the parsed ABI of the ICS20 callbacks.
+var CallbacksABI abi.ABI
+
+func init() {
+	var err error
+	CallbacksABI, err = abi.JSON(strings.NewReader(callbacksABI))
+	if err != nil {
+		panic(err)
+	}
+}
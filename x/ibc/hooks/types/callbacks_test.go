@@ -0,0 +1,29 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCallbackKey(t *testing.T) {
+	key := CallbackKey("transfer", "channel-0", 1)
+	require.Equal(t, append([]byte("transfer/channel-0/"), 0, 0, 0, 0, 0, 0, 0, 1), key)
+	require.NotEqual(t, key, CallbackKey("transfer", "channel-0", 2))
+	require.NotEqual(t, key, CallbackKey("transfer", "channel-1", 1))
+}
+
+func TestCallbacksABI(t *testing.T) {
+	ack, ok := CallbacksABI.Methods[OnAcknowledgementMethod]
+	require.True(t, ok)
+	require.Equal(t, "onAcknowledgement(string,string,uint64,bool,bytes)", ack.Sig)
+
+	timeout, ok := CallbacksABI.Methods[OnTimeoutMethod]
+	require.True(t, ok)
+	require.Equal(t, "onTimeout(string,string,uint64)", timeout.Sig)
+
+	_, err := CallbacksABI.Pack(OnAcknowledgementMethod, "transfer", "channel-0", uint64(1), true, []byte(`{"result":"AQ=="}`))
+	require.NoError(t, err)
+	_, err = CallbacksABI.Pack(OnTimeoutMethod, "transfer", "channel-0", uint64(1))
+	require.NoError(t, err)
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrInvalidMemo         = errorsmod.Register(ModuleName, 2, "invalid ibc hook memo")
+	ErrInvalidReceiver     = errorsmod.Register(ModuleName, 3, "invalid ibc hook receiver")
+	ErrEVMHookFailed       = errorsmod.Register(ModuleName, 4, "evm hook execution failed")
+	ErrWasmHookFailed      = errorsmod.Register(ModuleName, 5, "wasm hook execution failed")
+	ErrInsufficientBalance = errorsmod.Register(ModuleName, 6, "insufficient intermediate sender balance")
+)
@@ -0,0 +1,18 @@
+package types
+
+// ibc hooks events
+const (
+	EventTypeIBCHook     = "ibc_hook"
+	EventTypeIBCCallback = "ibc_callback"
+
+	AttributeKeyHookType           = "hook_type"
+	AttributeKeyContract           = "contract"
+	AttributeKeyIntermediateSender = "intermediate_sender"
+	AttributeKeyCallbackMethod     = "callback_method"
+	AttributeKeySourceChannel      = "source_channel"
+	AttributeKeySequence           = "sequence"
+	AttributeKeyError              = "error"
+
+	HookTypeEVM  = "evm"
+	HookTypeWasm = "wasm"
+)
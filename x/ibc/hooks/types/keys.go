@@ -0,0 +1,51 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC hooks middleware name
+	ModuleName = "ibchooks"
+
+	// StoreKey to be used when creating the KVStore. It differs from the
+	// module name, which has the IBC store key as prefix.
+	StoreKey = "hooks-for-ibc"
+
+	// SenderPrefix is the prefix of the hash used to derive the intermediate
+	// sender of the hook calls
+	SenderPrefix = "ibc-hook-intermediary"
+
+	// CallbackGasLimit is the gas limit of the acknowledgement and timeout
+	// callbacks executed on the EVM contracts
+	CallbackGasLimit uint64 = 200_000
+)
+
+// prefix bytes for the IBC hooks persistent store
+const (
+	prefixCallback = iota + 1
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixCallback = []byte{prefixCallback}
+)
+
+// CallbackKey returns the key of the callback contract registered for the
+// packet sent on the given port and channel with the given sequence.
+func CallbackKey(portID, channelID string, sequence uint64) []byte {
+	key := make([]byte, 0, len(portID)+len(channelID)+10)
+	key = append(key, []byte(portID+"/"+channelID+"/")...)
+	return append(key, sdk.Uint64ToBigEndian(sequence)...)
+}
+
+// DeriveIntermediateSender returns the deterministic address that receives the
+// transferred funds and executes the hook call on behalf of the original
+// sender of the packet on the counterparty chain. The address is derived from
+// the destination channel and the original sender so that it can't be
+// controlled by a local account. It is 20 bytes long to be usable in the EVM.
+func DeriveIntermediateSender(channel, originalSender string) sdk.AccAddress {
+	return sdk.AccAddress(address.Hash(SenderPrefix, []byte(channel+"/"+originalSender))[:20])
+}
@@ -0,0 +1,142 @@
+package types
+
+import (
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// Memo is the ICS-20 packet memo requesting the execution of a contract call
+// after the transfer is received, e.g.
+//
+//	{"evm":{"contract":"0x..","calldata":"0x.."}}
+//	{"wasm":{"contract":"anryton1..","msg":{..}}}
+//
+// Other memo fields are ignored.
+type Memo struct {
+	EVM  *EVMHook  `json:"evm,omitempty"`
+	Wasm *WasmHook `json:"wasm,omitempty"`
+}
+
+// EVMHook is the EVM contract call requested by the memo.
+type EVMHook struct {
+	// Contract is the hex address of the called contract
+	Contract string `json:"contract"`
+	// Calldata is the hex encoded ABI input of the call
+	Calldata hexutil.Bytes `json:"calldata"`
+}
+
+// WasmHook is the CosmWasm contract execution requested by the memo.
+type WasmHook struct {
+	// Contract is the bech32 address of the executed contract
+	Contract string `json:"contract"`
+	// Msg is the JSON execute message of the contract
+	Msg json.RawMessage `json:"msg"`
+}
+
+// ParseMemo parses the hook of the ICS-20 packet memo. It returns false if the
+// memo doesn't request a hook, in which case the packet is processed as a
+// regular transfer.
+func ParseMemo(memo string) (Memo, bool, error) {
+	var hook Memo
+	if memo == "" {
+		return hook, false, nil
+	}
+
+	// memos that are not JSON objects are plain transfer notes
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &fields); err != nil {
+		return hook, false, nil
+	}
+
+	_, hasEVM := fields[HookTypeEVM]
+	_, hasWasm := fields[HookTypeWasm]
+	if !hasEVM && !hasWasm {
+		return hook, false, nil
+	}
+
+	if err := json.Unmarshal([]byte(memo), &hook); err != nil {
+		return hook, true, errorsmod.Wrap(ErrInvalidMemo, err.Error())
+	}
+
+	return hook, true, hook.Validate()
+}
+
+// Validate checks that the memo requests exactly one valid hook.
+func (m Memo) Validate() error {
+	switch {
+	case m.EVM != nil && m.Wasm != nil:
+		return errorsmod.Wrap(ErrInvalidMemo, "only one of evm or wasm hook can be set")
+	case m.EVM != nil:
+		return m.EVM.Validate()
+	case m.Wasm != nil:
+		return m.Wasm.Validate()
+	default:
+		return errorsmod.Wrap(ErrInvalidMemo, "empty hook")
+	}
+}
+
+// Contract returns the contract called by the hook.
+func (m Memo) Contract() string {
+	if m.EVM != nil {
+		return m.EVM.Contract
+	}
+	if m.Wasm != nil {
+		return m.Wasm.Contract
+	}
+	return ""
+}
+
+// Validate checks the contract address and call data of the EVM hook.
+func (h EVMHook) Validate() error {
+	if !common.IsHexAddress(h.Contract) {
+		return errorsmod.Wrapf(ErrInvalidMemo, "invalid evm contract address %s", h.Contract)
+	}
+	if common.HexToAddress(h.Contract) == (common.Address{}) {
+		return errorsmod.Wrap(ErrInvalidMemo, "evm contract address cannot be the zero address")
+	}
+	if len(h.Calldata) == 0 {
+		return errorsmod.Wrap(ErrInvalidMemo, "empty evm calldata")
+	}
+	return nil
+}
+
+// ContractAddress returns the hex address of the called contract.
+func (h EVMHook) ContractAddress() common.Address {
+	return common.HexToAddress(h.Contract)
+}
+
+// Validate checks the contract address and message of the wasm hook.
+func (h WasmHook) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(h.Contract); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMemo, "invalid wasm contract address %s: %s", h.Contract, err)
+	}
+
+	var msg map[string]json.RawMessage
+	if err := json.Unmarshal(h.Msg, &msg); err != nil || len(msg) == 0 {
+		return errorsmod.Wrap(ErrInvalidMemo, "wasm msg must be a non empty JSON object")
+	}
+	return nil
+}
+
+// ValidateReceiver checks that the receiver of the packet is the contract
+// called by the hook, which makes the intent of the transfer explicit on the
+// counterparty chain.
+func (m Memo) ValidateReceiver(receiver string) error {
+	if m.EVM != nil {
+		contract := m.EVM.ContractAddress()
+		if common.IsHexAddress(receiver) && common.HexToAddress(receiver) == contract {
+			return nil
+		}
+		if addr, err := sdk.AccAddressFromBech32(receiver); err == nil && common.BytesToAddress(addr) == contract {
+			return nil
+		}
+	} else if m.Wasm != nil && receiver == m.Wasm.Contract {
+		return nil
+	}
+
+	return errorsmod.Wrapf(ErrInvalidReceiver, "receiver %s must be the hook contract %s", receiver, m.Contract())
+}
@@ -0,0 +1,111 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+func TestParseMemo(t *testing.T) {
+	testCases := []struct {
+		name      string
+		memo      string
+		expHook   bool
+		expEVM    bool
+		expWasm   bool
+		expectErr bool
+	}{
+		{"empty memo", "", false, false, false, false},
+		{"plain text memo", "thanks for the tokens", false, false, false, false},
+		{"json memo without hook", `{"forward":{"receiver":"cosmos1"}}`, false, false, false, false},
+		{
+			"evm hook",
+			`{"evm":{"contract":"0x3B98c72760f7BBa69D62ED6f48278451251948e7","calldata":"0xd0e30db0"}}`,
+			true, true, false, false,
+		},
+		{
+			"evm hook with other fields",
+			`{"evm":{"contract":"0x3B98c72760f7BBa69D62ED6f48278451251948e7","calldata":"0xd0e30db0"},"note":"deposit"}`,
+			true, true, false, false,
+		},
+		{
+			"wasm hook",
+			`{"wasm":{"contract":"cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr","msg":{"deposit":{}}}}`,
+			true, false, true, false,
+		},
+		{"evm hook invalid contract", `{"evm":{"contract":"0x1234","calldata":"0xd0e30db0"}}`, true, false, false, true},
+		{"evm hook zero contract", `{"evm":{"contract":"0x0000000000000000000000000000000000000000","calldata":"0x01"}}`, true, false, false, true},
+		{"evm hook empty calldata", `{"evm":{"contract":"0x3B98c72760f7BBa69D62ED6f48278451251948e7"}}`, true, false, false, true},
+		{"evm hook invalid calldata", `{"evm":{"contract":"0x3B98c72760f7BBa69D62ED6f48278451251948e7","calldata":"xyz"}}`, true, false, false, true},
+		{"wasm hook invalid contract", `{"wasm":{"contract":"0x1234","msg":{"deposit":{}}}}`, true, false, false, true},
+		{
+			"wasm hook empty msg",
+			`{"wasm":{"contract":"cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr","msg":{}}}`,
+			true, false, false, true,
+		},
+		{"null hook", `{"evm":null}`, true, false, false, true},
+		{
+			"both hooks",
+			`{"evm":{"contract":"0x3B98c72760f7BBa69D62ED6f48278451251948e7","calldata":"0x01"},"wasm":{"contract":"cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr","msg":{"a":{}}}}`,
+			true, false, false, true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			memo, isHook, err := ParseMemo(tc.memo)
+			require.Equal(t, tc.expHook, isHook)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expEVM, memo.EVM != nil)
+			require.Equal(t, tc.expWasm, memo.Wasm != nil)
+		})
+	}
+}
+
+func TestValidateReceiver(t *testing.T) {
+	contract := common.HexToAddress("0x3B98c72760f7BBa69D62ED6f48278451251948e7")
+	evmMemo := Memo{EVM: &EVMHook{Contract: contract.Hex(), Calldata: []byte{1}}}
+
+	wasmContract := "cosmos14hj2tavq8fpesdwxxcu44rty3hh90vhujrvcmstl4zr3txmfvw9s4hmalr"
+	wasmMemo := Memo{Wasm: &WasmHook{Contract: wasmContract, Msg: []byte(`{"a":{}}`)}}
+
+	testCases := []struct {
+		name      string
+		memo      Memo
+		receiver  string
+		expectErr bool
+	}{
+		{"evm hex receiver", evmMemo, contract.Hex(), false},
+		{"evm lower case hex receiver", evmMemo, "0x3b98c72760f7bba69d62ed6f48278451251948e7", false},
+		{"evm bech32 receiver", evmMemo, sdk.AccAddress(contract.Bytes()).String(), false},
+		{"evm other receiver", evmMemo, "0x0000000000000000000000000000000000000001", true},
+		{"wasm receiver", wasmMemo, wasmContract, false},
+		{"wasm other receiver", wasmMemo, sdk.AccAddress(contract.Bytes()).String(), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.memo.ValidateReceiver(tc.receiver)
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrInvalidReceiver)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestDeriveIntermediateSender(t *testing.T) {
+	sender := DeriveIntermediateSender("channel-0", "cosmos1sender")
+	require.Len(t, sender, common.AddressLength)
+	require.Equal(t, sender, DeriveIntermediateSender("channel-0", "cosmos1sender"))
+	require.NotEqual(t, sender, DeriveIntermediateSender("channel-1", "cosmos1sender"))
+	require.NotEqual(t, sender, DeriveIntermediateSender("channel-0", "cosmos1other"))
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+
+	"github.com/anryton/anryton/v2/x/ratelimit/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// RateLimits returns all the rate limits with the flows of their current
+// window
+func (k Keeper) RateLimits(c context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+		rateLimits = append(rateLimits, k.currentFlow(ctx, rateLimit))
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit returns the rate limit of a denom on a channel with the flows of
+// its current window
+func (k Keeper) RateLimit(c context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimit, found := k.getCurrentRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "rate limit for denom '%s' on channel '%s'", req.Denom, req.ChannelId)
+	}
+
+	return &types.QueryRateLimitResponse{RateLimit: rateLimit}, nil
+}
+
+// RateLimitsByChannel returns the rate limits of a channel with the flows of
+// their current window
+func (k Keeper) RateLimitsByChannel(c context.Context, req *types.QueryRateLimitsByChannelRequest) (*types.QueryRateLimitsByChannelResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	rateLimits := k.GetRateLimitsByChannel(ctx, req.ChannelId)
+	for i, rateLimit := range rateLimits {
+		rateLimits[i] = k.currentFlow(ctx, rateLimit)
+	}
+
+	return &types.QueryRateLimitsByChannelResponse{RateLimits: rateLimits}, nil
+}
@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+)
+
+// recvPacket relays the packet to Anryton and returns its acknowledgement.
+func (suite *KeeperTestSuite) recvPacket(packet channeltypes.Packet) channeltypes.Acknowledgement {
+	// the client of osmosis on Anryton proves the packet commitment
+	err := suite.anrytonEndpoint().UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err := suite.anrytonEndpoint().RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+
+	var acknowledgement channeltypes.Acknowledgement
+	suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+	return acknowledgement
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	suite.SetupTest()
+
+	// the first transfer mints the voucher supply on Anryton
+	ack := suite.recvPacket(suite.sendFromOsmosis(500))
+	suite.Require().True(ack.Success())
+
+	trace := transfertypes.ParseDenomTrace(transfertypes.GetPrefixedDenom(
+		suite.anrytonEndpoint().ChannelConfig.PortID, suite.anrytonEndpoint().ChannelID, "uosmo",
+	))
+	voucher := trace.IBCDenom()
+
+	// recv quota of 50
+	suite.addRateLimit(voucher, 10, 10, 24)
+
+	ack = suite.recvPacket(suite.sendFromOsmosis(50))
+	suite.Require().True(ack.Success())
+
+	// the rejected packet is acknowledged with an error, which refunds the
+	// sender on osmosis
+	ack = suite.recvPacket(suite.sendFromOsmosis(1))
+	suite.Require().False(ack.Success())
+
+	balance := suite.app.BankKeeper.GetBalance(suite.AnrytonChain.GetContext(), suite.AnrytonChain.SenderAccount.GetAddress(), voucher)
+	suite.Require().Equal(sdk.NewInt(550), balance.Amount)
+	suite.Require().Equal(sdk.NewInt(50), suite.rateLimit(voucher).Flow.Inflow)
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	testCases := []struct {
+		name       string
+		receiver   func() string
+		expSuccess bool
+		expOutflow int64
+	}{
+		{
+			"success acknowledgement - outflow kept",
+			suite.osmosisReceiver,
+			true,
+			100,
+		},
+		{
+			"error acknowledgement - outflow undone",
+			func() string { return "invalid" },
+			false,
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.addRateLimit(testDenom, 10, 10, 24)
+
+			packet, err := suite.sendFromAnryton(100, tc.receiver(), defaultTimeout())
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(suite.osmosisEndpoint().UpdateClient())
+			res, err := suite.osmosisEndpoint().RecvPacketWithResult(packet)
+			suite.Require().NoError(err)
+			ack, err := ibcgotesting.ParseAckFromEvents(res.GetEvents())
+			suite.Require().NoError(err)
+
+			var acknowledgement channeltypes.Acknowledgement
+			suite.Require().NoError(transfertypes.ModuleCdc.UnmarshalJSON(ack, &acknowledgement))
+			suite.Require().Equal(tc.expSuccess, acknowledgement.Success())
+
+			err = suite.anrytonEndpoint().AcknowledgePacket(packet, ack)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewInt(tc.expOutflow), suite.rateLimit(testDenom).Flow.Outflow)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	suite.SetupTest()
+	suite.addRateLimit(testDenom, 10, 10, 24)
+
+	clientHeight := suite.anrytonEndpoint().GetClientState().GetLatestHeight().(clienttypes.Height)
+	timeoutHeight := clienttypes.NewHeight(clientHeight.RevisionNumber, clientHeight.RevisionHeight+1)
+
+	packet, err := suite.sendFromAnryton(100, suite.osmosisReceiver(), timeoutHeight)
+	suite.Require().NoError(err)
+
+	// the quota is used until the packet times out
+	_, err = suite.sendFromAnryton(1, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().Error(err)
+
+	suite.coordinator.CommitNBlocks(suite.IBCOsmosisChain, 3)
+	suite.Require().NoError(suite.anrytonEndpoint().UpdateClient())
+	suite.Require().NoError(suite.anrytonEndpoint().TimeoutPacket(packet))
+
+	suite.Require().True(suite.rateLimit(testDenom).Flow.Outflow.IsZero())
+	balance := suite.app.BankKeeper.GetBalance(suite.AnrytonChain.GetContext(), suite.AnrytonChain.SenderAccount.GetAddress(), testDenom)
+	suite.Require().Equal(sdk.NewInt(testSupply), balance.Amount)
+}
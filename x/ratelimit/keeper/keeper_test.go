@@ -0,0 +1,218 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+
+	"github.com/anryton/anryton/v2/x/ratelimit"
+	"github.com/anryton/anryton/v2/x/ratelimit/types"
+)
+
+func (suite *KeeperTestSuite) TestAddRateLimit() {
+	testCases := []struct {
+		name     string
+		malleate func(msg *types.MsgAddRateLimit)
+		expErr   error
+	}{
+		{
+			"success",
+			func(*types.MsgAddRateLimit) {},
+			nil,
+		},
+		{
+			"invalid authority",
+			func(msg *types.MsgAddRateLimit) {
+				msg.Authority = suite.AnrytonChain.SenderAccount.GetAddress().String()
+			},
+			govtypes.ErrInvalidSigner,
+		},
+		{
+			"channel not found",
+			func(msg *types.MsgAddRateLimit) { msg.ChannelId = "channel-100" },
+			types.ErrChannelNotFound,
+		},
+		{
+			"denom without supply",
+			func(msg *types.MsgAddRateLimit) { msg.Denom = "aunknown" },
+			types.ErrZeroChannelValue,
+		},
+		{
+			"rate limit already exists",
+			func(*types.MsgAddRateLimit) { suite.addRateLimit(testDenom, 10, 10, 24) },
+			types.ErrRateLimitAlreadyExists,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			msg := &types.MsgAddRateLimit{
+				Authority:      suite.authority(),
+				Denom:          testDenom,
+				ChannelId:      suite.anrytonEndpoint().ChannelID,
+				MaxPercentSend: sdk.NewInt(10),
+				MaxPercentRecv: sdk.NewInt(20),
+				DurationHours:  24,
+			}
+			tc.malleate(msg)
+
+			ctx := suite.AnrytonChain.GetContext()
+			_, err := suite.app.RateLimitKeeper.AddRateLimit(sdk.WrapSDKContext(ctx), msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			rateLimit, found := suite.app.RateLimitKeeper.GetRateLimit(ctx, testDenom, msg.ChannelId)
+			suite.Require().True(found)
+			suite.Require().Equal(sdk.NewInt(testSupply), rateLimit.Flow.ChannelValue)
+			suite.Require().Equal(rateLimit.WindowStart(ctx.BlockTime()), rateLimit.Flow.WindowStart)
+			suite.Require().Empty(rateLimit.Buckets)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSendPacketRollingWindow() {
+	suite.SetupTest()
+	// send quota of 100 over a window of 2 hours
+	suite.addRateLimit(testDenom, 10, 10, 2)
+
+	_, err := suite.sendFromAnryton(60, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().NoError(err)
+
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	_, err = suite.sendFromAnryton(40, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().NoError(err)
+	_, err = suite.sendFromAnryton(1, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+
+	rateLimit := suite.rateLimit(testDenom)
+	suite.Require().Equal(sdk.NewInt(100), rateLimit.Flow.Outflow)
+	suite.Require().Len(rateLimit.Buckets, 2)
+
+	// the outflow of the first hour falls out of the window, the channel value
+	// is refreshed with the supply
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	rateLimit = suite.rateLimit(testDenom)
+	suite.Require().Equal(sdk.NewInt(40), rateLimit.Flow.Outflow)
+	suite.Require().Len(rateLimit.Buckets, 1)
+
+	_, err = suite.sendFromAnryton(60, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().NoError(err)
+	_, err = suite.sendFromAnryton(1, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().ErrorIs(err, types.ErrQuotaExceeded)
+}
+
+func (suite *KeeperTestSuite) TestUndoSendPacket() {
+	testCases := []struct {
+		name       string
+		undo       func(packet channeltypes.Packet)
+		expOutflow int64
+	}{
+		{
+			"error acknowledgement - outflow undone",
+			func(packet channeltypes.Packet) {
+				ack := channeltypes.NewErrorAcknowledgement(types.ErrQuotaExceeded)
+				suite.app.RateLimitKeeper.AcknowledgePacket(suite.AnrytonChain.GetContext(), packet, ack)
+			},
+			0,
+		},
+		{
+			"success acknowledgement - outflow kept",
+			func(packet channeltypes.Packet) {
+				ack := channeltypes.NewResultAcknowledgement([]byte{1})
+				suite.app.RateLimitKeeper.AcknowledgePacket(suite.AnrytonChain.GetContext(), packet, ack)
+			},
+			100,
+		},
+		{
+			"timeout - outflow undone",
+			func(packet channeltypes.Packet) {
+				suite.app.RateLimitKeeper.TimeoutPacket(suite.AnrytonChain.GetContext(), packet)
+			},
+			0,
+		},
+		{
+			"timeout of a packet sent in an earlier hour of the window - outflow undone",
+			func(packet channeltypes.Packet) {
+				suite.coordinator.IncrementTimeBy(time.Hour)
+				suite.app.RateLimitKeeper.TimeoutPacket(suite.AnrytonChain.GetContext(), packet)
+			},
+			0,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			suite.addRateLimit(testDenom, 10, 10, 24)
+
+			packet, err := suite.sendFromAnryton(100, suite.osmosisReceiver(), defaultTimeout())
+			suite.Require().NoError(err)
+
+			ctx := suite.AnrytonChain.GetContext()
+			pending, found := suite.app.RateLimitKeeper.GetPendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+			suite.Require().True(found)
+			suite.Require().Equal(suite.rateLimit(testDenom).CurrentBucketStart(), pending.BucketStart)
+
+			tc.undo(packet)
+			suite.coordinator.CommitBlock(suite.AnrytonChain)
+
+			ctx = suite.AnrytonChain.GetContext()
+			_, found = suite.app.RateLimitKeeper.GetPendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+			suite.Require().False(found)
+			suite.Require().Equal(sdk.NewInt(tc.expOutflow), suite.rateLimit(testDenom).Flow.Outflow)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUndoSendPacketOutOfWindow() {
+	suite.SetupTest()
+	suite.addRateLimit(testDenom, 10, 10, 1)
+
+	packet, err := suite.sendFromAnryton(100, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().NoError(err)
+
+	// the hour of the outflow falls out of the window before the timeout, the
+	// refund must not be deducted from the outflows of the new window
+	suite.coordinator.IncrementTimeBy(time.Hour)
+	_, err = suite.sendFromAnryton(100, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().NoError(err)
+
+	suite.app.RateLimitKeeper.TimeoutPacket(suite.AnrytonChain.GetContext(), packet)
+	suite.coordinator.CommitBlock(suite.AnrytonChain)
+	suite.Require().Equal(sdk.NewInt(100), suite.rateLimit(testDenom).Flow.Outflow)
+}
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	suite.SetupTest()
+	suite.addRateLimit(testDenom, 10, 10, 24)
+
+	packet, err := suite.sendFromAnryton(50, suite.osmosisReceiver(), defaultTimeout())
+	suite.Require().NoError(err)
+
+	ctx := suite.AnrytonChain.GetContext()
+	genesis := ratelimit.ExportGenesis(ctx, suite.app.RateLimitKeeper)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Len(genesis.RateLimits, 1)
+	suite.Require().Equal(sdk.NewInt(50), genesis.RateLimits[0].Flow.Outflow)
+	suite.Require().Len(genesis.PendingSendPackets, 1)
+	suite.Require().Equal(packet.Sequence, genesis.PendingSendPackets[0].Sequence)
+
+	// the pending packets are imported, so that a refund after a restart
+	// undoes the outflow
+	suite.app.RateLimitKeeper.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	suite.app.RateLimitKeeper.DeleteRateLimit(ctx, testDenom, packet.SourceChannel)
+	ratelimit.InitGenesis(ctx, suite.app.RateLimitKeeper, *genesis)
+	suite.Require().Equal(genesis, ratelimit.ExportGenesis(ctx, suite.app.RateLimitKeeper))
+
+	suite.app.RateLimitKeeper.TimeoutPacket(ctx, packet)
+	rateLimit, found := suite.app.RateLimitKeeper.GetRateLimit(ctx, testDenom, packet.SourceChannel)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+}
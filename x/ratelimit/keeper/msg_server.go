@@ -0,0 +1,129 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/anryton/anryton/v2/x/ratelimit/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// AddRateLimit implements the gRPC MsgServer interface. After a successful
+// governance vote it adds a rate limit on the transfers of a denom on a
+// channel, whose rolling window ends with the hour of the current block.
+func (k Keeper) AddRateLimit(goCtx context.Context, req *types.MsgAddRateLimit) (*types.MsgAddRateLimitResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, req.Denom, req.ChannelId); found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitAlreadyExists, "denom %s channel %s", req.Denom, req.ChannelId)
+	}
+
+	if err := k.checkChannel(ctx, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	rateLimit := k.ResetFlow(ctx, types.RateLimit{
+		Path:  types.Path{Denom: req.Denom, ChannelId: req.ChannelId},
+		Quota: types.NewQuota(req.MaxPercentSend, req.MaxPercentRecv, req.DurationHours),
+	})
+	if rateLimit.Flow.ChannelValue.IsZero() {
+		return nil, errorsmod.Wrapf(types.ErrZeroChannelValue, "denom %s has no supply", req.Denom)
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.emitRateLimitEvent(ctx, types.EventTypeAddRateLimit, rateLimit)
+
+	return &types.MsgAddRateLimitResponse{}, nil
+}
+
+// UpdateRateLimit implements the gRPC MsgServer interface. After a successful
+// governance vote it updates the quota of a rate limit and resets its flows.
+func (k Keeper) UpdateRateLimit(goCtx context.Context, req *types.MsgUpdateRateLimit) (*types.MsgUpdateRateLimitResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s channel %s", req.Denom, req.ChannelId)
+	}
+
+	rateLimit.Quota = types.NewQuota(req.MaxPercentSend, req.MaxPercentRecv, req.DurationHours)
+	rateLimit = k.ResetFlow(ctx, rateLimit)
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.emitRateLimitEvent(ctx, types.EventTypeUpdateRateLimit, rateLimit)
+
+	return &types.MsgUpdateRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit implements the gRPC MsgServer interface. After a successful
+// governance vote it removes a rate limit.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, req *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s channel %s", req.Denom, req.ChannelId)
+	}
+
+	k.DeleteRateLimit(ctx, req.Denom, req.ChannelId)
+	k.emitRateLimitEvent(ctx, types.EventTypeRemoveRateLimit, rateLimit)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit implements the gRPC MsgServer interface. After a successful
+// governance vote it resets the flows of a rate limit, starting a new window.
+func (k Keeper) ResetRateLimit(goCtx context.Context, req *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if err := k.checkAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, req.Denom, req.ChannelId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "denom %s channel %s", req.Denom, req.ChannelId)
+	}
+
+	rateLimit = k.ResetFlow(ctx, rateLimit)
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.emitRateLimitEvent(ctx, types.EventTypeResetRateLimit, rateLimit)
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// checkAuthority returns an error if the signer is not the governance account.
+func (k Keeper) checkAuthority(authority string) error {
+	if k.authority.String() != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+// emitRateLimitEvent emits the event of a rate limit message.
+func (k Keeper) emitRateLimitEvent(ctx sdk.Context, eventType string, rateLimit types.RateLimit) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentSend, rateLimit.Quota.MaxPercentSend.String()),
+			sdk.NewAttribute(types.AttributeKeyMaxPercentRecv, rateLimit.Quota.MaxPercentRecv.String()),
+			sdk.NewAttribute(types.AttributeKeyDurationHours, strconv.FormatUint(rateLimit.Quota.DurationHours, 10)),
+		),
+	)
+}
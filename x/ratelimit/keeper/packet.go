@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	capabilitytypes "github.com/cosmos/cosmos-sdk/x/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v7/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v7/modules/core/exported"
+
+	"github.com/anryton/anryton/v2/ibc"
+	"github.com/anryton/anryton/v2/x/ratelimit/types"
+)
+
+var _ porttypes.ICS4Wrapper = Keeper{}
+
+// SendPacket implements the ICS4Wrapper interface. It checks the outflow of
+// the sent ICS-20 packet against the rate limit of its denom and channel and
+// rejects the transfer if the send quota is exceeded.
+func (k Keeper) SendPacket(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &packetData); err != nil {
+		// not an ICS-20 packet, no rate limit applies
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	coin := ibc.GetSentCoin(packetData.Denom, packetData.Amount)
+
+	rateLimit, found := k.getCurrentRateLimit(ctx, coin.Denom, sourceChannel)
+	if !found {
+		return k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+
+	if err := rateLimit.AddOutflow(coin.Amount); err != nil {
+		k.emitQuotaExceededEvent(ctx, rateLimit, types.DirectionSend, coin.Amount)
+		return 0, err
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	k.SetPendingSendPacket(ctx, types.PendingSendPacket{
+		ChannelId:   sourceChannel,
+		Sequence:    sequence,
+		BucketStart: rateLimit.CurrentBucketStart(),
+	})
+
+	return sequence, nil
+}
+
+// WriteAcknowledgement implements the ICS4Wrapper interface.
+func (k Keeper) WriteAcknowledgement(
+	ctx sdk.Context,
+	chanCap *capabilitytypes.Capability,
+	packet exported.PacketI,
+	ack exported.Acknowledgement,
+) error {
+	return k.ics4Wrapper.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// GetAppVersion implements the ICS4Wrapper interface.
+func (k Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// ReceivePacket checks the inflow of the received ICS-20 packet against the
+// rate limit of its denom and channel. An error is returned if the recv quota
+// is exceeded.
+func (k Keeper) ReceivePacket(ctx sdk.Context, packet channeltypes.Packet) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		// not an ICS-20 packet, no rate limit applies
+		return nil
+	}
+
+	if _, ok := sdk.NewIntFromString(data.Amount); !ok {
+		// invalid amount, the packet is rejected by the transfer module
+		return nil
+	}
+
+	coin := ibc.GetReceivedCoin(
+		packet.SourcePort, packet.SourceChannel,
+		packet.DestinationPort, packet.DestinationChannel,
+		data.Denom, data.Amount,
+	)
+
+	rateLimit, found := k.getCurrentRateLimit(ctx, coin.Denom, packet.DestinationChannel)
+	if !found {
+		return nil
+	}
+
+	if err := rateLimit.AddInflow(coin.Amount); err != nil {
+		k.emitQuotaExceededEvent(ctx, rateLimit, types.DirectionRecv, coin.Amount)
+		return err
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+	return nil
+}
+
+// AcknowledgePacket undoes the outflow of a sent packet that failed on the
+// counterparty chain, if it was sent within the current window of the rate
+// limit.
+func (k Keeper) AcknowledgePacket(ctx sdk.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) {
+	if ack.Success() {
+		k.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+		return
+	}
+
+	k.undoSendPacket(ctx, packet)
+}
+
+// TimeoutPacket undoes the outflow of a sent packet that timed out, if it was
+// sent within the current window of the rate limit.
+func (k Keeper) TimeoutPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	k.undoSendPacket(ctx, packet)
+}
+
+// undoSendPacket removes the amount of the refunded packet from the outflow of
+// its rate limit, if the hour the packet was sent in is still in the window.
+func (k Keeper) undoSendPacket(ctx sdk.Context, packet channeltypes.Packet) {
+	pending, found := k.GetPendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return
+	}
+	k.DeletePendingSendPacket(ctx, packet.SourceChannel, packet.Sequence)
+
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return
+	}
+
+	coin := ibc.GetSentCoin(data.Denom, data.Amount)
+
+	rateLimit, found := k.getCurrentRateLimit(ctx, coin.Denom, packet.SourceChannel)
+	if !found || !rateLimit.UndoOutflow(pending.BucketStart, coin.Amount) {
+		return
+	}
+
+	k.SetRateLimit(ctx, rateLimit)
+}
+
+// emitQuotaExceededEvent emits the event of a transfer rejected by the rate
+// limit.
+func (k Keeper) emitQuotaExceededEvent(ctx sdk.Context, rateLimit types.RateLimit, direction string, amount sdk.Int) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeQuotaExceeded,
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Path.Denom),
+			sdk.NewAttribute(types.AttributeKeyChannel, rateLimit.Path.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+}
+
+// checkChannel returns an error if the transfer channel doesn't exist.
+func (k Keeper) checkChannel(ctx sdk.Context, channelID string) error {
+	if _, found := k.channelKeeper.GetChannel(ctx, transfertypes.PortID, channelID); !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", transfertypes.PortID, channelID)
+	}
+	return nil
+}
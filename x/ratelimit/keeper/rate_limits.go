@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/ratelimit/types"
+)
+
+// GetRateLimit returns the rate limit of a denom on a channel.
+func (k Keeper) GetRateLimit(ctx sdk.Context, denom, channelID string) (types.RateLimit, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	bz := store.Get(types.RateLimitKey(denom, channelID))
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+	return rateLimit, true
+}
+
+// SetRateLimit stores the rate limit.
+func (k Keeper) SetRateLimit(ctx sdk.Context, rateLimit types.RateLimit) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	key := types.RateLimitKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+	store.Set(key, k.cdc.MustMarshal(&rateLimit))
+}
+
+// DeleteRateLimit removes the rate limit of a denom on a channel.
+func (k Keeper) DeleteRateLimit(ctx sdk.Context, denom, channelID string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	store.Delete(types.RateLimitKey(denom, channelID))
+}
+
+// GetAllRateLimits returns all the rate limits.
+func (k Keeper) GetAllRateLimits(ctx sdk.Context) []types.RateLimit {
+	return k.getRateLimits(ctx, nil)
+}
+
+// GetRateLimitsByChannel returns the rate limits of a channel.
+func (k Keeper) GetRateLimitsByChannel(ctx sdk.Context, channelID string) []types.RateLimit {
+	return k.getRateLimits(ctx, types.RateLimitChannelPrefix(channelID))
+}
+
+// getRateLimits returns the rate limits with the given key prefix.
+func (k Keeper) getRateLimits(ctx sdk.Context, keyPrefix []byte) []types.RateLimit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRateLimit)
+	iterator := sdk.KVStorePrefixIterator(store, keyPrefix)
+	defer iterator.Close()
+
+	rateLimits := []types.RateLimit{}
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+		rateLimits = append(rateLimits, rateLimit)
+	}
+
+	return rateLimits
+}
+
+// ResetFlow starts a new window for the rate limit, whose channel value is
+// the current supply of the denom.
+func (k Keeper) ResetFlow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	channelValue := k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom).Amount
+	rateLimit.Flow = types.NewFlow(channelValue, rateLimit.WindowStart(ctx.BlockTime()))
+	rateLimit.Buckets = nil
+	return rateLimit
+}
+
+// getCurrentRateLimit returns the rate limit of a denom on a channel with the
+// flows of the current window.
+func (k Keeper) getCurrentRateLimit(ctx sdk.Context, denom, channelID string) (types.RateLimit, bool) {
+	rateLimit, found := k.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return types.RateLimit{}, false
+	}
+
+	return k.currentFlow(ctx, rateLimit), true
+}
+
+// currentFlow returns the rate limit with the flows of its current window.
+// The window rolls forward hourly, dropping the flows of the hours that fell
+// out of it, and the channel value is refreshed with the supply of the denom
+// every hour, or on every transfer while it is zero.
+func (k Keeper) currentFlow(ctx sdk.Context, rateLimit types.RateLimit) types.RateLimit {
+	if rateLimit.Advance(ctx.BlockTime()) || rateLimit.Flow.ChannelValue.IsZero() {
+		rateLimit.Flow.ChannelValue = k.bankKeeper.GetSupply(ctx, rateLimit.Path.Denom).Amount
+	}
+	return rateLimit
+}
+
+// SetPendingSendPacket stores the bucket of the outflow of a sent packet, so
+// that it can be undone if the packet fails within the window.
+func (k Keeper) SetPendingSendPacket(ctx sdk.Context, packet types.PendingSendPacket) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	store.Set(types.PendingSendPacketKey(packet.ChannelId, packet.Sequence), k.cdc.MustMarshal(&packet))
+}
+
+// GetPendingSendPacket returns the pending packet sent on a channel.
+func (k Keeper) GetPendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSendPacket, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	bz := store.Get(types.PendingSendPacketKey(channelID, sequence))
+	if len(bz) == 0 {
+		return types.PendingSendPacket{}, false
+	}
+
+	var packet types.PendingSendPacket
+	k.cdc.MustUnmarshal(bz, &packet)
+	return packet, true
+}
+
+// DeletePendingSendPacket removes the pending packet.
+func (k Keeper) DeletePendingSendPacket(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	store.Delete(types.PendingSendPacketKey(channelID, sequence))
+}
+
+// GetAllPendingSendPackets returns all the pending packets.
+func (k Keeper) GetAllPendingSendPackets(ctx sdk.Context) []types.PendingSendPacket {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPendingSendPacket)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	packets := []types.PendingSendPacket{}
+	for ; iterator.Valid(); iterator.Next() {
+		var packet types.PendingSendPacket
+		k.cdc.MustUnmarshal(iterator.Value(), &packet)
+		packets = append(packets, packet)
+	}
+
+	return packets
+}
@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	transfertypes "github.com/cosmos/ibc-go/v7/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v7/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v7/modules/core/04-channel/types"
+	ibcgotesting "github.com/cosmos/ibc-go/v7/testing"
+
+	"github.com/anryton/anryton/v2/app"
+	ibctesting "github.com/anryton/anryton/v2/ibc/testing"
+	"github.com/anryton/anryton/v2/utils"
+	"github.com/anryton/anryton/v2/x/ratelimit/types"
+)
+
+// testDenom is a native denom of Anryton whose whole supply is held by the
+// sender account, so that the quotas are percentages of a known amount.
+const (
+	testDenom  = "atest"
+	testSupply = 1000
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app *app.Anryton
+
+	coordinator *ibcgotesting.Coordinator
+
+	// testing chains used for convenience and readability
+	AnrytonChain    *ibcgotesting.TestChain
+	IBCOsmosisChain *ibcgotesting.TestChain
+
+	pathAnrytonOsmosis *ibctesting.Path
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	// initializes 2 test chains
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 1, 1)
+	suite.AnrytonChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(1))
+	suite.IBCOsmosisChain = suite.coordinator.GetChain(ibcgotesting.GetChainID(2))
+	suite.coordinator.CommitNBlocks(suite.AnrytonChain, 2)
+	suite.coordinator.CommitNBlocks(suite.IBCOsmosisChain, 2)
+
+	suite.app = suite.AnrytonChain.App.(*app.Anryton)
+	ctx := suite.AnrytonChain.GetContext()
+	evmParams := suite.app.EvmKeeper.GetParams(ctx)
+	evmParams.EvmDenom = utils.BaseDenom
+	err := suite.app.EvmKeeper.SetParams(ctx, evmParams)
+	suite.Require().NoError(err)
+
+	// Set block proposer once, so its carried over on the ibc-go-testing suite
+	validators := suite.app.StakingKeeper.GetValidators(ctx, 2)
+	cons, err := validators[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.AnrytonChain.CurrentHeader.ProposerAddress = cons.Bytes()
+
+	err = suite.app.StakingKeeper.SetValidatorByConsAddr(ctx, validators[0])
+	suite.Require().NoError(err)
+
+	// Mint the whole supply of the test denom to the Anryton sender, and coins
+	// for the IBC tx fees
+	amt, ok := sdk.NewIntFromString("1000000000000000000000")
+	suite.Require().True(ok)
+	coins := sdk.NewCoins(sdk.NewCoin(testDenom, sdk.NewInt(testSupply)), sdk.NewCoin(utils.BaseDenom, amt))
+	err = suite.app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = suite.app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, suite.AnrytonChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	// Mint coins on the osmosis side which are sent to Anryton, and for the
+	// IBC tx fees
+	coins = sdk.NewCoins(sdk.NewCoin("uosmo", sdk.NewInt(testSupply)), sdk.NewCoin(sdk.DefaultBondDenom, amt))
+	osmosisApp := suite.IBCOsmosisChain.GetSimApp()
+	err = osmosisApp.BankKeeper.MintCoins(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, coins)
+	suite.Require().NoError(err)
+	err = osmosisApp.BankKeeper.SendCoinsFromModuleToAccount(suite.IBCOsmosisChain.GetContext(), minttypes.ModuleName, suite.IBCOsmosisChain.SenderAccount.GetAddress(), coins)
+	suite.Require().NoError(err)
+
+	suite.pathAnrytonOsmosis = ibctesting.NewTransferPath(suite.AnrytonChain, suite.IBCOsmosisChain) // clientID, connectionID, channelID empty
+	ibctesting.SetupPath(suite.coordinator, suite.pathAnrytonOsmosis)                                // clientID, connectionID, channelID filled
+}
+
+// anrytonEndpoint returns the endpoint of the transfer channel on the Anryton
+// chain.
+func (suite *KeeperTestSuite) anrytonEndpoint() *ibctesting.Endpoint {
+	return suite.pathAnrytonOsmosis.EndpointA
+}
+
+// osmosisEndpoint returns the endpoint of the transfer channel on the osmosis
+// chain.
+func (suite *KeeperTestSuite) osmosisEndpoint() *ibctesting.Endpoint {
+	return suite.pathAnrytonOsmosis.EndpointB
+}
+
+// authority returns the governance account allowed to manage the rate limits.
+func (suite *KeeperTestSuite) authority() string {
+	return authtypes.NewModuleAddress(govtypes.ModuleName).String()
+}
+
+// addRateLimit adds a rate limit of the denom on the Anryton transfer channel.
+func (suite *KeeperTestSuite) addRateLimit(denom string, maxPercentSend, maxPercentRecv int64, durationHours uint64) {
+	ctx := suite.AnrytonChain.GetContext()
+	_, err := suite.app.RateLimitKeeper.AddRateLimit(sdk.WrapSDKContext(ctx), &types.MsgAddRateLimit{
+		Authority:      suite.authority(),
+		Denom:          denom,
+		ChannelId:      suite.anrytonEndpoint().ChannelID,
+		MaxPercentSend: sdk.NewInt(maxPercentSend),
+		MaxPercentRecv: sdk.NewInt(maxPercentRecv),
+		DurationHours:  durationHours,
+	})
+	suite.Require().NoError(err)
+	suite.coordinator.CommitBlock(suite.AnrytonChain)
+}
+
+// rateLimit returns the rate limit of the denom on the Anryton transfer
+// channel with the flows of its current window.
+func (suite *KeeperTestSuite) rateLimit(denom string) types.RateLimit {
+	res, err := suite.app.RateLimitKeeper.RateLimit(sdk.WrapSDKContext(suite.AnrytonChain.GetContext()), &types.QueryRateLimitRequest{
+		Denom:     denom,
+		ChannelId: suite.anrytonEndpoint().ChannelID,
+	})
+	suite.Require().NoError(err)
+	return res.RateLimit
+}
+
+// sendFromAnryton sends the test denom from Anryton to the given osmosis
+// receiver and returns the sent packet.
+func (suite *KeeperTestSuite) sendFromAnryton(amount int64, receiver string, timeoutHeight clienttypes.Height) (channeltypes.Packet, error) {
+	sender := suite.AnrytonChain.SenderAccount.GetAddress().String()
+	msg := transfertypes.NewMsgTransfer(
+		suite.anrytonEndpoint().ChannelConfig.PortID, suite.anrytonEndpoint().ChannelID,
+		sdk.NewCoin(testDenom, sdk.NewInt(amount)),
+		sender, receiver, timeoutHeight, 0, "",
+	)
+
+	// discard the escrow of a rejected transfer
+	ctx, write := suite.AnrytonChain.GetContext().CacheContext()
+	res, err := suite.app.TransferKeeper.Transfer(sdk.WrapSDKContext(ctx), msg)
+	if err != nil {
+		return channeltypes.Packet{}, err
+	}
+	write()
+	suite.coordinator.CommitBlock(suite.AnrytonChain)
+
+	data := transfertypes.NewFungibleTokenPacketData(testDenom, sdk.NewInt(amount).String(), sender, receiver, "")
+	packet := channeltypes.NewPacket(
+		data.GetBytes(), res.Sequence,
+		suite.anrytonEndpoint().ChannelConfig.PortID, suite.anrytonEndpoint().ChannelID,
+		suite.osmosisEndpoint().ChannelConfig.PortID, suite.osmosisEndpoint().ChannelID,
+		timeoutHeight, 0,
+	)
+	return packet, nil
+}
+
+// sendFromOsmosis sends the uosmo coins from osmosis to Anryton and returns
+// the sent packet.
+func (suite *KeeperTestSuite) sendFromOsmosis(amount int64) channeltypes.Packet {
+	msg := transfertypes.NewMsgTransfer(
+		suite.osmosisEndpoint().ChannelConfig.PortID, suite.osmosisEndpoint().ChannelID,
+		sdk.NewCoin("uosmo", sdk.NewInt(amount)),
+		suite.IBCOsmosisChain.SenderAccount.GetAddress().String(),
+		suite.AnrytonChain.SenderAccount.GetAddress().String(),
+		clienttypes.NewHeight(1000, 1000), 0, "",
+	)
+	res, err := ibctesting.SendMsgs(suite.IBCOsmosisChain, ibctesting.DefaultFeeAmt, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibcgotesting.ParsePacketFromEvents(res.GetEvents())
+	suite.Require().NoError(err)
+	return packet
+}
+
+// osmosisReceiver returns the receiver of the transfers to osmosis.
+func (suite *KeeperTestSuite) osmosisReceiver() string {
+	return suite.IBCOsmosisChain.SenderAccount.GetAddress().String()
+}
+
+// defaultTimeout returns a timeout height that is never reached in the tests.
+func defaultTimeout() clienttypes.Height {
+	return clienttypes.NewHeight(1000, 1000)
+}
@@ -0,0 +1,15 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrRateLimitNotFound      = errorsmod.Register(ModuleName, 2, "rate limit not found")
+	ErrRateLimitAlreadyExists = errorsmod.Register(ModuleName, 3, "rate limit already exists")
+	ErrInvalidQuota           = errorsmod.Register(ModuleName, 4, "invalid rate limit quota")
+	ErrQuotaExceeded          = errorsmod.Register(ModuleName, 5, "rate limit quota exceeded")
+	ErrZeroChannelValue       = errorsmod.Register(ModuleName, 6, "channel value is zero")
+	ErrChannelNotFound        = errorsmod.Register(ModuleName, 7, "channel not found")
+)
@@ -0,0 +1,21 @@
+package types
+
+// ratelimit events
+const (
+	EventTypeAddRateLimit    = "add_rate_limit"
+	EventTypeUpdateRateLimit = "update_rate_limit"
+	EventTypeRemoveRateLimit = "remove_rate_limit"
+	EventTypeResetRateLimit  = "reset_rate_limit"
+	EventTypeQuotaExceeded   = "rate_limit_quota_exceeded"
+
+	AttributeKeyDenom          = "denom"
+	AttributeKeyChannel        = "channel"
+	AttributeKeyDirection      = "direction"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyMaxPercentSend = "max_percent_send"
+	AttributeKeyMaxPercentRecv = "max_percent_recv"
+	AttributeKeyDurationHours  = "duration_hours"
+
+	DirectionSend = "send"
+	DirectionRecv = "recv"
+)
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewGenesisState creates a new genesis state instance
+func NewGenesisState(rateLimits []RateLimit, pendingSendPackets []PendingSendPacket) *GenesisState {
+	return &GenesisState{
+		RateLimits:         rateLimits,
+		PendingSendPackets: pendingSendPackets,
+	}
+}
+
+// DefaultGenesisState returns the default ratelimit genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]RateLimit{}, []PendingSendPacket{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, rateLimit := range gs.RateLimits {
+		key := string(RateLimitKey(rateLimit.Path.Denom, rateLimit.Path.ChannelId))
+		if seen[key] {
+			return fmt.Errorf("duplicated rate limit for denom %s on channel %s", rateLimit.Path.Denom, rateLimit.Path.ChannelId)
+		}
+		if err := rateLimit.Validate(); err != nil {
+			return err
+		}
+		seen[key] = true
+	}
+
+	seenPackets := make(map[string]bool)
+	for _, packet := range gs.PendingSendPackets {
+		if err := host.ChannelIdentifierValidator(packet.ChannelId); err != nil {
+			return err
+		}
+		key := string(PendingSendPacketKey(packet.ChannelId, packet.Sequence))
+		if seenPackets[key] {
+			return fmt.Errorf("duplicated pending packet %d on channel %s", packet.Sequence, packet.ChannelId)
+		}
+		seenPackets[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// constants
+const (
+	// module name
+	ModuleName = "ratelimit"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the ratelimit persistent store
+const (
+	prefixRateLimit = iota + 1
+	prefixPendingSendPacket
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixRateLimit         = []byte{prefixRateLimit}
+	KeyPrefixPendingSendPacket = []byte{prefixPendingSendPacket}
+)
+
+// RateLimitChannelPrefix returns the key prefix of the rate limits of a
+// channel.
+func RateLimitChannelPrefix(channelID string) []byte {
+	return []byte(channelID + "/")
+}
+
+// RateLimitKey returns the key of the rate limit of a denom on a channel.
+func RateLimitKey(denom, channelID string) []byte {
+	return append(RateLimitChannelPrefix(channelID), []byte(denom)...)
+}
+
+// PendingSendPacketKey returns the key of a packet sent on a channel whose
+// outflow is pending until it is acknowledged or times out.
+func PendingSendPacketKey(channelID string, sequence uint64) []byte {
+	return append(RateLimitChannelPrefix(channelID), sdk.Uint64ToBigEndian(sequence)...)
+}
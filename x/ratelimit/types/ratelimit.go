@@ -0,0 +1,212 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v7/modules/core/24-host"
+)
+
+// NewQuota returns a new Quota instance.
+func NewQuota(maxPercentSend, maxPercentRecv sdk.Int, durationHours uint64) Quota {
+	return Quota{
+		MaxPercentSend: maxPercentSend,
+		MaxPercentRecv: maxPercentRecv,
+		DurationHours:  durationHours,
+	}
+}
+
+// Validate checks that the percentages are within [0, 100], at least one of
+// them is set and the window duration is not zero.
+func (q Quota) Validate() error {
+	hundred := sdk.NewInt(100)
+
+	if q.MaxPercentSend.IsNil() || q.MaxPercentSend.IsNegative() || q.MaxPercentSend.GT(hundred) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "max percent send must be between 0 and 100: %s", q.MaxPercentSend)
+	}
+	if q.MaxPercentRecv.IsNil() || q.MaxPercentRecv.IsNegative() || q.MaxPercentRecv.GT(hundred) {
+		return errorsmod.Wrapf(ErrInvalidQuota, "max percent recv must be between 0 and 100: %s", q.MaxPercentRecv)
+	}
+	if q.MaxPercentSend.IsZero() && q.MaxPercentRecv.IsZero() {
+		return errorsmod.Wrap(ErrInvalidQuota, "max percent send and recv cannot both be zero")
+	}
+	if q.DurationHours == 0 {
+		return errorsmod.Wrap(ErrInvalidQuota, "duration hours cannot be zero")
+	}
+	return nil
+}
+
+// Duration returns the duration of the quota window.
+func (q Quota) Duration() time.Duration {
+	return time.Duration(q.DurationHours) * time.Hour
+}
+
+// Validate checks the denom and channel of the path.
+func (p Path) Validate() error {
+	if err := sdk.ValidateDenom(p.Denom); err != nil {
+		return err
+	}
+	return host.ChannelIdentifierValidator(p.ChannelId)
+}
+
+// NewFlow returns the flow of a new window starting at the given time with
+// the given channel value.
+func NewFlow(channelValue sdk.Int, windowStart time.Time) Flow {
+	return Flow{
+		Inflow:       sdk.ZeroInt(),
+		Outflow:      sdk.ZeroInt(),
+		ChannelValue: channelValue,
+		WindowStart:  windowStart,
+	}
+}
+
+// Validate checks the flow amounts.
+func (f Flow) Validate() error {
+	for _, amount := range []sdk.Int{f.Inflow, f.Outflow, f.ChannelValue} {
+		if amount.IsNil() || amount.IsNegative() {
+			return errorsmod.Wrapf(ErrInvalidQuota, "invalid flow amount %s", amount)
+		}
+	}
+	return nil
+}
+
+// BucketDuration is the duration of the hourly buckets of the rolling window.
+const BucketDuration = time.Hour
+
+// BucketStart returns the start time of the hourly bucket of the given block
+// time.
+func BucketStart(blockTime time.Time) time.Time {
+	return blockTime.UTC().Truncate(BucketDuration)
+}
+
+// WindowStart returns the start time of the rolling window ending with the
+// hourly bucket of the given block time.
+func (r RateLimit) WindowStart(blockTime time.Time) time.Time {
+	return BucketStart(blockTime).Add(BucketDuration - r.Quota.Duration())
+}
+
+// Advance rolls the window of the rate limit forward to the given block time.
+// The buckets that fell out of the window are dropped and the flows are
+// recomputed from the remaining ones. It returns true if the window moved, in
+// which case the channel value must be refreshed.
+func (r *RateLimit) Advance(blockTime time.Time) bool {
+	windowStart := r.WindowStart(blockTime)
+	if !r.Flow.WindowStart.Before(windowStart) {
+		return false
+	}
+
+	buckets := make([]FlowBucket, 0, len(r.Buckets))
+	inflow, outflow := sdk.ZeroInt(), sdk.ZeroInt()
+	for _, bucket := range r.Buckets {
+		if bucket.Start.Before(windowStart) {
+			continue
+		}
+		buckets = append(buckets, bucket)
+		inflow = inflow.Add(bucket.Inflow)
+		outflow = outflow.Add(bucket.Outflow)
+	}
+
+	r.Buckets = buckets
+	r.Flow.Inflow = inflow
+	r.Flow.Outflow = outflow
+	r.Flow.WindowStart = windowStart
+	return true
+}
+
+// CurrentBucketStart returns the start time of the last hour of the window,
+// where new flows are added.
+func (r RateLimit) CurrentBucketStart() time.Time {
+	return r.Flow.WindowStart.Add(r.Quota.Duration() - BucketDuration)
+}
+
+// currentBucket returns the bucket of the last hour of the window, appending
+// it if there were no flows in that hour yet.
+func (r *RateLimit) currentBucket() *FlowBucket {
+	start := r.CurrentBucketStart()
+	if n := len(r.Buckets); n > 0 && r.Buckets[n-1].Start.Equal(start) {
+		return &r.Buckets[n-1]
+	}
+
+	r.Buckets = append(r.Buckets, FlowBucket{Inflow: sdk.ZeroInt(), Outflow: sdk.ZeroInt(), Start: start})
+	return &r.Buckets[len(r.Buckets)-1]
+}
+
+// AddOutflow adds the amount to the outflow and checks that the net outflow
+// of the window doesn't exceed the send quota. The quota is not enforced while
+// the channel value is zero, as no percentage of it can be transferred.
+func (r *RateLimit) AddOutflow(amount sdk.Int) error {
+	outflow := r.Flow.Outflow.Add(amount)
+	threshold := r.Flow.ChannelValue.Mul(r.Quota.MaxPercentSend).QuoRaw(100)
+
+	if !r.Flow.ChannelValue.IsZero() && outflow.Sub(r.Flow.Inflow).GT(threshold) {
+		return errorsmod.Wrapf(
+			ErrQuotaExceeded, "outflow of %s%s on %s exceeds the send quota of %s",
+			amount, r.Path.Denom, r.Path.ChannelId, threshold,
+		)
+	}
+
+	r.Flow.Outflow = outflow
+	bucket := r.currentBucket()
+	bucket.Outflow = bucket.Outflow.Add(amount)
+	return nil
+}
+
+// AddInflow adds the amount to the inflow and checks that the net inflow of
+// the window doesn't exceed the recv quota. The quota is not enforced while
+// the channel value is zero, e.g. before the first transfer of an IBC denom.
+func (r *RateLimit) AddInflow(amount sdk.Int) error {
+	inflow := r.Flow.Inflow.Add(amount)
+	threshold := r.Flow.ChannelValue.Mul(r.Quota.MaxPercentRecv).QuoRaw(100)
+
+	if !r.Flow.ChannelValue.IsZero() && inflow.Sub(r.Flow.Outflow).GT(threshold) {
+		return errorsmod.Wrapf(
+			ErrQuotaExceeded, "inflow of %s%s on %s exceeds the recv quota of %s",
+			amount, r.Path.Denom, r.Path.ChannelId, threshold,
+		)
+	}
+
+	r.Flow.Inflow = inflow
+	bucket := r.currentBucket()
+	bucket.Inflow = bucket.Inflow.Add(amount)
+	return nil
+}
+
+// UndoOutflow removes the amount of a failed send from the outflow of the
+// bucket it was added to. It returns false if the bucket is no longer in the
+// window.
+func (r *RateLimit) UndoOutflow(bucketStart time.Time, amount sdk.Int) bool {
+	for i := range r.Buckets {
+		bucket := &r.Buckets[i]
+		if !bucket.Start.Equal(bucketStart) {
+			continue
+		}
+
+		undone := sdk.MinInt(bucket.Outflow, amount)
+		bucket.Outflow = bucket.Outflow.Sub(undone)
+		r.Flow.Outflow = r.Flow.Outflow.Sub(undone)
+		return true
+	}
+	return false
+}
+
+// Validate checks the path, quota and flow of the rate limit.
+func (r RateLimit) Validate() error {
+	if err := r.Path.Validate(); err != nil {
+		return err
+	}
+	if err := r.Quota.Validate(); err != nil {
+		return err
+	}
+	if err := r.Flow.Validate(); err != nil {
+		return err
+	}
+	for _, bucket := range r.Buckets {
+		for _, amount := range []sdk.Int{bucket.Inflow, bucket.Outflow} {
+			if amount.IsNil() || amount.IsNegative() {
+				return errorsmod.Wrapf(ErrInvalidQuota, "invalid bucket flow amount %s", amount)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuotaValidate(t *testing.T) {
+	testCases := []struct {
+		name      string
+		quota     Quota
+		expectErr bool
+	}{
+		{"valid", NewQuota(sdk.NewInt(10), sdk.NewInt(20), 24), false},
+		{"send only", NewQuota(sdk.NewInt(100), sdk.ZeroInt(), 1), false},
+		{"nil percent", Quota{MaxPercentRecv: sdk.NewInt(10), DurationHours: 1}, true},
+		{"negative percent", NewQuota(sdk.NewInt(-1), sdk.NewInt(10), 1), true},
+		{"percent above 100", NewQuota(sdk.NewInt(10), sdk.NewInt(101), 1), true},
+		{"zero percents", NewQuota(sdk.ZeroInt(), sdk.ZeroInt(), 1), true},
+		{"zero duration", NewQuota(sdk.NewInt(10), sdk.NewInt(10), 0), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.quota.Validate()
+			if tc.expectErr {
+				require.ErrorIs(t, err, ErrInvalidQuota)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRateLimitFlows(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	rateLimit := RateLimit{
+		Path:  Path{Denom: "aanryton", ChannelId: "channel-0"},
+		Quota: NewQuota(sdk.NewInt(10), sdk.NewInt(5), 24),
+	}
+	rateLimit.Flow = NewFlow(sdk.NewInt(1000), rateLimit.WindowStart(start))
+
+	// send quota is 100 and recv quota is 50
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(60)))
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(40)))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdk.NewInt(1)), ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(100), rateLimit.Flow.Outflow)
+
+	// the inflow is netted against the outflow
+	require.NoError(t, rateLimit.AddInflow(sdk.NewInt(150)))
+	require.ErrorIs(t, rateLimit.AddInflow(sdk.NewInt(1)), ErrQuotaExceeded)
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(100)))
+	require.Equal(t, sdk.NewInt(200), rateLimit.Flow.Outflow)
+
+	require.Len(t, rateLimit.Buckets, 1)
+	bucketStart := rateLimit.CurrentBucketStart()
+	require.Equal(t, BucketStart(start), bucketStart)
+
+	require.True(t, rateLimit.UndoOutflow(bucketStart, sdk.NewInt(50)))
+	require.Equal(t, sdk.NewInt(150), rateLimit.Flow.Outflow)
+	require.True(t, rateLimit.UndoOutflow(bucketStart, sdk.NewInt(500)))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.True(t, rateLimit.Buckets[0].Outflow.IsZero())
+	require.False(t, rateLimit.UndoOutflow(bucketStart.Add(-BucketDuration), sdk.NewInt(1)))
+}
+
+func TestRateLimitRollingWindow(t *testing.T) {
+	start := time.Unix(1_700_000_000, 0).UTC()
+	rateLimit := RateLimit{
+		Path:  Path{Denom: "aanryton", ChannelId: "channel-0"},
+		Quota: NewQuota(sdk.NewInt(10), sdk.NewInt(10), 3),
+	}
+	rateLimit.Flow = NewFlow(sdk.NewInt(1000), rateLimit.WindowStart(start))
+
+	require.False(t, rateLimit.Advance(start))
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(60)))
+
+	// the flows of the previous hours are kept in the window
+	require.True(t, rateLimit.Advance(start.Add(time.Hour)))
+	require.False(t, rateLimit.Advance(start.Add(time.Hour)))
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(40)))
+	require.True(t, rateLimit.Advance(start.Add(2*time.Hour)))
+	require.ErrorIs(t, rateLimit.AddOutflow(sdk.NewInt(1)), ErrQuotaExceeded)
+	require.Equal(t, sdk.NewInt(100), rateLimit.Flow.Outflow)
+
+	// the first hour falls out of the window
+	require.True(t, rateLimit.Advance(start.Add(3*time.Hour)))
+	require.Equal(t, sdk.NewInt(40), rateLimit.Flow.Outflow)
+	require.Len(t, rateLimit.Buckets, 1)
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(60)))
+
+	// all the hours fall out of the window
+	require.True(t, rateLimit.Advance(start.Add(10*time.Hour)))
+	require.True(t, rateLimit.Flow.Outflow.IsZero())
+	require.Empty(t, rateLimit.Buckets)
+	require.Equal(t, BucketStart(start.Add(8*time.Hour)), rateLimit.Flow.WindowStart)
+}
+
+func TestRateLimitZeroChannelValue(t *testing.T) {
+	rateLimit := RateLimit{
+		Path:  Path{Denom: "ibc/ATOM", ChannelId: "channel-0"},
+		Quota: NewQuota(sdk.NewInt(10), sdk.NewInt(10), 24),
+		Flow:  NewFlow(sdk.ZeroInt(), time.Time{}),
+	}
+
+	// the quota is not enforced until the denom has a supply
+	require.NoError(t, rateLimit.AddInflow(sdk.NewInt(1000)))
+	require.NoError(t, rateLimit.AddOutflow(sdk.NewInt(500)))
+
+	rateLimit.Flow.ChannelValue = sdk.NewInt(1000)
+	require.ErrorIs(t, rateLimit.AddInflow(sdk.NewInt(1000)), ErrQuotaExceeded)
+}
+
+func TestGenesisStateValidate(t *testing.T) {
+	rateLimit := RateLimit{
+		Path:  Path{Denom: "aanryton", ChannelId: "channel-0"},
+		Quota: NewQuota(sdk.NewInt(10), sdk.NewInt(5), 24),
+		Flow:  NewFlow(sdk.NewInt(1000), time.Time{}),
+	}
+	otherChannel := rateLimit
+	otherChannel.Path.ChannelId = "channel-1"
+	invalid := rateLimit
+	invalid.Path.ChannelId = "ch"
+	packet := PendingSendPacket{ChannelId: "channel-0", Sequence: 1}
+	otherPacket := PendingSendPacket{ChannelId: "channel-0", Sequence: 2}
+
+	testCases := []struct {
+		name      string
+		genState  *GenesisState
+		expectErr bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState([]RateLimit{rateLimit, otherChannel}, []PendingSendPacket{packet, otherPacket}), false},
+		{"duplicated", NewGenesisState([]RateLimit{rateLimit, rateLimit}, nil), true},
+		{"invalid channel", NewGenesisState([]RateLimit{invalid}, nil), true},
+		{"duplicated pending packet", NewGenesisState(nil, []PendingSendPacket{packet, packet}), true},
+		{"invalid pending packet channel", NewGenesisState(nil, []PendingSendPacket{{ChannelId: "ch", Sequence: 1}}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRateLimitKeys(t *testing.T) {
+	require.Equal(t, []byte("channel-1/aanryton"), RateLimitKey("aanryton", "channel-1"))
+	require.NotEqual(t, RateLimitChannelPrefix("channel-1"), RateLimitKey("x", "channel-10")[:len("channel-1/")])
+}
@@ -0,0 +1,181 @@
+package cli
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/anryton/anryton/v2/x/revenue/types"
+)
+
+// NewTxCmd returns a root CLI command handler for revenue transaction commands
+func NewTxCmd() *cobra.Command {
+	txCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "revenue subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	txCmd.AddCommand(
+		NewRegisterRevenueCmd(),
+		NewRegisterWasmRevenueCmd(),
+		NewUpdateRevenueCmd(),
+		NewCancelRevenueCmd(),
+	)
+	return txCmd
+}
+
+// NewRegisterRevenueCmd returns a CLI command handler for registering an EVM
+// contract for fee distribution
+func NewRegisterRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register CONTRACT_HEX NONCES [WITHDRAWER_BECH32]",
+		Short: "Register an EVM contract for fee distribution. Only the contract deployer can register it.",
+		Long:  "Register an EVM contract for fee distribution. NONCES is the comma separated chain of deployment nonces from the deployer to the contract. When the withdrawer [optional] is omitted, the fees are sent to the deployer.",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !common.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid contract hex address %s", args[0])
+			}
+			contract := common.HexToAddress(args[0])
+
+			var nonces []uint64
+			for _, nonce := range strings.Split(args[1], ",") {
+				n, err := strconv.ParseUint(strings.TrimSpace(nonce), 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid nonce %s: %w", nonce, err)
+				}
+				nonces = append(nonces, n)
+			}
+
+			var withdrawer sdk.AccAddress
+			if len(args) == 3 {
+				withdrawer, err = sdk.AccAddressFromBech32(args[2])
+				if err != nil {
+					return fmt.Errorf("invalid withdrawer address %w", err)
+				}
+			}
+
+			msg := types.NewMsgRegisterRevenue(contract, cliCtx.GetFromAddress(), withdrawer, nonces)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewRegisterWasmRevenueCmd returns a CLI command handler for registering a
+// CosmWasm contract for fee distribution
+func NewRegisterWasmRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-wasm CONTRACT_BECH32 [WITHDRAWER_BECH32]",
+		Short: "Register a CosmWasm contract for fee distribution. Only the contract admin can register it. When the withdrawer [optional] is omitted, the fees are sent to the admin.",
+		Args:  cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contract, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid contract address %w", err)
+			}
+
+			var withdrawer sdk.AccAddress
+			if len(args) == 2 {
+				withdrawer, err = sdk.AccAddressFromBech32(args[1])
+				if err != nil {
+					return fmt.Errorf("invalid withdrawer address %w", err)
+				}
+			}
+
+			msg := types.NewMsgRegisterWasmRevenue(contract, cliCtx.GetFromAddress(), withdrawer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewUpdateRevenueCmd returns a CLI command handler for updating the
+// withdrawer address of a registered contract
+func NewUpdateRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update CONTRACT_ADDRESS WITHDRAWER_BECH32",
+		Short: "Update the withdrawer address of a contract registered by the sender",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			withdrawer, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return fmt.Errorf("invalid withdrawer address %w", err)
+			}
+
+			msg := types.NewMsgUpdateRevenue(args[0], cliCtx.GetFromAddress(), withdrawer)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// NewCancelRevenueCmd returns a CLI command handler for cancelling the fee
+// distribution of a registered contract
+func NewCancelRevenueCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel CONTRACT_ADDRESS",
+		Short: "Cancel the fee distribution of a contract registered by the sender",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgCancelRevenue(args[0], cliCtx.GetFromAddress())
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,99 @@
+package keeper
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	"github.com/anryton/anryton/v2/x/revenue/types"
+)
+
+var _ evmtypes.EvmHooks = Hooks{}
+
+// Hooks wrapper struct for revenue keeper
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks return the wrapper hooks struct for the Keeper
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// PostTxProcessing is a wrapper for calling the EVM PostTxProcessing hook on
+// the module keeper
+func (h Hooks) PostTxProcessing(ctx sdk.Context, msg core.Message, receipt *ethtypes.Receipt) error {
+	return h.k.PostTxProcessing(ctx, msg, receipt)
+}
+
+// PostTxProcessing implements EvmHooks.PostTxProcessing. After each successful
+// interaction with a registered contract, the developer share of the fees is
+// transferred from the fee collector to the withdrawer address of the
+// contract.
+func (k Keeper) PostTxProcessing(
+	ctx sdk.Context,
+	msg core.Message,
+	receipt *ethtypes.Receipt,
+) error {
+	contract := msg.To()
+	if contract == nil {
+		return nil
+	}
+
+	// when the revenue module is disabled no error is returned to avoid
+	// reverting the tx and allow for other post processing txs to pass
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue || params.DeveloperShares.IsZero() {
+		return nil
+	}
+
+	revenue, found := k.GetRevenue(ctx, contract.Hex())
+	if !found {
+		return nil
+	}
+
+	// the gas covered by the gas quota of the sender generates no fee revenue
+	paidGas := receipt.GasUsed
+	if subsidizedGas := evmtypes.SubsidizedGas(ctx, receipt.TxHash); subsidizedGas < paidGas {
+		paidGas -= subsidizedGas
+	} else {
+		paidGas = 0
+	}
+
+	// the escrowed base fee doesn't reach the fee collector and generates no
+	// fee revenue
+	gasPrice := msg.GasPrice()
+	if baseFee := k.evmKeeper.GetEscrowedBaseFee(ctx); baseFee != nil {
+		gasPrice = new(big.Int).Sub(gasPrice, math.BigMin(baseFee, gasPrice))
+	}
+
+	txFee := new(big.Int).Mul(gasPrice, new(big.Int).SetUint64(paidGas))
+	developerFee := sdk.NewDecFromBigInt(txFee).Mul(params.DeveloperShares).TruncateInt()
+	if !developerFee.IsPositive() {
+		return nil
+	}
+
+	evmDenom := k.evmKeeper.GetParams(ctx).EvmDenom
+	fees := sdk.Coins{{Denom: evmDenom, Amount: developerFee}}
+
+	withdrawer := revenue.GetWithdrawerAddr()
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, withdrawer, fees); err != nil {
+		return errorsmod.Wrapf(types.ErrRevenueFeeDistribution, "fee collector account failed to distribute developer fees (%s) to withdraw address %s: %s", fees, withdrawer, err)
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDistributeDevRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, revenue.WithdrawerAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, developerFee.String()),
+		),
+	)
+
+	return nil
+}
@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	feemarkettypes "github.com/anryton/anryton/v2/x/feemarket/types"
+	"github.com/anryton/anryton/v2/x/revenue/types"
+)
+
+func (suite *KeeperTestSuite) TestRegisterRevenue() {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRegisterRevenue
+		expErr error
+	}{
+		{"valid", types.NewMsgRegisterRevenue(contract, deployer, nil, []uint64{1, 3}), nil},
+		{"wrong nonce", types.NewMsgRegisterRevenue(contract, deployer, nil, []uint64{1, 2}), types.ErrRevenueContractDeployerNotMatch},
+		{"not the deployer", types.NewMsgRegisterRevenue(contract, sdk.AccAddress([]byte("other_______________")), nil, []uint64{1, 3}), types.ErrRevenueContractDeployerNotMatch},
+		{"deployer is a contract", types.NewMsgRegisterRevenue(contract, withdrawer, nil, []uint64{3}), types.ErrRevenueDeployerIsNotEOA},
+		{"no contract code", types.NewMsgRegisterRevenue(crypto.CreateAddress(factory, 4), deployer, nil, []uint64{1, 4}), types.ErrRevenueNoContractDeployed},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := suite.keeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), tc.msg)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+
+			revenue, found := suite.keeper.GetRevenue(suite.ctx, contract.Hex())
+			suite.Require().True(found)
+			suite.Require().Equal(types.NewRevenue(contract.Hex(), deployer, deployer), revenue)
+			suite.Require().Equal([]string{contract.Hex()}, suite.keeper.GetContractsByDeployer(suite.ctx, deployer.String()))
+
+			_, err = suite.keeper.RegisterRevenue(sdk.WrapSDKContext(suite.ctx), tc.msg)
+			suite.Require().ErrorIs(err, types.ErrRevenueAlreadyRegistered)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRegisterWasmRevenue() {
+	suite.SetupTest()
+
+	_, err := suite.keeper.RegisterWasmRevenue(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterWasmRevenue(wasmContract, withdrawer, nil))
+	suite.Require().ErrorIs(err, types.ErrRevenueContractDeployerNotMatch)
+
+	_, err = suite.keeper.RegisterWasmRevenue(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterWasmRevenue(deployer, deployer, nil))
+	suite.Require().ErrorIs(err, types.ErrRevenueNoContractDeployed)
+
+	_, err = suite.keeper.RegisterWasmRevenue(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterWasmRevenue(wasmContract, deployer, withdrawer))
+	suite.Require().NoError(err)
+
+	revenue, found := suite.keeper.GetRevenue(suite.ctx, wasmContract.String())
+	suite.Require().True(found)
+	suite.Require().Equal(withdrawer.String(), revenue.WithdrawerAddress)
+
+	// the registration is disabled by governance
+	params := types.DefaultParams()
+	params.EnableRevenue = false
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	_, err = suite.keeper.RegisterWasmRevenue(sdk.WrapSDKContext(suite.ctx), types.NewMsgRegisterWasmRevenue(wasmContract, deployer, withdrawer))
+	suite.Require().ErrorIs(err, types.ErrRevenueDisabled)
+}
+
+func (suite *KeeperTestSuite) TestUpdateAndCancelRevenue() {
+	suite.SetupTest()
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	_, err := suite.keeper.UpdateRevenue(goCtx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, withdrawer))
+	suite.Require().ErrorIs(err, types.ErrRevenueNotFound)
+
+	_, err = suite.keeper.RegisterRevenue(goCtx, types.NewMsgRegisterRevenue(contract, deployer, nil, []uint64{1, 3}))
+	suite.Require().NoError(err)
+
+	// only the deployer can update the withdrawer, the contract address is
+	// not case sensitive
+	_, err = suite.keeper.UpdateRevenue(goCtx, types.NewMsgUpdateRevenue(contract.Hex(), withdrawer, withdrawer))
+	suite.Require().Error(err)
+	_, err = suite.keeper.UpdateRevenue(goCtx, types.NewMsgUpdateRevenue(contract.Hex(), deployer, deployer))
+	suite.Require().Error(err)
+	_, err = suite.keeper.UpdateRevenue(goCtx, types.NewMsgUpdateRevenue(common.Bytes2Hex(contract.Bytes()), deployer, withdrawer))
+	suite.Require().NoError(err)
+
+	revenue, found := suite.keeper.GetRevenue(suite.ctx, contract.Hex())
+	suite.Require().True(found)
+	suite.Require().Equal(withdrawer.String(), revenue.WithdrawerAddress)
+
+	_, err = suite.keeper.CancelRevenue(goCtx, types.NewMsgCancelRevenue(contract.Hex(), withdrawer))
+	suite.Require().Error(err)
+	_, err = suite.keeper.CancelRevenue(goCtx, types.NewMsgCancelRevenue(contract.Hex(), deployer))
+	suite.Require().NoError(err)
+
+	_, found = suite.keeper.GetRevenue(suite.ctx, contract.Hex())
+	suite.Require().False(found)
+	suite.Require().Empty(suite.keeper.GetContractsByDeployer(suite.ctx, deployer.String()))
+}
+
+func (suite *KeeperTestSuite) TestPostTxProcessing() {
+	suite.SetupTest()
+	suite.keeper.SetRevenue(suite.ctx, types.NewRevenue(contract.Hex(), deployer, withdrawer))
+
+	feeCollectorBalance := suite.balance(feeCollector)
+	from := common.BytesToAddress(deployer)
+	gasPrice := big.NewInt(10)
+	receipt := &ethtypes.Receipt{GasUsed: 21000}
+
+	// contract creations and calls to unregistered contracts are ignored
+	other := common.BytesToAddress([]byte("other"))
+	for _, to := range []*common.Address{nil, &other} {
+		msg := ethtypes.NewMessage(from, to, 0, nil, 50000, gasPrice, gasPrice, gasPrice, nil, nil, false)
+		suite.Require().NoError(suite.keeper.PostTxProcessing(suite.ctx, msg, receipt))
+		suite.Require().True(suite.balance(withdrawer).IsZero())
+	}
+
+	// half of the fees of the used gas are sent to the withdrawer
+	msg := ethtypes.NewMessage(from, &contract, 0, nil, 50000, gasPrice, gasPrice, gasPrice, nil, nil, false)
+	suite.Require().NoError(suite.keeper.PostTxProcessing(suite.ctx, msg, receipt))
+	suite.Require().Equal(sdk.NewInt(105000), suite.balance(withdrawer))
+	suite.Require().Equal(feeCollectorBalance.SubRaw(105000), suite.balance(feeCollector))
+
+	// the escrowed base fee generates no revenue
+	feeMarketParams := suite.app.FeeMarketKeeper.GetParams(suite.ctx)
+	feeMarketParams.NoBaseFee = false
+	feeMarketParams.BaseFee = sdk.NewInt(4)
+	feeMarketParams.BaseFeeDestination = feemarkettypes.BASE_FEE_DESTINATION_BURN
+	suite.Require().NoError(suite.app.FeeMarketKeeper.SetParams(suite.ctx, feeMarketParams))
+	suite.Require().NoError(suite.keeper.PostTxProcessing(suite.ctx, msg, receipt))
+	suite.Require().Equal(sdk.NewInt(168000), suite.balance(withdrawer))
+}
+
+func (suite *KeeperTestSuite) TestDistributeWasmFees() {
+	suite.SetupTest()
+	suite.keeper.SetRevenue(suite.ctx, types.NewRevenue(wasmContract.String(), deployer, withdrawer))
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1000))
+
+	// the share of the unregistered contract stays in the fee collector
+	err := suite.keeper.DistributeWasmFees(suite.ctx, fees, []sdk.AccAddress{wasmContract, deployer})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(250), suite.balance(withdrawer))
+
+	err = suite.keeper.DistributeWasmFees(suite.ctx, fees, []sdk.AccAddress{wasmContract})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(750), suite.balance(withdrawer))
+
+	// nothing is distributed when the module is disabled
+	params := types.DefaultParams()
+	params.EnableRevenue = false
+	suite.Require().NoError(suite.keeper.SetParams(suite.ctx, params))
+	suite.Require().NoError(suite.keeper.DistributeWasmFees(suite.ctx, fees, []sdk.AccAddress{wasmContract}))
+	suite.Require().Equal(sdk.NewInt(750), suite.balance(withdrawer))
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	suite.SetupTest()
+	params := types.NewParams(true, sdk.NewDecWithPrec(10, 2), 100)
+
+	_, err := suite.keeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{Authority: deployer.String(), Params: params})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = suite.keeper.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, suite.keeper.GetParams(suite.ctx))
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/anryton/anryton/v2/x/revenue/types"
+)
+
+var _ types.MsgServer = Keeper{}
+
+// RegisterRevenue implements the gRPC MsgServer interface. It registers an EVM
+// contract for fee distribution after verifying, with the chain of deployment
+// nonces, that the contract was deployed by the message signer.
+func (k Keeper) RegisterRevenue(goCtx context.Context, msg *types.MsgRegisterRevenue) (*types.MsgRegisterRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := common.HexToAddress(msg.ContractAddress)
+	if _, found := k.GetRevenue(ctx, contract.Hex()); found {
+		return nil, errorsmod.Wrapf(types.ErrRevenueAlreadyRegistered, "contract is already registered %s", contract)
+	}
+
+	deployer := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	deployerAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, common.BytesToAddress(deployer))
+	if deployerAccount != nil && deployerAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueDeployerIsNotEOA, "deployer cannot be a contract %s", msg.DeployerAddress)
+	}
+
+	// contract must already be deployed, to avoid spam registrations
+	contractAccount := k.evmKeeper.GetAccountWithoutBalance(ctx, contract)
+	if contractAccount == nil || !contractAccount.IsContract() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueNoContractDeployed, "no contract code found at address %s", msg.ContractAddress)
+	}
+
+	// the contract address is derived from the deployer address and the
+	// nonces of the factory contracts in between
+	derivedContract := common.BytesToAddress(deployer)
+	for _, nonce := range msg.Nonces {
+		ctx.GasMeter().ConsumeGas(params.AddrDerivationCostCreate, "revenue registration: address derivation CREATE opcode")
+		derivedContract = crypto.CreateAddress(derivedContract, nonce)
+	}
+
+	if contract != derivedContract {
+		return nil, errorsmod.Wrapf(types.ErrRevenueContractDeployerNotMatch, "not contract deployer or wrong nonce: expected %s instead of %s", derivedContract, msg.ContractAddress)
+	}
+
+	revenue := types.NewRevenue(contract.Hex(), deployer, withdrawerAddr(msg.WithdrawerAddress))
+	k.registerRevenue(ctx, revenue)
+
+	return &types.MsgRegisterRevenueResponse{}, nil
+}
+
+// RegisterWasmRevenue implements the gRPC MsgServer interface. It registers a
+// CosmWasm contract for fee distribution if the message signer is the admin
+// of the contract.
+func (k Keeper) RegisterWasmRevenue(goCtx context.Context, msg *types.MsgRegisterWasmRevenue) (*types.MsgRegisterWasmRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	contract := sdk.MustAccAddressFromBech32(msg.ContractAddress)
+	if _, found := k.GetRevenue(ctx, contract.String()); found {
+		return nil, errorsmod.Wrapf(types.ErrRevenueAlreadyRegistered, "contract is already registered %s", contract)
+	}
+
+	contractInfo := k.wasmKeeper.GetContractInfo(ctx, contract)
+	if contractInfo == nil {
+		return nil, errorsmod.Wrapf(types.ErrRevenueNoContractDeployed, "no wasm contract found at address %s", msg.ContractAddress)
+	}
+
+	deployer := sdk.MustAccAddressFromBech32(msg.DeployerAddress)
+	if contractInfo.Admin != deployer.String() {
+		return nil, errorsmod.Wrapf(types.ErrRevenueContractDeployerNotMatch, "%s is not the admin of the contract %s", msg.DeployerAddress, msg.ContractAddress)
+	}
+
+	revenue := types.NewRevenue(contract.String(), deployer, withdrawerAddr(msg.WithdrawerAddress))
+	k.registerRevenue(ctx, revenue)
+
+	return &types.MsgRegisterWasmRevenueResponse{}, nil
+}
+
+// UpdateRevenue implements the gRPC MsgServer interface. It updates the
+// withdrawer address of a contract registered by the message signer.
+func (k Keeper) UpdateRevenue(goCtx context.Context, msg *types.MsgUpdateRevenue) (*types.MsgUpdateRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := k.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	withdrawer := sdk.MustAccAddressFromBech32(msg.WithdrawerAddress)
+	if revenue.WithdrawerAddress == withdrawer.String() {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "withdrawer address is already set to %s", msg.WithdrawerAddress)
+	}
+
+	revenue.WithdrawerAddress = withdrawer.String()
+	k.SetRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeUpdateRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, revenue.WithdrawerAddress),
+		),
+	)
+
+	return &types.MsgUpdateRevenueResponse{}, nil
+}
+
+// CancelRevenue implements the gRPC MsgServer interface. It removes the fee
+// distribution of a contract registered by the message signer.
+func (k Keeper) CancelRevenue(goCtx context.Context, msg *types.MsgCancelRevenue) (*types.MsgCancelRevenueResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.GetParams(ctx).EnableRevenue {
+		return nil, types.ErrRevenueDisabled
+	}
+
+	revenue, err := k.getDeployerRevenue(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	k.DeleteRevenue(ctx, revenue)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCancelRevenue,
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.DeployerAddress),
+		),
+	)
+
+	return &types.MsgCancelRevenueResponse{}, nil
+}
+
+// UpdateParams implements the gRPC MsgServer interface. After a successful
+// governance vote it updates the parameters in the revenue module.
+func (k Keeper) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority.String() != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority.String(), req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.SetParams(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// registerRevenue stores a new revenue and emits its registration event.
+func (k Keeper) registerRevenue(ctx sdk.Context, revenue types.Revenue) {
+	k.SetRevenue(ctx, revenue)
+
+	k.Logger(ctx).Debug(
+		"registering contract for transaction fees",
+		"contract", revenue.ContractAddress,
+		"deployer", revenue.DeployerAddress,
+		"withdraw", revenue.WithdrawerAddress,
+	)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRegisterRevenue,
+			sdk.NewAttribute(sdk.AttributeKeySender, revenue.DeployerAddress),
+			sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+			sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, revenue.WithdrawerAddress),
+		),
+	)
+}
+
+// getDeployerRevenue returns the revenue of a contract if it was registered
+// by the deployer.
+func (k Keeper) getDeployerRevenue(ctx sdk.Context, contractAddress, deployerAddress string) (types.Revenue, error) {
+	contract, err := types.NormalizeContractAddress(contractAddress)
+	if err != nil {
+		return types.Revenue{}, errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	revenue, found := k.GetRevenue(ctx, contract)
+	if !found {
+		return types.Revenue{}, errorsmod.Wrapf(types.ErrRevenueNotFound, "contract %s is not registered", contract)
+	}
+
+	if revenue.DeployerAddress != deployerAddress {
+		return types.Revenue{}, errorsmod.Wrapf(errortypes.ErrUnauthorized, "%s is not the contract deployer", deployerAddress)
+	}
+
+	return revenue, nil
+}
+
+// withdrawerAddr returns the optional withdrawer address of a registration.
+func withdrawerAddr(withdrawerAddress string) sdk.AccAddress {
+	if withdrawerAddress == "" {
+		return nil
+	}
+	return sdk.MustAccAddressFromBech32(withdrawerAddress)
+}
@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/revenue/types"
+)
+
+// GetRevenue returns the revenue of a registered contract, indexed by its
+// normalized address.
+func (k Keeper) GetRevenue(ctx sdk.Context, contractAddress string) (types.Revenue, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	bz := store.Get([]byte(contractAddress))
+	if len(bz) == 0 {
+		return types.Revenue{}, false
+	}
+
+	var revenue types.Revenue
+	k.cdc.MustUnmarshal(bz, &revenue)
+	return revenue, true
+}
+
+// SetRevenue stores the revenue of a contract and indexes it by deployer.
+func (k Keeper) SetRevenue(ctx sdk.Context, revenue types.Revenue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	store.Set([]byte(revenue.ContractAddress), k.cdc.MustMarshal(&revenue))
+
+	deployerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployer(revenue.DeployerAddress))
+	deployerStore.Set([]byte(revenue.ContractAddress), []byte{1})
+}
+
+// DeleteRevenue removes the revenue of a contract and its deployer index.
+func (k Keeper) DeleteRevenue(ctx sdk.Context, revenue types.Revenue) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	store.Delete([]byte(revenue.ContractAddress))
+
+	deployerStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployer(revenue.DeployerAddress))
+	deployerStore.Delete([]byte(revenue.ContractAddress))
+}
+
+// GetRevenues returns all the registered revenues.
+func (k Keeper) GetRevenues(ctx sdk.Context) []types.Revenue {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixRevenue)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	revenues := []types.Revenue{}
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.Revenue
+		k.cdc.MustUnmarshal(iterator.Value(), &revenue)
+		revenues = append(revenues, revenue)
+	}
+	return revenues
+}
+
+// GetContractsByDeployer returns the addresses of the contracts registered by
+// a deployer.
+func (k Keeper) GetContractsByDeployer(ctx sdk.Context, deployerAddress string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetKeyPrefixDeployer(deployerAddress))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	contracts := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		contracts = append(contracts, string(iterator.Key()))
+	}
+	return contracts
+}
@@ -0,0 +1,88 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/anryton/anryton/v2/app"
+	"github.com/anryton/anryton/v2/testutil"
+	"github.com/anryton/anryton/v2/utils"
+	"github.com/anryton/anryton/v2/x/evm/statedb"
+	"github.com/anryton/anryton/v2/x/revenue/keeper"
+	"github.com/anryton/anryton/v2/x/revenue/types"
+	wasmtypes "github.com/anryton/anryton/v2/x/wasm/types"
+)
+
+var (
+	deployer     = sdk.AccAddress([]byte("deployer____________"))
+	withdrawer   = sdk.AccAddress([]byte("withdrawer__________"))
+	wasmContract = sdk.AccAddress([]byte("wasm_contract_address_padding_32"))
+	authority    = authtypes.NewModuleAddress(govtypes.ModuleName)
+	feeCollector = authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+
+	// the contract deployed by a factory deployed by the deployer
+	factory  = crypto.CreateAddress(common.BytesToAddress(deployer), 1)
+	contract = crypto.CreateAddress(factory, 3)
+)
+
+// Test helpers
+func (suite *KeeperTestSuite) DoSetupTest() {
+	checkTx := false
+
+	// init app
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(checkTx, nil, chainID)
+
+	// setup context
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, suite.consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, header)
+	suite.denom = suite.app.EvmKeeper.GetParams(suite.ctx).EvmDenom
+
+	// the deployer is an EOA while the contract and the withdrawer have code
+	code := []byte("code")
+	contractAccount := statedb.NewEmptyAccount()
+	contractAccount.CodeHash = crypto.Keccak256(code)
+	suite.app.EvmKeeper.SetCode(suite.ctx, contractAccount.CodeHash, code)
+	for addr, account := range map[common.Address]*statedb.Account{
+		contract:                          contractAccount,
+		common.BytesToAddress(deployer):   statedb.NewEmptyAccount(),
+		common.BytesToAddress(withdrawer): contractAccount,
+		crypto.CreateAddress(factory, 4):  statedb.NewEmptyAccount(),
+	} {
+		suite.Require().NoError(suite.app.EvmKeeper.SetAccount(suite.ctx, addr, *account))
+	}
+
+	err := testutil.FundModuleAccount(suite.ctx, suite.app.BankKeeper, authtypes.FeeCollectorName, sdk.NewCoins(sdk.NewInt64Coin(suite.denom, 1_000_000)))
+	suite.Require().NoError(err)
+
+	// the CosmWasm contract is served by a mock, as no CosmWasm code is
+	// stored on the test chain
+	suite.wasm = &mockWasmKeeper{contracts: map[string]*wasmtypes.ContractInfo{
+		wasmContract.String(): {CodeID: 1, Creator: withdrawer.String(), Admin: deployer.String()},
+	}}
+	suite.keeper = keeper.NewKeeper(
+		suite.app.GetKey(types.StoreKey), suite.app.AppCodec(), authority,
+		suite.app.BankKeeper, suite.app.EvmKeeper, suite.wasm, authtypes.FeeCollectorName,
+	)
+}
+
+// balance returns the EVM denom balance of the address
+func (suite *KeeperTestSuite) balance(addr sdk.AccAddress) sdk.Int {
+	return suite.app.BankKeeper.GetBalance(suite.ctx, addr, suite.denom).Amount
+}
+
+// mockWasmKeeper keeps the CosmWasm contracts
+type mockWasmKeeper struct {
+	contracts map[string]*wasmtypes.ContractInfo
+}
+
+func (w *mockWasmKeeper) GetContractInfo(_ sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return w.contracts[contractAddress.String()]
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/anryton/anryton/v2/x/revenue/types"
+)
+
+// DistributeWasmFees transfers the developer share of the fees of a Cosmos
+// transaction from the fee collector to the withdrawers of the registered
+// CosmWasm contracts it executes. The share is split evenly between the
+// executed contracts, the part of the unregistered contracts stays in the fee
+// collector.
+func (k Keeper) DistributeWasmFees(ctx sdk.Context, fees sdk.Coins, contracts []sdk.AccAddress) error {
+	if fees.IsZero() || len(contracts) == 0 {
+		return nil
+	}
+
+	params := k.GetParams(ctx)
+	if !params.EnableRevenue || params.DeveloperShares.IsZero() {
+		return nil
+	}
+
+	// the share of each executed contract
+	share := params.DeveloperShares.QuoInt64(int64(len(contracts)))
+	contractFees := sdk.Coins{}
+	for _, fee := range fees {
+		amount := sdk.NewDecFromInt(fee.Amount).Mul(share).TruncateInt()
+		if amount.IsPositive() {
+			contractFees = contractFees.Add(sdk.NewCoin(fee.Denom, amount))
+		}
+	}
+	if contractFees.IsZero() {
+		return nil
+	}
+
+	for _, contract := range contracts {
+		revenue, found := k.GetRevenue(ctx, contract.String())
+		if !found {
+			continue
+		}
+
+		withdrawer := revenue.GetWithdrawerAddr()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollectorName, withdrawer, contractFees); err != nil {
+			return errorsmod.Wrapf(types.ErrRevenueFeeDistribution, "fee collector account failed to distribute developer fees (%s) to withdraw address %s: %s", contractFees, withdrawer, err)
+		}
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDistributeDevRevenue,
+				sdk.NewAttribute(types.AttributeKeyContract, revenue.ContractAddress),
+				sdk.NewAttribute(types.AttributeKeyWithdrawerAddress, revenue.WithdrawerAddress),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, contractFees.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrRevenueDisabled                 = errorsmod.Register(ModuleName, 2, "revenue module is disabled by governance")
+	ErrRevenueAlreadyRegistered        = errorsmod.Register(ModuleName, 3, "revenue already exists for given contract")
+	ErrRevenueNotFound                 = errorsmod.Register(ModuleName, 4, "revenue not found for given contract")
+	ErrRevenueDeployerIsNotEOA         = errorsmod.Register(ModuleName, 5, "no revenue registration allowed with contract deployer")
+	ErrRevenueNoContractDeployed       = errorsmod.Register(ModuleName, 6, "no contract deployed")
+	ErrRevenueContractDeployerNotMatch = errorsmod.Register(ModuleName, 7, "contract deployer address does not match")
+	ErrRevenueFeeDistribution          = errorsmod.Register(ModuleName, 8, "failed to distribute the developer share of the fees")
+	ErrInvalidParams                   = errorsmod.Register(ModuleName, 9, "invalid revenue params")
+)
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state instance
+func NewGenesisState(params Params, revenues []Revenue) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		Revenues: revenues,
+	}
+}
+
+// DefaultGenesisState returns the default revenue genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Revenue{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, revenue := range gs.Revenues {
+		if seen[revenue.ContractAddress] {
+			return fmt.Errorf("duplicated revenue for contract %s", revenue.ContractAddress)
+		}
+		if err := revenue.Validate(); err != nil {
+			return err
+		}
+		seen[revenue.ContractAddress] = true
+	}
+
+	return gs.Params.Validate()
+}
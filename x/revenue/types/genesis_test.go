@@ -0,0 +1,70 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	deployer = sdk.AccAddress([]byte("deployer____________"))
+	contract = common.HexToAddress("0x5dCA2483280D9727c80b5518faC4556617fb1911")
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	revenue := NewRevenue(contract.Hex(), deployer, nil)
+
+	testCases := []struct {
+		name      string
+		genState  *GenesisState
+		expectErr bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState(DefaultParams(), []Revenue{revenue}), false},
+		{"valid wasm contract", NewGenesisState(DefaultParams(), []Revenue{NewRevenue(deployer.String(), deployer, nil)}), false},
+		{"duplicated revenue", NewGenesisState(DefaultParams(), []Revenue{revenue, revenue}), true},
+		{"not normalized contract", NewGenesisState(DefaultParams(), []Revenue{NewRevenue(common.Bytes2Hex(contract.Bytes()), deployer, nil)}), true},
+		{"invalid deployer", NewGenesisState(DefaultParams(), []Revenue{{ContractAddress: contract.Hex(), DeployerAddress: "deployer", WithdrawerAddress: deployer.String()}}), true},
+		{"invalid shares", NewGenesisState(NewParams(true, sdk.NewDec(2), 50), nil), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestNormalizeContractAddress(t *testing.T) {
+	testCases := []struct {
+		name      string
+		address   string
+		expected  string
+		expectErr bool
+	}{
+		{"checksummed hex", contract.Hex(), contract.Hex(), false},
+		{"lowercase hex", common.Bytes2Hex(contract.Bytes()), contract.Hex(), false},
+		{"bech32", deployer.String(), deployer.String(), false},
+		{"zero address", common.Address{}.Hex(), "", true},
+		{"invalid", "contract", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			address, err := NormalizeContractAddress(tc.address)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, address)
+		})
+	}
+}
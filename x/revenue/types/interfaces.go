@@ -0,0 +1,32 @@
+package types
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/anryton/anryton/v2/x/evm/statedb"
+	evmtypes "github.com/anryton/anryton/v2/x/evm/types"
+	wasmtypes "github.com/anryton/anryton/v2/x/wasm/types"
+)
+
+// BankKeeper defines the expected interface needed to distribute the fees
+// from the fee collector.
+type BankKeeper interface {
+	SendCoinsFromModuleToAccount(ctx sdk.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// EVMKeeper defines the expected EVM keeper interface used to verify the
+// contract deployers and to retrieve the EVM denom and escrowed base fee.
+type EVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+	GetAccountWithoutBalance(ctx sdk.Context, addr common.Address) *statedb.Account
+	GetEscrowedBaseFee(ctx sdk.Context) *big.Int
+}
+
+// WasmKeeper defines the expected CosmWasm keeper interface used to verify the
+// contract admins.
+type WasmKeeper interface {
+	GetContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo
+}
@@ -0,0 +1,33 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "revenue"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the revenue persistent store
+const (
+	prefixParams = iota + 1
+	prefixRevenue
+	prefixDeployer
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixParams   = []byte{prefixParams}
+	KeyPrefixRevenue  = []byte{prefixRevenue}
+	KeyPrefixDeployer = []byte{prefixDeployer}
+)
+
+// GetKeyPrefixDeployer returns the KVStore key prefix for the reverse mapping
+// of the deployer to its registered contracts
+func GetKeyPrefixDeployer(deployerAddress string) []byte {
+	return append(KeyPrefixDeployer, []byte(deployerAddress+"/")...)
+}
@@ -0,0 +1,208 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	_ sdk.Msg = &MsgRegisterRevenue{}
+	_ sdk.Msg = &MsgRegisterWasmRevenue{}
+	_ sdk.Msg = &MsgUpdateRevenue{}
+	_ sdk.Msg = &MsgCancelRevenue{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// MaxNonces is the maximum number of deployment nonces of a revenue
+// registration, which bounds the depth of the factory contracts.
+const MaxNonces = 20
+
+// NewMsgRegisterRevenue creates a new instance of MsgRegisterRevenue
+func NewMsgRegisterRevenue(
+	contract common.Address,
+	deployer,
+	withdrawer sdk.AccAddress,
+	nonces []uint64,
+) *MsgRegisterRevenue {
+	withdrawerAddress := ""
+	if len(withdrawer) > 0 {
+		withdrawerAddress = withdrawer.String()
+	}
+
+	return &MsgRegisterRevenue{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddress,
+		Nonces:            nonces,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgRegisterRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", m.DeployerAddress)
+	}
+
+	if !common.IsHexAddress(m.ContractAddress) || common.HexToAddress(m.ContractAddress) == (common.Address{}) {
+		return errorsmod.Wrapf(errortypes.ErrInvalidAddress, "invalid contract address %s", m.ContractAddress)
+	}
+
+	if err := validateWithdrawer(m.WithdrawerAddress); err != nil {
+		return err
+	}
+
+	if len(m.Nonces) < 1 {
+		return errorsmod.Wrap(errortypes.ErrInvalidRequest, "invalid nonces - empty array")
+	}
+	if len(m.Nonces) > MaxNonces {
+		return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "invalid nonces - array length must be less than or equal to %d", MaxNonces)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgRegisterRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgRegisterRevenue) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.DeployerAddress)}
+}
+
+// NewMsgRegisterWasmRevenue creates a new instance of MsgRegisterWasmRevenue
+func NewMsgRegisterWasmRevenue(contract, deployer, withdrawer sdk.AccAddress) *MsgRegisterWasmRevenue {
+	withdrawerAddress := ""
+	if len(withdrawer) > 0 {
+		withdrawerAddress = withdrawer.String()
+	}
+
+	return &MsgRegisterWasmRevenue{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawerAddress,
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgRegisterWasmRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", m.DeployerAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.ContractAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid contract address %s", m.ContractAddress)
+	}
+
+	return validateWithdrawer(m.WithdrawerAddress)
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgRegisterWasmRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgRegisterWasmRevenue) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.DeployerAddress)}
+}
+
+// NewMsgUpdateRevenue creates a new instance of MsgUpdateRevenue
+func NewMsgUpdateRevenue(contractAddress string, deployer, withdrawer sdk.AccAddress) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress:   contractAddress,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgUpdateRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", m.DeployerAddress)
+	}
+
+	if _, err := NormalizeContractAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	if _, err := sdk.AccAddressFromBech32(m.WithdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdrawer address %s", m.WithdrawerAddress)
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgUpdateRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgUpdateRevenue) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.DeployerAddress)}
+}
+
+// NewMsgCancelRevenue creates a new instance of MsgCancelRevenue
+func NewMsgCancelRevenue(contractAddress string, deployer sdk.AccAddress) *MsgCancelRevenue {
+	return &MsgCancelRevenue{
+		ContractAddress: contractAddress,
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// ValidateBasic runs stateless checks on the message
+func (m MsgCancelRevenue) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.DeployerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid deployer address %s", m.DeployerAddress)
+	}
+
+	if _, err := NormalizeContractAddress(m.ContractAddress); err != nil {
+		return errorsmod.Wrap(errortypes.ErrInvalidAddress, err.Error())
+	}
+
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (m *MsgCancelRevenue) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(m))
+}
+
+// GetSigners defines whose signature is required
+func (m MsgCancelRevenue) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(m.DeployerAddress)}
+}
+
+// GetSigners returns the expected signers for a MsgUpdateParams message.
+func (m *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	addr := sdk.MustAccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic does a sanity check of the provided data
+func (m *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return errorsmod.Wrap(err, "Invalid authority address")
+	}
+	return m.Params.Validate()
+}
+
+// GetSignBytes implements the LegacyMsg interface.
+func (m MsgUpdateParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(AminoCdc.MustMarshalJSON(&m))
+}
+
+// validateWithdrawer checks the optional withdrawer address
+func validateWithdrawer(withdrawerAddress string) error {
+	if withdrawerAddress == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(withdrawerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid withdrawer address %s", withdrawerAddress)
+	}
+	return nil
+}
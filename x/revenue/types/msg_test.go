@@ -0,0 +1,65 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgRegisterRevenueValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       *MsgRegisterRevenue
+		expectErr bool
+	}{
+		{"valid", NewMsgRegisterRevenue(contract, deployer, nil, []uint64{1}), false},
+		{"valid with withdrawer", NewMsgRegisterRevenue(contract, deployer, deployer, []uint64{1, 2}), false},
+		{"zero contract", NewMsgRegisterRevenue(common.Address{}, deployer, nil, []uint64{1}), true},
+		{"invalid deployer", &MsgRegisterRevenue{ContractAddress: contract.Hex(), DeployerAddress: "deployer", Nonces: []uint64{1}}, true},
+		{"invalid withdrawer", &MsgRegisterRevenue{ContractAddress: contract.Hex(), DeployerAddress: deployer.String(), WithdrawerAddress: "withdrawer", Nonces: []uint64{1}}, true},
+		{"no nonces", NewMsgRegisterRevenue(contract, deployer, nil, nil), true},
+		{"too many nonces", NewMsgRegisterRevenue(contract, deployer, nil, make([]uint64, MaxNonces+1)), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestMsgsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expectErr bool
+	}{
+		{"register wasm", NewMsgRegisterWasmRevenue(deployer, deployer, nil), false},
+		{"register wasm invalid contract", &MsgRegisterWasmRevenue{ContractAddress: contract.Hex(), DeployerAddress: deployer.String()}, true},
+		{"update", NewMsgUpdateRevenue(contract.Hex(), deployer, deployer), false},
+		{"update without withdrawer", &MsgUpdateRevenue{ContractAddress: contract.Hex(), DeployerAddress: deployer.String()}, true},
+		{"update invalid contract", NewMsgUpdateRevenue("contract", deployer, deployer), true},
+		{"cancel", NewMsgCancelRevenue(deployer.String(), deployer), false},
+		{"cancel invalid deployer", &MsgCancelRevenue{ContractAddress: contract.Hex(), DeployerAddress: "deployer"}, true},
+		{"update params", &MsgUpdateParams{Authority: deployer.String(), Params: DefaultParams()}, false},
+		{"update params invalid shares", &MsgUpdateParams{Authority: deployer.String(), Params: NewParams(true, sdk.NewDec(-1), 50)}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
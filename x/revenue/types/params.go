@@ -0,0 +1,44 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	// DefaultDeveloperShares is the default proportion of the fees distributed
+	// to the contract withdrawer
+	DefaultDeveloperShares = sdk.NewDecWithPrec(50, 2)
+	// DefaultAddrDerivationCostCreate is the default gas cost of deriving an
+	// address from a deployment nonce
+	DefaultAddrDerivationCostCreate = uint64(50)
+)
+
+// NewParams creates a new Params object
+func NewParams(
+	enableRevenue bool,
+	developerShares sdk.Dec,
+	addrDerivationCostCreate uint64,
+) Params {
+	return Params{
+		EnableRevenue:            enableRevenue,
+		DeveloperShares:          developerShares,
+		AddrDerivationCostCreate: addrDerivationCostCreate,
+	}
+}
+
+// DefaultParams returns the default revenue params
+func DefaultParams() Params {
+	return NewParams(true, DefaultDeveloperShares, DefaultAddrDerivationCostCreate)
+}
+
+// Validate performs a basic validation of the params
+func (p Params) Validate() error {
+	if p.DeveloperShares.IsNil() {
+		return errorsmod.Wrap(ErrInvalidParams, "developer shares cannot be nil")
+	}
+	if p.DeveloperShares.IsNegative() || p.DeveloperShares.GT(sdk.OneDec()) {
+		return errorsmod.Wrapf(ErrInvalidParams, "developer shares must be between 0 and 1, got %s", p.DeveloperShares)
+	}
+	return nil
+}
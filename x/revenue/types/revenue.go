@@ -0,0 +1,72 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewRevenue returns an instance of Revenue. The withdrawer defaults to the
+// deployer when empty.
+func NewRevenue(contractAddress string, deployer, withdrawer sdk.AccAddress) Revenue {
+	if len(withdrawer) == 0 {
+		withdrawer = deployer
+	}
+
+	return Revenue{
+		ContractAddress:   contractAddress,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// GetDeployerAddr returns the contract deployer address
+func (r Revenue) GetDeployerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.DeployerAddress)
+}
+
+// GetWithdrawerAddr returns the address that receives the fees
+func (r Revenue) GetWithdrawerAddr() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(r.WithdrawerAddress)
+}
+
+// Validate performs a stateless validation of a Revenue
+func (r Revenue) Validate() error {
+	contractAddress, err := NormalizeContractAddress(r.ContractAddress)
+	if err != nil {
+		return err
+	}
+	if contractAddress != r.ContractAddress {
+		return fmt.Errorf("contract address %s is not normalized, expected %s", r.ContractAddress, contractAddress)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.DeployerAddress); err != nil {
+		return fmt.Errorf("invalid deployer address %s: %w", r.DeployerAddress, err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(r.WithdrawerAddress); err != nil {
+		return fmt.Errorf("invalid withdrawer address %s: %w", r.WithdrawerAddress, err)
+	}
+
+	return nil
+}
+
+// NormalizeContractAddress returns the checksummed hex address of an EVM
+// contract or the bech32 address of a CosmWasm contract, which are used to
+// index the revenues.
+func NormalizeContractAddress(contractAddress string) (string, error) {
+	if common.IsHexAddress(contractAddress) {
+		address := common.HexToAddress(contractAddress)
+		if address == (common.Address{}) {
+			return "", fmt.Errorf("invalid contract address %s: zero address", contractAddress)
+		}
+		return address.Hex(), nil
+	}
+
+	address, err := sdk.AccAddressFromBech32(contractAddress)
+	if err != nil {
+		return "", fmt.Errorf("invalid contract address %s: must be a hex or a bech32 address", contractAddress)
+	}
+	return address.String(), nil
+}
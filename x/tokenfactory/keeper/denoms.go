@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/anryton/anryton/v2/x/tokenfactory/types"
+)
+
+// CreateFactoryDenom creates the factory/{creator}/{subdenom} denom with the
+// creator as admin, after charging the denom creation fee. The bank metadata
+// of the denom is set and its ERC20 representation is registered if the
+// erc20 module is enabled, in which case the ERC20 contract address is
+// returned.
+func (k Keeper) CreateFactoryDenom(ctx sdk.Context, creator sdk.AccAddress, subdenom string) (string, string, error) {
+	denom, err := types.GetTokenDenom(creator.String(), subdenom)
+	if err != nil {
+		return "", "", err
+	}
+
+	if _, found := k.GetAuthorityMetadata(ctx, denom); found {
+		return "", "", errorsmod.Wrapf(types.ErrDenomExists, "denom %s", denom)
+	}
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); found {
+		return "", "", errorsmod.Wrapf(types.ErrDenomExists, "denom metadata %s", denom)
+	}
+
+	if fee := k.GetParams(ctx).DenomCreationFee; !fee.IsZero() {
+		if err := k.distributionKeeper.FundCommunityPool(ctx, fee, creator); err != nil {
+			return "", "", errorsmod.Wrap(err, "failed to pay the denom creation fee")
+		}
+	}
+
+	metadata := banktypes.Metadata{
+		Description: "Token factory denom " + denom,
+		DenomUnits: []*banktypes.DenomUnit{
+			{
+				Denom:    denom,
+				Exponent: 0,
+			},
+		},
+		Base:    denom,
+		Display: denom,
+		Name:    subdenom,
+		Symbol:  subdenom,
+	}
+	k.bankKeeper.SetDenomMetaData(ctx, metadata)
+
+	k.SetAuthorityMetadata(ctx, denom, types.DenomAuthorityMetadata{Admin: creator.String()})
+	k.AddCreatorDenom(ctx, creator.String(), denom)
+
+	var erc20Address string
+	if k.erc20Keeper.IsERC20Enabled(ctx) {
+		pair, err := k.erc20Keeper.RegisterNewCoin(ctx, metadata)
+		if err != nil {
+			return "", "", errorsmod.Wrapf(types.ErrERC20Registration, "denom %s: %s", denom, err)
+		}
+		erc20Address = pair.Erc20Address
+	}
+
+	return denom, erc20Address, nil
+}
+
+// GetAuthorityMetadata returns the authority metadata of a token factory
+// denom.
+func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.DenomAuthorityMetadata, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomAuthorityMetadata)
+	bz := store.Get([]byte(denom))
+	if len(bz) == 0 {
+		return types.DenomAuthorityMetadata{}, false
+	}
+
+	var metadata types.DenomAuthorityMetadata
+	k.cdc.MustUnmarshal(bz, &metadata)
+	return metadata, true
+}
+
+// SetAuthorityMetadata stores the authority metadata of a token factory
+// denom.
+func (k Keeper) SetAuthorityMetadata(ctx sdk.Context, denom string, metadata types.DenomAuthorityMetadata) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomAuthorityMetadata)
+	store.Set([]byte(denom), k.cdc.MustMarshal(&metadata))
+}
+
+// GetDenomsFromCreator returns the denoms created by an address.
+func (k Keeper) GetDenomsFromCreator(ctx sdk.Context, creator string) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixCreatorDenoms, types.CreatorDenomsPrefix(creator)...))
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		denoms = append(denoms, string(iterator.Key()))
+	}
+	return denoms
+}
+
+// GetAllFactoryDenoms returns all the token factory denoms with their
+// authority metadata.
+func (k Keeper) GetAllFactoryDenoms(ctx sdk.Context) []types.GenesisDenom {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDenomAuthorityMetadata)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	denoms := []types.GenesisDenom{}
+	for ; iterator.Valid(); iterator.Next() {
+		var metadata types.DenomAuthorityMetadata
+		k.cdc.MustUnmarshal(iterator.Value(), &metadata)
+		denoms = append(denoms, types.GenesisDenom{
+			Denom:             string(iterator.Key()),
+			AuthorityMetadata: metadata,
+		})
+	}
+	return denoms
+}
+
+// AddCreatorDenom indexes a denom by its creator.
+func (k Keeper) AddCreatorDenom(ctx sdk.Context, creator, denom string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.KeyPrefixCreatorDenoms, types.CreatorDenomsPrefix(creator)...))
+	store.Set([]byte(denom), []byte{})
+}
+
+// checkAdmin returns an error if the address is not the admin of the denom.
+func (k Keeper) checkAdmin(ctx sdk.Context, denom string, address string) error {
+	metadata, found := k.GetAuthorityMetadata(ctx, denom)
+	if !found {
+		return errorsmod.Wrapf(types.ErrDenomNotFound, "denom %s", denom)
+	}
+	if metadata.Admin == "" || metadata.Admin != address {
+		return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of %s", address, denom)
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/anryton/anryton/v2/utils"
+	erc20types "github.com/anryton/anryton/v2/x/erc20/types"
+	"github.com/anryton/anryton/v2/x/tokenfactory/types"
+)
+
+func (suite *KeeperTestSuite) TestCreateDenom() {
+	suite.SetupTest()
+	k := suite.app.TokenFactoryKeeper
+	denom := "factory/" + creator.String() + "/bitcoin"
+	fee := types.DefaultDenomCreationFee.AmountOf(utils.BaseDenom)
+	communityPool := suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(utils.BaseDenom)
+
+	res, err := k.CreateDenom(sdk.WrapSDKContext(suite.ctx), types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	suite.Require().NoError(err)
+	suite.Require().Equal(denom, res.NewTokenDenom)
+
+	// the fee is paid to the community pool
+	suite.Require().Equal(
+		communityPool.Add(sdk.NewDecFromInt(fee)),
+		suite.app.DistrKeeper.GetFeePoolCommunityCoins(suite.ctx).AmountOf(utils.BaseDenom),
+	)
+	suite.Require().Equal(fee.QuoRaw(2), suite.app.BankKeeper.GetBalance(suite.ctx, creator, utils.BaseDenom).Amount)
+
+	// the metadata is set and the ERC20 representation registered
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().NoError(metadata.Validate())
+
+	pair, found := suite.app.Erc20Keeper.GetTokenPair(suite.ctx, suite.app.Erc20Keeper.GetTokenPairID(suite.ctx, denom))
+	suite.Require().True(found)
+	suite.Require().Equal(res.Erc20Address, pair.Erc20Address)
+	suite.Require().Equal(erc20types.OWNER_MODULE, pair.ContractOwner)
+
+	authorityMetadata, found := k.GetAuthorityMetadata(suite.ctx, denom)
+	suite.Require().True(found)
+	suite.Require().Equal(creator.String(), authorityMetadata.Admin)
+	suite.Require().Equal([]string{denom}, k.GetDenomsFromCreator(suite.ctx, creator.String()))
+
+	// the denom can't be created twice
+	_, err = k.CreateDenom(sdk.WrapSDKContext(suite.ctx), types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	suite.Require().ErrorIs(err, types.ErrDenomExists)
+
+	// the creator can't pay the fee
+	_, err = k.CreateDenom(sdk.WrapSDKContext(suite.ctx), types.NewMsgCreateDenom(creator.String(), "litecoin"))
+	suite.Require().Error(err)
+
+	// no ERC20 representation when the erc20 module is disabled
+	suite.Require().NoError(k.SetParams(suite.ctx, types.NewParams(sdk.Coins{})))
+	erc20Params := suite.app.Erc20Keeper.GetParams(suite.ctx)
+	erc20Params.EnableErc20 = false
+	suite.Require().NoError(suite.app.Erc20Keeper.SetParams(suite.ctx, erc20Params))
+
+	res, err = k.CreateDenom(sdk.WrapSDKContext(suite.ctx), types.NewMsgCreateDenom(creator.String(), "litecoin"))
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Erc20Address)
+	suite.Require().False(suite.app.Erc20Keeper.IsDenomRegistered(suite.ctx, res.NewTokenDenom))
+	suite.Require().Len(k.GetDenomsFromCreator(suite.ctx, creator.String()), 2)
+}
+
+func (suite *KeeperTestSuite) TestAdminMsgs() {
+	suite.SetupTest()
+	k := suite.app.TokenFactoryKeeper
+	goCtx := sdk.WrapSDKContext(suite.ctx)
+
+	res, err := k.CreateDenom(goCtx, types.NewMsgCreateDenom(creator.String(), "bitcoin"))
+	suite.Require().NoError(err)
+	denom := res.NewTokenDenom
+
+	// mint to the admin and to another address
+	_, err = k.Mint(goCtx, types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 100), ""))
+	suite.Require().NoError(err)
+	_, err = k.Mint(goCtx, types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 50), receiver.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(100), suite.app.BankKeeper.GetBalance(suite.ctx, creator, denom).Amount)
+	suite.Require().Equal(sdk.NewInt(50), suite.app.BankKeeper.GetBalance(suite.ctx, receiver, denom).Amount)
+	suite.Require().Equal(sdk.NewInt(150), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+
+	_, err = k.Mint(goCtx, types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 50), authtypes.NewModuleAddress(types.ModuleName).String()))
+	suite.Require().Error(err)
+
+	// only the admin can mint and burn
+	_, err = k.Mint(goCtx, types.NewMsgMint(receiver.String(), sdk.NewInt64Coin(denom, 100), ""))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = k.Burn(goCtx, types.NewMsgBurn(receiver.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+
+	_, err = k.Burn(goCtx, types.NewMsgBurn(creator.String(), sdk.NewInt64Coin(denom, 40)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewInt(60), suite.app.BankKeeper.GetBalance(suite.ctx, creator, denom).Amount)
+	suite.Require().Equal(sdk.NewInt(110), suite.app.BankKeeper.GetSupply(suite.ctx, denom).Amount)
+
+	// unknown denom
+	_, err = k.Mint(goCtx, types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom+"x", 100), ""))
+	suite.Require().ErrorIs(err, types.ErrDenomNotFound)
+
+	// set the metadata
+	metadata, found := suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().True(found)
+	metadata.Name = "Bitcoin"
+	_, err = k.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(receiver.String(), metadata))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = k.SetDenomMetadata(goCtx, types.NewMsgSetDenomMetadata(creator.String(), metadata))
+	suite.Require().NoError(err)
+	metadata, _ = suite.app.BankKeeper.GetDenomMetaData(suite.ctx, denom)
+	suite.Require().Equal("Bitcoin", metadata.Name)
+
+	// transfer the administration
+	_, err = k.ChangeAdmin(goCtx, types.NewMsgChangeAdmin(creator.String(), denom, receiver.String()))
+	suite.Require().NoError(err)
+	_, err = k.Mint(goCtx, types.NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 100), ""))
+	suite.Require().ErrorIs(err, types.ErrUnauthorized)
+	_, err = k.Burn(goCtx, types.NewMsgBurn(receiver.String(), sdk.NewInt64Coin(denom, 10)))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	suite.SetupTest()
+	k := suite.app.TokenFactoryKeeper
+	params := types.NewParams(sdk.NewCoins(sdk.NewInt64Coin(utils.BaseDenom, 1)))
+
+	_, err := k.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{Authority: creator.String(), Params: params})
+	suite.Require().ErrorIs(err, govtypes.ErrInvalidSigner)
+
+	_, err = k.UpdateParams(sdk.WrapSDKContext(suite.ctx), &types.MsgUpdateParams{Authority: authority.String(), Params: params})
+	suite.Require().NoError(err)
+	suite.Require().Equal(params, k.GetParams(suite.ctx))
+}
@@ -0,0 +1,46 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/anryton/anryton/v2/app"
+	"github.com/anryton/anryton/v2/testutil"
+	"github.com/anryton/anryton/v2/utils"
+	"github.com/anryton/anryton/v2/x/tokenfactory/types"
+)
+
+var (
+	creator   = sdk.AccAddress([]byte("creator_____________"))
+	receiver  = sdk.AccAddress([]byte("receiver____________"))
+	authority = authtypes.NewModuleAddress(govtypes.ModuleName)
+)
+
+// Test helpers
+func (suite *KeeperTestSuite) DoSetupTest() {
+	checkTx := false
+
+	// init app
+	chainID := utils.TestnetChainID + "-1"
+	suite.app = app.Setup(checkTx, nil, chainID)
+
+	// setup context
+	header := testutil.NewHeader(
+		1, time.Now().UTC(), chainID, suite.consAddress, nil, nil,
+	)
+	suite.ctx = suite.app.BaseApp.NewContext(checkTx, header)
+
+	// the genesis validator proposes the block, as the EVM coinbase
+	consAddress, err := suite.app.StakingKeeper.GetAllValidators(suite.ctx)[0].GetConsAddr()
+	suite.Require().NoError(err)
+	suite.consAddress = consAddress
+	suite.ctx = suite.ctx.WithProposer(consAddress)
+
+	// the creator can pay the denom creation fee once
+	fee := types.DefaultDenomCreationFee.AmountOf(utils.BaseDenom)
+	err = testutil.FundAccount(suite.ctx, suite.app.BankKeeper, creator, sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, fee.MulRaw(3).QuoRaw(2))))
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleDenomPrefix is the prefix of the token factory denoms
+	ModuleDenomPrefix = "factory"
+	// MaxSubdenomLength is the maximum length of a subdenom
+	MaxSubdenomLength = 44
+	// MaxCreatorLength is the maximum length of the creator address
+	MaxCreatorLength = 75
+)
+
+// GetTokenDenom returns the factory/{creator}/{subdenom} denom after checking
+// that it's a valid denom.
+func GetTokenDenom(creator, subdenom string) (string, error) {
+	if len(subdenom) > MaxSubdenomLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+	if len(creator) > MaxCreatorLength {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "creator too long, max length is %d", MaxCreatorLength)
+	}
+	if strings.Contains(creator, "/") {
+		return "", errorsmod.Wrapf(ErrInvalidDenom, "creator %s cannot contain '/'", creator)
+	}
+
+	denom := strings.Join([]string{ModuleDenomPrefix, creator, subdenom}, "/")
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+	return denom, nil
+}
+
+// DeconstructDenom returns the creator and the subdenom of a token factory
+// denom. The subdenom can contain '/'.
+func DeconstructDenom(denom string) (creator, subdenom string, err error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return "", "", errorsmod.Wrap(ErrInvalidDenom, err.Error())
+	}
+
+	parts := strings.SplitN(denom, "/", 3)
+	if len(parts) != 3 || parts[0] != ModuleDenomPrefix {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "denom %s must have the format %s/{creator}/{subdenom}", denom, ModuleDenomPrefix)
+	}
+
+	creator = parts[1]
+	if _, err := sdk.AccAddressFromBech32(creator); err != nil {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "invalid creator address %s: %s", creator, err)
+	}
+
+	subdenom = parts[2]
+	if len(subdenom) > MaxSubdenomLength {
+		return "", "", errorsmod.Wrapf(ErrInvalidDenom, "subdenom too long, max length is %d", MaxSubdenomLength)
+	}
+
+	return creator, subdenom, nil
+}
+
+// Validate checks the admin address of the authority metadata.
+func (m DenomAuthorityMetadata) Validate() error {
+	if m.Admin == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(m.Admin); err != nil {
+		return fmt.Errorf("invalid admin address %s: %w", m.Admin, err)
+	}
+	return nil
+}
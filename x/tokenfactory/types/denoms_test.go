@@ -0,0 +1,67 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+var creator = sdk.AccAddress([]byte("creator_____________"))
+
+func TestGetTokenDenom(t *testing.T) {
+	testCases := []struct {
+		name      string
+		creator   string
+		subdenom  string
+		expDenom  string
+		expectErr bool
+	}{
+		{"valid", creator.String(), "bitcoin", "factory/" + creator.String() + "/bitcoin", false},
+		{"valid subdenom with slash", creator.String(), "bit/coin", "factory/" + creator.String() + "/bit/coin", false},
+		{"empty subdenom", creator.String(), "", "factory/" + creator.String() + "/", false},
+		{"subdenom too long", creator.String(), "abcdefghijklmnopqrstuvwxyzabcdefghijklmnopqrstuvwxyz", "", true},
+		{"creator with slash", "anry/1", "bitcoin", "", true},
+		{"invalid characters", creator.String(), "bit*coin", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denom, err := GetTokenDenom(tc.creator, tc.subdenom)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expDenom, denom)
+		})
+	}
+}
+
+func TestDeconstructDenom(t *testing.T) {
+	testCases := []struct {
+		name        string
+		denom       string
+		expSubdenom string
+		expectErr   bool
+	}{
+		{"valid", "factory/" + creator.String() + "/bitcoin", "bitcoin", false},
+		{"valid subdenom with slash", "factory/" + creator.String() + "/bit/coin", "bit/coin", false},
+		{"not a factory denom", "aanry", "", true},
+		{"invalid prefix", "ibc/" + creator.String() + "/bitcoin", "", true},
+		{"invalid creator", "factory/creator/bitcoin", "", true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			denomCreator, subdenom, err := DeconstructDenom(tc.denom)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, creator.String(), denomCreator)
+			require.Equal(t, tc.expSubdenom, subdenom)
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// errors
+var (
+	ErrDenomExists       = errorsmod.Register(ModuleName, 2, "denom already exists")
+	ErrInvalidDenom      = errorsmod.Register(ModuleName, 3, "invalid token factory denom")
+	ErrDenomNotFound     = errorsmod.Register(ModuleName, 4, "denom not found")
+	ErrUnauthorized      = errorsmod.Register(ModuleName, 5, "unauthorized account")
+	ErrInvalidMetadata   = errorsmod.Register(ModuleName, 6, "invalid denom metadata")
+	ErrInvalidParams     = errorsmod.Register(ModuleName, 7, "invalid tokenfactory params")
+	ErrERC20Registration = errorsmod.Register(ModuleName, 8, "failed to register the ERC20 representation")
+)
@@ -0,0 +1,41 @@
+package types
+
+import (
+	"fmt"
+)
+
+// NewGenesisState creates a new genesis state instance
+func NewGenesisState(params Params, denoms []GenesisDenom) *GenesisState {
+	return &GenesisState{
+		Params:        params,
+		FactoryDenoms: denoms,
+	}
+}
+
+// DefaultGenesisState returns the default tokenfactory genesis state
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []GenesisDenom{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]bool)
+	for _, denom := range gs.FactoryDenoms {
+		if seen[denom.Denom] {
+			return fmt.Errorf("duplicated factory denom %s", denom.Denom)
+		}
+		if _, _, err := DeconstructDenom(denom.Denom); err != nil {
+			return err
+		}
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return err
+		}
+		seen[denom.Denom] = true
+	}
+	return nil
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	denom := GenesisDenom{
+		Denom:             "factory/" + creator.String() + "/bitcoin",
+		AuthorityMetadata: DenomAuthorityMetadata{Admin: creator.String()},
+	}
+
+	testCases := []struct {
+		name      string
+		genState  *GenesisState
+		expectErr bool
+	}{
+		{"default", DefaultGenesisState(), false},
+		{"valid", NewGenesisState(NewParams(sdk.NewCoins(sdk.NewInt64Coin("aanry", 100))), []GenesisDenom{denom}), false},
+		{"no admin", NewGenesisState(DefaultParams(), []GenesisDenom{{Denom: denom.Denom}}), false},
+		{"duplicated denom", NewGenesisState(DefaultParams(), []GenesisDenom{denom, denom}), true},
+		{"invalid denom", NewGenesisState(DefaultParams(), []GenesisDenom{{Denom: "aanry"}}), true},
+		{"invalid admin", NewGenesisState(DefaultParams(), []GenesisDenom{{Denom: denom.Denom, AuthorityMetadata: DenomAuthorityMetadata{Admin: "admin"}}}), true},
+		{"invalid fee", NewGenesisState(Params{DenomCreationFee: sdk.Coins{{Denom: "aanry", Amount: sdk.NewInt(-1)}}}, nil), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.genState.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+// constants
+const (
+	// module name
+	ModuleName = "tokenfactory"
+
+	// StoreKey to be used when creating the KVStore
+	StoreKey = ModuleName
+
+	// RouterKey to be used for message routing
+	RouterKey = ModuleName
+)
+
+// prefix bytes for the tokenfactory persistent store
+const (
+	prefixParams = iota + 1
+	prefixDenomAuthorityMetadata
+	prefixCreatorDenoms
+)
+
+// KVStore key prefixes
+var (
+	KeyPrefixParams                 = []byte{prefixParams}
+	KeyPrefixDenomAuthorityMetadata = []byte{prefixDenomAuthorityMetadata}
+	KeyPrefixCreatorDenoms          = []byte{prefixCreatorDenoms}
+)
+
+// CreatorDenomsPrefix returns the key prefix of the denoms created by an
+// address.
+func CreatorDenomsPrefix(creator string) []byte {
+	return []byte(creator + "/")
+}
@@ -0,0 +1,53 @@
+package types
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMsgValidateBasic(t *testing.T) {
+	denom := "factory/" + creator.String() + "/bitcoin"
+	metadata := banktypes.Metadata{
+		DenomUnits: []*banktypes.DenomUnit{{Denom: denom}},
+		Base:       denom,
+		Display:    denom,
+		Name:       "Bitcoin",
+		Symbol:     "BTC",
+	}
+
+	testCases := []struct {
+		name      string
+		msg       sdk.Msg
+		expectErr bool
+	}{
+		{"create denom", NewMsgCreateDenom(creator.String(), "bitcoin"), false},
+		{"create denom - invalid sender", NewMsgCreateDenom("creator", "bitcoin"), true},
+		{"create denom - invalid subdenom", NewMsgCreateDenom(creator.String(), "bit*coin"), true},
+		{"mint", NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 10), ""), false},
+		{"mint - to address", NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 10), creator.String()), false},
+		{"mint - invalid to address", NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 10), "receiver"), true},
+		{"mint - zero amount", NewMsgMint(creator.String(), sdk.NewInt64Coin(denom, 0), ""), true},
+		{"mint - not a factory denom", NewMsgMint(creator.String(), sdk.NewInt64Coin("aanry", 10), ""), true},
+		{"burn", NewMsgBurn(creator.String(), sdk.NewInt64Coin(denom, 10)), false},
+		{"burn - not a factory denom", NewMsgBurn(creator.String(), sdk.NewInt64Coin("aanry", 10)), true},
+		{"change admin", NewMsgChangeAdmin(creator.String(), denom, creator.String()), false},
+		{"change admin - invalid admin", NewMsgChangeAdmin(creator.String(), denom, "admin"), true},
+		{"change admin - not a factory denom", NewMsgChangeAdmin(creator.String(), "aanry", creator.String()), true},
+		{"set denom metadata", NewMsgSetDenomMetadata(creator.String(), metadata), false},
+		{"set denom metadata - invalid metadata", NewMsgSetDenomMetadata(creator.String(), banktypes.Metadata{Base: denom}), true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,33 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	anrytontypes "github.com/anryton/anryton/v2/types"
+	"github.com/anryton/anryton/v2/utils"
+)
+
+// DefaultDenomCreationFee is the default fee, 10 ANRY, charged for creating a
+// denom. The fee is sent to the community pool.
+var DefaultDenomCreationFee = sdk.NewCoins(sdk.NewCoin(utils.BaseDenom, anrytontypes.PowerReduction.MulRaw(10)))
+
+// NewParams creates a new Params object
+func NewParams(denomCreationFee sdk.Coins) Params {
+	return Params{
+		DenomCreationFee: denomCreationFee,
+	}
+}
+
+// DefaultParams returns the default tokenfactory params
+func DefaultParams() Params {
+	return NewParams(DefaultDenomCreationFee)
+}
+
+// Validate performs a basic validation of the params
+func (p Params) Validate() error {
+	if err := p.DenomCreationFee.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidParams, "invalid denom creation fee: %s", err)
+	}
+	return nil
+}
@@ -50,7 +50,7 @@ type ClawbackVestingAccount struct {
 func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
 func (*ClawbackVestingAccount) ProtoMessage() {}
 func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_87430af691abf9dd, []int{0}
+	return fileDescriptor_010aed33302288e8, []int{0}
 }
 func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -83,37 +83,37 @@ func init() {
 	proto.RegisterType((*ClawbackVestingAccount)(nil), "anryton.vesting.v1.ClawbackVestingAccount")
 }
 
-func init() { proto.RegisterFile("anryton/vesting/v1/vesting.proto", fileDescriptor_87430af691abf9dd) }
+func init() { proto.RegisterFile("anryton/vesting/v1/vesting.proto", fileDescriptor_010aed33302288e8) }
 
-var fileDescriptor_87430af691abf9dd = []byte{
-	// 420 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x31, 0x8f, 0xd3, 0x30,
-	0x14, 0x8e, 0xb9, 0x82, 0xee, 0x7c, 0xba, 0x82, 0xa2, 0x13, 0x8a, 0x3a, 0x38, 0x15, 0x02, 0xa9,
-	0x42, 0xc2, 0xd6, 0x15, 0x16, 0xd8, 0x9a, 0xdb, 0x11, 0x8a, 0x10, 0x03, 0x4b, 0x64, 0x27, 0xbe,
-	0x34, 0x6a, 0x13, 0x47, 0xb1, 0x53, 0x8e, 0x7f, 0x50, 0x75, 0xea, 0xc8, 0xd8, 0x99, 0x5f, 0xd2,
-	0xb1, 0x23, 0x53, 0x8b, 0xda, 0x3f, 0x82, 0x62, 0x3b, 0x55, 0x01, 0xb1, 0x32, 0xe5, 0xbd, 0xef,
-	0xf9, 0xbd, 0xef, 0xfb, 0xf2, 0x1e, 0x44, 0x72, 0x9c, 0x25, 0x82, 0xcc, 0xb8, 0x54, 0x59, 0x91,
-	0x92, 0xd9, 0x4d, 0x1b, 0xe2, 0xb2, 0x12, 0x4a, 0xb8, 0x4f, 0x74, 0x1d, 0xb7, 0xe0, 0xec, 0xa6,
-	0xf7, 0x3c, 0x16, 0x32, 0x17, 0xf2, 0xa4, 0x85, 0x71, 0x45, 0xff, 0xe8, 0xeb, 0x5d, 0xa7, 0x22,
-	0x15, 0x3a, 0x24, 0x4d, 0x64, 0x51, 0x3f, 0x15, 0x22, 0x9d, 0x72, 0xa2, 0x33, 0x56, 0xdf, 0x11,
-	0x95, 0xe5, 0x5c, 0x2a, 0x9a, 0x97, 0xe6, 0xc1, 0xb3, 0x45, 0x07, 0x3e, 0xbd, 0x9d, 0xd2, 0x2f,
-	0x8c, 0xc6, 0x93, 0x4f, 0x66, 0xe0, 0x28, 0x8e, 0x45, 0x5d, 0x28, 0x97, 0xc1, 0x6b, 0x46, 0x25,
-	0x8f, 0x2c, 0x4f, 0x44, 0x0d, 0xee, 0x81, 0x3e, 0x18, 0x5c, 0x0e, 0x5f, 0x62, 0x23, 0xeb, 0x44,
-	0xa9, 0x96, 0x85, 0x03, 0x2a, 0xf9, 0xef, 0x93, 0x82, 0xce, 0x66, 0xeb, 0x83, 0xd0, 0x65, 0x7f,
-	0x55, 0xdc, 0x17, 0xb0, 0x7b, 0x57, 0x17, 0x09, 0xaf, 0x22, 0x9a, 0x24, 0x15, 0x97, 0xd2, 0x7b,
-	0xd0, 0x07, 0x83, 0x8b, 0xf0, 0xca, 0xa0, 0x23, 0x03, 0xba, 0xb7, 0x10, 0x4a, 0x45, 0x2b, 0x15,
-	0x35, 0xf2, 0xbd, 0x33, 0x2d, 0xa0, 0x87, 0x8d, 0x37, 0xdc, 0x7a, 0xc3, 0x1f, 0x5b, 0x6f, 0xc1,
-	0xf9, 0x7a, 0xeb, 0x3b, 0xcb, 0x9d, 0x0f, 0xc2, 0x0b, 0xdd, 0xd7, 0x54, 0xdc, 0x39, 0x80, 0xdd,
-	0xa9, 0x88, 0x27, 0x75, 0x19, 0x95, 0xbc, 0xca, 0x44, 0x22, 0xbd, 0x4e, 0xff, 0x6c, 0x70, 0x39,
-	0x44, 0xff, 0xb2, 0xf2, 0x41, 0x3f, 0x0b, 0x46, 0xcd, 0xb4, 0xef, 0x3b, 0xff, 0x6d, 0x9a, 0xa9,
-	0x71, 0xcd, 0x70, 0x2c, 0x72, 0x62, 0x77, 0x62, 0x3e, 0xaf, 0x64, 0x32, 0x21, 0xf7, 0x84, 0xd6,
-	0x6a, 0x7c, 0xdc, 0x92, 0xfa, 0x5a, 0x72, 0x69, 0x27, 0xc8, 0xf0, 0xca, 0x10, 0xdb, 0xd4, 0x5d,
-	0x00, 0xf8, 0xb8, 0xfd, 0xad, 0xad, 0x96, 0x87, 0xff, 0x4b, 0x4b, 0xd7, 0xc2, 0x36, 0x7f, 0x77,
-	0x3e, 0x5f, 0xf9, 0xce, 0xb7, 0x95, 0xef, 0x04, 0xef, 0xd7, 0x7b, 0x04, 0x36, 0x7b, 0x04, 0x7e,
-	0xee, 0x11, 0x58, 0x1e, 0x90, 0xb3, 0x39, 0x20, 0xe7, 0xc7, 0x01, 0x39, 0x9f, 0xdf, 0x9c, 0xd0,
-	0x99, 0x03, 0xb6, 0x67, 0x3c, 0x24, 0xf7, 0x47, 0x9a, 0x3c, 0x4b, 0x2b, 0xaa, 0x32, 0x51, 0x48,
-	0xc3, 0xc8, 0x1e, 0xe9, 0xd5, 0xbc, 0xfe, 0x15, 0x00, 0x00, 0xff, 0xff, 0x75, 0x57, 0xe8, 0xda,
-	0xf4, 0x02, 0x00, 0x00,
+var fileDescriptor_010aed33302288e8 = []byte{
+	// 419 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x52, 0x4d, 0x6f, 0xd3, 0x30,
+	0x18, 0x8e, 0x59, 0x41, 0x9b, 0xa7, 0x15, 0xc9, 0x9a, 0x50, 0xd4, 0x43, 0x12, 0x21, 0x90, 0x2a,
+	0x24, 0x6c, 0xad, 0x5c, 0x80, 0x5b, 0xb3, 0x3f, 0x80, 0x22, 0xc4, 0x81, 0x4b, 0x64, 0x27, 0x5e,
+	0x16, 0xb5, 0x89, 0xa3, 0xd8, 0x09, 0xdb, 0x3f, 0x98, 0x76, 0xda, 0x91, 0x63, 0xcf, 0xfc, 0x92,
+	0x1e, 0x7b, 0xe4, 0xd4, 0xa2, 0xf6, 0x8f, 0xa0, 0xc4, 0x76, 0x55, 0x40, 0x5c, 0x77, 0xf2, 0xfb,
+	0x3e, 0xef, 0xc7, 0xf3, 0x3c, 0x79, 0x03, 0x03, 0x5a, 0xd6, 0xb7, 0x4a, 0x94, 0xa4, 0xe5, 0x52,
+	0xe5, 0x65, 0x46, 0xda, 0x0b, 0x1b, 0xe2, 0xaa, 0x16, 0x4a, 0x20, 0x64, 0x3a, 0xb0, 0x85, 0xdb,
+	0x8b, 0xd1, 0xab, 0x44, 0xc8, 0x42, 0xc8, 0x83, 0x21, 0xc6, 0x15, 0xfd, 0x6b, 0x72, 0x74, 0x9e,
+	0x89, 0x4c, 0xf4, 0x21, 0xe9, 0x22, 0x83, 0xfa, 0x99, 0x10, 0xd9, 0x9c, 0x93, 0x3e, 0x63, 0xcd,
+	0x15, 0x51, 0x79, 0xc1, 0xa5, 0xa2, 0x45, 0xa5, 0x1b, 0x5e, 0xde, 0x0f, 0xe0, 0x8b, 0xcb, 0x39,
+	0xfd, 0xc6, 0x68, 0x32, 0xfb, 0xa2, 0x17, 0x4e, 0x93, 0x44, 0x34, 0xa5, 0x42, 0x0c, 0x9e, 0x33,
+	0x2a, 0x79, 0x6c, 0x78, 0x62, 0xaa, 0x71, 0x17, 0x04, 0x60, 0x7c, 0x3a, 0x79, 0x83, 0xb5, 0xac,
+	0x03, 0xa5, 0xbd, 0x2c, 0x1c, 0x52, 0xc9, 0xff, 0xdc, 0x14, 0x0e, 0x56, 0x6b, 0x1f, 0x44, 0x88,
+	0xfd, 0x53, 0x41, 0xaf, 0xe1, 0xf0, 0xaa, 0x29, 0x53, 0x5e, 0xc7, 0x34, 0x4d, 0x6b, 0x2e, 0xa5,
+	0xfb, 0x24, 0x00, 0xe3, 0x93, 0xe8, 0x4c, 0xa3, 0x53, 0x0d, 0xa2, 0x4b, 0x08, 0xa5, 0xa2, 0xb5,
+	0x8a, 0x3b, 0xf9, 0xee, 0x51, 0x2f, 0x60, 0x84, 0xb5, 0x37, 0x6c, 0xbd, 0xe1, 0xcf, 0xd6, 0x5b,
+	0x78, 0xbc, 0x5c, 0xfb, 0xce, 0xc3, 0xc6, 0x07, 0xd1, 0x49, 0x3f, 0xd7, 0x55, 0xd0, 0x1d, 0x80,
+	0xc3, 0xb9, 0x48, 0x66, 0x4d, 0x15, 0x57, 0xbc, 0xce, 0x45, 0x2a, 0xdd, 0x41, 0x70, 0x34, 0x3e,
+	0x9d, 0x78, 0xff, 0xb3, 0xf2, 0xa9, 0x6f, 0x0b, 0xa7, 0xdd, 0xb6, 0x1f, 0x1b, 0xff, 0x43, 0x96,
+	0xab, 0xeb, 0x86, 0xe1, 0x44, 0x14, 0xc4, 0xdc, 0x44, 0x3f, 0x6f, 0x65, 0x3a, 0x23, 0x37, 0x84,
+	0x36, 0xea, 0x7a, 0x7f, 0x25, 0x75, 0x5b, 0x71, 0x69, 0x36, 0xc8, 0xe8, 0x4c, 0x13, 0x9b, 0x14,
+	0xdd, 0x03, 0xf8, 0xdc, 0x7e, 0x56, 0xab, 0xe5, 0xe9, 0x63, 0x69, 0x19, 0x1a, 0xd8, 0xe4, 0x1f,
+	0x8f, 0xef, 0x16, 0xbe, 0xf3, 0x7d, 0xe1, 0x3b, 0x61, 0xb4, 0xdc, 0x7a, 0x60, 0xb5, 0xf5, 0xc0,
+	0xaf, 0xad, 0x07, 0x1e, 0x76, 0x9e, 0xb3, 0xda, 0x79, 0xce, 0xcf, 0x9d, 0xe7, 0x7c, 0x7d, 0x7f,
+	0x40, 0x67, 0x7f, 0x62, 0xfb, 0xb6, 0x13, 0x72, 0xb3, 0xa7, 0x2a, 0xf2, 0xac, 0xa6, 0x2a, 0x17,
+	0xa5, 0xd4, 0xac, 0xec, 0x59, 0x7f, 0x9e, 0x77, 0xbf, 0x07, 0x00, 0xed, 0x0a, 0x8e, 0x62, 0xfc,
+	0x02, 0x00, 0x00,
 }
 
 func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
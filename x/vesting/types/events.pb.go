@@ -35,7 +35,7 @@ func (m *EventCreateClawbackVestingAccount) Reset()         { *m = EventCreateCl
 func (m *EventCreateClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
 func (*EventCreateClawbackVestingAccount) ProtoMessage()    {}
 func (*EventCreateClawbackVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28bf3a6bae1a8cd3, []int{0}
+	return fileDescriptor_fa807b81f682d678, []int{0}
 }
 func (m *EventCreateClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -94,7 +94,7 @@ func (m *EventFundVestingAccount) Reset()         { *m = EventFundVestingAccount
 func (m *EventFundVestingAccount) String() string { return proto.CompactTextString(m) }
 func (*EventFundVestingAccount) ProtoMessage()    {}
 func (*EventFundVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28bf3a6bae1a8cd3, []int{1}
+	return fileDescriptor_fa807b81f682d678, []int{1}
 }
 func (m *EventFundVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -165,7 +165,7 @@ func (m *EventClawback) Reset()         { *m = EventClawback{} }
 func (m *EventClawback) String() string { return proto.CompactTextString(m) }
 func (*EventClawback) ProtoMessage()    {}
 func (*EventClawback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28bf3a6bae1a8cd3, []int{2}
+	return fileDescriptor_fa807b81f682d678, []int{2}
 }
 func (m *EventClawback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -229,7 +229,7 @@ func (m *EventUpdateVestingFunder) Reset()         { *m = EventUpdateVestingFund
 func (m *EventUpdateVestingFunder) String() string { return proto.CompactTextString(m) }
 func (*EventUpdateVestingFunder) ProtoMessage()    {}
 func (*EventUpdateVestingFunder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_28bf3a6bae1a8cd3, []int{3}
+	return fileDescriptor_fa807b81f682d678, []int{3}
 }
 func (m *EventUpdateVestingFunder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -286,30 +286,30 @@ func init() {
 	proto.RegisterType((*EventUpdateVestingFunder)(nil), "anryton.vesting.v2.EventUpdateVestingFunder")
 }
 
-func init() { proto.RegisterFile("anryton/vesting/v2/events.proto", fileDescriptor_28bf3a6bae1a8cd3) }
+func init() { proto.RegisterFile("anryton/vesting/v2/events.proto", fileDescriptor_fa807b81f682d678) }
 
-var fileDescriptor_28bf3a6bae1a8cd3 = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4a, 0x33, 0x31,
-	0x14, 0xc5, 0x3b, 0xdf, 0x47, 0x2b, 0xbd, 0xe2, 0x1f, 0x06, 0xd1, 0xd9, 0x34, 0xd4, 0x6e, 0xd4,
-	0xcd, 0x0c, 0xd4, 0x27, 0xd0, 0x62, 0x1f, 0x40, 0xd4, 0x85, 0x9b, 0x92, 0x26, 0xd7, 0x36, 0xd4,
-	0x26, 0x65, 0x72, 0x67, 0xaa, 0x4f, 0xa1, 0x8f, 0xe5, 0xb2, 0x4b, 0x97, 0xd2, 0x79, 0x11, 0x31,
-	0x89, 0x62, 0xa1, 0x82, 0x2e, 0x6f, 0xee, 0xc9, 0xef, 0x9e, 0x03, 0x07, 0x5a, 0x76, 0xac, 0xa4,
-	0xc9, 0x4a, 0xb4, 0xa4, 0xf4, 0x28, 0x2b, 0xbb, 0x19, 0x96, 0xa8, 0xc9, 0xa6, 0xb3, 0xdc, 0x90,
-	0x89, 0x77, 0xdd, 0x3a, 0x0d, 0xeb, 0xb4, 0xec, 0x76, 0x24, 0x1c, 0x5e, 0x7c, 0x28, 0x7a, 0x39,
-	0x72, 0xc2, 0xde, 0x3d, 0x9f, 0x0f, 0xb9, 0x98, 0xdc, 0x78, 0xc1, 0x99, 0x10, 0xa6, 0xd0, 0x14,
-	0xef, 0x43, 0xe3, 0xae, 0xd0, 0x12, 0xf3, 0x24, 0x6a, 0x47, 0xc7, 0xcd, 0xcb, 0x30, 0xc5, 0x47,
-	0xb0, 0x13, 0x50, 0x03, 0xee, 0xa5, 0xc9, 0x3f, 0x27, 0xd8, 0x2e, 0x57, 0x00, 0x9d, 0xa7, 0x08,
-	0x0e, 0xdc, 0x99, 0x7e, 0xa1, 0xe5, 0x2f, 0xe1, 0x7b, 0x50, 0x17, 0x46, 0x69, 0x1b, 0x90, 0x7e,
-	0x88, 0x5b, 0x00, 0x96, 0x78, 0x4e, 0x03, 0x52, 0x53, 0x4c, 0xfe, 0xbb, 0x55, 0xd3, 0xbd, 0x5c,
-	0xa9, 0x29, 0xae, 0x73, 0x54, 0x5f, 0xeb, 0x48, 0xc0, 0x96, 0xcf, 0x1d, 0x12, 0xff, 0x68, 0x23,
-	0x81, 0x8d, 0xd5, 0x6c, 0x9f, 0x63, 0xdc, 0x86, 0x4d, 0xe9, 0xa0, 0x9c, 0x94, 0xd1, 0xc1, 0xcb,
-	0xf7, 0xa7, 0xce, 0x04, 0x12, 0x77, 0xe4, 0x7a, 0x26, 0x39, 0x61, 0xc8, 0xdd, 0xf7, 0xdc, 0xbf,
-	0xdf, 0x6b, 0x01, 0x68, 0x9c, 0x0f, 0xc2, 0xaf, 0x10, 0x5d, 0xe3, 0xdc, 0x03, 0xcf, 0x7b, 0x2f,
-	0x4b, 0x16, 0x2d, 0x96, 0x2c, 0x7a, 0x5b, 0xb2, 0xe8, 0xb9, 0x62, 0xb5, 0x45, 0xc5, 0x6a, 0xaf,
-	0x15, 0xab, 0xdd, 0x9e, 0x8c, 0x14, 0x8d, 0x8b, 0x61, 0x2a, 0xcc, 0x34, 0xf3, 0xfd, 0x08, 0x2d,
-	0xe9, 0x66, 0x0f, 0x5f, 0x55, 0xa1, 0xc7, 0x19, 0xda, 0x61, 0xc3, 0xf5, 0xe4, 0xf4, 0x3d, 0x00,
-	0x00, 0xff, 0xff, 0x51, 0x23, 0x86, 0xef, 0x48, 0x02, 0x00, 0x00,
+var fileDescriptor_fa807b81f682d678 = []byte{
+	// 314 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x4e, 0x32, 0x31,
+	0x14, 0x85, 0x99, 0xff, 0x0f, 0x18, 0xae, 0x51, 0x93, 0xc6, 0xe8, 0x6c, 0xa8, 0xc8, 0x46, 0x37,
+	0xce, 0x24, 0xf8, 0x04, 0x4a, 0xc4, 0xbd, 0x51, 0x17, 0x6e, 0x48, 0xe9, 0x5c, 0xb1, 0x41, 0x5a,
+	0x32, 0x73, 0xa7, 0xc8, 0x53, 0xe8, 0x63, 0xb9, 0x64, 0xe9, 0xd2, 0xc0, 0x8b, 0x18, 0xdb, 0x62,
+	0x24, 0xc1, 0x44, 0x57, 0x93, 0x7b, 0xe7, 0xf4, 0xbb, 0xe7, 0x24, 0x07, 0x0e, 0x84, 0xce, 0xa7,
+	0x64, 0x74, 0x6a, 0xb1, 0x20, 0xa5, 0x07, 0xa9, 0x6d, 0xa7, 0x68, 0x51, 0x53, 0x91, 0x8c, 0x73,
+	0x43, 0x86, 0xb1, 0x20, 0x48, 0x82, 0x20, 0xb1, 0xed, 0x56, 0x06, 0x87, 0x17, 0x9f, 0x9a, 0x4e,
+	0x8e, 0x82, 0xb0, 0xf3, 0x28, 0x26, 0x7d, 0x21, 0x87, 0xb7, 0x5e, 0x70, 0x26, 0xa5, 0x29, 0x35,
+	0xb1, 0x3d, 0xa8, 0xdd, 0x97, 0x3a, 0xc3, 0x3c, 0x8e, 0x9a, 0xd1, 0x71, 0xfd, 0x2a, 0x4c, 0xec,
+	0x08, 0x76, 0x02, 0xaa, 0x27, 0xbc, 0x34, 0xfe, 0xe7, 0x04, 0xdb, 0x76, 0x05, 0xd0, 0x7a, 0x8e,
+	0x60, 0xdf, 0x9d, 0xe9, 0x96, 0x3a, 0xfb, 0x25, 0x7c, 0x17, 0xaa, 0xd2, 0x28, 0x5d, 0x04, 0xa4,
+	0x1f, 0x58, 0x03, 0xa0, 0x20, 0x91, 0x53, 0x8f, 0xd4, 0x08, 0xe3, 0xff, 0xee, 0x57, 0xdd, 0x6d,
+	0xae, 0xd5, 0x08, 0xd7, 0x39, 0xaa, 0xae, 0x75, 0x24, 0x61, 0xcb, 0xe7, 0x0e, 0x89, 0x7f, 0xb4,
+	0x11, 0xc3, 0xc6, 0x6a, 0xb6, 0xe5, 0xc8, 0x9a, 0xb0, 0x99, 0x39, 0xa8, 0x20, 0x65, 0x74, 0xf0,
+	0xf2, 0x7d, 0xd5, 0x1a, 0x42, 0xec, 0x8e, 0xdc, 0x8c, 0x33, 0x41, 0x18, 0x72, 0x77, 0x3d, 0xf7,
+	0xef, 0xf7, 0x1a, 0x00, 0x1a, 0x27, 0xbd, 0xf0, 0x2a, 0x44, 0xd7, 0x38, 0xf1, 0xc0, 0xf3, 0xcb,
+	0xd7, 0x39, 0x8f, 0x66, 0x73, 0x1e, 0xbd, 0xcf, 0x79, 0xf4, 0xb2, 0xe0, 0x95, 0xd9, 0x82, 0x57,
+	0xde, 0x16, 0xbc, 0x72, 0x77, 0x32, 0x50, 0xf4, 0x50, 0xf6, 0x13, 0x69, 0x46, 0xe9, 0xb2, 0x23,
+	0xcb, 0xaf, 0x6d, 0xa7, 0x4f, 0x5f, 0x85, 0xa1, 0xe9, 0x18, 0x8b, 0x7e, 0xcd, 0xb5, 0xe5, 0xf4,
+	0x63, 0x00, 0x28, 0xd4, 0xfb, 0x6f, 0x50, 0x02, 0x00, 0x00,
 }
 
 func (m *EventCreateClawbackVestingAccount) Marshal() (dAtA []byte, err error) {
@@ -41,7 +41,7 @@ func (m *QueryBalancesRequest) Reset()         { *m = QueryBalancesRequest{} }
 func (m *QueryBalancesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryBalancesRequest) ProtoMessage()    {}
 func (*QueryBalancesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cc84808c96921af, []int{0}
+	return fileDescriptor_59903efba50cbdbb, []int{0}
 }
 func (m *QueryBalancesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -92,7 +92,7 @@ func (m *QueryBalancesResponse) Reset()         { *m = QueryBalancesResponse{} }
 func (m *QueryBalancesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryBalancesResponse) ProtoMessage()    {}
 func (*QueryBalancesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7cc84808c96921af, []int{1}
+	return fileDescriptor_59903efba50cbdbb, []int{1}
 }
 func (m *QueryBalancesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -147,34 +147,34 @@ func init() {
 	proto.RegisterType((*QueryBalancesResponse)(nil), "anryton.vesting.v2.QueryBalancesResponse")
 }
 
-func init() { proto.RegisterFile("anryton/vesting/v2/query.proto", fileDescriptor_7cc84808c96921af) }
-
-var fileDescriptor_7cc84808c96921af = []byte{
-	// 382 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xc1, 0x4e, 0xea, 0x40,
-	0x14, 0xed, 0x40, 0x1e, 0x8f, 0x37, 0x6f, 0xf3, 0xd2, 0xf0, 0x92, 0x3e, 0x42, 0x0a, 0x21, 0x2f,
-	0x88, 0x89, 0xce, 0x40, 0xfd, 0x03, 0xf8, 0x02, 0x59, 0xba, 0x9b, 0xb6, 0x93, 0xd2, 0x00, 0x73,
-	0x0b, 0x33, 0x6d, 0x24, 0xc6, 0x8d, 0x3b, 0x77, 0x26, 0xfc, 0x85, 0x5f, 0x42, 0x5c, 0x91, 0xb8,
-	0x71, 0xa5, 0x06, 0xfc, 0x10, 0xd3, 0x76, 0x20, 0x06, 0x4d, 0xdc, 0xe8, 0xaa, 0xb7, 0x73, 0xef,
-	0x3d, 0xf7, 0x9e, 0x73, 0x2e, 0xae, 0xc9, 0x61, 0xe8, 0x03, 0x4d, 0xb8, 0x54, 0xa1, 0x08, 0x68,
-	0xe2, 0xd0, 0x69, 0xcc, 0x67, 0x73, 0x12, 0xcd, 0x40, 0x81, 0xf9, 0x27, 0xcb, 0x12, 0x9d, 0x25,
-	0x89, 0x53, 0xb5, 0x3d, 0x90, 0x13, 0x90, 0xd4, 0x65, 0x92, 0xd3, 0xa4, 0xeb, 0x72, 0xc5, 0xba,
-	0xd4, 0x83, 0x50, 0xe4, 0x1d, 0xd5, 0x4a, 0x00, 0x01, 0x64, 0x21, 0x4d, 0x23, 0xfd, 0x5a, 0x0b,
-	0x00, 0x82, 0x31, 0xa7, 0x2c, 0x0a, 0x29, 0x13, 0x02, 0x14, 0x53, 0x21, 0x08, 0x99, 0x67, 0x9b,
-	0x1d, 0x5c, 0x39, 0x4d, 0x87, 0xf6, 0xd8, 0x98, 0x09, 0x8f, 0xcb, 0x01, 0x9f, 0xc6, 0x5c, 0x2a,
-	0xd3, 0xc2, 0x3f, 0x99, 0xef, 0xcf, 0xb8, 0x94, 0x16, 0x6a, 0xa0, 0xf6, 0xaf, 0xc1, 0xf6, 0xb7,
-	0x79, 0x57, 0xc0, 0x7f, 0xf7, 0x5a, 0x64, 0x04, 0x42, 0x72, 0xd3, 0xc3, 0xa5, 0x31, 0x78, 0x23,
-	0xee, 0x5b, 0xa8, 0x51, 0x6c, 0xff, 0x76, 0xfe, 0x91, 0x7c, 0x61, 0x92, 0x2e, 0x4c, 0xf4, 0xc2,
-	0xa4, 0x0f, 0xa1, 0xe8, 0x75, 0x96, 0x8f, 0x75, 0xe3, 0xf6, 0xa9, 0xde, 0x0e, 0x42, 0x35, 0x8c,
-	0x5d, 0xe2, 0xc1, 0x84, 0x6a, 0x76, 0xf9, 0xe7, 0x58, 0xfa, 0x23, 0xaa, 0xe6, 0x11, 0x97, 0x59,
-	0x83, 0x1c, 0x68, 0x68, 0x33, 0xc0, 0xe5, 0x58, 0xa4, 0xa2, 0x70, 0xdf, 0x2a, 0x7c, 0xfd, 0x98,
-	0x1d, 0x78, 0xca, 0x46, 0x8f, 0x29, 0x7e, 0x03, 0x9b, 0x1c, 0xda, 0x59, 0x20, 0xfc, 0x23, 0x13,
-	0xd3, 0xbc, 0x46, 0xb8, 0xbc, 0x55, 0xd4, 0x6c, 0x91, 0x7d, 0xf3, 0xc9, 0x47, 0x2e, 0x55, 0x0f,
-	0x3e, 0xad, 0xcb, 0xad, 0x69, 0x1e, 0x5d, 0xdd, 0xbf, 0x2c, 0x0a, 0x2d, 0xf3, 0x3f, 0x7d, 0x77,
-	0x73, 0xae, 0xae, 0xa5, 0x17, 0xda, 0xe1, 0xcb, 0x5e, 0x7f, 0xb9, 0xb6, 0xd1, 0x6a, 0x6d, 0xa3,
-	0xe7, 0xb5, 0x8d, 0x6e, 0x36, 0xb6, 0xb1, 0xda, 0xd8, 0xc6, 0xc3, 0xc6, 0x36, 0xce, 0x0e, 0xdf,
-	0x30, 0xcc, 0x91, 0x34, 0x9e, 0x43, 0xcf, 0x77, 0xa0, 0x19, 0x51, 0xb7, 0x94, 0x1d, 0xd8, 0xc9,
-	0x6b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x87, 0xe1, 0xbe, 0xe1, 0xe6, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("anryton/vesting/v2/query.proto", fileDescriptor_59903efba50cbdbb) }
+
+var fileDescriptor_59903efba50cbdbb = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x52, 0xb1, 0x6e, 0xdb, 0x30,
+	0x10, 0x15, 0x6d, 0xd4, 0x75, 0xd9, 0x8d, 0x70, 0x01, 0xd5, 0x28, 0x68, 0xc3, 0x43, 0xa1, 0x0e,
+	0x26, 0x6d, 0xf5, 0x0f, 0xdc, 0xa1, 0x73, 0x3d, 0x76, 0xa3, 0x24, 0x42, 0x15, 0x6c, 0xf3, 0x64,
+	0x91, 0x12, 0x6a, 0x14, 0x5d, 0xfa, 0x03, 0x0d, 0x90, 0x29, 0xbf, 0x90, 0x2f, 0x31, 0x32, 0x19,
+	0xc8, 0x92, 0x29, 0x09, 0xec, 0x7c, 0x48, 0x20, 0x89, 0x36, 0x82, 0xc4, 0x43, 0x86, 0x64, 0xd2,
+	0x89, 0x77, 0xf7, 0xee, 0xde, 0x7b, 0x87, 0xa9, 0x50, 0xd9, 0xca, 0x80, 0xe2, 0x85, 0xd4, 0x26,
+	0x51, 0x31, 0x2f, 0x7c, 0xbe, 0xcc, 0x65, 0xb6, 0x62, 0x69, 0x06, 0x06, 0x08, 0xb1, 0x79, 0x66,
+	0xf3, 0xac, 0xf0, 0xbb, 0x34, 0x04, 0xbd, 0x00, 0xcd, 0x03, 0xa1, 0x25, 0x2f, 0xc6, 0x81, 0x34,
+	0x62, 0xcc, 0x43, 0x48, 0x54, 0xdd, 0xd3, 0xed, 0xc4, 0x10, 0x43, 0x15, 0xf2, 0x32, 0xb2, 0xaf,
+	0x9f, 0x62, 0x80, 0x78, 0x2e, 0xb9, 0x48, 0x13, 0x2e, 0x94, 0x02, 0x23, 0x4c, 0x02, 0x4a, 0xd7,
+	0xd9, 0xc1, 0x08, 0x77, 0x7e, 0x94, 0x63, 0x27, 0x62, 0x2e, 0x54, 0x28, 0xf5, 0x54, 0x2e, 0x73,
+	0xa9, 0x0d, 0x71, 0xf1, 0x5b, 0x11, 0x45, 0x99, 0xd4, 0xda, 0x45, 0x7d, 0xe4, 0xbd, 0x9b, 0xee,
+	0x7f, 0x07, 0x17, 0x0d, 0xfc, 0xe1, 0x51, 0x8b, 0x4e, 0x41, 0x69, 0x49, 0x42, 0xdc, 0x9a, 0x43,
+	0x38, 0x93, 0x91, 0x8b, 0xfa, 0x4d, 0xef, 0xbd, 0xff, 0x91, 0xd5, 0x0b, 0xb3, 0x72, 0x61, 0x66,
+	0x17, 0x66, 0xdf, 0x20, 0x51, 0x93, 0xd1, 0xfa, 0xba, 0xe7, 0x9c, 0xdf, 0xf4, 0xbc, 0x38, 0x31,
+	0xbf, 0xf2, 0x80, 0x85, 0xb0, 0xe0, 0x96, 0x5d, 0xfd, 0x19, 0xea, 0x68, 0xc6, 0xcd, 0x2a, 0x95,
+	0xba, 0x6a, 0xd0, 0x53, 0x0b, 0x4d, 0x62, 0xdc, 0xce, 0x55, 0x29, 0x8a, 0x8c, 0xdc, 0xc6, 0xcb,
+	0x8f, 0x39, 0x80, 0x97, 0x6c, 0xec, 0x98, 0xe6, 0x2b, 0xb0, 0xa9, 0xa1, 0xfd, 0x33, 0x84, 0xdf,
+	0x54, 0x62, 0x92, 0xff, 0x08, 0xb7, 0xf7, 0x8a, 0x12, 0x8f, 0x3d, 0xb5, 0x9f, 0x1d, 0xf3, 0xa9,
+	0xfb, 0xe5, 0x19, 0x95, 0xb5, 0x3d, 0x03, 0xf6, 0xef, 0xf2, 0xee, 0xb4, 0xe1, 0x91, 0xcf, 0xfc,
+	0xc8, 0xed, 0x05, 0xb6, 0x9a, 0xff, 0xb1, 0x3e, 0xff, 0x9d, 0x7c, 0x5f, 0x6f, 0x29, 0xda, 0x6c,
+	0x29, 0xba, 0xdd, 0x52, 0x74, 0xb2, 0xa3, 0xce, 0x66, 0x47, 0x9d, 0xab, 0x1d, 0x75, 0x7e, 0x0e,
+	0x1f, 0xf0, 0xdc, 0x63, 0x1d, 0x30, 0x7d, 0xfe, 0xfb, 0x00, 0x5c, 0x51, 0x0e, 0x5a, 0xd5, 0xa9,
+	0x7d, 0xbd, 0x1f, 0x00, 0xd8, 0x8a, 0xcb, 0x67, 0xf4, 0x02, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -53,7 +53,7 @@ func (m *MsgCreateClawbackVestingAccount) Reset()         { *m = MsgCreateClawba
 func (m *MsgCreateClawbackVestingAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateClawbackVestingAccount) ProtoMessage()    {}
 func (*MsgCreateClawbackVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{0}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{0}
 }
 func (m *MsgCreateClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -114,7 +114,7 @@ func (m *MsgCreateClawbackVestingAccountResponse) Reset() {
 func (m *MsgCreateClawbackVestingAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateClawbackVestingAccountResponse) ProtoMessage()    {}
 func (*MsgCreateClawbackVestingAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{1}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{1}
 }
 func (m *MsgCreateClawbackVestingAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,7 +162,7 @@ func (m *MsgFundVestingAccount) Reset()         { *m = MsgFundVestingAccount{} }
 func (m *MsgFundVestingAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgFundVestingAccount) ProtoMessage()    {}
 func (*MsgFundVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{2}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{2}
 }
 func (m *MsgFundVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -235,7 +235,7 @@ func (m *MsgFundVestingAccountResponse) Reset()         { *m = MsgFundVestingAcc
 func (m *MsgFundVestingAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgFundVestingAccountResponse) ProtoMessage()    {}
 func (*MsgFundVestingAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{3}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{3}
 }
 func (m *MsgFundVestingAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -282,7 +282,7 @@ func (m *MsgClawback) Reset()         { *m = MsgClawback{} }
 func (m *MsgClawback) String() string { return proto.CompactTextString(m) }
 func (*MsgClawback) ProtoMessage()    {}
 func (*MsgClawback) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{4}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{4}
 }
 func (m *MsgClawback) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -342,7 +342,7 @@ func (m *MsgClawbackResponse) Reset()         { *m = MsgClawbackResponse{} }
 func (m *MsgClawbackResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgClawbackResponse) ProtoMessage()    {}
 func (*MsgClawbackResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{5}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{5}
 }
 func (m *MsgClawbackResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -393,7 +393,7 @@ func (m *MsgUpdateVestingFunder) Reset()         { *m = MsgUpdateVestingFunder{}
 func (m *MsgUpdateVestingFunder) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateVestingFunder) ProtoMessage()    {}
 func (*MsgUpdateVestingFunder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{6}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{6}
 }
 func (m *MsgUpdateVestingFunder) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -452,7 +452,7 @@ func (m *MsgUpdateVestingFunderResponse) Reset()         { *m = MsgUpdateVesting
 func (m *MsgUpdateVestingFunderResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateVestingFunderResponse) ProtoMessage()    {}
 func (*MsgUpdateVestingFunderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{7}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{7}
 }
 func (m *MsgUpdateVestingFunderResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -491,7 +491,7 @@ func (m *MsgConvertVestingAccount) Reset()         { *m = MsgConvertVestingAccou
 func (m *MsgConvertVestingAccount) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertVestingAccount) ProtoMessage()    {}
 func (*MsgConvertVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{8}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{8}
 }
 func (m *MsgConvertVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -535,7 +535,7 @@ func (m *MsgConvertVestingAccountResponse) Reset()         { *m = MsgConvertVest
 func (m *MsgConvertVestingAccountResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConvertVestingAccountResponse) ProtoMessage()    {}
 func (*MsgConvertVestingAccountResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7aef80a34ea2addd, []int{9}
+	return fileDescriptor_71ba2acbb9e95ce9, []int{9}
 }
 func (m *MsgConvertVestingAccountResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -577,63 +577,64 @@ func init() {
 	proto.RegisterType((*MsgConvertVestingAccountResponse)(nil), "anryton.vesting.v2.MsgConvertVestingAccountResponse")
 }
 
-func init() { proto.RegisterFile("anryton/vesting/v2/tx.proto", fileDescriptor_7aef80a34ea2addd) }
-
-var fileDescriptor_7aef80a34ea2addd = []byte{
-	// 847 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x6f, 0xe3, 0x44,
-	0x14, 0xce, 0x6c, 0xd8, 0x55, 0x77, 0xc2, 0x96, 0xc5, 0xa1, 0x90, 0x5a, 0xad, 0x1d, 0x22, 0xaa,
-	0xa6, 0xa5, 0x78, 0x1a, 0x53, 0x21, 0xb5, 0xe2, 0xd2, 0x44, 0x2a, 0xa7, 0x48, 0x28, 0x02, 0x0e,
-	0x5c, 0xa2, 0x89, 0x3d, 0x75, 0xad, 0x26, 0x1e, 0x2b, 0x33, 0x76, 0xc2, 0xb5, 0x27, 0xc4, 0xa9,
-	0x12, 0xe2, 0x0e, 0x07, 0x2e, 0x20, 0x24, 0xee, 0xfc, 0x01, 0x54, 0x9c, 0x2a, 0x71, 0x81, 0x0b,
-	0x45, 0x2d, 0x12, 0xfc, 0x19, 0xc8, 0x33, 0x63, 0xb7, 0xa4, 0x43, 0x9b, 0x1e, 0xe0, 0x94, 0x78,
-	0xde, 0xf7, 0xde, 0xfb, 0xe6, 0x7b, 0x3f, 0x06, 0x2e, 0xb3, 0xa3, 0xd0, 0xa7, 0x28, 0x25, 0x8c,
-	0x87, 0x51, 0x80, 0x52, 0x17, 0xf1, 0xa9, 0x13, 0x8f, 0x29, 0xa7, 0xc6, 0x73, 0x61, 0x72, 0x94,
-	0xc9, 0x49, 0x5d, 0xd3, 0xf2, 0x28, 0x1b, 0x51, 0x86, 0x06, 0x98, 0x11, 0x94, 0xb6, 0x06, 0x84,
-	0xe3, 0x16, 0xf2, 0x68, 0x18, 0x49, 0x0f, 0xf3, 0x35, 0x65, 0x1f, 0xb1, 0x00, 0xa5, 0xad, 0xec,
-	0x47, 0x19, 0xde, 0x50, 0x86, 0x22, 0x8d, 0xf2, 0xcd, 0x63, 0x4b, 0xd4, 0x2b, 0x01, 0x0d, 0xa8,
-	0xf8, 0x8b, 0xb2, 0x7f, 0xea, 0x74, 0x25, 0xa0, 0x34, 0x18, 0x12, 0x84, 0xe3, 0x10, 0xe1, 0x28,
-	0xa2, 0x1c, 0xf3, 0x90, 0x46, 0x4c, 0x59, 0x6d, 0x65, 0x15, 0x5f, 0x83, 0xe4, 0x10, 0xf1, 0x70,
-	0x44, 0x18, 0xc7, 0xa3, 0x58, 0x02, 0x1a, 0x3f, 0x00, 0x68, 0x77, 0x59, 0xd0, 0x19, 0x13, 0xcc,
-	0x49, 0x67, 0x88, 0x27, 0x03, 0xec, 0x1d, 0x7f, 0x24, 0xf3, 0xee, 0x7b, 0x1e, 0x4d, 0x22, 0x6e,
-	0xac, 0xc1, 0xc5, 0xc3, 0x24, 0xf2, 0xc9, 0xb8, 0x8f, 0x7d, 0x7f, 0x4c, 0x18, 0xab, 0x81, 0x3a,
-	0x68, 0x3e, 0xed, 0x3d, 0x93, 0xa7, 0xfb, 0xf2, 0xd0, 0x58, 0x87, 0x2f, 0x29, 0xc2, 0x05, 0xee,
-	0x91, 0xc0, 0x2d, 0xaa, 0xe3, 0x1c, 0xe8, 0xc0, 0x2a, 0x89, 0xf0, 0x60, 0x48, 0xfa, 0x01, 0x4d,
-	0xfb, 0x9e, 0x4a, 0x5a, 0x2b, 0xd7, 0x41, 0x73, 0xa1, 0xf7, 0xb2, 0x34, 0xbd, 0x47, 0xd3, 0x9c,
-	0xcd, 0x5e, 0xed, 0xaf, 0x2f, 0xed, 0xd2, 0xc9, 0x9f, 0xdf, 0x6f, 0xce, 0xc6, 0x6f, 0x6c, 0xc0,
-	0xf5, 0x7b, 0xc8, 0xf7, 0x08, 0x8b, 0x69, 0xc4, 0x48, 0xe3, 0xd7, 0x32, 0x5c, 0xea, 0xb2, 0xe0,
-	0x20, 0x89, 0xfc, 0xff, 0xf8, 0x7a, 0x1d, 0x08, 0x19, 0xc7, 0x63, 0xde, 0xcf, 0xb4, 0x16, 0xb7,
-	0xaa, 0xb8, 0xa6, 0x23, 0x0b, 0xe1, 0xe4, 0x85, 0x70, 0x3e, 0xc8, 0x0b, 0xd1, 0x5e, 0x38, 0xfb,
-	0xcd, 0x2e, 0x9d, 0x5e, 0xd8, 0xa0, 0xf7, 0x54, 0xf8, 0x65, 0x16, 0xe3, 0x53, 0x00, 0x17, 0x87,
-	0xd4, 0x3b, 0x4e, 0xe2, 0x7e, 0x4c, 0xc6, 0x21, 0xf5, 0x59, 0xed, 0x85, 0x7a, 0xb9, 0x59, 0x71,
-	0x2d, 0x47, 0x36, 0xcb, 0x75, 0xe3, 0xc9, 0x66, 0x71, 0xde, 0x17, 0xb0, 0xf6, 0x7e, 0x16, 0xed,
-	0x9b, 0x0b, 0x7b, 0x37, 0x08, 0xf9, 0x51, 0x32, 0x70, 0x3c, 0x3a, 0x42, 0xaa, 0xbd, 0xe4, 0xcf,
-	0x5b, 0xcc, 0x3f, 0x46, 0x53, 0x84, 0x13, 0x7e, 0x54, 0x34, 0x1c, 0xff, 0x24, 0x26, 0x4c, 0x45,
-	0x60, 0xbd, 0x67, 0x32, 0xb1, 0xfa, 0x34, 0x3e, 0x03, 0xd7, 0x37, 0xcf, 0xb9, 0x3c, 0xfe, 0xbf,
-	0xb8, 0xe4, 0xe2, 0xaa, 0xef, 0xbd, 0x6a, 0xd6, 0x07, 0x33, 0xf5, 0x6a, 0xd8, 0x70, 0x55, 0x5b,
-	0xda, 0xa2, 0xf8, 0x5f, 0x00, 0x58, 0xc9, 0x1a, 0x45, 0xb5, 0xc8, 0x03, 0x4a, 0x8e, 0x65, 0xa4,
-	0xd9, 0x92, 0xab, 0xe3, 0x1c, 0xf8, 0x3a, 0x7c, 0xd1, 0x27, 0xec, 0x1a, 0x55, 0x16, 0xa8, 0x4a,
-	0x76, 0xa6, 0x20, 0x7a, 0xe2, 0x53, 0x58, 0xbd, 0x41, 0x2b, 0xa7, 0x6b, 0x60, 0xf8, 0x38, 0x5b,
-	0x1b, 0x19, 0xab, 0x4c, 0xe6, 0xe5, 0x5c, 0xe6, 0x6c, 0xb1, 0x14, 0x1a, 0x77, 0x68, 0x18, 0xb5,
-	0xb7, 0x95, 0xc2, 0xcd, 0x3b, 0x15, 0x96, 0x92, 0x66, 0x0e, 0xac, 0x27, 0x23, 0x37, 0xbe, 0x05,
-	0xf0, 0xd5, 0x2e, 0x0b, 0x3e, 0x8c, 0x7d, 0xcc, 0x89, 0x52, 0xed, 0x40, 0x90, 0x9b, 0x57, 0x9c,
-	0x2d, 0x68, 0x44, 0x64, 0xd2, 0x9f, 0x81, 0x4a, 0x7d, 0x9e, 0x47, 0x64, 0x72, 0x70, 0xdf, 0xf4,
-	0x94, 0x75, 0xd3, 0xa3, 0xd7, 0xa9, 0x0e, 0x2d, 0x3d, 0xd9, 0xa2, 0xc2, 0x1d, 0x58, 0xcb, 0x94,
-	0xa4, 0x51, 0x4a, 0xc6, 0x7c, 0x66, 0xc0, 0x35, 0xb9, 0x81, 0x2e, 0x77, 0xa3, 0x01, 0xeb, 0xff,
-	0x16, 0x24, 0x4f, 0xe4, 0xfe, 0xf8, 0x04, 0x96, 0xbb, 0x2c, 0x30, 0x7e, 0x02, 0x70, 0xe5, 0xce,
-	0xad, 0xd9, 0x72, 0x66, 0x1f, 0x08, 0xe7, 0x9e, 0x5d, 0x65, 0xee, 0x3e, 0xd8, 0xa5, 0xb8, 0xff,
-	0xbb, 0x27, 0x3f, 0xff, 0xf1, 0xf9, 0xa3, 0x77, 0x8c, 0x1d, 0xa4, 0x79, 0xb1, 0x90, 0x27, 0x42,
-	0x14, 0xab, 0xb6, 0x5f, 0xc8, 0xa1, 0xb8, 0x7e, 0x05, 0xa0, 0xa1, 0xd9, 0x8c, 0xeb, 0x5a, 0x3e,
-	0xb7, 0x81, 0x26, 0x9a, 0x13, 0x58, 0xd0, 0x6d, 0x09, 0xba, 0x6f, 0x1a, 0x1b, 0x5a, 0xba, 0x59,
-	0xf5, 0x6f, 0x71, 0x9c, 0xc0, 0x85, 0x62, 0x7e, 0x57, 0xf5, 0x42, 0x29, 0xb3, 0xb9, 0x76, 0xa7,
-	0xb9, 0x20, 0xb1, 0x26, 0x48, 0xd8, 0xc6, 0xaa, 0x5e, 0xb3, 0x3c, 0xd9, 0xd7, 0x00, 0x56, 0x75,
-	0x73, 0xd2, 0xd4, 0x66, 0xd1, 0x20, 0xcd, 0xed, 0x79, 0x91, 0x05, 0x35, 0x57, 0x50, 0xdb, 0x32,
-	0x36, 0xb5, 0xd4, 0x12, 0xe1, 0x59, 0x28, 0x24, 0x87, 0xc5, 0xf8, 0x0e, 0xc0, 0x25, 0xfd, 0x00,
-	0x6c, 0xea, 0xf5, 0xd0, 0x61, 0x4d, 0x77, 0x7e, 0x6c, 0xc1, 0x76, 0x47, 0xb0, 0x75, 0x8c, 0x2d,
-	0xbd, 0x90, 0xd2, 0x77, 0xb6, 0xa0, 0xed, 0xce, 0xd9, 0xa5, 0x05, 0xce, 0x2f, 0x2d, 0xf0, 0xfb,
-	0xa5, 0x05, 0x4e, 0xaf, 0xac, 0xd2, 0xf9, 0x95, 0x55, 0xfa, 0xe5, 0xca, 0x2a, 0x7d, 0xbc, 0x71,
-	0x63, 0x9b, 0xc9, 0x88, 0x2a, 0xae, 0x8b, 0xa6, 0xff, 0x7c, 0x27, 0x06, 0x4f, 0xc4, 0x83, 0xfa,
-	0xf6, 0xdf, 0x01, 0x00, 0x00, 0xff, 0xff, 0x87, 0xea, 0xb1, 0x20, 0xa9, 0x09, 0x00, 0x00,
+func init() { proto.RegisterFile("anryton/vesting/v2/tx.proto", fileDescriptor_71ba2acbb9e95ce9) }
+
+var fileDescriptor_71ba2acbb9e95ce9 = []byte{
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x55, 0xcf, 0x8f, 0xdb, 0x44,
+	0x14, 0xce, 0x34, 0xb4, 0xda, 0x4e, 0xe8, 0x02, 0x13, 0x0a, 0xc1, 0x14, 0x3b, 0x8d, 0x40, 0x49,
+	0xcb, 0xae, 0xa7, 0xc9, 0x56, 0x15, 0x14, 0x09, 0x69, 0x13, 0x69, 0x7b, 0x8a, 0x84, 0x22, 0xe0,
+	0xc0, 0x25, 0x9a, 0xd8, 0x53, 0xd7, 0xda, 0x64, 0xc6, 0xf2, 0x8c, 0xbd, 0xe9, 0x81, 0x4b, 0x4f,
+	0x88, 0x53, 0x25, 0xc4, 0x9d, 0x1b, 0x12, 0xbd, 0x20, 0x71, 0xe4, 0x1f, 0xe8, 0x71, 0x25, 0x2e,
+	0x70, 0xa1, 0x68, 0x17, 0x04, 0x7f, 0x06, 0xb2, 0x67, 0x3c, 0x5b, 0xb2, 0x76, 0xb3, 0x45, 0x82,
+	0x93, 0xed, 0x79, 0xdf, 0x7b, 0xef, 0xf3, 0xf7, 0x7e, 0x0c, 0x7c, 0x93, 0xb0, 0xf8, 0xbe, 0xe4,
+	0x0c, 0xa7, 0x54, 0xc8, 0x90, 0x05, 0x38, 0x1d, 0x60, 0xb9, 0x74, 0xa3, 0x98, 0x4b, 0x8e, 0x90,
+	0x36, 0xba, 0xda, 0xe8, 0xa6, 0x03, 0xcb, 0xf6, 0xb8, 0x58, 0x70, 0x81, 0x67, 0x44, 0x50, 0x9c,
+	0xf6, 0x67, 0x54, 0x92, 0x3e, 0xf6, 0x78, 0xc8, 0x94, 0x8f, 0xf5, 0xba, 0xb6, 0x2f, 0x44, 0x80,
+	0xd3, 0x7e, 0xf6, 0xd0, 0x86, 0xb7, 0xb5, 0xc1, 0x24, 0xd2, 0xbe, 0x45, 0x6c, 0x85, 0x7a, 0x35,
+	0xe0, 0x01, 0xcf, 0x5f, 0x71, 0xf6, 0xa6, 0x4f, 0xaf, 0x04, 0x9c, 0x07, 0x73, 0x8a, 0x49, 0x14,
+	0x62, 0xc2, 0x18, 0x97, 0x44, 0x86, 0x9c, 0x09, 0x6d, 0x75, 0xb4, 0x35, 0xff, 0x9a, 0x25, 0x77,
+	0xb1, 0x0c, 0x17, 0x54, 0x48, 0xb2, 0x88, 0x14, 0xa0, 0xf3, 0x23, 0x80, 0xce, 0x58, 0x04, 0xa3,
+	0x98, 0x12, 0x49, 0x47, 0x73, 0x72, 0x30, 0x23, 0xde, 0xfe, 0xa7, 0x2a, 0xef, 0xae, 0xe7, 0xf1,
+	0x84, 0x49, 0xf4, 0x0e, 0xdc, 0xbc, 0x9b, 0x30, 0x9f, 0xc6, 0x53, 0xe2, 0xfb, 0x31, 0x15, 0xa2,
+	0x05, 0xda, 0xa0, 0x77, 0x71, 0x72, 0x49, 0x9d, 0xee, 0xaa, 0x43, 0xd4, 0x85, 0x2f, 0x69, 0xc2,
+	0x06, 0x77, 0x2e, 0xc7, 0x6d, 0xea, 0xe3, 0x02, 0xe8, 0xc2, 0x26, 0x65, 0x64, 0x36, 0xa7, 0xd3,
+	0x80, 0xa7, 0x53, 0x4f, 0x27, 0x6d, 0xd5, 0xdb, 0xa0, 0xb7, 0x31, 0x79, 0x45, 0x99, 0xee, 0xf0,
+	0xb4, 0x60, 0x73, 0xbb, 0xf5, 0xd7, 0x37, 0x4e, 0xed, 0xc1, 0x9f, 0xdf, 0x5f, 0x5f, 0x8d, 0xdf,
+	0xb9, 0x06, 0xbb, 0x6b, 0xc8, 0x4f, 0xa8, 0x88, 0x38, 0x13, 0xb4, 0xf3, 0x4b, 0x1d, 0x5e, 0x1e,
+	0x8b, 0x60, 0x2f, 0x61, 0xfe, 0x7f, 0xfc, 0x7b, 0x23, 0x08, 0x85, 0x24, 0xb1, 0x9c, 0x66, 0x5a,
+	0xe7, 0x7f, 0xd5, 0x18, 0x58, 0xae, 0x2a, 0x84, 0x5b, 0x14, 0xc2, 0xfd, 0xb8, 0x28, 0xc4, 0x70,
+	0xe3, 0xf1, 0xaf, 0x4e, 0xed, 0xe1, 0x13, 0x07, 0x4c, 0x2e, 0xe6, 0x7e, 0x99, 0x05, 0x7d, 0x01,
+	0xe0, 0xe6, 0x9c, 0x7b, 0xfb, 0x49, 0x34, 0x8d, 0x68, 0x1c, 0x72, 0x5f, 0xb4, 0x5e, 0x68, 0xd7,
+	0x7b, 0x8d, 0x81, 0xed, 0xaa, 0x66, 0x39, 0x69, 0x3c, 0xd5, 0x2c, 0xee, 0x47, 0x39, 0x6c, 0xb8,
+	0x9b, 0x45, 0xfb, 0xee, 0x89, 0xf3, 0x7e, 0x10, 0xca, 0x7b, 0xc9, 0xcc, 0xf5, 0xf8, 0x02, 0xeb,
+	0xf6, 0x52, 0x8f, 0x6d, 0xe1, 0xef, 0xe3, 0x25, 0x26, 0x89, 0xbc, 0x67, 0x1a, 0x4e, 0xde, 0x8f,
+	0xa8, 0xd0, 0x11, 0xc4, 0xe4, 0x92, 0x4a, 0xac, 0x3f, 0xd1, 0x97, 0xe0, 0xe4, 0xcf, 0x0b, 0x2e,
+	0xe7, 0xff, 0x2f, 0x2e, 0x85, 0xb8, 0xfa, 0xfb, 0x76, 0x33, 0xeb, 0x83, 0x95, 0x7a, 0x75, 0x1c,
+	0xf8, 0x56, 0x69, 0x69, 0x4d, 0xf1, 0xbf, 0x06, 0xb0, 0x91, 0x35, 0x8a, 0x6e, 0x91, 0xe7, 0x28,
+	0x39, 0x51, 0x91, 0x56, 0x4b, 0xae, 0x8f, 0x0b, 0xe0, 0x55, 0xf8, 0xa2, 0x4f, 0xc5, 0x09, 0xaa,
+	0x9e, 0xa3, 0x1a, 0xd9, 0x99, 0x86, 0x94, 0x13, 0x5f, 0xc2, 0xe6, 0x53, 0xb4, 0x0a, 0xba, 0x88,
+	0xc0, 0xf3, 0xd9, 0xda, 0xc8, 0x58, 0x65, 0x32, 0xbf, 0x51, 0xc8, 0x9c, 0x2d, 0x16, 0xa3, 0xf1,
+	0x88, 0x87, 0x6c, 0x78, 0x43, 0x2b, 0xdc, 0x7b, 0xa6, 0xc2, 0x4a, 0xd2, 0xcc, 0x41, 0x4c, 0x54,
+	0xe4, 0xce, 0x23, 0x00, 0x5f, 0x1b, 0x8b, 0xe0, 0x93, 0xc8, 0x27, 0x92, 0x6a, 0xd5, 0xf6, 0x72,
+	0x72, 0x67, 0x15, 0x67, 0x0b, 0x22, 0x46, 0x0f, 0xa6, 0x2b, 0x50, 0xa5, 0xcf, 0xcb, 0x8c, 0x1e,
+	0xec, 0xad, 0x9b, 0x9e, 0x7a, 0xd9, 0xf4, 0x94, 0xeb, 0xd4, 0x86, 0x76, 0x39, 0x59, 0x53, 0xe1,
+	0x11, 0x6c, 0x65, 0x4a, 0x72, 0x96, 0xd2, 0x58, 0xae, 0x0c, 0x78, 0x49, 0x6e, 0x50, 0x96, 0xbb,
+	0xd3, 0x81, 0xed, 0xaa, 0x20, 0x45, 0xa2, 0xc1, 0x1f, 0x17, 0x60, 0x7d, 0x2c, 0x02, 0x74, 0x08,
+	0xe0, 0x95, 0x67, 0x6e, 0xcd, 0x1d, 0xf7, 0xf4, 0x15, 0xe1, 0xae, 0xd9, 0x56, 0xd6, 0x07, 0xff,
+	0xc2, 0xc9, 0x68, 0xf0, 0xe1, 0x83, 0x9f, 0x7e, 0xff, 0xea, 0xdc, 0x7b, 0xe8, 0x16, 0x2e, 0xbd,
+	0xb9, 0xb0, 0x97, 0x07, 0x31, 0x0b, 0x77, 0x6a, 0x44, 0xd1, 0x8c, 0xbf, 0x05, 0x10, 0x95, 0xec,
+	0xc7, 0x6b, 0x15, 0x9c, 0x4e, 0x43, 0xad, 0xfe, 0x99, 0xa1, 0x86, 0xf4, 0x4e, 0x4e, 0x7a, 0x1b,
+	0xbd, 0x5b, 0x41, 0x3a, 0xeb, 0x84, 0x53, 0x4c, 0x3f, 0x87, 0x1b, 0x66, 0x96, 0x9d, 0x2a, 0xc9,
+	0x34, 0xc0, 0xea, 0xae, 0x01, 0x18, 0x2a, 0xdd, 0x9c, 0xca, 0x55, 0xe4, 0x54, 0xe9, 0x57, 0xa4,
+	0x7c, 0x04, 0x60, 0xb3, 0x6c, 0x72, 0xae, 0x57, 0x64, 0x2a, 0xc1, 0x5a, 0x83, 0xb3, 0x63, 0x0d,
+	0xc1, 0x9b, 0x39, 0x41, 0x17, 0x6d, 0x55, 0x10, 0x4c, 0x72, 0x5f, 0xa3, 0x96, 0x1a, 0x22, 0xf4,
+	0x03, 0x80, 0x97, 0xcb, 0x07, 0x63, 0xab, 0x4a, 0x99, 0x32, 0xb4, 0x75, 0xf3, 0x79, 0xd0, 0x86,
+	0xf3, 0xad, 0x9c, 0xf3, 0x0d, 0xe4, 0x56, 0x89, 0xaa, 0xbc, 0x57, 0x4b, 0x3c, 0xbc, 0xf3, 0xf8,
+	0xc8, 0x06, 0x87, 0x47, 0x36, 0xf8, 0xed, 0xc8, 0x06, 0x0f, 0x8f, 0xed, 0xda, 0xe1, 0xb1, 0x5d,
+	0xfb, 0xf9, 0xd8, 0xae, 0x7d, 0xb6, 0xfd, 0xd4, 0xae, 0x2b, 0x62, 0x9a, 0xd8, 0x03, 0xbc, 0xfc,
+	0xe7, 0x4d, 0x32, 0xbb, 0x90, 0x5f, 0xb9, 0x3b, 0x7f, 0x0f, 0x00, 0xbe, 0xcc, 0x52, 0x67, 0xcf,
+	0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
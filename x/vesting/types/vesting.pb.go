@@ -50,7 +50,7 @@ type ClawbackVestingAccount struct {
 func (m *ClawbackVestingAccount) Reset()      { *m = ClawbackVestingAccount{} }
 func (*ClawbackVestingAccount) ProtoMessage() {}
 func (*ClawbackVestingAccount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8846751a735fc006, []int{0}
+	return fileDescriptor_556aa4ae9c888147, []int{0}
 }
 func (m *ClawbackVestingAccount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -100,7 +100,7 @@ func (m *ClawbackProposal) Reset()         { *m = ClawbackProposal{} }
 func (m *ClawbackProposal) String() string { return proto.CompactTextString(m) }
 func (*ClawbackProposal) ProtoMessage()    {}
 func (*ClawbackProposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_8846751a735fc006, []int{1}
+	return fileDescriptor_556aa4ae9c888147, []int{1}
 }
 func (m *ClawbackProposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -162,41 +162,41 @@ func init() {
 	proto.RegisterType((*ClawbackProposal)(nil), "anryton.vesting.v2.ClawbackProposal")
 }
 
-func init() { proto.RegisterFile("anryton/vesting/v2/vesting.proto", fileDescriptor_8846751a735fc006) }
+func init() { proto.RegisterFile("anryton/vesting/v2/vesting.proto", fileDescriptor_556aa4ae9c888147) }
 
-var fileDescriptor_8846751a735fc006 = []byte{
+var fileDescriptor_556aa4ae9c888147 = []byte{
 	// 487 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x3f, 0x6f, 0xd3, 0x40,
-	0x14, 0xf7, 0x91, 0x14, 0x9a, 0x8b, 0x1a, 0xaa, 0x23, 0x42, 0x56, 0x06, 0x3b, 0xaa, 0x40, 0x0a,
-	0x48, 0xf8, 0x54, 0x33, 0xd1, 0x2d, 0xce, 0x17, 0xa8, 0x2c, 0xc4, 0xc0, 0x62, 0x9d, 0xed, 0xab,
-	0x63, 0xc5, 0xc9, 0x59, 0xbe, 0x73, 0x28, 0xdf, 0xa0, 0xea, 0xd4, 0x11, 0x89, 0x25, 0x33, 0x9f,
-	0xa4, 0x63, 0x46, 0xa6, 0x16, 0x25, 0x0b, 0x1f, 0x03, 0xf9, 0xfe, 0xb4, 0x89, 0x10, 0x2b, 0xd3,
-	0xbd, 0xf7, 0x7b, 0x7f, 0xee, 0xf7, 0xde, 0xef, 0x0e, 0x3a, 0x7c, 0x9a, 0xa7, 0x0c, 0x2f, 0x29,
-	0x17, 0xf9, 0x22, 0xc3, 0x4b, 0xdf, 0x98, 0x5e, 0x59, 0x31, 0xc1, 0xd0, 0xb1, 0x8c, 0x7b, 0x06,
-	0x5c, 0xfa, 0x83, 0x57, 0x09, 0xe3, 0x73, 0xc6, 0x1f, 0x4b, 0x4e, 0x63, 0x2a, 0xc8, 0xe9, 0x7e,
-	0xdd, 0xa0, 0x9f, 0xb1, 0x8c, 0x49, 0x13, 0x37, 0x96, 0x46, 0xdd, 0x8c, 0xb1, 0xac, 0xa0, 0x58,
-	0x7a, 0x71, 0x7d, 0x81, 0x45, 0x3e, 0xa7, 0x5c, 0x90, 0x79, 0xa9, 0x12, 0x4e, 0xae, 0xdb, 0xf0,
-	0xe5, 0xa4, 0x20, 0x5f, 0x62, 0x92, 0xcc, 0x3e, 0xa9, 0x86, 0xe3, 0x24, 0x61, 0xf5, 0x42, 0xa0,
-	0x18, 0xf6, 0x63, 0xc2, 0x69, 0xa4, 0xef, 0x89, 0x88, 0xc2, 0x6d, 0x30, 0x04, 0xa3, 0xae, 0xff,
-	0xd6, 0x53, 0xb4, 0x1e, 0x99, 0x2a, 0x5a, 0x5e, 0x40, 0x38, 0xdd, 0xef, 0x14, 0xb4, 0xd7, 0x77,
-	0x2e, 0x08, 0x51, 0xfc, 0x57, 0x04, 0xbd, 0x86, 0xbd, 0x8b, 0x7a, 0x91, 0xd2, 0x2a, 0x22, 0x69,
-	0x5a, 0x51, 0xce, 0xed, 0x27, 0x43, 0x30, 0xea, 0x84, 0x47, 0x0a, 0x1d, 0x2b, 0x10, 0x4d, 0x20,
-	0xe4, 0x82, 0x54, 0x22, 0x6a, 0xe8, 0xdb, 0x2d, 0x49, 0x60, 0xe0, 0xa9, 0xd9, 0x3c, 0x33, 0x9b,
-	0xf7, 0xd1, 0xcc, 0x16, 0x1c, 0xde, 0xde, 0xb9, 0xd6, 0xcd, 0xbd, 0x0b, 0xc2, 0x8e, 0xac, 0x6b,
-	0x22, 0xe8, 0x0a, 0xc0, 0x5e, 0xc1, 0x92, 0x59, 0x5d, 0x46, 0x25, 0xad, 0x72, 0x96, 0x72, 0xbb,
-	0x3d, 0x6c, 0x8d, 0xba, 0xbe, 0xf3, 0xaf, 0x51, 0xce, 0x65, 0x5a, 0x30, 0x6e, 0xba, 0xfd, 0xb8,
-	0x77, 0x3f, 0x64, 0xb9, 0x98, 0xd6, 0xb1, 0x97, 0xb0, 0x39, 0xd6, 0x9a, 0xa8, 0xe3, 0x1d, 0x4f,
-	0x67, 0xf8, 0x12, 0x93, 0x5a, 0x4c, 0x1f, 0x54, 0x12, 0x5f, 0x4b, 0xca, 0x75, 0x07, 0x1e, 0x1e,
-	0xa9, 0x8b, 0xb5, 0x8b, 0xae, 0x01, 0x7c, 0x6e, 0xd6, 0x6a, 0xb8, 0x1c, 0xfc, 0x2f, 0x2e, 0x3d,
-	0x0d, 0x6b, 0xff, 0xec, 0xf0, 0x6a, 0xe5, 0x5a, 0xdf, 0x56, 0xae, 0x75, 0xf2, 0x1d, 0xc0, 0x63,
-	0xf3, 0x18, 0xce, 0x2b, 0x56, 0x32, 0x4e, 0x0a, 0xd4, 0x87, 0x07, 0x22, 0x17, 0x05, 0x95, 0xba,
-	0x77, 0x42, 0xe5, 0xa0, 0x21, 0xec, 0xa6, 0x94, 0x27, 0x55, 0x5e, 0x8a, 0x9c, 0x2d, 0xb4, 0x6a,
-	0xbb, 0x10, 0xb2, 0xe1, 0x33, 0xa3, 0x69, 0x4b, 0x46, 0x8d, 0x8b, 0x30, 0x7c, 0x91, 0x4a, 0x0a,
-	0xa4, 0x49, 0x7c, 0x50, 0xbe, 0x2d, 0xb3, 0xd0, 0x4e, 0x48, 0xcb, 0x7f, 0xd6, 0xfe, 0xbd, 0x72,
-	0xad, 0x60, 0x72, 0xbb, 0x71, 0xc0, 0x7a, 0xe3, 0x80, 0x5f, 0x1b, 0x07, 0xdc, 0x6c, 0x1d, 0x6b,
-	0xbd, 0x75, 0xac, 0x9f, 0x5b, 0xc7, 0xfa, 0xfc, 0x66, 0x67, 0x19, 0xea, 0x7b, 0xe9, 0x4f, 0xe6,
-	0xe3, 0xcb, 0xfd, 0x25, 0xc4, 0x4f, 0xe5, 0x6b, 0x79, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0xaa,
-	0xdd, 0x15, 0xe9, 0x87, 0x03, 0x00, 0x00,
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xbd, 0x6e, 0xdb, 0x3c,
+	0x14, 0x15, 0x3f, 0x3b, 0x5f, 0x63, 0x1a, 0x71, 0x0b, 0xd6, 0x28, 0x0c, 0x0f, 0x92, 0x10, 0xb4,
+	0x80, 0x51, 0x20, 0x22, 0xa2, 0x4e, 0xcd, 0x66, 0x65, 0xe8, 0x1a, 0x08, 0x45, 0x87, 0x2e, 0x02,
+	0x25, 0x31, 0x8a, 0x60, 0x59, 0x14, 0x44, 0xca, 0x4d, 0xde, 0x20, 0xc8, 0x94, 0xb1, 0x40, 0x17,
+	0xcf, 0x7d, 0x92, 0x8c, 0x1e, 0x3b, 0x25, 0x85, 0xbd, 0xf4, 0x31, 0x0a, 0xf1, 0x27, 0xb1, 0x51,
+	0x74, 0xed, 0xc4, 0x7b, 0xcf, 0xfd, 0xe1, 0xb9, 0xf7, 0x90, 0xd0, 0x25, 0x65, 0x7d, 0x25, 0x58,
+	0x89, 0x17, 0x94, 0x8b, 0xbc, 0xcc, 0xf0, 0xc2, 0x37, 0xa6, 0x57, 0xd5, 0x4c, 0x30, 0x84, 0x74,
+	0x86, 0x67, 0xe0, 0x85, 0x3f, 0x7e, 0x9d, 0x30, 0x3e, 0x67, 0xfc, 0xa9, 0xe8, 0x38, 0xa6, 0x82,
+	0x1c, 0xef, 0x56, 0x8e, 0x87, 0x19, 0xcb, 0x98, 0x34, 0x71, 0x6b, 0x69, 0xd4, 0xc9, 0x18, 0xcb,
+	0x0a, 0x8a, 0xa5, 0x17, 0x37, 0xe7, 0x58, 0xe4, 0x73, 0xca, 0x05, 0x99, 0x57, 0x2a, 0xe1, 0xf0,
+	0xa6, 0x0b, 0x5f, 0x9d, 0x16, 0xe4, 0x4b, 0x4c, 0x92, 0xd9, 0x27, 0xd5, 0x70, 0x9a, 0x24, 0xac,
+	0x29, 0x05, 0x8a, 0xe1, 0x30, 0x26, 0x9c, 0x46, 0xfa, 0x9e, 0x88, 0x28, 0x7c, 0x04, 0x5c, 0x30,
+	0xe9, 0xfb, 0x6f, 0x3d, 0x45, 0xeb, 0x89, 0xa9, 0xa2, 0xe5, 0x05, 0x84, 0xd3, 0xdd, 0x4e, 0x41,
+	0x77, 0x75, 0xef, 0x80, 0x10, 0xc5, 0x7f, 0x44, 0xd0, 0x1b, 0x38, 0x38, 0x6f, 0xca, 0x94, 0xd6,
+	0x11, 0x49, 0xd3, 0x9a, 0x72, 0x3e, 0xfa, 0xcf, 0x05, 0x93, 0x5e, 0x78, 0xa0, 0xd0, 0xa9, 0x02,
+	0xd1, 0x29, 0x84, 0x5c, 0x90, 0x5a, 0x44, 0x2d, 0xfd, 0x51, 0x47, 0x12, 0x18, 0x7b, 0x6a, 0x36,
+	0xcf, 0xcc, 0xe6, 0x7d, 0x34, 0xb3, 0x05, 0xfb, 0x77, 0xf7, 0x8e, 0x75, 0xfb, 0xe0, 0x80, 0xb0,
+	0x27, 0xeb, 0xda, 0x08, 0xba, 0x06, 0x70, 0x50, 0xb0, 0x64, 0xd6, 0x54, 0x51, 0x45, 0xeb, 0x9c,
+	0xa5, 0x7c, 0xd4, 0x75, 0x3b, 0x93, 0xbe, 0x6f, 0xff, 0x6d, 0x94, 0x33, 0x99, 0x16, 0x4c, 0xdb,
+	0x6e, 0xdf, 0x1f, 0x9c, 0xf7, 0x59, 0x2e, 0x2e, 0x9a, 0xd8, 0x4b, 0xd8, 0x1c, 0x6b, 0x4d, 0xd4,
+	0x71, 0xc4, 0xd3, 0x19, 0xbe, 0xc4, 0xa4, 0x11, 0x17, 0x8f, 0x2a, 0x89, 0xab, 0x8a, 0x72, 0xdd,
+	0x81, 0x87, 0x07, 0xea, 0x62, 0xed, 0xa2, 0x1b, 0x00, 0x9f, 0x9b, 0xb5, 0x1a, 0x2e, 0x7b, 0xff,
+	0x8a, 0xcb, 0x40, 0xc3, 0xda, 0x3f, 0xd9, 0xbf, 0x5e, 0x3a, 0xd6, 0xd7, 0xa5, 0x63, 0x1d, 0x7e,
+	0x03, 0xf0, 0x85, 0x79, 0x0c, 0x67, 0x35, 0xab, 0x18, 0x27, 0x05, 0x1a, 0xc2, 0x3d, 0x91, 0x8b,
+	0x82, 0x4a, 0xdd, 0x7b, 0xa1, 0x72, 0x90, 0x0b, 0xfb, 0x29, 0xe5, 0x49, 0x9d, 0x57, 0x22, 0x67,
+	0xa5, 0x56, 0x6d, 0x1b, 0x42, 0x23, 0xf8, 0xcc, 0x68, 0xda, 0x91, 0x51, 0xe3, 0x22, 0x0c, 0x5f,
+	0xa6, 0x92, 0x02, 0x69, 0x13, 0x1f, 0x95, 0xef, 0xca, 0x2c, 0xb4, 0x15, 0xd2, 0xf2, 0x9f, 0x74,
+	0x7f, 0x2d, 0x1d, 0x2b, 0xf8, 0x70, 0xb7, 0xb6, 0xc1, 0x6a, 0x6d, 0x83, 0x9f, 0x6b, 0x1b, 0xdc,
+	0x6e, 0x6c, 0x6b, 0xb5, 0xb1, 0xad, 0x1f, 0x1b, 0xdb, 0xfa, 0x7c, 0xb4, 0xb5, 0x0c, 0xf3, 0xc5,
+	0xcc, 0xb9, 0xf0, 0xf1, 0xe5, 0xee, 0x22, 0xe2, 0xff, 0xe5, 0x8b, 0x79, 0xf7, 0x7b, 0x00, 0x35,
+	0x64, 0x65, 0x12, 0x8f, 0x03, 0x00, 0x00,
 }
 
 func (m *ClawbackVestingAccount) Marshal() (dAtA []byte, err error) {